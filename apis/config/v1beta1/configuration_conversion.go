@@ -107,6 +107,10 @@ func Convert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(in *v1beta2.MultiKueue, ou
 	return autoConvert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(in, out, s)
 }
 
+func Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in *v1beta2.MultiKueueExternalFramework, out *MultiKueueExternalFramework, s conversionapi.Scope) error {
+	return autoConvert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in, out, s)
+}
+
 func Convert_v1beta2_ControllerMetricsCustomLabel_To_v1beta1_ControllerMetricsCustomLabel(in *v1beta2.ControllerMetricsCustomLabel, out *ControllerMetricsCustomLabel, s conversionapi.Scope) error {
 	return autoConvert_v1beta2_ControllerMetricsCustomLabel_To_v1beta1_ControllerMetricsCustomLabel(in, out, s)
 }
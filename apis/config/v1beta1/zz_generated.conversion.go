@@ -155,11 +155,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.MultiKueueExternalFramework)(nil), (*MultiKueueExternalFramework)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(a.(*v1beta2.MultiKueueExternalFramework), b.(*MultiKueueExternalFramework), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ObjectRetentionPolicies)(nil), (*v1beta2.ObjectRetentionPolicies)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ObjectRetentionPolicies_To_v1beta2_ObjectRetentionPolicies(a.(*ObjectRetentionPolicies), b.(*v1beta2.ObjectRetentionPolicies), scope)
 	}); err != nil {
@@ -260,6 +255,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.MultiKueueExternalFramework)(nil), (*MultiKueueExternalFramework)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(a.(*v1beta2.MultiKueueExternalFramework), b.(*MultiKueueExternalFramework), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.Resources)(nil), (*Resources)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_Resources_To_v1beta1_Resources(a.(*v1beta2.Resources), b.(*Resources), scope)
 	}); err != nil {
@@ -438,6 +438,7 @@ func autoConvert_v1beta2_Configuration_To_v1beta1_Configuration(in *v1beta2.Conf
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	out.ObjectRetentionPolicies = (*ObjectRetentionPolicies)(unsafe.Pointer(in.ObjectRetentionPolicies))
 	// WARNING: in.VisibilityServer requires manual conversion: does not exist in peer-type
+	// WARNING: in.SchedulingProfile requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -722,7 +723,17 @@ func autoConvert_v1beta1_MultiKueue_To_v1beta2_MultiKueue(in *MultiKueue, out *v
 	out.Origin = (*string)(unsafe.Pointer(in.Origin))
 	out.WorkerLostTimeout = (*metav1.Duration)(unsafe.Pointer(in.WorkerLostTimeout))
 	out.DispatcherName = (*string)(unsafe.Pointer(in.DispatcherName))
-	out.ExternalFrameworks = *(*[]v1beta2.MultiKueueExternalFramework)(unsafe.Pointer(&in.ExternalFrameworks))
+	if in.ExternalFrameworks != nil {
+		in, out := &in.ExternalFrameworks, &out.ExternalFrameworks
+		*out = make([]v1beta2.MultiKueueExternalFramework, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_MultiKueueExternalFramework_To_v1beta2_MultiKueueExternalFramework(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalFrameworks = nil
+	}
 	return nil
 }
 
@@ -736,9 +747,20 @@ func autoConvert_v1beta2_MultiKueue_To_v1beta1_MultiKueue(in *v1beta2.MultiKueue
 	out.Origin = (*string)(unsafe.Pointer(in.Origin))
 	out.WorkerLostTimeout = (*metav1.Duration)(unsafe.Pointer(in.WorkerLostTimeout))
 	out.DispatcherName = (*string)(unsafe.Pointer(in.DispatcherName))
-	out.ExternalFrameworks = *(*[]MultiKueueExternalFramework)(unsafe.Pointer(&in.ExternalFrameworks))
+	if in.ExternalFrameworks != nil {
+		in, out := &in.ExternalFrameworks, &out.ExternalFrameworks
+		*out = make([]MultiKueueExternalFramework, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalFrameworks = nil
+	}
 	// WARNING: in.ClusterProfile requires manual conversion: does not exist in peer-type
 	// WARNING: in.IncrementalDispatcherConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.CapacityAwareDispatcherConfig requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -754,14 +776,13 @@ func Convert_v1beta1_MultiKueueExternalFramework_To_v1beta2_MultiKueueExternalFr
 
 func autoConvert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in *v1beta2.MultiKueueExternalFramework, out *MultiKueueExternalFramework, s conversion.Scope) error {
 	out.Name = in.Name
+	// WARNING: in.ManagedByPath requires manual conversion: does not exist in peer-type
+	// WARNING: in.SuspendPath requires manual conversion: does not exist in peer-type
+	// WARNING: in.StatusPaths requires manual conversion: does not exist in peer-type
+	// WARNING: in.FinishedExpression requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework is an autogenerated conversion function.
-func Convert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in *v1beta2.MultiKueueExternalFramework, out *MultiKueueExternalFramework, s conversion.Scope) error {
-	return autoConvert_v1beta2_MultiKueueExternalFramework_To_v1beta1_MultiKueueExternalFramework(in, out, s)
-}
-
 func autoConvert_v1beta1_ObjectRetentionPolicies_To_v1beta2_ObjectRetentionPolicies(in *ObjectRetentionPolicies, out *v1beta2.ObjectRetentionPolicies, s conversion.Scope) error {
 	out.Workloads = (*v1beta2.WorkloadRetentionPolicy)(unsafe.Pointer(in.Workloads))
 	return nil
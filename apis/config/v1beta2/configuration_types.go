@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	resourcev1 "k8s.io/api/resource/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -112,6 +113,12 @@ type Configuration struct {
 	// VisibilityServer configures the visibility server.
 	// +optional
 	VisibilityServer *VisibilityServerConfiguration `json:"visibilityServer,omitempty"`
+
+	// SchedulingProfile configures the plugins run by the scheduler during
+	// the admission cycle. It requires the SchedulingFramework feature gate.
+	// When nil, the scheduler runs the default admission logic only.
+	// +optional
+	SchedulingProfile *SchedulingProfile `json:"schedulingProfile,omitempty"`
 }
 
 type ControllerManager struct {
@@ -371,6 +378,11 @@ type MultiKueue struct {
 	// Note: This field is going to be ignored when the MultiKueueIncrementalDispatcherConfig feature gate is disabled.
 	// +optional
 	IncrementalDispatcherConfig *IncrementalDispatcherConfig `json:"incrementalDispatcherConfig,omitempty"`
+
+	// CapacityAwareDispatcherConfig contains the configuration for the capacity-aware dispatcher.
+	// This field is only valid when DispatcherName is set to the capacity-aware dispatcher.
+	// +optional
+	CapacityAwareDispatcherConfig *CapacityAwareDispatcherConfig `json:"capacityAwareDispatcherConfig,omitempty"`
 }
 
 // IncrementalDispatcherConfig holds configuration for the MultiKueue Incremental Dispatcher.
@@ -382,12 +394,79 @@ type IncrementalDispatcherConfig struct {
 	StepSize *int32 `json:"stepSize,omitempty"`
 }
 
+// CapacityAwareDispatcherConfig holds configuration for the MultiKueue Capacity-Aware Dispatcher.
+type CapacityAwareDispatcherConfig struct {
+	// StepSize defines the number of the best scoring worker clusters
+	// the Capacity-Aware Dispatcher nominates in each round.
+	// Minimum value is 1. If not set, it defaults to 1.
+	// +optional
+	StepSize *int32 `json:"stepSize,omitempty"`
+
+	// Scoring defines how the worker clusters are scored.
+	// +optional
+	Scoring *CapacityAwareScoring `json:"scoring,omitempty"`
+}
+
+// CapacityAwareScoring defines the weights of the signals combined into the
+// score of a worker cluster. Each signal is scored from 0 to 100, based on the
+// status of the worker ClusterQueue which the workload would be submitted to,
+// and the score of the worker cluster is their weighted average.
+type CapacityAwareScoring struct {
+	// FreeQuotaWeight is the weight of the fraction of the workload requests
+	// which fit in the unused nominal quota of the worker ClusterQueue.
+	// Defaults to 2.
+	// +optional
+	FreeQuotaWeight *int32 `json:"freeQuotaWeight,omitempty"`
+
+	// PendingWorkloadsWeight is the weight of the number of pending workloads
+	// in the worker ClusterQueue, fewer being better.
+	// Defaults to 1.
+	// +optional
+	PendingWorkloadsWeight *int32 `json:"pendingWorkloadsWeight,omitempty"`
+
+	// FairSharingWeight is the weight of the fair sharing weighted share of
+	// the worker ClusterQueue, lower being better.
+	// Defaults to 1.
+	// +optional
+	FairSharingWeight *int32 `json:"fairSharingWeight,omitempty"`
+}
+
 // MultiKueueExternalFramework defines a framework that is not built-in.
 type MultiKueueExternalFramework struct {
 	// Name is the GVK of the resource that are
 	// managed by external controllers
 	// the expected format is `kind.version.group`.
 	Name string `json:"name"`
+
+	// ManagedByPath is the path, in dot notation, of the field which marks
+	// the resource as managed by MultiKueue in the manager cluster.
+	// The field is removed from the copies created in the worker clusters.
+	// Defaults to `spec.managedBy`.
+	// +optional
+	ManagedByPath *string `json:"managedByPath,omitempty"`
+
+	// SuspendPath is the path, in dot notation, of the boolean field
+	// suspending the resource. When set, the copies are created suspended in
+	// the worker clusters, and the status is not copied back while the resource
+	// is suspended in the manager cluster and running, but not finished, in the
+	// worker cluster.
+	// +optional
+	SuspendPath *string `json:"suspendPath,omitempty"`
+
+	// StatusPaths are the paths, in dot notation, of the status fields copied
+	// back from the worker cluster to the resource in the manager cluster.
+	// Each path must be `status` or start with `status.`.
+	// Defaults to the whole status.
+	// +optional
+	StatusPaths []string `json:"statusPaths,omitempty"`
+
+	// FinishedExpression is a CEL expression returning true when the resource
+	// in the worker cluster, available as `object`, is finished.
+	// For example: `has(object.status.completionTime)`.
+	// If the evaluation fails, for example because a field is not set yet,
+	// the resource is not considered finished.
+	// +optional
+	FinishedExpression *string `json:"finishedExpression,omitempty"`
 }
 
 const (
@@ -398,6 +477,10 @@ const (
 	// MultiKueueDispatcherModeIncremental is the name of dispatcher mode where worker clusters are incrementally added to the pool of nominated clusters.
 	// The process begins with up to 3 initial clusters and expands the pool by up to 3 clusters at a time (if fewer remain, all are added).
 	MultiKueueDispatcherModeIncremental = "kueue.x-k8s.io/multikueue-dispatcher-incremental"
+
+	// MultiKueueDispatcherModeCapacityAware is the name of dispatcher mode where worker clusters are scored based on
+	// the status of their ClusterQueues, and incrementally added to the pool of nominated clusters, the best scoring first.
+	MultiKueueDispatcherModeCapacityAware = "kueue.x-k8s.io/multikueue-dispatcher-capacity-aware"
 )
 
 type RequeuingStrategy struct {
@@ -555,6 +638,7 @@ type Integrations struct {
 	//  - "deployment"
 	//  - "statefulset"
 	//  - "leaderworkerset.x-k8s.io/leaderworkerset"
+	//  - "serving.kserve.io/inferenceservice"
 	Frameworks []string `json:"frameworks,omitempty"`
 	// List of GroupVersionKinds that are managed for Kueue by external controllers;
 	// the expected format is `Kind.version.group.com`.
@@ -822,3 +906,66 @@ type VisibilityServerConfiguration struct {
 	// +optional
 	BindPort *int32 `json:"bindPort,omitempty"`
 }
+
+// SchedulingProfile defines the plugins enabled at each extension point of
+// the admission cycle, and their arguments.
+type SchedulingProfile struct {
+	// Plugins lists the plugins enabled at each extension point.
+	// +optional
+	Plugins SchedulingPlugins `json:"plugins,omitempty"`
+
+	// PluginConfig holds the arguments passed to the plugins when they are
+	// built. A plugin without an entry receives no arguments.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	PluginConfig []PluginConfig `json:"pluginConfig,omitempty"`
+}
+
+// SchedulingPlugins lists, by name, the plugins enabled at each extension
+// point. The plugins at an extension point run in the listed order.
+type SchedulingPlugins struct {
+	// QueueSort is the plugin ordering the pending workloads within a
+	// ClusterQueue. At most one plugin can be set.
+	// Defaults to the priority and queue order timestamp ordering.
+	// +optional
+	QueueSort []string `json:"queueSort,omitempty"`
+
+	// PreFilter are the plugins checking whether a workload can be
+	// considered for admission in the current cycle, before flavors are
+	// assigned. A workload rejected by a plugin is requeued as inadmissible.
+	// +optional
+	PreFilter []string `json:"preFilter,omitempty"`
+
+	// FlavorScore are the plugins scoring the flavors which fit a podset.
+	// When several flavors are equally preferred by the flavor fungibility
+	// policy, the flavor with the highest total score is chosen.
+	// +optional
+	FlavorScore []string `json:"flavorScore,omitempty"`
+
+	// PreemptionCandidateFilter are the plugins excluding workloads from the
+	// preemption candidates of a workload.
+	// +optional
+	PreemptionCandidateFilter []string `json:"preemptionCandidateFilter,omitempty"`
+
+	// PreemptionCandidateOrder is the plugin ordering the preemption
+	// candidates of a workload. The candidates are preempted in this order
+	// until the workload fits. At most one plugin can be set.
+	// Defaults to the DefaultPreemptionOrder plugin.
+	// +optional
+	PreemptionCandidateOrder []string `json:"preemptionCandidateOrder,omitempty"`
+
+	// PostAdmit are the plugins notified once a workload is admitted.
+	// +optional
+	PostAdmit []string `json:"postAdmit,omitempty"`
+}
+
+// PluginConfig holds the arguments of a plugin.
+type PluginConfig struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+
+	// Args are the arguments of the plugin, in the format defined by it.
+	// +optional
+	Args runtime.RawExtension `json:"args,omitempty"`
+}
@@ -142,6 +142,16 @@ func SetDefaults_Configuration(cfg *Configuration) {
 		cfg.MultiKueue.IncrementalDispatcherConfig.StepSize = cmp.Or(cfg.MultiKueue.IncrementalDispatcherConfig.StepSize, new(int32(3)))
 	}
 
+	if ptr.Deref(cfg.MultiKueue.DispatcherName, "") == MultiKueueDispatcherModeCapacityAware {
+		cadc := cmp.Or(cfg.MultiKueue.CapacityAwareDispatcherConfig, &CapacityAwareDispatcherConfig{})
+		cadc.StepSize = cmp.Or(cadc.StepSize, new(int32(1)))
+		cadc.Scoring = cmp.Or(cadc.Scoring, &CapacityAwareScoring{})
+		cadc.Scoring.FreeQuotaWeight = cmp.Or(cadc.Scoring.FreeQuotaWeight, new(int32(2)))
+		cadc.Scoring.PendingWorkloadsWeight = cmp.Or(cadc.Scoring.PendingWorkloadsWeight, new(int32(1)))
+		cadc.Scoring.FairSharingWeight = cmp.Or(cadc.Scoring.FairSharingWeight, new(int32(1)))
+		cfg.MultiKueue.CapacityAwareDispatcherConfig = cadc
+	}
+
 	if afs := cfg.AdmissionFairSharing; afs != nil {
 		afs.UsageSamplingInterval.Duration = cmp.Or(afs.UsageSamplingInterval.Duration, 5*time.Minute)
 	}
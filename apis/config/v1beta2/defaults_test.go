@@ -592,6 +592,47 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				WaitForPodsReady:             defaultWaitForPodsReady,
 			},
 		},
+		"multiKueue with the capacity-aware dispatcher": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: new(false),
+				},
+				MultiKueue: &MultiKueue{
+					DispatcherName: new(MultiKueueDispatcherModeCapacityAware),
+					CapacityAwareDispatcherConfig: &CapacityAwareDispatcherConfig{
+						Scoring: &CapacityAwareScoring{
+							PendingWorkloadsWeight: new(int32(3)),
+						},
+					},
+				},
+			},
+			want: &Configuration{
+				Namespace:         new(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: new(false),
+				},
+				ClientConnection: defaultClientConnection,
+				Integrations:     defaultIntegrations,
+				MultiKueue: &MultiKueue{
+					GCInterval:        &metav1.Duration{Duration: DefaultMultiKueueGCInterval},
+					Origin:            new(DefaultMultiKueueOrigin),
+					WorkerLostTimeout: &metav1.Duration{Duration: DefaultMultiKueueWorkerLostTimeout},
+					DispatcherName:    new(MultiKueueDispatcherModeCapacityAware),
+					CapacityAwareDispatcherConfig: &CapacityAwareDispatcherConfig{
+						StepSize: new(int32(1)),
+						Scoring: &CapacityAwareScoring{
+							FreeQuotaWeight:        new(int32(2)),
+							PendingWorkloadsWeight: new(int32(3)),
+							FairSharingWeight:      new(int32(1)),
+						},
+					},
+				},
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				VisibilityServer:             defaultVisibilityServer,
+				WaitForPodsReady:             defaultWaitForPodsReady,
+			},
+		},
 		"multiKueue origin is an empty value": {
 			original: &Configuration{
 				InternalCertManagement: &InternalCertManagement{
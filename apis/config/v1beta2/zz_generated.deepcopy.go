@@ -52,6 +52,61 @@ func (in *AdmissionFairSharing) DeepCopy() *AdmissionFairSharing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityAwareDispatcherConfig) DeepCopyInto(out *CapacityAwareDispatcherConfig) {
+	*out = *in
+	if in.StepSize != nil {
+		in, out := &in.StepSize, &out.StepSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(CapacityAwareScoring)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityAwareDispatcherConfig.
+func (in *CapacityAwareDispatcherConfig) DeepCopy() *CapacityAwareDispatcherConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityAwareDispatcherConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityAwareScoring) DeepCopyInto(out *CapacityAwareScoring) {
+	*out = *in
+	if in.FreeQuotaWeight != nil {
+		in, out := &in.FreeQuotaWeight, &out.FreeQuotaWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PendingWorkloadsWeight != nil {
+		in, out := &in.PendingWorkloadsWeight, &out.PendingWorkloadsWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FairSharingWeight != nil {
+		in, out := &in.FairSharingWeight, &out.FairSharingWeight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityAwareScoring.
+func (in *CapacityAwareScoring) DeepCopy() *CapacityAwareScoring {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityAwareScoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -194,6 +249,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(VisibilityServerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SchedulingProfile != nil {
+		in, out := &in.SchedulingProfile, &out.SchedulingProfile
+		*out = new(SchedulingProfile)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
@@ -591,7 +651,9 @@ func (in *MultiKueue) DeepCopyInto(out *MultiKueue) {
 	if in.ExternalFrameworks != nil {
 		in, out := &in.ExternalFrameworks, &out.ExternalFrameworks
 		*out = make([]MultiKueueExternalFramework, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ClusterProfile != nil {
 		in, out := &in.ClusterProfile, &out.ClusterProfile
@@ -603,6 +665,11 @@ func (in *MultiKueue) DeepCopyInto(out *MultiKueue) {
 		*out = new(IncrementalDispatcherConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityAwareDispatcherConfig != nil {
+		in, out := &in.CapacityAwareDispatcherConfig, &out.CapacityAwareDispatcherConfig
+		*out = new(CapacityAwareDispatcherConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueue.
@@ -618,6 +685,26 @@ func (in *MultiKueue) DeepCopy() *MultiKueue {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueueExternalFramework) DeepCopyInto(out *MultiKueueExternalFramework) {
 	*out = *in
+	if in.ManagedByPath != nil {
+		in, out := &in.ManagedByPath, &out.ManagedByPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuspendPath != nil {
+		in, out := &in.SuspendPath, &out.SuspendPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusPaths != nil {
+		in, out := &in.StatusPaths, &out.StatusPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FinishedExpression != nil {
+		in, out := &in.FinishedExpression, &out.FinishedExpression
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiKueueExternalFramework.
@@ -650,6 +737,22 @@ func (in *ObjectRetentionPolicies) DeepCopy() *ObjectRetentionPolicies {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginConfig) DeepCopyInto(out *PluginConfig) {
+	*out = *in
+	in.Args.DeepCopyInto(&out.Args)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfig.
+func (in *PluginConfig) DeepCopy() *PluginConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequeuingStrategy) DeepCopyInto(out *RequeuingStrategy) {
 	*out = *in
@@ -751,6 +854,74 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingPlugins) DeepCopyInto(out *SchedulingPlugins) {
+	*out = *in
+	if in.QueueSort != nil {
+		in, out := &in.QueueSort, &out.QueueSort
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreFilter != nil {
+		in, out := &in.PreFilter, &out.PreFilter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FlavorScore != nil {
+		in, out := &in.FlavorScore, &out.FlavorScore
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreemptionCandidateFilter != nil {
+		in, out := &in.PreemptionCandidateFilter, &out.PreemptionCandidateFilter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreemptionCandidateOrder != nil {
+		in, out := &in.PreemptionCandidateOrder, &out.PreemptionCandidateOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostAdmit != nil {
+		in, out := &in.PostAdmit, &out.PostAdmit
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPlugins.
+func (in *SchedulingPlugins) DeepCopy() *SchedulingPlugins {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingPlugins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingProfile) DeepCopyInto(out *SchedulingProfile) {
+	*out = *in
+	in.Plugins.DeepCopyInto(&out.Plugins)
+	if in.PluginConfig != nil {
+		in, out := &in.PluginConfig, &out.PluginConfig
+		*out = make([]PluginConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingProfile.
+func (in *SchedulingProfile) DeepCopy() *SchedulingProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSOptions) DeepCopyInto(out *TLSOptions) {
 	*out = *in
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	conversionapi "k8s.io/apimachinery/pkg/conversion"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 
 	"sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -34,3 +35,7 @@ func (dst *Cohort) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*v1beta2.Cohort)
 	return Convert_v1beta2_Cohort_To_v1beta1_Cohort(src, dst, nil)
 }
+
+func Convert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(in *v1beta2.CohortSpec, out *CohortSpec, s conversionapi.Scope) error {
+	return autoConvert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(in, out, s)
+}
@@ -95,6 +95,8 @@ func Convert_v1beta1_TopologyAssignment_To_v1beta2_TopologyAssignment(in *Topolo
 }
 
 func Convert_v1beta2_TopologyAssignment_To_v1beta1_TopologyAssignment(in *v1beta2.TopologyAssignment, out *TopologyAssignment, s conversionapi.Scope) error {
+	// TopologyName is intentionally dropped during conversion to v1beta1 as it
+	// has no equivalent field.
 	out.Levels = in.Levels
 	out.Domains = make([]TopologyDomainAssignment, 0, tas.TotalDomainCount(in))
 	for req := range tas.InternalSeqFrom(in) {
@@ -105,3 +107,7 @@ func Convert_v1beta2_TopologyAssignment_To_v1beta1_TopologyAssignment(in *v1beta
 	}
 	return nil
 }
+
+func Convert_v1beta2_Admission_To_v1beta1_Admission(in *v1beta2.Admission, out *Admission, s conversionapi.Scope) error {
+	return autoConvert_v1beta2_Admission_To_v1beta1_Admission(in, out, s)
+}
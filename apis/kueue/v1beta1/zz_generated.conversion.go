@@ -44,11 +44,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Admission)(nil), (*Admission)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Admission_To_v1beta1_Admission(a.(*v1beta2.Admission), b.(*Admission), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AdmissionCheck)(nil), (*v1beta2.AdmissionCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AdmissionCheck_To_v1beta2_AdmissionCheck(a.(*AdmissionCheck), b.(*v1beta2.AdmissionCheck), scope)
 	}); err != nil {
@@ -184,11 +179,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterQueueStatus)(nil), (*ClusterQueueStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(a.(*v1beta2.ClusterQueueStatus), b.(*ClusterQueueStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Cohort)(nil), (*v1beta2.Cohort)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Cohort_To_v1beta2_Cohort(a.(*Cohort), b.(*v1beta2.Cohort), scope)
 	}); err != nil {
@@ -214,11 +204,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.CohortSpec)(nil), (*CohortSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(a.(*v1beta2.CohortSpec), b.(*CohortSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*CohortStatus)(nil), (*v1beta2.CohortStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CohortStatus_To_v1beta2_CohortStatus(a.(*CohortStatus), b.(*v1beta2.CohortStatus), scope)
 	}); err != nil {
@@ -539,11 +524,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ResourceFlavorSpec)(nil), (*ResourceFlavorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(a.(*v1beta2.ResourceFlavorSpec), b.(*ResourceFlavorSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*v1beta2.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceGroup_To_v1beta2_ResourceGroup(a.(*ResourceGroup), b.(*v1beta2.ResourceGroup), scope)
 	}); err != nil {
@@ -629,11 +609,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.TopologySpec)(nil), (*TopologySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_TopologySpec_To_v1beta1_TopologySpec(a.(*v1beta2.TopologySpec), b.(*TopologySpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*UnhealthyNode)(nil), (*v1beta2.UnhealthyNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_UnhealthyNode_To_v1beta2_UnhealthyNode(a.(*UnhealthyNode), b.(*v1beta2.UnhealthyNode), scope)
 	}); err != nil {
@@ -739,11 +714,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Admission)(nil), (*Admission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Admission_To_v1beta1_Admission(a.(*v1beta2.Admission), b.(*Admission), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ClusterQueueSpec)(nil), (*ClusterQueueSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ClusterQueueSpec_To_v1beta1_ClusterQueueSpec(a.(*v1beta2.ClusterQueueSpec), b.(*ClusterQueueSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ClusterQueueStatus)(nil), (*ClusterQueueStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(a.(*v1beta2.ClusterQueueStatus), b.(*ClusterQueueStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.CohortSpec)(nil), (*CohortSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(a.(*v1beta2.CohortSpec), b.(*CohortSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.LocalQueueStatus)(nil), (*LocalQueueStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_LocalQueueStatus_To_v1beta1_LocalQueueStatus(a.(*v1beta2.LocalQueueStatus), b.(*LocalQueueStatus), scope)
 	}); err != nil {
@@ -764,11 +754,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ResourceFlavorSpec)(nil), (*ResourceFlavorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(a.(*v1beta2.ResourceFlavorSpec), b.(*ResourceFlavorSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.TopologyAssignment)(nil), (*TopologyAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_TopologyAssignment_To_v1beta1_TopologyAssignment(a.(*v1beta2.TopologyAssignment), b.(*TopologyAssignment), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.TopologySpec)(nil), (*TopologySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_TopologySpec_To_v1beta1_TopologySpec(a.(*v1beta2.TopologySpec), b.(*TopologySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.WorkloadSpec)(nil), (*WorkloadSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_WorkloadSpec_To_v1beta1_WorkloadSpec(a.(*v1beta2.WorkloadSpec), b.(*WorkloadSpec), scope)
 	}); err != nil {
@@ -816,14 +816,10 @@ func autoConvert_v1beta2_Admission_To_v1beta1_Admission(in *v1beta2.Admission, o
 	} else {
 		out.PodSetAssignments = nil
 	}
+	// WARNING: in.Cost requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Admission_To_v1beta1_Admission is an autogenerated conversion function.
-func Convert_v1beta2_Admission_To_v1beta1_Admission(in *v1beta2.Admission, out *Admission, s conversion.Scope) error {
-	return autoConvert_v1beta2_Admission_To_v1beta1_Admission(in, out, s)
-}
-
 func autoConvert_v1beta1_AdmissionCheck_To_v1beta2_AdmissionCheck(in *AdmissionCheck, out *v1beta2.AdmissionCheck, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_AdmissionCheckSpec_To_v1beta2_AdmissionCheckSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1221,6 +1217,10 @@ func autoConvert_v1beta2_ClusterQueueSpec_To_v1beta1_ClusterQueueSpec(in *v1beta
 	out.FairSharing = (*FairSharing)(unsafe.Pointer(in.FairSharing))
 	out.AdmissionScope = (*AdmissionScope)(unsafe.Pointer(in.AdmissionScope))
 	// WARNING: in.ConcurrentAdmissionPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.QuotaSchedule requires manual conversion: does not exist in peer-type
+	// WARNING: in.Reservations requires manual conversion: does not exist in peer-type
+	// WARNING: in.TopologyDefragmentation requires manual conversion: does not exist in peer-type
+	// WARNING: in.WorkflowReservationLimit requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1260,14 +1260,12 @@ func autoConvert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in *v1
 	} else {
 		out.FairSharing = nil
 	}
+	// WARNING: in.QuotaWindow requires manual conversion: does not exist in peer-type
+	// WARNING: in.Backfill requires manual conversion: does not exist in peer-type
+	// WARNING: in.TopologyDefragmentation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus is an autogenerated conversion function.
-func Convert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in *v1beta2.ClusterQueueStatus, out *ClusterQueueStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_ClusterQueueStatus_To_v1beta1_ClusterQueueStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_Cohort_To_v1beta2_Cohort(in *Cohort, out *v1beta2.Cohort, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CohortSpec_To_v1beta2_CohortSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1358,14 +1356,10 @@ func autoConvert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(in *v1beta2.CohortSpec
 	out.ParentName = CohortReference(in.ParentName)
 	out.ResourceGroups = *(*[]ResourceGroup)(unsafe.Pointer(&in.ResourceGroups))
 	out.FairSharing = (*FairSharing)(unsafe.Pointer(in.FairSharing))
+	// WARNING: in.Reservations requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_CohortSpec_To_v1beta1_CohortSpec is an autogenerated conversion function.
-func Convert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(in *v1beta2.CohortSpec, out *CohortSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_CohortSpec_To_v1beta1_CohortSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_CohortStatus_To_v1beta2_CohortStatus(in *CohortStatus, out *v1beta2.CohortStatus, s conversion.Scope) error {
 	if in.FairSharing != nil {
 		in, out := &in.FairSharing, &out.FairSharing
@@ -1690,6 +1684,7 @@ func autoConvert_v1beta2_LocalQueueStatus_To_v1beta1_LocalQueueStatus(in *v1beta
 	out.FlavorsReservation = *(*[]LocalQueueFlavorUsage)(unsafe.Pointer(&in.FlavorsReservation))
 	// WARNING: in.FlavorsUsage requires manual conversion: does not exist in peer-type
 	out.FairSharing = (*FairSharingStatus)(unsafe.Pointer(in.FairSharing))
+	// WARNING: in.UsageAccounting requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2270,7 +2265,17 @@ func Convert_v1beta2_ResourceFlavor_To_v1beta1_ResourceFlavor(in *v1beta2.Resour
 
 func autoConvert_v1beta1_ResourceFlavorList_To_v1beta2_ResourceFlavorList(in *ResourceFlavorList, out *v1beta2.ResourceFlavorList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta2.ResourceFlavor)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.ResourceFlavor, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ResourceFlavor_To_v1beta2_ResourceFlavor(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2281,7 +2286,17 @@ func Convert_v1beta1_ResourceFlavorList_To_v1beta2_ResourceFlavorList(in *Resour
 
 func autoConvert_v1beta2_ResourceFlavorList_To_v1beta1_ResourceFlavorList(in *v1beta2.ResourceFlavorList, out *ResourceFlavorList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ResourceFlavor)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceFlavor, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ResourceFlavor_To_v1beta1_ResourceFlavor(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2306,16 +2321,13 @@ func Convert_v1beta1_ResourceFlavorSpec_To_v1beta2_ResourceFlavorSpec(in *Resour
 func autoConvert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(in *v1beta2.ResourceFlavorSpec, out *ResourceFlavorSpec, s conversion.Scope) error {
 	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
 	out.NodeTaints = *(*[]corev1.Taint)(unsafe.Pointer(&in.NodeTaints))
+	// WARNING: in.Prices requires manual conversion: does not exist in peer-type
 	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.TopologyName = (*TopologyReference)(unsafe.Pointer(in.TopologyName))
+	// WARNING: in.Topologies requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec is an autogenerated conversion function.
-func Convert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(in *v1beta2.ResourceFlavorSpec, out *ResourceFlavorSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_ResourceFlavorSpec_To_v1beta1_ResourceFlavorSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_ResourceGroup_To_v1beta2_ResourceGroup(in *ResourceGroup, out *v1beta2.ResourceGroup, s conversion.Scope) error {
 	out.CoveredResources = *(*[]corev1.ResourceName)(unsafe.Pointer(&in.CoveredResources))
 	out.Flavors = *(*[]v1beta2.FlavorQuotas)(unsafe.Pointer(&in.Flavors))
@@ -2443,6 +2455,7 @@ func autoConvert_v1beta1_TopologyAssignment_To_v1beta2_TopologyAssignment(in *To
 func autoConvert_v1beta2_TopologyAssignment_To_v1beta1_TopologyAssignment(in *v1beta2.TopologyAssignment, out *TopologyAssignment, s conversion.Scope) error {
 	out.Levels = *(*[]string)(unsafe.Pointer(&in.Levels))
 	// WARNING: in.Slices requires manual conversion: does not exist in peer-type
+	// WARNING: in.TopologyName requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2490,7 +2503,17 @@ func Convert_v1beta2_TopologyLevel_To_v1beta1_TopologyLevel(in *v1beta2.Topology
 
 func autoConvert_v1beta1_TopologyList_To_v1beta2_TopologyList(in *TopologyList, out *v1beta2.TopologyList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta2.Topology)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.Topology, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Topology_To_v1beta2_Topology(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2501,7 +2524,17 @@ func Convert_v1beta1_TopologyList_To_v1beta2_TopologyList(in *TopologyList, out
 
 func autoConvert_v1beta2_TopologyList_To_v1beta1_TopologyList(in *v1beta2.TopologyList, out *TopologyList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Topology)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Topology, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_Topology_To_v1beta1_Topology(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -2522,14 +2555,10 @@ func Convert_v1beta1_TopologySpec_To_v1beta2_TopologySpec(in *TopologySpec, out
 
 func autoConvert_v1beta2_TopologySpec_To_v1beta1_TopologySpec(in *v1beta2.TopologySpec, out *TopologySpec, s conversion.Scope) error {
 	out.Levels = *(*[]TopologyLevel)(unsafe.Pointer(&in.Levels))
+	// WARNING: in.Distances requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_TopologySpec_To_v1beta1_TopologySpec is an autogenerated conversion function.
-func Convert_v1beta2_TopologySpec_To_v1beta1_TopologySpec(in *v1beta2.TopologySpec, out *TopologySpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_TopologySpec_To_v1beta1_TopologySpec(in, out, s)
-}
-
 func autoConvert_v1beta1_UnhealthyNode_To_v1beta2_UnhealthyNode(in *UnhealthyNode, out *v1beta2.UnhealthyNode, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -2733,6 +2762,7 @@ func autoConvert_v1beta2_WorkloadSpec_To_v1beta1_WorkloadSpec(in *v1beta2.Worklo
 	out.Active = (*bool)(unsafe.Pointer(in.Active))
 	out.MaximumExecutionTimeSeconds = (*int32)(unsafe.Pointer(in.MaximumExecutionTimeSeconds))
 	// WARNING: in.PreemptionGates requires manual conversion: does not exist in peer-type
+	// WARNING: in.PodSetShapeConstraints requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2780,5 +2810,7 @@ func autoConvert_v1beta2_WorkloadStatus_To_v1beta1_WorkloadStatus(in *v1beta2.Wo
 	out.ClusterName = (*string)(unsafe.Pointer(in.ClusterName))
 	out.UnhealthyNodes = *(*[]UnhealthyNode)(unsafe.Pointer(&in.UnhealthyNodes))
 	// WARNING: in.PreemptionGates requires manual conversion: does not exist in peer-type
+	// WARNING: in.Migrations requires manual conversion: does not exist in peer-type
+	// WARNING: in.TopologyPlacementExplanations requires manual conversion: does not exist in peer-type
 	return nil
 }
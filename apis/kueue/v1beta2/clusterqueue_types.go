@@ -100,10 +100,15 @@ type ClusterQueueSpec struct {
 	// - BestEffortFIFO: workloads are ordered by creation time,
 	// however older workloads that can't be admitted will not block
 	// admitting newer workloads that fit existing quota.
+	// - Backfill: workloads are ordered by creation time. When the oldest
+	// workload can't be admitted, its start time is estimated from the
+	// maximumExecutionTimeSeconds of the admitted workloads, and newer
+	// workloads are only admitted if they are guaranteed to finish before it.
+	// This strategy requires the BackfillQueueing feature gate.
 	//
 	// +optional
 	// +kubebuilder:default=BestEffortFIFO
-	// +kubebuilder:validation:Enum=StrictFIFO;BestEffortFIFO
+	// +kubebuilder:validation:Enum=StrictFIFO;BestEffortFIFO;Backfill
 	QueueingStrategy QueueingStrategy `json:"queueingStrategy,omitempty"`
 
 	// namespaceSelector defines which namespaces are allowed to submit workloads to
@@ -160,8 +165,86 @@ type ClusterQueueSpec struct {
 	//
 	// +optional
 	ConcurrentAdmissionPolicy *ConcurrentAdmissionPolicy `json:"concurrentAdmissionPolicy,omitempty"`
+
+	// quotaSchedule defines recurring time windows during which the quotas
+	// of some flavors are overridden. Outside of the windows, the quotas
+	// defined in resourceGroups apply.
+	// This field requires the TimeWindowedQuotas feature gate.
+	//
+	// +optional
+	QuotaSchedule *QuotaSchedule `json:"quotaSchedule,omitempty"`
+
+	// reservations lists the Reservations booking quota of this
+	// ClusterQueue. During the window of a Reservation, its quantities are
+	// only available to the Workloads tagged for it.
+	// This field requires the CapacityReservations feature gate.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Reservations []ReservationReference `json:"reservations,omitempty"`
+
+	// topologyDefragmentation configures the defragmentation of the
+	// TopologyAwareScheduling flavors of the ClusterQueue. When set, Kueue
+	// periodically looks for admitted workloads whose eviction, and placement
+	// after a pending workload, would open a topology domain required by the
+	// pending workload, and publishes the plan in the status.
+	// This field requires the TASDefragmentation feature gate.
+	//
+	// +optional
+	TopologyDefragmentation *TopologyDefragmentation `json:"topologyDefragmentation,omitempty"`
+
+	// workflowReservationLimit caps the quota a workflow can reserve in this
+	// ClusterQueue with the kueue.x-k8s.io/workflow-reservation annotation
+	// of its Workloads. The Workloads reserving more than the limit for a
+	// resource, or a resource missing from the limit, are not admitted.
+	// When the field is not set, the Workloads with a workflow reservation
+	// are not admitted.
+	// This field requires the WorkflowBudgets feature gate.
+	//
+	// +optional
+	WorkflowReservationLimit corev1.ResourceList `json:"workflowReservationLimit,omitempty"`
+}
+
+// TopologyDefragmentation configures the defragmentation of the
+// TopologyAwareScheduling flavors of a ClusterQueue.
+type TopologyDefragmentation struct {
+	// policy defines whether the defragmentation plans are executed.
+	// Possible values are:
+	//
+	// - Plan: the plans are only published in the ClusterQueue status.
+	// - Execute: the workloads of the plan are evicted with the
+	//   TopologyDefragmentation reason, so that they are placed again after
+	//   the pending workload.
+	//
+	// +kubebuilder:validation:Enum=Plan;Execute
+	// +kubebuilder:default=Plan
+	// +optional
+	Policy TopologyDefragmentationPolicy `json:"policy,omitempty"`
+
+	// maxEvictions is the maximum number of workloads evicted by a plan.
+	// Only the admitted workloads of the ClusterQueue with a priority lower
+	// than the pending workload are evicted.
+	// Defaults to 4.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +kubebuilder:default=4
+	// +optional
+	MaxEvictions *int32 `json:"maxEvictions,omitempty"`
 }
 
+// TopologyDefragmentationPolicy defines whether the defragmentation plans of
+// a ClusterQueue are executed.
+type TopologyDefragmentationPolicy string
+
+const (
+	// TopologyDefragmentationPolicyPlan only publishes the plans.
+	TopologyDefragmentationPolicyPlan TopologyDefragmentationPolicy = "Plan"
+	// TopologyDefragmentationPolicyExecute evicts the workloads of the plans.
+	TopologyDefragmentationPolicyExecute TopologyDefragmentationPolicy = "Execute"
+)
+
 // AdmissionChecksStrategy defines a strategy for a AdmissionCheck.
 type AdmissionChecksStrategy struct {
 	// admissionChecks is a list of strategies for AdmissionChecks
@@ -199,6 +282,12 @@ const (
 	// however older workloads that can't be admitted will not block
 	// admitting newer workloads that fit existing quota.
 	BestEffortFIFO QueueingStrategy = "BestEffortFIFO"
+
+	// Backfill means that workloads of the same priority are ordered by creation time.
+	// Older workloads that can't be admitted reserve their expected start time,
+	// computed from the maximum execution time of the admitted workloads, and
+	// newer workloads are only admitted if they finish before that time.
+	Backfill QueueingStrategy = "Backfill"
 )
 
 type ConcurrentAdmissionPolicy struct {
@@ -347,6 +436,109 @@ type ResourceQuota struct {
 // +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
 type ResourceFlavorReference string
 
+// QuotaSchedule defines recurring time windows overriding the quotas of a
+// ClusterQueue.
+// +kubebuilder:validation:XValidation:rule="!has(self.evictionGracePeriodSeconds) || self.overQuotaPolicy == 'Evict'", message="evictionGracePeriodSeconds can only be set when overQuotaPolicy is Evict"
+type QuotaSchedule struct {
+	// timeZone is the name of the time zone, from the IANA Time Zone database,
+	// that the schedules of the windows are evaluated in.
+	// Defaults to UTC.
+	//
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// overQuotaPolicy defines what happens to the workloads admitted
+	// to the ClusterQueue when a window transition lowers the quota below
+	// the current usage. The possible values are:
+	//
+	// - `Keep` (default): the admitted workloads keep running, and new
+	//   workloads are admitted as the quota allows, including by preemption.
+	// - `Drain`: the admitted workloads keep running until they finish, and
+	//   no workload is admitted to the ClusterQueue while the usage exceeds
+	//   the quota.
+	// - `Evict`: the workloads exceeding the new quota are evicted after
+	//   evictionGracePeriodSeconds, starting from the lowest priority and,
+	//   for the same priority, the most recently admitted.
+	//
+	// +kubebuilder:validation:Enum=Keep;Drain;Evict
+	// +kubebuilder:default=Keep
+	// +optional
+	OverQuotaPolicy OverQuotaPolicy `json:"overQuotaPolicy,omitempty"`
+
+	// evictionGracePeriodSeconds is the time given to the workloads exceeding
+	// the quota to finish before they are evicted, when overQuotaPolicy is
+	// Evict. Defaults to 0, meaning that the workloads are evicted at the
+	// transition.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	EvictionGracePeriodSeconds *int32 `json:"evictionGracePeriodSeconds,omitempty"`
+
+	// windows is the list of quota windows. When several windows are active
+	// at the same time, the first one in the list applies.
+	// windows are limited to 16 elements.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Windows []QuotaWindow `json:"windows,omitempty"`
+}
+
+// QuotaWindow overrides the quotas of some flavors during a recurring time
+// window.
+type QuotaWindow struct {
+	// name of the window.
+	//
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +required
+	Name string `json:"name"`
+
+	// schedule is the start time of the window, in the standard cron format
+	// with five fields (minute, hour, day of month, month, day of week).
+	// For example, "0 20 * * 1-5" starts the window at 8 PM on week days.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +required
+	Schedule string `json:"schedule"`
+
+	// durationMinutes is how long the window lasts after each start.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	DurationMinutes int32 `json:"durationMinutes"`
+
+	// flavors are the quotas applied while the window is active. Each entry
+	// replaces the quotas of the flavor with the same name in resourceGroups,
+	// and must list the same resources as its resource group. The flavors
+	// not listed keep their quotas.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +required
+	Flavors []FlavorQuotas `json:"flavors,omitempty"`
+}
+
+// OverQuotaPolicy defines the handling of the admitted workloads exceeding
+// the quota after a quota window transition.
+type OverQuotaPolicy string
+
+const (
+	// OverQuotaPolicyKeep keeps the admitted workloads running.
+	OverQuotaPolicyKeep OverQuotaPolicy = "Keep"
+	// OverQuotaPolicyDrain keeps the admitted workloads running and stops the
+	// admission until the usage fits in the quota.
+	OverQuotaPolicyDrain OverQuotaPolicy = "Drain"
+	// OverQuotaPolicyEvict evicts the workloads exceeding the quota after the eviction grace period.
+	OverQuotaPolicyEvict OverQuotaPolicy = "Evict"
+)
+
 // ClusterQueueStatus defines the observed state of ClusterQueue
 type ClusterQueueStatus struct {
 	// conditions hold the latest available observations of the ClusterQueue
@@ -396,6 +588,150 @@ type ClusterQueueStatus struct {
 	// This is recorded only when Fair Sharing is enabled in the Kueue configuration.
 	// +optional
 	FairSharing *FairSharingStatus `json:"fairSharing,omitempty"`
+
+	// quotaWindow is the state of the quotaSchedule of the ClusterQueue.
+	// This is recorded only when the ClusterQueue has a quotaSchedule.
+	// +optional
+	QuotaWindow *ClusterQueueQuotaWindowStatus `json:"quotaWindow,omitempty"`
+
+	// backfill is the start time reserved for the workload blocking the
+	// head of the ClusterQueue.
+	// This is recorded only when the queueingStrategy is Backfill and the
+	// head of the ClusterQueue can't be admitted.
+	// +optional
+	Backfill *ClusterQueueBackfillStatus `json:"backfill,omitempty"`
+
+	// topologyDefragmentation is the defragmentation plan opening a topology
+	// domain for a pending workload of the ClusterQueue.
+	// This is recorded only when the ClusterQueue has a topologyDefragmentation
+	// and a plan is found.
+	// +optional
+	TopologyDefragmentation *ClusterQueueTopologyDefragmentationStatus `json:"topologyDefragmentation,omitempty"`
+}
+
+// ClusterQueueTopologyDefragmentationStatus is a defragmentation plan of the
+// TopologyAwareScheduling flavors of a ClusterQueue.
+type ClusterQueueTopologyDefragmentationStatus struct {
+	// workload is the pending workload for which the plan opens a topology
+	// domain.
+	// +required
+	Workload TopologyDefragmentationWorkloadReference `json:"workload"`
+
+	// podSet is the name of the PodSet of the workload which requires the
+	// topology domain.
+	// +required
+	PodSet PodSetReference `json:"podSet"`
+
+	// flavor is the name of the ResourceFlavor of the topology domain.
+	// +required
+	Flavor ResourceFlavorReference `json:"flavor"`
+
+	// levels are the node labels of the topology levels, down to the level
+	// of the topology domain required by the PodSet.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Levels []string `json:"levels,omitempty"`
+
+	// domain are the values of the node labels of the levels identifying the
+	// topology domain opened by the plan.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Domain []string `json:"domain,omitempty"`
+
+	// evictions are the admitted workloads whose eviction opens the topology
+	// domain, and which can be placed again after the pending workload.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Evictions []TopologyDefragmentationWorkloadReference `json:"evictions,omitempty"`
+
+	// state of the plan. Possible values are:
+	//
+	// - Planned: the plan is published, but the workloads aren't evicted.
+	// - Executed: the workloads of the plan were evicted.
+	//
+	// +kubebuilder:validation:Enum=Planned;Executed
+	// +required
+	State TopologyDefragmentationState `json:"state"`
+
+	// lastTransitionTime is the last time the plan, or its state, changed.
+	// +required
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// TopologyDefragmentationWorkloadReference identifies a workload of a
+// defragmentation plan.
+type TopologyDefragmentationWorkloadReference struct {
+	// name of the workload.
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
+
+	// namespace of the workload.
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Namespace string `json:"namespace"`
+}
+
+// TopologyDefragmentationState is the state of a defragmentation plan.
+type TopologyDefragmentationState string
+
+const (
+	// TopologyDefragmentationPlanned means that the plan is published, but
+	// the workloads aren't evicted.
+	TopologyDefragmentationPlanned TopologyDefragmentationState = "Planned"
+	// TopologyDefragmentationExecuted means that the workloads of the plan
+	// were evicted.
+	TopologyDefragmentationExecuted TopologyDefragmentationState = "Executed"
+)
+
+// ClusterQueueBackfillStatus is the state of the Backfill queueing strategy
+// of a ClusterQueue.
+type ClusterQueueBackfillStatus struct {
+	// workload is the pending workload at the head of the ClusterQueue
+	// which can't be admitted.
+	// +required
+	Workload BackfillWorkloadReference `json:"workload"`
+
+	// shadowTime is the expected start time of the workload, computed from
+	// the maximumExecutionTimeSeconds of the admitted workloads. Workloads
+	// behind it are only admitted if they finish before shadowTime.
+	// It is unset when the admitted workloads don't free enough quota
+	// before their maximum execution time, in which case no workload is
+	// admitted behind it.
+	// +optional
+	ShadowTime *metav1.Time `json:"shadowTime,omitempty"`
+}
+
+// BackfillWorkloadReference identifies the workload blocking the head of a
+// ClusterQueue.
+type BackfillWorkloadReference struct {
+	// name of the workload.
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Name string `json:"name"`
+
+	// namespace of the workload.
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Namespace string `json:"namespace"`
+}
+
+// ClusterQueueQuotaWindowStatus is the state of the quotaSchedule of a ClusterQueue.
+type ClusterQueueQuotaWindowStatus struct {
+	// active is the name of the quota window currently applied. It is empty
+	// when no window is active and the quotas of resourceGroups apply.
+	// +optional
+	Active string `json:"active,omitempty"`
+
+	// lastTransitionTime is the last time the applied quotas changed.
+	// +required
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
 }
 
 type FlavorUsage struct {
@@ -448,6 +784,7 @@ type FlavorFungibilityPreference string
 const (
 	BorrowingOverPreemption FlavorFungibilityPreference = "BorrowingOverPreemption"
 	PreemptionOverBorrowing FlavorFungibilityPreference = "PreemptionOverBorrowing"
+	LowestCost              FlavorFungibilityPreference = "LowestCost"
 )
 
 // FlavorFungibility determines whether a workload should try the next flavor
@@ -487,8 +824,16 @@ type FlavorFungibility struct {
 	// when such a choice is possible.  More technically it optimizes the preemption mode
 	// (reclaim over preemption within ClusterQueue), and solves tie-breaks by minimizing
 	// the borrowing distance in the cohort tree.
+	// - `LowestCost`: prefer the cheapest flavor, according to the prices of the
+	// ResourceFlavors, among the flavors in which the workload fits without
+	// preemption, or else among the flavors requiring preemption. Tie-breaks are
+	// solved as with `BorrowingOverPreemption`. This value requires the FlavorCost
+	// feature gate to be enabled.
+	// The flavors of all the PodSets and resource groups are chosen together,
+	// so that the combination with the lowest total cost for the workload is
+	// assigned.
 	//
-	// +kubebuilder:validation:Enum={BorrowingOverPreemption,PreemptionOverBorrowing}
+	// +kubebuilder:validation:Enum={BorrowingOverPreemption,PreemptionOverBorrowing,LowestCost}
 	// +optional
 	Preference *FlavorFungibilityPreference `json:"preference,omitempty"`
 }
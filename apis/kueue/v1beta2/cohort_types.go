@@ -69,6 +69,16 @@ type CohortSpec struct {
 	// if FairSharing is enabled in the Kueue configuration.
 	// +optional
 	FairSharing *FairSharing `json:"fairSharing,omitempty"`
+
+	// reservations lists the Reservations booking quota of this Cohort
+	// subtree. During the window of a Reservation, its quantities are only
+	// available to the Workloads tagged for it.
+	// This field requires the CapacityReservations feature gate.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Reservations []ReservationReference `json:"reservations,omitempty"`
 }
 
 // CohortStatus defines the observed state of Cohort.
@@ -27,6 +27,34 @@ const (
 	ElasticJobSchedulingGate = "kueue.x-k8s.io/elastic-job"
 )
 
+const (
+	// WorkflowLabel is the label set on a Job, and propagated to its Workload,
+	// to group the Workloads of the steps of a workflow. The value is the name
+	// of the workflow, unique in the namespace.
+	//
+	// This label is alpha-level for the WorkflowBudgets feature gate.
+	WorkflowLabel = "kueue.x-k8s.io/workflow"
+
+	// WorkflowBudgetAnnotation is the annotation set on the Jobs of a
+	// workflow, and propagated to their Workloads, to cap the total requests
+	// of the admitted Workloads of the workflow. The value is a comma
+	// separated list of resource quantities, like "cpu=8,memory=32Gi".
+	//
+	// This annotation is alpha-level for the WorkflowBudgets feature gate.
+	WorkflowBudgetAnnotation = "kueue.x-k8s.io/workflow-budget"
+
+	// WorkflowReservationAnnotation is the annotation set on the Jobs of a
+	// workflow, and propagated to their Workloads, to reserve quota in their
+	// ClusterQueue for the steps of the workflow, typically the ones of its
+	// critical path. The value has the format of WorkflowBudgetAnnotation.
+	// The reserved quota is kept while the workflow has admitted Workloads,
+	// and for a grace period after the last one finishes. The reservation is
+	// capped by the workflowReservationLimit of the ClusterQueue.
+	//
+	// This annotation is alpha-level for the WorkflowBudgets feature gate.
+	WorkflowReservationAnnotation = "kueue.x-k8s.io/workflow-reservation"
+)
+
 type StopPolicy string
 
 const (
@@ -41,9 +41,11 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AdmissionCheck{}, &AdmissionCheckList{},
 		&ClusterQueue{}, &ClusterQueueList{},
 		&Cohort{}, &CohortList{},
+		&JobIntegration{}, &JobIntegrationList{},
 		&LocalQueue{}, &LocalQueueList{},
 		&MultiKueueConfig{}, &MultiKueueConfigList{}, &MultiKueueCluster{}, &MultiKueueClusterList{},
 		&ProvisioningRequestConfig{}, &ProvisioningRequestConfigList{},
+		&Reservation{}, &ReservationList{},
 		&ResourceFlavor{}, &ResourceFlavorList{},
 		&Topology{}, &TopologyList{},
 		&Workload{}, &WorkloadList{},
@@ -0,0 +1,207 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// JobIntegrationActive indicates whether the jobs of the kind described
+	// by the JobIntegration are managed by Kueue.
+	JobIntegrationActive = "Active"
+
+	// JobIntegrationReasonRegistered is the reason of the Active condition
+	// when the controller and the webhook for the kind are registered.
+	JobIntegrationReasonRegistered = "Registered"
+
+	// JobIntegrationReasonInvalidSpec is the reason of the Active condition
+	// when a path or an expression of the JobIntegration can't be compiled.
+	JobIntegrationReasonInvalidSpec = "InvalidSpec"
+
+	// JobIntegrationReasonKindConflict is the reason of the Active condition
+	// when the kind is already managed by a built-in integration or another
+	// JobIntegration.
+	JobIntegrationReasonKindConflict = "KindConflict"
+
+	// JobIntegrationReasonKindNotFound is the reason of the Active condition
+	// when the kind is not served by the API server.
+	JobIntegrationReasonKindNotFound = "KindNotFound"
+)
+
+// JobIntegrationSpec defines how Kueue manages the jobs of a kind which has
+// no built-in integration.
+//
+// The paths are in dot notation, like `spec.template`, and relative to the
+// job. The expressions are CEL expressions evaluated against the job,
+// available as `object`.
+type JobIntegrationSpec struct {
+	// jobKind is the kind of the jobs, in the format `Kind.version.group`,
+	// like `TrainingRun.v1.example.com`.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="field is immutable"
+	JobKind string `json:"jobKind"`
+
+	// podSets describe the pod templates of the jobs.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	PodSets []JobIntegrationPodSet `json:"podSets"`
+
+	// suspendPath is the path of the boolean field suspending the job,
+	// like `spec.suspend`.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	SuspendPath string `json:"suspendPath"`
+
+	// priorityClassPath is the path of the field holding the name of the
+	// priority class of the job. When not set, the priority class is taken
+	// from the pod templates.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	PriorityClassPath *string `json:"priorityClassPath,omitempty"`
+
+	// podLabelSelectorPath is the path of the label selector, like
+	// `spec.selector`, matching the pods of the job.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	PodLabelSelectorPath *string `json:"podLabelSelectorPath,omitempty"`
+
+	// finishedExpression returns true when the job is finished, either
+	// successfully or not.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	FinishedExpression string `json:"finishedExpression"`
+
+	// succeededExpression returns true when the finished job succeeded.
+	// When not set, finished jobs are considered succeeded.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	SucceededExpression *string `json:"succeededExpression,omitempty"`
+
+	// activeExpression returns true while pods of the job are running. Kueue
+	// waits for a suspended job to be inactive before releasing its quota.
+	// When not set, jobs are considered inactive.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	ActiveExpression *string `json:"activeExpression,omitempty"`
+
+	// podsReadyExpression returns true when all the pods of the job are
+	// ready, for waitForPodsReady. When not set, the pods are considered
+	// ready.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	PodsReadyExpression *string `json:"podsReadyExpression,omitempty"`
+}
+
+// JobIntegrationPodSet describes a pod template of the jobs.
+type JobIntegrationPodSet struct {
+	// name is the name of the PodSet of the Workloads.
+	//
+	// +required
+	Name PodSetReference `json:"name"`
+
+	// templatePath is the path of the pod template, like `spec.template`.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	TemplatePath string `json:"templatePath"`
+
+	// countPath is the path of the integer field holding the number of
+	// pods created from the template, like `spec.replicas`. When not set,
+	// one pod is created from the template.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	CountPath *string `json:"countPath,omitempty"`
+
+	// reclaimableCountExpression returns the number of pods created from
+	// the template which finished successfully and whose quota can be
+	// released before the job finishes, like `object.status.succeeded`.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	ReclaimableCountExpression *string `json:"reclaimableCountExpression,omitempty"`
+}
+
+// JobIntegrationStatus defines the observed state of JobIntegration
+type JobIntegrationStatus struct {
+	// conditions hold the latest available observations of the
+	// JobIntegration current state.
+	//
+	// The Active condition is True while the jobs of the kind are managed
+	// by Kueue.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +kubebuilder:validation:MaxItems=16
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Job Kind",JSONPath=".spec.jobKind",type=string,description="Kind of the jobs"
+// +kubebuilder:printcolumn:name="Active",JSONPath=".status.conditions[?(@.type=='Active')].status",type=string,description="Whether the jobs are managed by Kueue"
+
+// JobIntegration is the Schema for the jobintegrations API. A JobIntegration
+// lets Kueue manage the jobs of a kind without a built-in integration, by
+// describing where the fields Kueue relies on are found in the jobs.
+type JobIntegration struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the metadata of the JobIntegration.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the specification of the JobIntegration.
+	// +optional
+	Spec JobIntegrationSpec `json:"spec"`
+	// status is the status of the JobIntegration.
+	// +optional
+	Status JobIntegrationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobIntegrationList contains a list of JobIntegration
+type JobIntegrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JobIntegration `json:"items"`
+}
@@ -119,6 +119,12 @@ type LocalQueueStatus struct {
 	// fairSharing contains the information about the current status of fair sharing.
 	// +optional
 	FairSharing *LocalQueueFairSharingStatus `json:"fairSharing,omitempty"`
+
+	// usageAccounting holds the quota reserved and used by the workloads
+	// assigned to this LocalQueue, accumulated over time.
+	// This field requires the UsageAccounting feature gate to be enabled.
+	// +optional
+	UsageAccounting *LocalQueueUsageAccounting `json:"usageAccounting,omitempty"`
 }
 
 // LocalQueueFairSharingStatus contains the information about the current status of Fair Sharing.
@@ -179,6 +185,75 @@ type LocalQueueResourceUsage struct {
 	Total resource.Quantity `json:"total,omitempty"`
 }
 
+// LocalQueueUsageAccounting holds the quota reserved and used by the workloads
+// of a LocalQueue, accumulated over time.
+type LocalQueueUsageAccounting struct {
+	// lastUpdateTime is the time up to which the usage is accounted.
+	// +required
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+
+	// resources lists the accumulated usage, by ClusterQueue, flavor and resource.
+	// +listType=map
+	// +listMapKey=clusterQueue
+	// +listMapKey=flavor
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=256
+	// +optional
+	Resources []ResourceUsageAccounting `json:"resources,omitempty"`
+
+	// periods lists the usage accumulated during each calendar month, in UTC,
+	// for the last 12 months including the current one, ordered by start.
+	// The months without usage are omitted.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=12
+	// +optional
+	Periods []UsageAccountingPeriod `json:"periods,omitempty"`
+}
+
+// UsageAccountingPeriod is the usage accumulated during a calendar month.
+type UsageAccountingPeriod struct {
+	// start is the start of the month, in UTC.
+	// +required
+	Start metav1.Time `json:"start"`
+
+	// resources lists the usage accumulated during the month, by
+	// ClusterQueue, flavor and resource.
+	// +listType=map
+	// +listMapKey=clusterQueue
+	// +listMapKey=flavor
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=256
+	// +optional
+	Resources []ResourceUsageAccounting `json:"resources,omitempty"`
+}
+
+// ResourceUsageAccounting is the usage of a resource in a flavor, accumulated
+// over time. The usage is measured in resource-seconds: one GPU reserved for
+// one hour accounts for 3600.
+type ResourceUsageAccounting struct {
+	// clusterQueue is the ClusterQueue in which the quota was reserved.
+	// +required
+	ClusterQueue ClusterQueueReference `json:"clusterQueue"`
+
+	// flavor is the flavor in which the quota was reserved.
+	// +required
+	Flavor ResourceFlavorReference `json:"flavor"`
+
+	// name of the resource.
+	// +required
+	Name corev1.ResourceName `json:"name"`
+
+	// reserved is the quota reserved by the workloads, multiplied by the
+	// time, in seconds, during which it was reserved.
+	// +required
+	Reserved resource.Quantity `json:"reserved"`
+
+	// used is the quota of the admitted workloads, multiplied by the time,
+	// in seconds, during which they were admitted.
+	// +required
+	Used resource.Quantity `json:"used"`
+}
+
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
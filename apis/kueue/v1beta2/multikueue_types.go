@@ -32,6 +32,12 @@ const (
 	// the management cluster on remote objects.
 	MultiKueueOriginUIDAnnotation = "kueue.x-k8s.io/multikueue-origin-uid"
 
+	// MultiKueueMigrateAnnotation is set on a workload admitted on a MultiKueue
+	// worker cluster to migrate it to another worker cluster. The value is
+	// recorded as the message of the migration.
+	// Requires enabling the MultiKueueWorkloadMigration feature gate.
+	MultiKueueMigrateAnnotation = "kueue.x-k8s.io/multikueue-migrate"
+
 	// MultiKueueControllerName is the name used by the MultiKueue
 	// admission check controller.
 	MultiKueueControllerName = "kueue.x-k8s.io/multikueue"
@@ -53,6 +59,11 @@ const (
 	// SecretLocationType is the name of the secret inside the namespace in which the kueue controller
 	// manager is running. The config should be stored in the "kubeconfig" key.
 	SecretLocationType LocationType = "Secret"
+
+	// LoopbackLocationType is the name of an in-process worker cluster registered
+	// when setting up the MultiKueue controllers, for example an envtest API server
+	// or a fake client in the binary running the integration tests.
+	LoopbackLocationType LocationType = "Loopback"
 )
 
 type KubeConfig struct {
@@ -60,6 +71,9 @@ type KubeConfig struct {
 	//
 	// If LocationType is Secret then Location is the name of the secret inside the namespace in
 	// which the kueue controller manager is running. The config should be stored in the "kubeconfig" key.
+	//
+	// If LocationType is Loopback then Location is the name of an in-process worker cluster,
+	// registered when setting up the MultiKueue controllers.
 	// +kubebuilder:validation:MaxLength=256
 	// +kubebuilder:validation:MinLength=1
 	// +required
@@ -69,7 +83,7 @@ type KubeConfig struct {
 	//
 	// +kubebuilder:default=Secret
 	// +optional
-	// +kubebuilder:validation:Enum=Secret;Path
+	// +kubebuilder:validation:Enum=Secret;Path;Loopback
 	LocationType LocationType `json:"locationType,omitempty"`
 }
 
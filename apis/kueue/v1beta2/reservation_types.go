@@ -0,0 +1,169 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ReservationLabel is the label set on a Job, and propagated to its
+	// Workload, to tag the Workload for a Reservation. The value is the name
+	// of the Reservation. Only tagged Workloads can consume the quota booked
+	// by the Reservation.
+	//
+	// This label is alpha-level for the CapacityReservations feature gate.
+	ReservationLabel = "kueue.x-k8s.io/reservation"
+
+	// ReservationActive indicates whether the Reservation window is open.
+	ReservationActive = "Active"
+
+	// ReservationPending is the reason of the Active condition when the
+	// Reservation window has not started yet.
+	ReservationPending = "Pending"
+
+	// ReservationStarted is the reason of the Active condition when the
+	// Reservation window is open.
+	ReservationStarted = "Started"
+
+	// ReservationExpired is the reason of the Active condition when the
+	// Reservation window has ended.
+	ReservationExpired = "Expired"
+)
+
+// ReservationReference is the name of the Reservation.
+// +kubebuilder:validation:MaxLength=253
+// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
+type ReservationReference string
+
+// ReservationSpec defines the desired state of Reservation
+// +kubebuilder:validation:XValidation:rule="self.endTime > self.startTime",message="endTime must be after startTime"
+type ReservationSpec struct {
+	// startTime is the time at which the reserved quota starts being
+	// booked for the Workloads tagged for the Reservation.
+	//
+	// Before startTime, Workloads that are not tagged for the Reservation
+	// are not admitted if their maximumExecutionTimeSeconds is unset or
+	// would make them run past startTime.
+	//
+	// +required
+	StartTime metav1.Time `json:"startTime"`
+
+	// endTime is the time at which the reserved quota is released.
+	//
+	// +required
+	EndTime metav1.Time `json:"endTime"`
+
+	// flavors lists the quantities of the resources reserved in each
+	// flavor. The flavors and resources should be covered by the quotas of
+	// the ClusterQueue or Cohort referencing the Reservation.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Flavors []ReservationFlavor `json:"flavors"`
+}
+
+// ReservationFlavor defines the quantities reserved in a flavor.
+type ReservationFlavor struct {
+	// name of this flavor. The name should match the .metadata.name of a
+	// ResourceFlavor.
+	//
+	// +required
+	Name ResourceFlavorReference `json:"name"`
+
+	// resources lists the quantities reserved in this flavor.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Resources []ReservationResource `json:"resources"`
+}
+
+// ReservationResource defines the quantity reserved of a resource.
+type ReservationResource struct {
+	// name of this resource.
+	//
+	// +required
+	Name corev1.ResourceName `json:"name"`
+
+	// quantity of this resource booked for the Workloads tagged for the
+	// Reservation.
+	//
+	// +required
+	Quantity resource.Quantity `json:"quantity"`
+}
+
+// ReservationStatus defines the observed state of Reservation
+type ReservationStatus struct {
+	// conditions hold the latest available observations of the Reservation
+	// current state.
+	//
+	// The Active condition is True while the Reservation window is open. Its
+	// reason is Pending before the window starts and Expired after the
+	// window ends.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +kubebuilder:validation:MaxItems=16
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,shortName={resv}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Start",JSONPath=".spec.startTime",type=string,format=date-time,description="Start of the reservation window"
+// +kubebuilder:printcolumn:name="End",JSONPath=".spec.endTime",type=string,format=date-time,description="End of the reservation window"
+// +kubebuilder:printcolumn:name="Active",JSONPath=".status.conditions[?(@.type=='Active')].status",type=string,description="Whether the reservation window is open"
+
+// Reservation is the Schema for the reservations API. A Reservation books
+// quota of the ClusterQueues or Cohorts referencing it for the Workloads
+// tagged with the kueue.x-k8s.io/reservation label during a time window.
+type Reservation struct {
+	metav1.TypeMeta `json:",inline"`
+	// metadata is the metadata of the Reservation.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the specification of the Reservation.
+	// +optional
+	Spec ReservationSpec `json:"spec"`
+	// status is the status of the Reservation.
+	// +optional
+	Status ReservationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReservationList contains a list of Reservation
+type ReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Reservation `json:"items"`
+}
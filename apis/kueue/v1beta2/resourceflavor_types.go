@@ -47,6 +47,8 @@ type TopologyReference string
 // ResourceFlavorSpec defines the desired state of the ResourceFlavor
 // +kubebuilder:validation:XValidation:rule="!has(self.topologyName) || self.nodeLabels.size() >= 1", message="at least one nodeLabel is required when topology is set"
 // +kubebuilder:validation:XValidation:rule="!has(oldSelf.topologyName) || (has(self.topologyName) && self.topologyName == oldSelf.topologyName)", message="topologyName is immutable when topologyName is set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.topologyName) && has(self.topologies))", message="topologyName and topologies are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.topologies) || (has(self.topologies) && self.topologies == oldSelf.topologies)", message="topologies is immutable when topologies is set"
 type ResourceFlavorSpec struct {
 	// nodeLabels are labels that associate the ResourceFlavor with Nodes that
 	// have the same labels.
@@ -83,6 +85,21 @@ type ResourceFlavorSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self.all(x, x.effect in ['NoSchedule', 'PreferNoSchedule', 'NoExecute'])", message="supported taint effect values: 'NoSchedule', 'PreferNoSchedule', 'NoExecute'"
 	NodeTaints []corev1.Taint `json:"nodeTaints,omitempty"`
 
+	// prices are the prices of one unit of each resource in this flavor, for
+	// example one CPU or one byte of memory, in an arbitrary currency shared by
+	// all the ResourceFlavors. Resources without a price are free.
+	// The prices are used to choose the flavors when the flavorFungibility
+	// preference of a ClusterQueue is LowestCost, and to compute the cost of
+	// the admitted Workloads.
+	//
+	// prices can be up to 64 elements.
+	// This field requires the FlavorCost feature gate to be enabled.
+	//
+	// +optional
+	// +mapType=atomic
+	// +kubebuilder:validation:MaxProperties=64
+	Prices corev1.ResourceList `json:"prices,omitempty"`
+
 	// tolerations are extra tolerations that will be added to the pods admitted in
 	// the quota associated with this resource flavor.
 	//
@@ -107,6 +124,43 @@ type ResourceFlavorSpec struct {
 	//
 	// +optional
 	TopologyName *TopologyReference `json:"topologyName,omitempty"`
+
+	// topologies indicates the topologies for a TAS ResourceFlavor whose nodes
+	// belong to several topologies with different levels, for example TPU
+	// slices and GPU racks. Each topology is scraped from the nodes matching
+	// both the Resource Flavor node labels and the node labels of the topology.
+	// When computing the topology assignment of a PodSet, the topologies are
+	// tried in order, and the topology assignment records the one used.
+	// topologies is mutually exclusive with topologyName.
+	//
+	// topologies can be up to 8 elements.
+	// This field requires the TASMultiTopologyFlavors feature gate to be enabled.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Topologies []FlavorTopology `json:"topologies,omitempty"`
+}
+
+// FlavorTopology is one of the topologies of a ResourceFlavor spanning several
+// topologies.
+type FlavorTopology struct {
+	// name is the name of the Topology.
+	//
+	// +required
+	Name TopologyReference `json:"name,omitempty"`
+
+	// nodeLabels are labels that select, among the Nodes associated with the
+	// ResourceFlavor, the Nodes which belong to this topology.
+	//
+	// nodeLabels can be up to 8 elements.
+	// +required
+	// +mapType=atomic
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:MaxProperties=8
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -105,6 +105,7 @@ const (
 )
 
 // TopologySpec defines the desired state of Topology
+// +kubebuilder:validation:XValidation:rule="!has(self.distances) || self.distances.all(d, self.levels.exists(l, l.nodeLabel == d.nodeLabel))",message="distances must refer to the levels of the topology"
 type TopologySpec struct {
 	// levels define the levels of topology.
 	//
@@ -116,6 +117,70 @@ type TopologySpec struct {
 	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, size(self.filter(j, j == i)) > 1)) == 0",message="must be unique"
 	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, i.nodeLabel == 'kubernetes.io/hostname')) == 0 || self[size(self) - 1].nodeLabel == 'kubernetes.io/hostname'",message="the kubernetes.io/hostname label can only be used at the lowest level of topology"
 	Levels []TopologyLevel `json:"levels,omitempty"`
+
+	// distances define the network distances between the topology domains of
+	// the levels, e.g. the number of network hops between the blocks.
+	//
+	// When the pods of a PodSet cannot fit within a single topology domain,
+	// Topology Aware Scheduling places them on the set of domains with the
+	// lowest total distance between them, rather than on the domains with the
+	// most free capacity.
+	//
+	// This field is alpha-level and is honored only when the TASNetworkDistance
+	// feature gate is enabled.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=nodeLabel
+	// +kubebuilder:validation:MaxItems=16
+	Distances []TopologyLevelDistances `json:"distances,omitempty"`
+}
+
+// TopologyLevelDistances defines the network distances between the topology
+// domains of a level.
+type TopologyLevelDistances struct {
+	// nodeLabel indicates the name of the node label of the topology level.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$`
+	NodeLabel string `json:"nodeLabel,omitempty"`
+
+	// domains define the distances between pairs of domains of the level. The
+	// distances are symmetric. The distance between two domains which are not
+	// listed is the largest distance listed for the level.
+	//
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1024
+	Domains []TopologyDomainDistance `json:"domains,omitempty"`
+}
+
+// TopologyDomainDistance defines the network distance between two topology
+// domains of a level, identified by the values of the node label of the level.
+// +kubebuilder:validation:XValidation:rule="self.from != self.to",message="from and to must be different domains"
+type TopologyDomainDistance struct {
+	// from is the value of the node label of the first domain.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	From string `json:"from,omitempty"`
+
+	// to is the value of the node label of the second domain.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	To string `json:"to,omitempty"`
+
+	// distance is the network distance between the domains.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	Distance int32 `json:"distance"`
 }
 
 // TopologyLevel defines the desired state of TopologyLevel
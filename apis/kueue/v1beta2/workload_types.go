@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -84,6 +85,51 @@ type WorkloadSpec struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +optional
 	PreemptionGates []PreemptionGate `json:"preemptionGates,omitempty"`
+
+	// podSetShapeConstraints keep the counts of coupled podSets proportional
+	// when the workload is partially admitted, for example 8 workers per
+	// parameter server. Each constraint sets the count of a podSet to a
+	// multiple of the count of another podSet.
+	//
+	// The counts of the podSets must satisfy the constraints. The minCount of
+	// a constrained podSet and the maxCount of the constraint are optional and
+	// further bound its admitted count.
+	// podSetShapeConstraints cannot be changed while .status.admission is not null.
+	//
+	// This is an alpha field and requires enabling PodSetShapeConstraints feature gate.
+	//
+	// +listType=map
+	// +listMapKey=podSet
+	// +kubebuilder:validation:MaxItems=17
+	// +optional
+	PodSetShapeConstraints []PodSetShapeConstraint `json:"podSetShapeConstraints,omitempty"`
+}
+
+// PodSetShapeConstraint sets the count of a podSet to a multiple of the count
+// of another podSet.
+type PodSetShapeConstraint struct {
+	// podSet is the name of the constrained podSet.
+	// +required
+	PodSet PodSetReference `json:"podSet"`
+
+	// relativeTo is the name of the podSet whose count the count of podSet
+	// is a multiple of.
+	// +required
+	RelativeTo PodSetReference `json:"relativeTo"`
+
+	// ratio is the number of pods of podSet per pod of relativeTo.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	Ratio int32 `json:"ratio"`
+
+	// maxCount is the maximum count of podSet the workload is admitted
+	// with, which also bounds the count of relativeTo. It must be a multiple
+	// of ratio, between the minCount and the count of podSet, and requires
+	// the workload to be partially admissible.
+	// Defaults to the count of podSet.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
 }
 
 // PriorityClassGroup indicates the API group of the PriorityClass object.
@@ -277,6 +323,12 @@ type Admission struct {
 	// +kubebuilder:validation:MaxItems=18
 	// +optional
 	PodSetAssignments []PodSetAssignment `json:"podSetAssignments"`
+
+	// cost is the total cost of the resources assigned to the workload, computed
+	// from the prices of the assigned ResourceFlavors at the moment of admission.
+	// It is only set when at least one of the assigned flavors has prices.
+	// +optional
+	Cost *resource.Quantity `json:"cost,omitempty"`
 }
 
 // PodSetReference is the name of a PodSet.
@@ -474,6 +526,13 @@ type TopologyAssignment struct {
 	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=1000
 	Slices []TopologyAssignmentSlice `json:"slices,omitempty"`
+
+	// topologyName is the name of the Topology used for the assignment. It is
+	// set only when the ResourceFlavor references several topologies, with
+	// the spec.topologies field.
+	//
+	// +optional
+	TopologyName *TopologyReference `json:"topologyName,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="!has(self.podCounts.individual) || size(self.podCounts.individual) == self.domainCount", message="podCounts.individual must have length equal to domainCount"
@@ -725,6 +784,211 @@ type WorkloadStatus struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +optional
 	PreemptionGates []PreemptionGateState `json:"preemptionGates,omitempty"`
+
+	// migrations records the migrations of the workload between MultiKueue
+	// worker clusters, the most recent last. Only the 8 most recent
+	// migrations are kept.
+	// Requires enabling the MultiKueueWorkloadMigration feature gate.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	Migrations []WorkloadMigration `json:"migrations,omitempty"`
+
+	// topologyPlacementExplanations explains why Topology-Aware Scheduling
+	// could not place the PodSets of a pending workload. It is cleared when
+	// the workload reserves quota.
+	// Requires enabling the TASPlacementExplanation feature gate.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	TopologyPlacementExplanations []TopologyPlacementExplanation `json:"topologyPlacementExplanations,omitempty"`
+}
+
+// WorkloadMigrationReason is the reason of a migration of a workload away from
+// a MultiKueue worker cluster.
+type WorkloadMigrationReason string
+
+const (
+	// WorkloadMigrationWorkerClusterLost means that the worker cluster where the
+	// workload was admitted was lost for longer than the worker lost timeout.
+	WorkloadMigrationWorkerClusterLost WorkloadMigrationReason = "WorkerClusterLost"
+
+	// WorkloadMigrationRequested means that the migration was requested by
+	// setting the kueue.x-k8s.io/multikueue-migrate annotation on the workload.
+	WorkloadMigrationRequested WorkloadMigrationReason = "Requested"
+)
+
+// WorkloadMigration describes a migration of the workload away from the
+// MultiKueue worker cluster where it was admitted.
+type WorkloadMigration struct {
+	// sourceCluster is the name of the worker cluster the workload was
+	// migrated away from. The cluster is not nominated again until the
+	// workload is admitted on another worker cluster, unless it is the only
+	// worker cluster.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=256
+	SourceCluster string `json:"sourceCluster"`
+
+	// targetCluster is the name of the worker cluster where the workload was
+	// admitted after the migration. It is unset while the workload is being
+	// dispatched again.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	TargetCluster *string `json:"targetCluster,omitempty"`
+
+	// reason of the migration, one of WorkerClusterLost or Requested.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=WorkerClusterLost;Requested
+	Reason WorkloadMigrationReason `json:"reason"`
+
+	// message is a human readable message with details about the migration.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=32768
+	Message string `json:"message,omitempty"`
+
+	// startTime is the time when the migration started.
+	//
+	// +required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	StartTime metav1.Time `json:"startTime,omitempty,omitzero"`
+}
+
+// TopologyPlacementExplanation explains why a PodSet could not be placed in
+// the topology of a ResourceFlavor.
+type TopologyPlacementExplanation struct {
+	// name is the name of the PodSet.
+	//
+	// +required
+	Name PodSetReference `json:"name"`
+
+	// flavor is the name of the ResourceFlavor whose topology was searched.
+	//
+	// +required
+	Flavor ResourceFlavorReference `json:"flavor"`
+
+	// count is the number of pods of the PodSet which need to be placed.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count"`
+
+	// totalNodes is the number of nodes of the topology considered for the
+	// PodSet.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TotalNodes int32 `json:"totalNodes,omitempty"`
+
+	// candidates lists the best candidate domain at each topology level, from
+	// the highest level down to the level requested by the PodSet. At the
+	// highest level the candidate is the domain which could host the most
+	// pods, at each lower level it is the child of the candidate above which
+	// could host the most pods.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	Candidates []TopologyPlacementCandidate `json:"candidates,omitempty"`
+
+	// exclusions lists the most frequent reasons why nodes were excluded from
+	// the placement, by decreasing number of excluded nodes.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	Exclusions []TopologyPlacementExclusion `json:"exclusions,omitempty"`
+}
+
+// TopologyPlacementCandidate is the best candidate domain for a PodSet at a
+// topology level.
+type TopologyPlacementCandidate struct {
+	// level is the node label of the topology level.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=316
+	Level string `json:"level"`
+
+	// value is the value of the level label of the candidate domain.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	Value string `json:"value"`
+
+	// podCount is the number of pods of the PodSet which the candidate domain
+	// could host.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	PodCount int32 `json:"podCount"`
+}
+
+// TopologyPlacementExclusionReason is the reason why nodes were excluded from
+// the placement of a PodSet.
+type TopologyPlacementExclusionReason string
+
+const (
+	// TopologyPlacementExclusionNodeSelector means that the nodes don't match
+	// the node selector of the PodSet.
+	TopologyPlacementExclusionNodeSelector TopologyPlacementExclusionReason = "NodeSelector"
+
+	// TopologyPlacementExclusionNodeAffinity means that the nodes don't match
+	// the required node affinity of the PodSet.
+	TopologyPlacementExclusionNodeAffinity TopologyPlacementExclusionReason = "NodeAffinity"
+
+	// TopologyPlacementExclusionTaint means that the nodes have a taint not
+	// tolerated by the PodSet.
+	TopologyPlacementExclusionTaint TopologyPlacementExclusionReason = "Taint"
+
+	// TopologyPlacementExclusionInsufficientResource means that the nodes
+	// don't have enough free capacity of a resource for a pod of the PodSet.
+	TopologyPlacementExclusionInsufficientResource TopologyPlacementExclusionReason = "InsufficientResource"
+
+	// TopologyPlacementExclusionTopologyDomain means that the nodes are
+	// outside of the topology domain the PodSet is restricted to, for example
+	// when replacing a failed node.
+	TopologyPlacementExclusionTopologyDomain TopologyPlacementExclusionReason = "TopologyDomain"
+
+	// TopologyPlacementExclusionSchedulerPlugin means that the nodes were
+	// rejected by the kube-scheduler plugins run by Kueue.
+	TopologyPlacementExclusionSchedulerPlugin TopologyPlacementExclusionReason = "SchedulerPlugin"
+)
+
+// TopologyPlacementExclusion is a reason why nodes were excluded from the
+// placement of a PodSet.
+type TopologyPlacementExclusion struct {
+	// reason why the nodes were excluded, one of NodeSelector, NodeAffinity,
+	// Taint, InsufficientResource, TopologyDomain or SchedulerPlugin.
+	//
+	// +required
+	// +kubebuilder:validation:Enum=NodeSelector;NodeAffinity;Taint;InsufficientResource;TopologyDomain;SchedulerPlugin
+	Reason TopologyPlacementExclusionReason `json:"reason"`
+
+	// resource is the name of the insufficient resource. It is set only for
+	// the InsufficientResource reason.
+	//
+	// +optional
+	Resource corev1.ResourceName `json:"resource,omitempty"`
+
+	// taint is the taint which is not tolerated, in the key=value:effect
+	// format. It is set only for the Taint reason.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=512
+	Taint string `json:"taint,omitempty"`
+
+	// nodes is the number of nodes excluded for the reason.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	Nodes int32 `json:"nodes"`
 }
 
 type SchedulingStats struct {
@@ -1109,6 +1373,16 @@ const (
 	// due to non-recoverable node failures.
 	WorkloadEvictedDueToNodeFailures = "NodeFailures"
 
+	// WorkloadEvictedByQuotaWindow indicates that the workload was evicted
+	// because its usage exceeded the quota of the ClusterQueue after a quota
+	// window started or ended.
+	WorkloadEvictedByQuotaWindow = "QuotaWindow"
+
+	// WorkloadEvictedByTopologyDefragmentation indicates that the workload was
+	// evicted to open a topology domain for a pending workload of its
+	// ClusterQueue, as part of a defragmentation plan.
+	WorkloadEvictedByTopologyDefragmentation = "TopologyDefragmentation"
+
 	// WorkloadEvictedOnManagerCluster indicates the workload was evicted on the
 	// manager cluster.
 	WorkloadEvictedOnManagerCluster = "EvictedOnManagerCluster"
@@ -36,6 +36,11 @@ func (in *Admission) DeepCopyInto(out *Admission) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Admission.
@@ -254,6 +259,21 @@ func (in *AdmissionScope) DeepCopy() *AdmissionScope {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackfillWorkloadReference) DeepCopyInto(out *BackfillWorkloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackfillWorkloadReference.
+func (in *BackfillWorkloadReference) DeepCopy() *BackfillWorkloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(BackfillWorkloadReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BorrowWithinCohort) DeepCopyInto(out *BorrowWithinCohort) {
 	*out = *in
@@ -316,6 +336,26 @@ func (in *ClusterQueue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueueBackfillStatus) DeepCopyInto(out *ClusterQueueBackfillStatus) {
+	*out = *in
+	out.Workload = in.Workload
+	if in.ShadowTime != nil {
+		in, out := &in.ShadowTime, &out.ShadowTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueBackfillStatus.
+func (in *ClusterQueueBackfillStatus) DeepCopy() *ClusterQueueBackfillStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueueBackfillStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterQueueList) DeepCopyInto(out *ClusterQueueList) {
 	*out = *in
@@ -368,6 +408,22 @@ func (in *ClusterQueuePreemption) DeepCopy() *ClusterQueuePreemption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueueQuotaWindowStatus) DeepCopyInto(out *ClusterQueueQuotaWindowStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueQuotaWindowStatus.
+func (in *ClusterQueueQuotaWindowStatus) DeepCopy() *ClusterQueueQuotaWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueueQuotaWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterQueueSpec) DeepCopyInto(out *ClusterQueueSpec) {
 	*out = *in
@@ -418,6 +474,28 @@ func (in *ClusterQueueSpec) DeepCopyInto(out *ClusterQueueSpec) {
 		*out = new(ConcurrentAdmissionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QuotaSchedule != nil {
+		in, out := &in.QuotaSchedule, &out.QuotaSchedule
+		*out = new(QuotaSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]ReservationReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopologyDefragmentation != nil {
+		in, out := &in.TopologyDefragmentation, &out.TopologyDefragmentation
+		*out = new(TopologyDefragmentation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkflowReservationLimit != nil {
+		in, out := &in.WorkflowReservationLimit, &out.WorkflowReservationLimit
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueSpec.
@@ -459,6 +537,21 @@ func (in *ClusterQueueStatus) DeepCopyInto(out *ClusterQueueStatus) {
 		*out = new(FairSharingStatus)
 		**out = **in
 	}
+	if in.QuotaWindow != nil {
+		in, out := &in.QuotaWindow, &out.QuotaWindow
+		*out = new(ClusterQueueQuotaWindowStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(ClusterQueueBackfillStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologyDefragmentation != nil {
+		in, out := &in.TopologyDefragmentation, &out.TopologyDefragmentation
+		*out = new(ClusterQueueTopologyDefragmentationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueStatus.
@@ -471,6 +564,38 @@ func (in *ClusterQueueStatus) DeepCopy() *ClusterQueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueueTopologyDefragmentationStatus) DeepCopyInto(out *ClusterQueueTopologyDefragmentationStatus) {
+	*out = *in
+	out.Workload = in.Workload
+	if in.Levels != nil {
+		in, out := &in.Levels, &out.Levels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Evictions != nil {
+		in, out := &in.Evictions, &out.Evictions
+		*out = make([]TopologyDefragmentationWorkloadReference, len(*in))
+		copy(*out, *in)
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueTopologyDefragmentationStatus.
+func (in *ClusterQueueTopologyDefragmentationStatus) DeepCopy() *ClusterQueueTopologyDefragmentationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueueTopologyDefragmentationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSource) DeepCopyInto(out *ClusterSource) {
 	*out = *in
@@ -570,6 +695,11 @@ func (in *CohortSpec) DeepCopyInto(out *CohortSpec) {
 		*out = new(FairSharing)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]ReservationReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortSpec.
@@ -735,6 +865,28 @@ func (in *FlavorQuotas) DeepCopy() *FlavorQuotas {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorTopology) DeepCopyInto(out *FlavorTopology) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorTopology.
+func (in *FlavorTopology) DeepCopy() *FlavorTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FlavorUsage) DeepCopyInto(out *FlavorUsage) {
 	*out = *in
@@ -757,6 +909,159 @@ func (in *FlavorUsage) DeepCopy() *FlavorUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobIntegration) DeepCopyInto(out *JobIntegration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobIntegration.
+func (in *JobIntegration) DeepCopy() *JobIntegration {
+	if in == nil {
+		return nil
+	}
+	out := new(JobIntegration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JobIntegration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobIntegrationList) DeepCopyInto(out *JobIntegrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JobIntegration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobIntegrationList.
+func (in *JobIntegrationList) DeepCopy() *JobIntegrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(JobIntegrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JobIntegrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobIntegrationPodSet) DeepCopyInto(out *JobIntegrationPodSet) {
+	*out = *in
+	if in.CountPath != nil {
+		in, out := &in.CountPath, &out.CountPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReclaimableCountExpression != nil {
+		in, out := &in.ReclaimableCountExpression, &out.ReclaimableCountExpression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobIntegrationPodSet.
+func (in *JobIntegrationPodSet) DeepCopy() *JobIntegrationPodSet {
+	if in == nil {
+		return nil
+	}
+	out := new(JobIntegrationPodSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobIntegrationSpec) DeepCopyInto(out *JobIntegrationSpec) {
+	*out = *in
+	if in.PodSets != nil {
+		in, out := &in.PodSets, &out.PodSets
+		*out = make([]JobIntegrationPodSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityClassPath != nil {
+		in, out := &in.PriorityClassPath, &out.PriorityClassPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodLabelSelectorPath != nil {
+		in, out := &in.PodLabelSelectorPath, &out.PodLabelSelectorPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.SucceededExpression != nil {
+		in, out := &in.SucceededExpression, &out.SucceededExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.ActiveExpression != nil {
+		in, out := &in.ActiveExpression, &out.ActiveExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodsReadyExpression != nil {
+		in, out := &in.PodsReadyExpression, &out.PodsReadyExpression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobIntegrationSpec.
+func (in *JobIntegrationSpec) DeepCopy() *JobIntegrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobIntegrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobIntegrationStatus) DeepCopyInto(out *JobIntegrationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobIntegrationStatus.
+func (in *JobIntegrationStatus) DeepCopy() *JobIntegrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobIntegrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeConfig) DeepCopyInto(out *KubeConfig) {
 	*out = *in
@@ -966,6 +1271,11 @@ func (in *LocalQueueStatus) DeepCopyInto(out *LocalQueueStatus) {
 		*out = new(LocalQueueFairSharingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UsageAccounting != nil {
+		in, out := &in.UsageAccounting, &out.UsageAccounting
+		*out = new(LocalQueueUsageAccounting)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueStatus.
@@ -978,6 +1288,36 @@ func (in *LocalQueueStatus) DeepCopy() *LocalQueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueUsageAccounting) DeepCopyInto(out *LocalQueueUsageAccounting) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceUsageAccounting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Periods != nil {
+		in, out := &in.Periods, &out.Periods
+		*out = make([]UsageAccountingPeriod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueUsageAccounting.
+func (in *LocalQueueUsageAccounting) DeepCopy() *LocalQueueUsageAccounting {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueUsageAccounting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueueCluster) DeepCopyInto(out *MultiKueueCluster) {
 	*out = *in
@@ -1250,6 +1590,26 @@ func (in *PodSetRequest) DeepCopy() *PodSetRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSetShapeConstraint) DeepCopyInto(out *PodSetShapeConstraint) {
+	*out = *in
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetShapeConstraint.
+func (in *PodSetShapeConstraint) DeepCopy() *PodSetShapeConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSetShapeConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSetTopologyRequest) DeepCopyInto(out *PodSetTopologyRequest) {
 	*out = *in
@@ -1585,11 +1945,65 @@ func (in *ProvisioningRequestRetryStrategy) DeepCopy() *ProvisioningRequestRetry
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReclaimablePod) DeepCopyInto(out *ReclaimablePod) {
+func (in *QuotaSchedule) DeepCopyInto(out *QuotaSchedule) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReclaimablePod.
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.EvictionGracePeriodSeconds != nil {
+		in, out := &in.EvictionGracePeriodSeconds, &out.EvictionGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]QuotaWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaSchedule.
+func (in *QuotaSchedule) DeepCopy() *QuotaSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaWindow) DeepCopyInto(out *QuotaWindow) {
+	*out = *in
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]FlavorQuotas, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaWindow.
+func (in *QuotaWindow) DeepCopy() *QuotaWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReclaimablePod) DeepCopyInto(out *ReclaimablePod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReclaimablePod.
 func (in *ReclaimablePod) DeepCopy() *ReclaimablePod {
 	if in == nil {
 		return nil
@@ -1623,6 +2037,149 @@ func (in *RequeueState) DeepCopy() *RequeueState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Reservation) DeepCopyInto(out *Reservation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Reservation.
+func (in *Reservation) DeepCopy() *Reservation {
+	if in == nil {
+		return nil
+	}
+	out := new(Reservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Reservation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationFlavor) DeepCopyInto(out *ReservationFlavor) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ReservationResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationFlavor.
+func (in *ReservationFlavor) DeepCopy() *ReservationFlavor {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationFlavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationList) DeepCopyInto(out *ReservationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Reservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationList.
+func (in *ReservationList) DeepCopy() *ReservationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationResource) DeepCopyInto(out *ReservationResource) {
+	*out = *in
+	out.Quantity = in.Quantity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationResource.
+func (in *ReservationResource) DeepCopy() *ReservationResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationSpec) DeepCopyInto(out *ReservationSpec) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]ReservationFlavor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationSpec.
+func (in *ReservationSpec) DeepCopy() *ReservationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationStatus) DeepCopyInto(out *ReservationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationStatus.
+func (in *ReservationStatus) DeepCopy() *ReservationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceFlavor) DeepCopyInto(out *ResourceFlavor) {
 	*out = *in
@@ -1698,6 +2255,13 @@ func (in *ResourceFlavorSpec) DeepCopyInto(out *ResourceFlavorSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Prices != nil {
+		in, out := &in.Prices, &out.Prices
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]corev1.Toleration, len(*in))
@@ -1710,6 +2274,13 @@ func (in *ResourceFlavorSpec) DeepCopyInto(out *ResourceFlavorSpec) {
 		*out = new(TopologyReference)
 		**out = **in
 	}
+	if in.Topologies != nil {
+		in, out := &in.Topologies, &out.Topologies
+		*out = make([]FlavorTopology, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavorSpec.
@@ -1792,6 +2363,23 @@ func (in *ResourceUsage) DeepCopy() *ResourceUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceUsageAccounting) DeepCopyInto(out *ResourceUsageAccounting) {
+	*out = *in
+	out.Reserved = in.Reserved.DeepCopy()
+	out.Used = in.Used.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsageAccounting.
+func (in *ResourceUsageAccounting) DeepCopy() *ResourceUsageAccounting {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUsageAccounting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingStats) DeepCopyInto(out *SchedulingStats) {
 	*out = *in
@@ -1853,6 +2441,11 @@ func (in *TopologyAssignment) DeepCopyInto(out *TopologyAssignment) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologyName != nil {
+		in, out := &in.TopologyName, &out.TopologyName
+		*out = new(TopologyReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyAssignment.
@@ -1968,6 +2561,56 @@ func (in *TopologyAssignmentSlicePodCounts) DeepCopy() *TopologyAssignmentSliceP
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDefragmentation) DeepCopyInto(out *TopologyDefragmentation) {
+	*out = *in
+	if in.MaxEvictions != nil {
+		in, out := &in.MaxEvictions, &out.MaxEvictions
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDefragmentation.
+func (in *TopologyDefragmentation) DeepCopy() *TopologyDefragmentation {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDefragmentation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDefragmentationWorkloadReference) DeepCopyInto(out *TopologyDefragmentationWorkloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDefragmentationWorkloadReference.
+func (in *TopologyDefragmentationWorkloadReference) DeepCopy() *TopologyDefragmentationWorkloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDefragmentationWorkloadReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDomainDistance) DeepCopyInto(out *TopologyDomainDistance) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDomainDistance.
+func (in *TopologyDomainDistance) DeepCopy() *TopologyDomainDistance {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDomainDistance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyInfo) DeepCopyInto(out *TopologyInfo) {
 	*out = *in
@@ -2003,6 +2646,26 @@ func (in *TopologyLevel) DeepCopy() *TopologyLevel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyLevelDistances) DeepCopyInto(out *TopologyLevelDistances) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]TopologyDomainDistance, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyLevelDistances.
+func (in *TopologyLevelDistances) DeepCopy() *TopologyLevelDistances {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyLevelDistances)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyList) DeepCopyInto(out *TopologyList) {
 	*out = *in
@@ -2035,6 +2698,61 @@ func (in *TopologyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyPlacementCandidate) DeepCopyInto(out *TopologyPlacementCandidate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyPlacementCandidate.
+func (in *TopologyPlacementCandidate) DeepCopy() *TopologyPlacementCandidate {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyPlacementCandidate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyPlacementExclusion) DeepCopyInto(out *TopologyPlacementExclusion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyPlacementExclusion.
+func (in *TopologyPlacementExclusion) DeepCopy() *TopologyPlacementExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyPlacementExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyPlacementExplanation) DeepCopyInto(out *TopologyPlacementExplanation) {
+	*out = *in
+	if in.Candidates != nil {
+		in, out := &in.Candidates, &out.Candidates
+		*out = make([]TopologyPlacementCandidate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]TopologyPlacementExclusion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyPlacementExplanation.
+func (in *TopologyPlacementExplanation) DeepCopy() *TopologyPlacementExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyPlacementExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
 	*out = *in
@@ -2043,6 +2761,13 @@ func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
 		*out = make([]TopologyLevel, len(*in))
 		copy(*out, *in)
 	}
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = make([]TopologyLevelDistances, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpec.
@@ -2070,6 +2795,29 @@ func (in *UnhealthyNode) DeepCopy() *UnhealthyNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageAccountingPeriod) DeepCopyInto(out *UsageAccountingPeriod) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceUsageAccounting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageAccountingPeriod.
+func (in *UsageAccountingPeriod) DeepCopy() *UsageAccountingPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageAccountingPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workload) DeepCopyInto(out *Workload) {
 	*out = *in
@@ -2129,6 +2877,27 @@ func (in *WorkloadList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadMigration) DeepCopyInto(out *WorkloadMigration) {
+	*out = *in
+	if in.TargetCluster != nil {
+		in, out := &in.TargetCluster, &out.TargetCluster
+		*out = new(string)
+		**out = **in
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadMigration.
+func (in *WorkloadMigration) DeepCopy() *WorkloadMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadPriorityClass) DeepCopyInto(out *WorkloadPriorityClass) {
 	*out = *in
@@ -2236,6 +3005,13 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 		*out = make([]PreemptionGate, len(*in))
 		copy(*out, *in)
 	}
+	if in.PodSetShapeConstraints != nil {
+		in, out := &in.PodSetShapeConstraints, &out.PodSetShapeConstraints
+		*out = make([]PodSetShapeConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSpec.
@@ -2319,6 +3095,20 @@ func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = make([]WorkloadMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologyPlacementExplanations != nil {
+		in, out := &in.TopologyPlacementExplanations, &out.TopologyPlacementExplanations
+		*out = make([]TopologyPlacementExplanation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
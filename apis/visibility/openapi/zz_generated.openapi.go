@@ -34,75 +34,85 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		resource.Quantity{}.OpenAPIModelName():               schema_apimachinery_pkg_api_resource_Quantity(ref),
-		v1.APIGroup{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_APIGroup(ref),
-		v1.APIGroupList{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_APIGroupList(ref),
-		v1.APIResource{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_APIResource(ref),
-		v1.APIResourceList{}.OpenAPIModelName():              schema_pkg_apis_meta_v1_APIResourceList(ref),
-		v1.APIVersions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_APIVersions(ref),
-		v1.ApplyOptions{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		v1.Condition{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_Condition(ref),
-		v1.CreateOptions{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_CreateOptions(ref),
-		v1.DeleteOptions{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		v1.Duration{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_Duration(ref),
-		v1.FieldSelectorRequirement{}.OpenAPIModelName():     schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		v1.FieldsV1{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_FieldsV1(ref),
-		v1.GetOptions{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_GetOptions(ref),
-		v1.GroupKind{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_GroupKind(ref),
-		v1.GroupResource{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_GroupResource(ref),
-		v1.GroupVersion{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_GroupVersion(ref),
-		v1.GroupVersionForDiscovery{}.OpenAPIModelName():     schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		v1.GroupVersionKind{}.OpenAPIModelName():             schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		v1.GroupVersionResource{}.OpenAPIModelName():         schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		v1.InternalEvent{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_InternalEvent(ref),
-		v1.LabelSelector{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_LabelSelector(ref),
-		v1.LabelSelectorRequirement{}.OpenAPIModelName():     schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		v1.List{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_List(ref),
-		v1.ListMeta{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_ListMeta(ref),
-		v1.ListOptions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_ListOptions(ref),
-		v1.ManagedFieldsEntry{}.OpenAPIModelName():           schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		v1.MicroTime{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_MicroTime(ref),
-		v1.ObjectMeta{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		v1.OwnerReference{}.OpenAPIModelName():               schema_pkg_apis_meta_v1_OwnerReference(ref),
-		v1.PartialObjectMetadata{}.OpenAPIModelName():        schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		v1.PartialObjectMetadataList{}.OpenAPIModelName():    schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		v1.Patch{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_Patch(ref),
-		v1.PatchOptions{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_PatchOptions(ref),
-		v1.Preconditions{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_Preconditions(ref),
-		v1.RootPaths{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_RootPaths(ref),
-		v1.ServerAddressByClientCIDR{}.OpenAPIModelName():    schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		v1.ShardInfo{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_ShardInfo(ref),
-		v1.Status{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_Status(ref),
-		v1.StatusCause{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_StatusCause(ref),
-		v1.StatusDetails{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_StatusDetails(ref),
-		v1.Table{}.OpenAPIModelName():                        schema_pkg_apis_meta_v1_Table(ref),
-		v1.TableColumnDefinition{}.OpenAPIModelName():        schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		v1.TableOptions{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_TableOptions(ref),
-		v1.TableRow{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_TableRow(ref),
-		v1.TableRowCondition{}.OpenAPIModelName():            schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		v1.Time{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_Time(ref),
-		v1.Timestamp{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_Timestamp(ref),
-		v1.TypeMeta{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_TypeMeta(ref),
-		v1.UpdateOptions{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		v1.WatchEvent{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_WatchEvent(ref),
-		runtime.RawExtension{}.OpenAPIModelName():            schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		runtime.TypeMeta{}.OpenAPIModelName():                schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		runtime.Unknown{}.OpenAPIModelName():                 schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		version.Info{}.OpenAPIModelName():                    schema_k8sio_apimachinery_pkg_version_Info(ref),
-		v1beta1.ClusterQueue{}.OpenAPIModelName():            schema_kueue_apis_visibility_v1beta1_ClusterQueue(ref),
-		v1beta1.ClusterQueueList{}.OpenAPIModelName():        schema_kueue_apis_visibility_v1beta1_ClusterQueueList(ref),
-		v1beta1.LocalQueue{}.OpenAPIModelName():              schema_kueue_apis_visibility_v1beta1_LocalQueue(ref),
-		v1beta1.LocalQueueList{}.OpenAPIModelName():          schema_kueue_apis_visibility_v1beta1_LocalQueueList(ref),
-		v1beta1.PendingWorkload{}.OpenAPIModelName():         schema_kueue_apis_visibility_v1beta1_PendingWorkload(ref),
-		v1beta1.PendingWorkloadOptions{}.OpenAPIModelName():  schema_kueue_apis_visibility_v1beta1_PendingWorkloadOptions(ref),
-		v1beta1.PendingWorkloadsSummary{}.OpenAPIModelName(): schema_kueue_apis_visibility_v1beta1_PendingWorkloadsSummary(ref),
-		v1beta2.ClusterQueue{}.OpenAPIModelName():            schema_kueue_apis_visibility_v1beta2_ClusterQueue(ref),
-		v1beta2.ClusterQueueList{}.OpenAPIModelName():        schema_kueue_apis_visibility_v1beta2_ClusterQueueList(ref),
-		v1beta2.LocalQueue{}.OpenAPIModelName():              schema_kueue_apis_visibility_v1beta2_LocalQueue(ref),
-		v1beta2.LocalQueueList{}.OpenAPIModelName():          schema_kueue_apis_visibility_v1beta2_LocalQueueList(ref),
-		v1beta2.PendingWorkload{}.OpenAPIModelName():         schema_kueue_apis_visibility_v1beta2_PendingWorkload(ref),
-		v1beta2.PendingWorkloadOptions{}.OpenAPIModelName():  schema_kueue_apis_visibility_v1beta2_PendingWorkloadOptions(ref),
-		v1beta2.PendingWorkloadsSummary{}.OpenAPIModelName(): schema_kueue_apis_visibility_v1beta2_PendingWorkloadsSummary(ref),
+		resource.Quantity{}.OpenAPIModelName():                 schema_apimachinery_pkg_api_resource_Quantity(ref),
+		v1.APIGroup{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_APIGroup(ref),
+		v1.APIGroupList{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_APIGroupList(ref),
+		v1.APIResource{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_APIResource(ref),
+		v1.APIResourceList{}.OpenAPIModelName():                schema_pkg_apis_meta_v1_APIResourceList(ref),
+		v1.APIVersions{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_APIVersions(ref),
+		v1.ApplyOptions{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		v1.Condition{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_Condition(ref),
+		v1.CreateOptions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_CreateOptions(ref),
+		v1.DeleteOptions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		v1.Duration{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_Duration(ref),
+		v1.FieldSelectorRequirement{}.OpenAPIModelName():       schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		v1.FieldsV1{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_FieldsV1(ref),
+		v1.GetOptions{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_GetOptions(ref),
+		v1.GroupKind{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_GroupKind(ref),
+		v1.GroupResource{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_GroupResource(ref),
+		v1.GroupVersion{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_GroupVersion(ref),
+		v1.GroupVersionForDiscovery{}.OpenAPIModelName():       schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		v1.GroupVersionKind{}.OpenAPIModelName():               schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		v1.GroupVersionResource{}.OpenAPIModelName():           schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		v1.InternalEvent{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_InternalEvent(ref),
+		v1.LabelSelector{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_LabelSelector(ref),
+		v1.LabelSelectorRequirement{}.OpenAPIModelName():       schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		v1.List{}.OpenAPIModelName():                           schema_pkg_apis_meta_v1_List(ref),
+		v1.ListMeta{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_ListMeta(ref),
+		v1.ListOptions{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_ListOptions(ref),
+		v1.ManagedFieldsEntry{}.OpenAPIModelName():             schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		v1.MicroTime{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_MicroTime(ref),
+		v1.ObjectMeta{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		v1.OwnerReference{}.OpenAPIModelName():                 schema_pkg_apis_meta_v1_OwnerReference(ref),
+		v1.PartialObjectMetadata{}.OpenAPIModelName():          schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		v1.PartialObjectMetadataList{}.OpenAPIModelName():      schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		v1.Patch{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_Patch(ref),
+		v1.PatchOptions{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_PatchOptions(ref),
+		v1.Preconditions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_Preconditions(ref),
+		v1.RootPaths{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_RootPaths(ref),
+		v1.ServerAddressByClientCIDR{}.OpenAPIModelName():      schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		v1.ShardInfo{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_ShardInfo(ref),
+		v1.Status{}.OpenAPIModelName():                         schema_pkg_apis_meta_v1_Status(ref),
+		v1.StatusCause{}.OpenAPIModelName():                    schema_pkg_apis_meta_v1_StatusCause(ref),
+		v1.StatusDetails{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_StatusDetails(ref),
+		v1.Table{}.OpenAPIModelName():                          schema_pkg_apis_meta_v1_Table(ref),
+		v1.TableColumnDefinition{}.OpenAPIModelName():          schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		v1.TableOptions{}.OpenAPIModelName():                   schema_pkg_apis_meta_v1_TableOptions(ref),
+		v1.TableRow{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_TableRow(ref),
+		v1.TableRowCondition{}.OpenAPIModelName():              schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		v1.Time{}.OpenAPIModelName():                           schema_pkg_apis_meta_v1_Time(ref),
+		v1.Timestamp{}.OpenAPIModelName():                      schema_pkg_apis_meta_v1_Timestamp(ref),
+		v1.TypeMeta{}.OpenAPIModelName():                       schema_pkg_apis_meta_v1_TypeMeta(ref),
+		v1.UpdateOptions{}.OpenAPIModelName():                  schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		v1.WatchEvent{}.OpenAPIModelName():                     schema_pkg_apis_meta_v1_WatchEvent(ref),
+		runtime.RawExtension{}.OpenAPIModelName():              schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		runtime.TypeMeta{}.OpenAPIModelName():                  schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		runtime.Unknown{}.OpenAPIModelName():                   schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		version.Info{}.OpenAPIModelName():                      schema_k8sio_apimachinery_pkg_version_Info(ref),
+		v1beta1.ClusterQueue{}.OpenAPIModelName():              schema_kueue_apis_visibility_v1beta1_ClusterQueue(ref),
+		v1beta1.ClusterQueueList{}.OpenAPIModelName():          schema_kueue_apis_visibility_v1beta1_ClusterQueueList(ref),
+		v1beta1.LocalQueue{}.OpenAPIModelName():                schema_kueue_apis_visibility_v1beta1_LocalQueue(ref),
+		v1beta1.LocalQueueList{}.OpenAPIModelName():            schema_kueue_apis_visibility_v1beta1_LocalQueueList(ref),
+		v1beta1.PendingWorkload{}.OpenAPIModelName():           schema_kueue_apis_visibility_v1beta1_PendingWorkload(ref),
+		v1beta1.PendingWorkloadOptions{}.OpenAPIModelName():    schema_kueue_apis_visibility_v1beta1_PendingWorkloadOptions(ref),
+		v1beta1.PendingWorkloadsSummary{}.OpenAPIModelName():   schema_kueue_apis_visibility_v1beta1_PendingWorkloadsSummary(ref),
+		v1beta2.AdmissionSimulation{}.OpenAPIModelName():       schema_kueue_apis_visibility_v1beta2_AdmissionSimulation(ref),
+		v1beta2.AdmissionSimulationResult{}.OpenAPIModelName(): schema_kueue_apis_visibility_v1beta2_AdmissionSimulationResult(ref),
+		v1beta2.ClusterQueue{}.OpenAPIModelName():              schema_kueue_apis_visibility_v1beta2_ClusterQueue(ref),
+		v1beta2.ClusterQueueList{}.OpenAPIModelName():          schema_kueue_apis_visibility_v1beta2_ClusterQueueList(ref),
+		v1beta2.LocalQueue{}.OpenAPIModelName():                schema_kueue_apis_visibility_v1beta2_LocalQueue(ref),
+		v1beta2.LocalQueueList{}.OpenAPIModelName():            schema_kueue_apis_visibility_v1beta2_LocalQueueList(ref),
+		v1beta2.LocalQueueUsage{}.OpenAPIModelName():           schema_kueue_apis_visibility_v1beta2_LocalQueueUsage(ref),
+		v1beta2.PendingWorkload{}.OpenAPIModelName():           schema_kueue_apis_visibility_v1beta2_PendingWorkload(ref),
+		v1beta2.PendingWorkloadOptions{}.OpenAPIModelName():    schema_kueue_apis_visibility_v1beta2_PendingWorkloadOptions(ref),
+		v1beta2.PendingWorkloadsSummary{}.OpenAPIModelName():   schema_kueue_apis_visibility_v1beta2_PendingWorkloadsSummary(ref),
+		v1beta2.RemoteWorkloadStatus{}.OpenAPIModelName():      schema_kueue_apis_visibility_v1beta2_RemoteWorkloadStatus(ref),
+		v1beta2.ResourceUsageAccounting{}.OpenAPIModelName():   schema_kueue_apis_visibility_v1beta2_ResourceUsageAccounting(ref),
+		v1beta2.SimulatedPodSetAssignment{}.OpenAPIModelName(): schema_kueue_apis_visibility_v1beta2_SimulatedPodSetAssignment(ref),
+		v1beta2.SimulatedPreemptionTarget{}.OpenAPIModelName(): schema_kueue_apis_visibility_v1beta2_SimulatedPreemptionTarget(ref),
+		v1beta2.UsagePeriod{}.OpenAPIModelName():               schema_kueue_apis_visibility_v1beta2_UsagePeriod(ref),
+		v1beta2.UsageReport{}.OpenAPIModelName():               schema_kueue_apis_visibility_v1beta2_UsageReport(ref),
+		v1beta2.WorkloadUsage{}.OpenAPIModelName():             schema_kueue_apis_visibility_v1beta2_WorkloadUsage(ref),
 	}
 }
 
@@ -3133,6 +3143,137 @@ func schema_kueue_apis_visibility_v1beta1_PendingWorkloadsSummary(ref common.Ref
 	}
 }
 
+func schema_kueue_apis_visibility_v1beta2_AdmissionSimulation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionSimulation evaluates how the scheduler would handle a Workload submitted to a LocalQueue, without creating the Workload or changing the state of the cluster. The request carries the Workload manifest and the response carries the outcome of the evaluation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"workload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workload is the manifest of the kueue.x-k8s.io Workload to evaluate. The namespace and queue name of the Workload are taken from the LocalQueue the simulation is requested for.",
+							Ref:         ref(runtime.RawExtension{}.OpenAPIModelName()),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Result is the outcome of the simulation. It is populated by the server.",
+							Ref:         ref(v1beta2.AdmissionSimulationResult{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"workload"},
+			},
+		},
+		Dependencies: []string{
+			v1.ObjectMeta{}.OpenAPIModelName(), runtime.RawExtension{}.OpenAPIModelName(), v1beta2.AdmissionSimulationResult{}.OpenAPIModelName()},
+	}
+}
+
+func schema_kueue_apis_visibility_v1beta2_AdmissionSimulationResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionSimulationResult is the outcome of evaluating a Workload against the current state of the ClusterQueue its LocalQueue points to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterQueue is the name of the ClusterQueue the Workload was evaluated against.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the outcome of the flavor assignment: Fit when the Workload can be admitted right away, Preempt when it needs to preempt other workloads, and NoFit when it cannot be admitted.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"admissible": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Admissible indicates that the Workload would be admitted, possibly after the preemption of the workloads listed in PreemptionTargets.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"borrowing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Borrowing indicates that the assignment borrows quota from the cohort.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"podSetAssignments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSetAssignments holds the flavors that would be assigned to every PodSet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.SimulatedPodSetAssignment{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"preemptionTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreemptionTargets lists the workloads that would be preempted to make room for the Workload. The workloads in other namespaces than the LocalQueue are listed without their name and namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.SimulatedPreemptionTarget{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains why the Workload does not fit, if applicable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"clusterQueue", "mode", "admissible", "borrowing"},
+			},
+		},
+		Dependencies: []string{
+			v1beta2.SimulatedPodSetAssignment{}.OpenAPIModelName(), v1beta2.SimulatedPreemptionTarget{}.OpenAPIModelName()},
+	}
+}
+
 func schema_kueue_apis_visibility_v1beta2_ClusterQueue(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3311,6 +3452,66 @@ func schema_kueue_apis_visibility_v1beta2_LocalQueueList(ref common.ReferenceCal
 	}
 }
 
+func schema_kueue_apis_visibility_v1beta2_LocalQueueUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalQueueUsage is the usage accounted for a LocalQueue.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the LocalQueue.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the LocalQueue.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources holds the usage per ClusterQueue, flavor and resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.ResourceUsageAccounting{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"periods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Periods holds the usage per calendar month, in UTC, ordered by start.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.UsagePeriod{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "namespace"},
+			},
+		},
+		Dependencies: []string{
+			v1beta2.ResourceUsageAccounting{}.OpenAPIModelName(), v1beta2.UsagePeriod{}.OpenAPIModelName()},
+	}
+}
+
 func schema_kueue_apis_visibility_v1beta2_PendingWorkload(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3356,12 +3557,45 @@ func schema_kueue_apis_visibility_v1beta2_PendingWorkload(ref common.ReferenceCa
 							Format:      "int32",
 						},
 					},
+					"shadowTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShadowTime is the expected start time reserved for the workload when it blocks the head of a ClusterQueue with the Backfill queueing strategy.",
+							Ref:         ref(v1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"estimatedAdmissionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EstimatedAdmissionTime is the estimated time at which the workload is admitted. It's computed from the admission wait times in the ClusterQueue, the maximum execution times of the admitted workloads, and the workload's position in the ClusterQueue.",
+							Ref:         ref(v1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"estimatedAdmissionTimeConfidence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EstimatedAdmissionTimeConfidence indicates how reliable the EstimatedAdmissionTime is.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remoteStatuses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteStatuses holds the state of the workload in each of the MultiKueue worker clusters it's dispatched to. It's only set in the manager cluster, for the workloads dispatched to the worker clusters and not admitted yet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.RemoteWorkloadStatus{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"priority", "localQueueName", "positionInClusterQueue", "positionInLocalQueue"},
 			},
 		},
 		Dependencies: []string{
-			v1.ObjectMeta{}.OpenAPIModelName()},
+			v1.ObjectMeta{}.OpenAPIModelName(), v1.Time{}.OpenAPIModelName(), v1beta2.RemoteWorkloadStatus{}.OpenAPIModelName()},
 	}
 }
 
@@ -3456,3 +3690,393 @@ func schema_kueue_apis_visibility_v1beta2_PendingWorkloadsSummary(ref common.Ref
 			v1.ObjectMeta{}.OpenAPIModelName(), v1beta2.PendingWorkload{}.OpenAPIModelName()},
 	}
 }
+
+func schema_kueue_apis_visibility_v1beta2_RemoteWorkloadStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoteWorkloadStatus is the state of a workload in a MultiKueue worker cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterName is the name of the MultiKueue worker cluster",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the workload in the worker cluster",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"positionInClusterQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PositionInClusterQueue indicates the workload's position in the ClusterQueue of the worker cluster, starting from 0. It's only set when the workload is pending.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"positionInLocalQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PositionInLocalQueue indicates the workload's position in the LocalQueue of the worker cluster, starting from 0. It's only set when the workload is pending.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message explains the state of the workload in the worker cluster, for example why it's pending.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"clusterName", "state"},
+			},
+		},
+	}
+}
+
+func schema_kueue_apis_visibility_v1beta2_ResourceUsageAccounting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceUsageAccounting is the quota of a resource reserved and used over time, in resource-seconds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterQueue is the ClusterQueue the quota was reserved in.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flavor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flavor is the flavor of the quota.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reserved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reserved is the quota reserved over time, in resource-seconds.",
+							Ref:         ref(resource.Quantity{}.OpenAPIModelName()),
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the quota used by admitted workloads over time, in resource-seconds.",
+							Ref:         ref(resource.Quantity{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"clusterQueue", "flavor", "name", "reserved", "used"},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName()},
+	}
+}
+
+func schema_kueue_apis_visibility_v1beta2_SimulatedPodSetAssignment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SimulatedPodSetAssignment describes the flavors assigned to a PodSet during an admission simulation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the PodSet.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flavors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flavors are the flavors assigned to the PodSet per resource.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"resourceUsage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceUsage is the total quantity of resources the PodSet would use.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref(resource.Quantity{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of pods the PodSet would be admitted with.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "count"},
+			},
+		},
+		Dependencies: []string{
+			resource.Quantity{}.OpenAPIModelName()},
+	}
+}
+
+func schema_kueue_apis_visibility_v1beta2_SimulatedPreemptionTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SimulatedPreemptionTarget is a workload that would be preempted to admit the simulated Workload.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the preempted workload. It's omitted when the workload is in another namespace than the LocalQueue.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the preempted workload. It's omitted when the workload is in another namespace than the LocalQueue.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterQueue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterQueue is the ClusterQueue the preempted workload is admitted in.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority of the preempted workload.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the reason of the preemption, for example InClusterQueue or InCohortReclamation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"clusterQueue", "priority", "reason"},
+			},
+		},
+	}
+}
+
+func schema_kueue_apis_visibility_v1beta2_UsagePeriod(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsagePeriod is the usage accounted during a calendar month.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the start of the month, in UTC.",
+							Ref:         ref(v1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources holds the usage per ClusterQueue, flavor and resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.ResourceUsageAccounting{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"start"},
+			},
+		},
+		Dependencies: []string{
+			v1.Time{}.OpenAPIModelName(), v1beta2.ResourceUsageAccounting{}.OpenAPIModelName()},
+	}
+}
+
+func schema_kueue_apis_visibility_v1beta2_UsageReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsageReport contains the quota reserved and used over time by the workloads of a LocalQueue, or of all the LocalQueues pointing to a ClusterQueue.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(v1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is when the usage was accounted up to.",
+							Ref:         ref(v1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"localQueues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalQueues holds the usage accounted for the LocalQueues, including the usage of the workloads which no longer exist.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.LocalQueueUsage{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"workloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workloads holds the usage accounted for the existing workloads since the start of the Kueue manager.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.WorkloadUsage{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"time"},
+			},
+		},
+		Dependencies: []string{
+			v1.ObjectMeta{}.OpenAPIModelName(), v1.Time{}.OpenAPIModelName(), v1beta2.LocalQueueUsage{}.OpenAPIModelName(), v1beta2.WorkloadUsage{}.OpenAPIModelName()},
+	}
+}
+
+func schema_kueue_apis_visibility_v1beta2_WorkloadUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkloadUsage is the usage accounted for a workload.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the workload.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the workload.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"localQueueName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LocalQueueName is the name of the LocalQueue the workload is submitted to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources holds the usage per ClusterQueue, flavor and resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.ResourceUsageAccounting{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+					"periods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Periods holds the usage per calendar month, in UTC, ordered by start.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(v1beta2.UsagePeriod{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "namespace", "localQueueName"},
+			},
+		},
+		Dependencies: []string{
+			v1beta2.ResourceUsageAccounting{}.OpenAPIModelName(), v1beta2.UsagePeriod{}.OpenAPIModelName()},
+	}
+}
@@ -84,11 +84,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.PendingWorkload)(nil), (*PendingWorkload)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_PendingWorkload_To_v1beta1_PendingWorkload(a.(*v1beta2.PendingWorkload), b.(*PendingWorkload), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*PendingWorkloadOptions)(nil), (*v1beta2.PendingWorkloadOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PendingWorkloadOptions_To_v1beta2_PendingWorkloadOptions(a.(*PendingWorkloadOptions), b.(*v1beta2.PendingWorkloadOptions), scope)
 	}); err != nil {
@@ -114,6 +109,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.PendingWorkload)(nil), (*PendingWorkload)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PendingWorkload_To_v1beta1_PendingWorkload(a.(*v1beta2.PendingWorkload), b.(*PendingWorkload), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -233,14 +233,13 @@ func autoConvert_v1beta2_PendingWorkload_To_v1beta1_PendingWorkload(in *v1beta2.
 	out.LocalQueueName = kueuev1beta1.LocalQueueName(in.LocalQueueName)
 	out.PositionInClusterQueue = in.PositionInClusterQueue
 	out.PositionInLocalQueue = in.PositionInLocalQueue
+	// WARNING: in.ShadowTime requires manual conversion: does not exist in peer-type
+	// WARNING: in.EstimatedAdmissionTime requires manual conversion: does not exist in peer-type
+	// WARNING: in.EstimatedAdmissionTimeConfidence requires manual conversion: does not exist in peer-type
+	// WARNING: in.RemoteStatuses requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_PendingWorkload_To_v1beta1_PendingWorkload is an autogenerated conversion function.
-func Convert_v1beta2_PendingWorkload_To_v1beta1_PendingWorkload(in *v1beta2.PendingWorkload, out *PendingWorkload, s conversion.Scope) error {
-	return autoConvert_v1beta2_PendingWorkload_To_v1beta1_PendingWorkload(in, out, s)
-}
-
 func autoConvert_v1beta1_PendingWorkloadOptions_To_v1beta2_PendingWorkloadOptions(in *PendingWorkloadOptions, out *v1beta2.PendingWorkloadOptions, s conversion.Scope) error {
 	out.Offset = in.Offset
 	out.Limit = in.Limit
@@ -290,7 +289,17 @@ func Convert_url_Values_To_v1beta1_PendingWorkloadOptions(in *url.Values, out *P
 
 func autoConvert_v1beta1_PendingWorkloadsSummary_To_v1beta2_PendingWorkloadsSummary(in *PendingWorkloadsSummary, out *v1beta2.PendingWorkloadsSummary, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	out.Items = *(*[]v1beta2.PendingWorkload)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.PendingWorkload, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_PendingWorkload_To_v1beta2_PendingWorkload(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -301,7 +310,17 @@ func Convert_v1beta1_PendingWorkloadsSummary_To_v1beta2_PendingWorkloadsSummary(
 
 func autoConvert_v1beta2_PendingWorkloadsSummary_To_v1beta1_PendingWorkloadsSummary(in *v1beta2.PendingWorkloadsSummary, out *PendingWorkloadsSummary, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	out.Items = *(*[]PendingWorkload)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PendingWorkload, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_PendingWorkload_To_v1beta1_PendingWorkload(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -17,7 +17,10 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
@@ -27,6 +30,7 @@ import (
 // +k8s:openapi-gen=true
 // +genclient:nonNamespaced
 // +genclient:method=GetPendingWorkloadsSummary,verb=get,subresource=pendingworkloads,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.PendingWorkloadsSummary
+// +genclient:method=GetUsageReport,verb=get,subresource=usage,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.UsageReport
 type ClusterQueue struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -46,6 +50,8 @@ type ClusterQueueList struct {
 // +kubebuilder:object:root=true
 // +k8s:openapi-gen=true
 // +genclient:method=GetPendingWorkloadsSummary,verb=get,subresource=pendingworkloads,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.PendingWorkloadsSummary
+// +genclient:method=SimulateAdmission,verb=create,subresource=admissionsimulation,input=sigs.k8s.io/kueue/apis/visibility/v1beta2.AdmissionSimulation,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.AdmissionSimulation
+// +genclient:method=GetUsageReport,verb=get,subresource=usage,result=sigs.k8s.io/kueue/apis/visibility/v1beta2.UsageReport
 type LocalQueue struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -77,8 +83,92 @@ type PendingWorkload struct {
 
 	// PositionInLocalQueue indicates the workload's position in the LocalQueue, starting from 0
 	PositionInLocalQueue int32 `json:"positionInLocalQueue"`
+
+	// ShadowTime is the expected start time reserved for the workload when it blocks
+	// the head of a ClusterQueue with the Backfill queueing strategy.
+	// +optional
+	ShadowTime *metav1.Time `json:"shadowTime,omitempty"`
+
+	// EstimatedAdmissionTime is the estimated time at which the workload is
+	// admitted. It's computed from the admission wait times in the ClusterQueue,
+	// the maximum execution times of the admitted workloads, and the
+	// workload's position in the ClusterQueue.
+	// +optional
+	EstimatedAdmissionTime *metav1.Time `json:"estimatedAdmissionTime,omitempty"`
+
+	// EstimatedAdmissionTimeConfidence indicates how reliable the
+	// EstimatedAdmissionTime is.
+	// +optional
+	EstimatedAdmissionTimeConfidence EstimationConfidence `json:"estimatedAdmissionTimeConfidence,omitempty"`
+
+	// RemoteStatuses holds the state of the workload in each of the MultiKueue
+	// worker clusters it's dispatched to. It's only set in the manager cluster,
+	// for the workloads dispatched to the worker clusters and not admitted yet.
+	// +optional
+	RemoteStatuses []RemoteWorkloadStatus `json:"remoteStatuses,omitempty"`
 }
 
+// RemoteWorkloadState is the state of a workload in a MultiKueue worker cluster.
+type RemoteWorkloadState string
+
+const (
+	// RemoteWorkloadPending means that the workload is pending in the worker cluster.
+	RemoteWorkloadPending RemoteWorkloadState = "Pending"
+
+	// RemoteWorkloadQuotaReserved means that the workload has quota reserved
+	// in the worker cluster, and waits for its admission checks.
+	RemoteWorkloadQuotaReserved RemoteWorkloadState = "QuotaReserved"
+
+	// RemoteWorkloadAdmitted means that the workload is admitted in the worker cluster.
+	RemoteWorkloadAdmitted RemoteWorkloadState = "Admitted"
+
+	// RemoteWorkloadUnknown means that the state of the workload in the worker
+	// cluster can't be retrieved, for example because the cluster is not connected.
+	RemoteWorkloadUnknown RemoteWorkloadState = "Unknown"
+)
+
+// RemoteWorkloadStatus is the state of a workload in a MultiKueue worker cluster.
+type RemoteWorkloadStatus struct {
+	// ClusterName is the name of the MultiKueue worker cluster
+	ClusterName string `json:"clusterName"`
+
+	// State is the state of the workload in the worker cluster
+	State RemoteWorkloadState `json:"state"`
+
+	// PositionInClusterQueue indicates the workload's position in the ClusterQueue
+	// of the worker cluster, starting from 0. It's only set when the workload is pending.
+	// +optional
+	PositionInClusterQueue *int32 `json:"positionInClusterQueue,omitempty"`
+
+	// PositionInLocalQueue indicates the workload's position in the LocalQueue
+	// of the worker cluster, starting from 0. It's only set when the workload is pending.
+	// +optional
+	PositionInLocalQueue *int32 `json:"positionInLocalQueue,omitempty"`
+
+	// Message explains the state of the workload in the worker cluster, for
+	// example why it's pending.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// EstimationConfidence indicates how reliable an estimation is.
+type EstimationConfidence string
+
+const (
+	// HighConfidence means that the estimation is based on the maximum
+	// execution times of all the admitted workloads, and that it agrees
+	// with the admission wait times in the ClusterQueue.
+	HighConfidence EstimationConfidence = "High"
+
+	// MediumConfidence means that the estimation is based on the maximum
+	// execution times of the admitted workloads only.
+	MediumConfidence EstimationConfidence = "Medium"
+
+	// LowConfidence means that the estimation is based on the admission
+	// wait times in the ClusterQueue only.
+	LowConfidence EstimationConfidence = "Low"
+)
+
 // +k8s:openapi-gen=true
 // +kubebuilder:object:root=true
 
@@ -106,3 +196,189 @@ type PendingWorkloadOptions struct {
 	// Limit indicates max number of pending workloads that should be fetched. 1000 by default
 	Limit int64 `json:"limit,omitempty"`
 }
+
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// AdmissionSimulation evaluates how the scheduler would handle a Workload
+// submitted to a LocalQueue, without creating the Workload or changing the
+// state of the cluster. The request carries the Workload manifest and the
+// response carries the outcome of the evaluation.
+type AdmissionSimulation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Workload is the manifest of the kueue.x-k8s.io Workload to evaluate.
+	// The namespace and queue name of the Workload are taken from the LocalQueue
+	// the simulation is requested for.
+	Workload runtime.RawExtension `json:"workload"`
+
+	// Result is the outcome of the simulation. It is populated by the server.
+	// +optional
+	Result *AdmissionSimulationResult `json:"result,omitempty"`
+}
+
+// AdmissionSimulationResult is the outcome of evaluating a Workload against the
+// current state of the ClusterQueue its LocalQueue points to.
+type AdmissionSimulationResult struct {
+	// ClusterQueue is the name of the ClusterQueue the Workload was evaluated against.
+	ClusterQueue v1beta2.ClusterQueueReference `json:"clusterQueue"`
+
+	// Mode is the outcome of the flavor assignment: Fit when the Workload
+	// can be admitted right away, Preempt when it needs to preempt other
+	// workloads, and NoFit when it cannot be admitted.
+	Mode string `json:"mode"`
+
+	// Admissible indicates that the Workload would be admitted, possibly after
+	// the preemption of the workloads listed in PreemptionTargets.
+	Admissible bool `json:"admissible"`
+
+	// Borrowing indicates that the assignment borrows quota from the cohort.
+	Borrowing bool `json:"borrowing"`
+
+	// PodSetAssignments holds the flavors that would be assigned to every PodSet.
+	// +optional
+	PodSetAssignments []SimulatedPodSetAssignment `json:"podSetAssignments,omitempty"`
+
+	// PreemptionTargets lists the workloads that would be preempted to make room
+	// for the Workload. The workloads in other namespaces than the LocalQueue
+	// are listed without their name and namespace.
+	// +optional
+	PreemptionTargets []SimulatedPreemptionTarget `json:"preemptionTargets,omitempty"`
+
+	// Message explains why the Workload does not fit, if applicable.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// SimulatedPodSetAssignment describes the flavors assigned to a PodSet during
+// an admission simulation.
+type SimulatedPodSetAssignment struct {
+	// Name is the name of the PodSet.
+	Name v1beta2.PodSetReference `json:"name"`
+
+	// Flavors are the flavors assigned to the PodSet per resource.
+	// +optional
+	Flavors map[corev1.ResourceName]v1beta2.ResourceFlavorReference `json:"flavors,omitempty"`
+
+	// ResourceUsage is the total quantity of resources the PodSet would use.
+	// +optional
+	ResourceUsage corev1.ResourceList `json:"resourceUsage,omitempty"`
+
+	// Count is the number of pods the PodSet would be admitted with.
+	Count int32 `json:"count"`
+}
+
+// SimulatedPreemptionTarget is a workload that would be preempted to admit
+// the simulated Workload.
+type SimulatedPreemptionTarget struct {
+	// Name of the preempted workload. It's omitted when the workload is in
+	// another namespace than the LocalQueue.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the preempted workload. It's omitted when the workload is
+	// in another namespace than the LocalQueue.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// ClusterQueue is the ClusterQueue the preempted workload is admitted in.
+	ClusterQueue v1beta2.ClusterQueueReference `json:"clusterQueue"`
+
+	// Priority of the preempted workload.
+	Priority int32 `json:"priority"`
+
+	// Reason is the reason of the preemption, for example InClusterQueue or
+	// InCohortReclamation.
+	Reason string `json:"reason"`
+}
+
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// UsageReport contains the quota reserved and used over time by the workloads
+// of a LocalQueue, or of all the LocalQueues pointing to a ClusterQueue.
+type UsageReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Time is when the usage was accounted up to.
+	Time metav1.Time `json:"time"`
+
+	// LocalQueues holds the usage accounted for the LocalQueues, including the
+	// usage of the workloads which no longer exist.
+	// +optional
+	LocalQueues []LocalQueueUsage `json:"localQueues,omitempty"`
+
+	// Workloads holds the usage accounted for the existing workloads since the
+	// start of the Kueue manager.
+	// +optional
+	Workloads []WorkloadUsage `json:"workloads,omitempty"`
+}
+
+// LocalQueueUsage is the usage accounted for a LocalQueue.
+type LocalQueueUsage struct {
+	// Name of the LocalQueue.
+	Name v1beta2.LocalQueueName `json:"name"`
+
+	// Namespace of the LocalQueue.
+	Namespace string `json:"namespace"`
+
+	// Resources holds the usage per ClusterQueue, flavor and resource.
+	// +optional
+	Resources []ResourceUsageAccounting `json:"resources,omitempty"`
+
+	// Periods holds the usage per calendar month, in UTC, ordered by start.
+	// +optional
+	Periods []UsagePeriod `json:"periods,omitempty"`
+}
+
+// WorkloadUsage is the usage accounted for a workload.
+type WorkloadUsage struct {
+	// Name of the workload.
+	Name string `json:"name"`
+
+	// Namespace of the workload.
+	Namespace string `json:"namespace"`
+
+	// LocalQueueName is the name of the LocalQueue the workload is submitted to.
+	LocalQueueName v1beta2.LocalQueueName `json:"localQueueName"`
+
+	// Resources holds the usage per ClusterQueue, flavor and resource.
+	// +optional
+	Resources []ResourceUsageAccounting `json:"resources,omitempty"`
+
+	// Periods holds the usage per calendar month, in UTC, ordered by start.
+	// +optional
+	Periods []UsagePeriod `json:"periods,omitempty"`
+}
+
+// UsagePeriod is the usage accounted during a calendar month.
+type UsagePeriod struct {
+	// Start is the start of the month, in UTC.
+	Start metav1.Time `json:"start"`
+
+	// Resources holds the usage per ClusterQueue, flavor and resource.
+	// +optional
+	Resources []ResourceUsageAccounting `json:"resources,omitempty"`
+}
+
+// ResourceUsageAccounting is the quota of a resource reserved and used over
+// time, in resource-seconds.
+type ResourceUsageAccounting struct {
+	// ClusterQueue is the ClusterQueue the quota was reserved in.
+	ClusterQueue v1beta2.ClusterQueueReference `json:"clusterQueue"`
+
+	// Flavor is the flavor of the quota.
+	Flavor v1beta2.ResourceFlavorReference `json:"flavor"`
+
+	// Name of the resource.
+	Name corev1.ResourceName `json:"name"`
+
+	// Reserved is the quota reserved over time, in resource-seconds.
+	Reserved resource.Quantity `json:"reserved"`
+
+	// Used is the quota used by admitted workloads over time, in
+	// resource-seconds.
+	Used resource.Quantity `json:"used"`
+}
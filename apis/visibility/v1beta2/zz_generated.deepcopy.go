@@ -21,9 +21,69 @@ limitations under the License.
 package v1beta2
 
 import (
+	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionSimulation) DeepCopyInto(out *AdmissionSimulation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Workload.DeepCopyInto(&out.Workload)
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(AdmissionSimulationResult)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionSimulation.
+func (in *AdmissionSimulation) DeepCopy() *AdmissionSimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionSimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdmissionSimulation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionSimulationResult) DeepCopyInto(out *AdmissionSimulationResult) {
+	*out = *in
+	if in.PodSetAssignments != nil {
+		in, out := &in.PodSetAssignments, &out.PodSetAssignments
+		*out = make([]SimulatedPodSetAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreemptionTargets != nil {
+		in, out := &in.PreemptionTargets, &out.PreemptionTargets
+		*out = make([]SimulatedPreemptionTarget, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionSimulationResult.
+func (in *AdmissionSimulationResult) DeepCopy() *AdmissionSimulationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionSimulationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterQueue) DeepCopyInto(out *ClusterQueue) {
 	*out = *in
@@ -140,10 +200,54 @@ func (in *LocalQueueList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueUsage) DeepCopyInto(out *LocalQueueUsage) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceUsageAccounting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Periods != nil {
+		in, out := &in.Periods, &out.Periods
+		*out = make([]UsagePeriod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueUsage.
+func (in *LocalQueueUsage) DeepCopy() *LocalQueueUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PendingWorkload) DeepCopyInto(out *PendingWorkload) {
 	*out = *in
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.ShadowTime != nil {
+		in, out := &in.ShadowTime, &out.ShadowTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EstimatedAdmissionTime != nil {
+		in, out := &in.EstimatedAdmissionTime, &out.EstimatedAdmissionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RemoteStatuses != nil {
+		in, out := &in.RemoteStatuses, &out.RemoteStatuses
+		*out = make([]RemoteWorkloadStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingWorkload.
@@ -211,3 +315,181 @@ func (in *PendingWorkloadsSummary) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteWorkloadStatus) DeepCopyInto(out *RemoteWorkloadStatus) {
+	*out = *in
+	if in.PositionInClusterQueue != nil {
+		in, out := &in.PositionInClusterQueue, &out.PositionInClusterQueue
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PositionInLocalQueue != nil {
+		in, out := &in.PositionInLocalQueue, &out.PositionInLocalQueue
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteWorkloadStatus.
+func (in *RemoteWorkloadStatus) DeepCopy() *RemoteWorkloadStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteWorkloadStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceUsageAccounting) DeepCopyInto(out *ResourceUsageAccounting) {
+	*out = *in
+	out.Reserved = in.Reserved.DeepCopy()
+	out.Used = in.Used.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsageAccounting.
+func (in *ResourceUsageAccounting) DeepCopy() *ResourceUsageAccounting {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceUsageAccounting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedPodSetAssignment) DeepCopyInto(out *SimulatedPodSetAssignment) {
+	*out = *in
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make(map[v1.ResourceName]kueuev1beta2.ResourceFlavorReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedPodSetAssignment.
+func (in *SimulatedPodSetAssignment) DeepCopy() *SimulatedPodSetAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedPodSetAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedPreemptionTarget) DeepCopyInto(out *SimulatedPreemptionTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedPreemptionTarget.
+func (in *SimulatedPreemptionTarget) DeepCopy() *SimulatedPreemptionTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedPreemptionTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsagePeriod) DeepCopyInto(out *UsagePeriod) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceUsageAccounting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsagePeriod.
+func (in *UsagePeriod) DeepCopy() *UsagePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(UsagePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageReport) DeepCopyInto(out *UsageReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Time.DeepCopyInto(&out.Time)
+	if in.LocalQueues != nil {
+		in, out := &in.LocalQueues, &out.LocalQueues
+		*out = make([]LocalQueueUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]WorkloadUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageReport.
+func (in *UsageReport) DeepCopy() *UsageReport {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UsageReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUsage) DeepCopyInto(out *WorkloadUsage) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceUsageAccounting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Periods != nil {
+		in, out := &in.Periods, &out.Periods
+		*out = make([]UsagePeriod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUsage.
+func (in *WorkloadUsage) DeepCopy() *WorkloadUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUsage)
+	in.DeepCopyInto(out)
+	return out
+}
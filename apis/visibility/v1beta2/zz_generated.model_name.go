@@ -21,6 +21,16 @@ limitations under the License.
 
 package v1beta2
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AdmissionSimulation) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.AdmissionSimulation"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in AdmissionSimulationResult) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.AdmissionSimulationResult"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in ClusterQueue) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.ClusterQueue"
@@ -41,6 +51,11 @@ func (in LocalQueueList) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.LocalQueueList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in LocalQueueUsage) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.LocalQueueUsage"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in PendingWorkload) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.PendingWorkload"
@@ -55,3 +70,38 @@ func (in PendingWorkloadOptions) OpenAPIModelName() string {
 func (in PendingWorkloadsSummary) OpenAPIModelName() string {
 	return "io.k8s.kueue.visibility.v1beta2.PendingWorkloadsSummary"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RemoteWorkloadStatus) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.RemoteWorkloadStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in ResourceUsageAccounting) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.ResourceUsageAccounting"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SimulatedPodSetAssignment) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.SimulatedPodSetAssignment"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in SimulatedPreemptionTarget) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.SimulatedPreemptionTarget"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in UsagePeriod) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.UsagePeriod"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in UsageReport) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.UsageReport"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WorkloadUsage) OpenAPIModelName() string {
+	return "io.k8s.kueue.visibility.v1beta2.WorkloadUsage"
+}
@@ -19,6 +19,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
 
@@ -29,6 +30,10 @@ type AdmissionApplyConfiguration struct {
 	ClusterQueue *kueuev1beta2.ClusterQueueReference `json:"clusterQueue,omitempty"`
 	// podSetAssignments hold the admission results for each of the .spec.podSets entries.
 	PodSetAssignments []PodSetAssignmentApplyConfiguration `json:"podSetAssignments,omitempty"`
+	// cost is the total cost of the resources assigned to the workload, computed
+	// from the prices of the assigned ResourceFlavors at the moment of admission.
+	// It is only set when at least one of the assigned flavors has prices.
+	Cost *resource.Quantity `json:"cost,omitempty"`
 }
 
 // AdmissionApplyConfiguration constructs a declarative configuration of the Admission type for use with
@@ -57,3 +62,11 @@ func (b *AdmissionApplyConfiguration) WithPodSetAssignments(values ...*PodSetAss
 	}
 	return b
 }
+
+// WithCost sets the Cost field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cost field is set to the value of the last call.
+func (b *AdmissionApplyConfiguration) WithCost(value resource.Quantity) *AdmissionApplyConfiguration {
+	b.Cost = &value
+	return b
+}
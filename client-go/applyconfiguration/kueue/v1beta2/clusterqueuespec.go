@@ -19,6 +19,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
@@ -91,6 +92,31 @@ type ClusterQueueSpecApplyConfiguration struct {
 	// Additionally after the admission, Workloads can still try to pursue capacity on the more preferable flavors while running.
 	// It enables them to migrate to more preferable, whenever capacity appears.
 	ConcurrentAdmissionPolicy *ConcurrentAdmissionPolicyApplyConfiguration `json:"concurrentAdmissionPolicy,omitempty"`
+	// quotaSchedule defines recurring time windows during which the quotas
+	// of some flavors are overridden. Outside of the windows, the quotas
+	// defined in resourceGroups apply.
+	// This field requires the TimeWindowedQuotas feature gate.
+	QuotaSchedule *QuotaScheduleApplyConfiguration `json:"quotaSchedule,omitempty"`
+	// reservations lists the Reservations booking quota of this
+	// ClusterQueue. During the window of a Reservation, its quantities are
+	// only available to the Workloads tagged for it.
+	// This field requires the CapacityReservations feature gate.
+	Reservations []kueuev1beta2.ReservationReference `json:"reservations,omitempty"`
+	// topologyDefragmentation configures the defragmentation of the
+	// TopologyAwareScheduling flavors of the ClusterQueue. When set, Kueue
+	// periodically looks for admitted workloads whose eviction, and placement
+	// after a pending workload, would open a topology domain required by the
+	// pending workload, and publishes the plan in the status.
+	// This field requires the TASDefragmentation feature gate.
+	TopologyDefragmentation *TopologyDefragmentationApplyConfiguration `json:"topologyDefragmentation,omitempty"`
+	// workflowReservationLimit caps the quota a workflow can reserve in this
+	// ClusterQueue with the kueue.x-k8s.io/workflow-reservation annotation
+	// of its Workloads. The Workloads reserving more than the limit for a
+	// resource, or a resource missing from the limit, are not admitted.
+	// When the field is not set, the Workloads with a workflow reservation
+	// are not admitted.
+	// This field requires the WorkflowBudgets feature gate.
+	WorkflowReservationLimit *corev1.ResourceList `json:"workflowReservationLimit,omitempty"`
 }
 
 // ClusterQueueSpecApplyConfiguration constructs a declarative configuration of the ClusterQueueSpec type for use with
@@ -191,3 +217,37 @@ func (b *ClusterQueueSpecApplyConfiguration) WithConcurrentAdmissionPolicy(value
 	b.ConcurrentAdmissionPolicy = value
 	return b
 }
+
+// WithQuotaSchedule sets the QuotaSchedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QuotaSchedule field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithQuotaSchedule(value *QuotaScheduleApplyConfiguration) *ClusterQueueSpecApplyConfiguration {
+	b.QuotaSchedule = value
+	return b
+}
+
+// WithReservations adds the given value to the Reservations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Reservations field.
+func (b *ClusterQueueSpecApplyConfiguration) WithReservations(values ...kueuev1beta2.ReservationReference) *ClusterQueueSpecApplyConfiguration {
+	for i := range values {
+		b.Reservations = append(b.Reservations, values[i])
+	}
+	return b
+}
+
+// WithTopologyDefragmentation sets the TopologyDefragmentation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyDefragmentation field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithTopologyDefragmentation(value *TopologyDefragmentationApplyConfiguration) *ClusterQueueSpecApplyConfiguration {
+	b.TopologyDefragmentation = value
+	return b
+}
+
+// WithWorkflowReservationLimit sets the WorkflowReservationLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkflowReservationLimit field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithWorkflowReservationLimit(value corev1.ResourceList) *ClusterQueueSpecApplyConfiguration {
+	b.WorkflowReservationLimit = &value
+	return b
+}
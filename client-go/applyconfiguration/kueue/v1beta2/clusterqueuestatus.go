@@ -51,6 +51,19 @@ type ClusterQueueStatusApplyConfiguration struct {
 	// when participating in Fair Sharing.
 	// This is recorded only when Fair Sharing is enabled in the Kueue configuration.
 	FairSharing *FairSharingStatusApplyConfiguration `json:"fairSharing,omitempty"`
+	// quotaWindow is the state of the quotaSchedule of the ClusterQueue.
+	// This is recorded only when the ClusterQueue has a quotaSchedule.
+	QuotaWindow *ClusterQueueQuotaWindowStatusApplyConfiguration `json:"quotaWindow,omitempty"`
+	// backfill is the start time reserved for the workload blocking the
+	// head of the ClusterQueue.
+	// This is recorded only when the queueingStrategy is Backfill and the
+	// head of the ClusterQueue can't be admitted.
+	Backfill *ClusterQueueBackfillStatusApplyConfiguration `json:"backfill,omitempty"`
+	// topologyDefragmentation is the defragmentation plan opening a topology
+	// domain for a pending workload of the ClusterQueue.
+	// This is recorded only when the ClusterQueue has a topologyDefragmentation
+	// and a plan is found.
+	TopologyDefragmentation *ClusterQueueTopologyDefragmentationStatusApplyConfiguration `json:"topologyDefragmentation,omitempty"`
 }
 
 // ClusterQueueStatusApplyConfiguration constructs a declarative configuration of the ClusterQueueStatus type for use with
@@ -129,3 +142,27 @@ func (b *ClusterQueueStatusApplyConfiguration) WithFairSharing(value *FairSharin
 	b.FairSharing = value
 	return b
 }
+
+// WithQuotaWindow sets the QuotaWindow field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QuotaWindow field is set to the value of the last call.
+func (b *ClusterQueueStatusApplyConfiguration) WithQuotaWindow(value *ClusterQueueQuotaWindowStatusApplyConfiguration) *ClusterQueueStatusApplyConfiguration {
+	b.QuotaWindow = value
+	return b
+}
+
+// WithBackfill sets the Backfill field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Backfill field is set to the value of the last call.
+func (b *ClusterQueueStatusApplyConfiguration) WithBackfill(value *ClusterQueueBackfillStatusApplyConfiguration) *ClusterQueueStatusApplyConfiguration {
+	b.Backfill = value
+	return b
+}
+
+// WithTopologyDefragmentation sets the TopologyDefragmentation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyDefragmentation field is set to the value of the last call.
+func (b *ClusterQueueStatusApplyConfiguration) WithTopologyDefragmentation(value *ClusterQueueTopologyDefragmentationStatusApplyConfiguration) *ClusterQueueStatusApplyConfiguration {
+	b.TopologyDefragmentation = value
+	return b
+}
@@ -66,6 +66,11 @@ type CohortSpecApplyConfiguration struct {
 	// participating in FairSharing. The values are only relevant
 	// if FairSharing is enabled in the Kueue configuration.
 	FairSharing *FairSharingApplyConfiguration `json:"fairSharing,omitempty"`
+	// reservations lists the Reservations booking quota of this Cohort
+	// subtree. During the window of a Reservation, its quantities are only
+	// available to the Workloads tagged for it.
+	// This field requires the CapacityReservations feature gate.
+	Reservations []kueuev1beta2.ReservationReference `json:"reservations,omitempty"`
 }
 
 // CohortSpecApplyConfiguration constructs a declarative configuration of the CohortSpec type for use with
@@ -102,3 +107,13 @@ func (b *CohortSpecApplyConfiguration) WithFairSharing(value *FairSharingApplyCo
 	b.FairSharing = value
 	return b
 }
+
+// WithReservations adds the given value to the Reservations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Reservations field.
+func (b *CohortSpecApplyConfiguration) WithReservations(values ...kueuev1beta2.ReservationReference) *CohortSpecApplyConfiguration {
+	for i := range values {
+		b.Reservations = append(b.Reservations, values[i])
+	}
+	return b
+}
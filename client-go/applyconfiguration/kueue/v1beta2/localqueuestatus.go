@@ -47,6 +47,10 @@ type LocalQueueStatusApplyConfiguration struct {
 	FlavorsUsage []LocalQueueFlavorUsageApplyConfiguration `json:"flavorsUsage,omitempty"`
 	// fairSharing contains the information about the current status of fair sharing.
 	FairSharing *LocalQueueFairSharingStatusApplyConfiguration `json:"fairSharing,omitempty"`
+	// usageAccounting holds the quota reserved and used by the workloads
+	// assigned to this LocalQueue, accumulated over time.
+	// This field requires the UsageAccounting feature gate to be enabled.
+	UsageAccounting *LocalQueueUsageAccountingApplyConfiguration `json:"usageAccounting,omitempty"`
 }
 
 // LocalQueueStatusApplyConfiguration constructs a declarative configuration of the LocalQueueStatus type for use with
@@ -125,3 +129,11 @@ func (b *LocalQueueStatusApplyConfiguration) WithFairSharing(value *LocalQueueFa
 	b.FairSharing = value
 	return b
 }
+
+// WithUsageAccounting sets the UsageAccounting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UsageAccounting field is set to the value of the last call.
+func (b *LocalQueueStatusApplyConfiguration) WithUsageAccounting(value *LocalQueueUsageAccountingApplyConfiguration) *LocalQueueStatusApplyConfiguration {
+	b.UsageAccounting = value
+	return b
+}
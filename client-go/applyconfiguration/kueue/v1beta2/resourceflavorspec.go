@@ -19,7 +19,8 @@ limitations under the License.
 package v1beta2
 
 import (
-	v1 "k8s.io/client-go/applyconfigurations/core/v1"
+	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 )
 
@@ -52,7 +53,17 @@ type ResourceFlavorSpecApplyConfiguration struct {
 	// cloud.provider.com/preemptible="true":NoSchedule
 	//
 	// nodeTaints can be up to 8 elements.
-	NodeTaints []v1.TaintApplyConfiguration `json:"nodeTaints,omitempty"`
+	NodeTaints []corev1.TaintApplyConfiguration `json:"nodeTaints,omitempty"`
+	// prices are the prices of one unit of each resource in this flavor, for
+	// example one CPU or one byte of memory, in an arbitrary currency shared by
+	// all the ResourceFlavors. Resources without a price are free.
+	// The prices are used to choose the flavors when the flavorFungibility
+	// preference of a ClusterQueue is LowestCost, and to compute the cost of
+	// the admitted Workloads.
+	//
+	// prices can be up to 64 elements.
+	// This field requires the FlavorCost feature gate to be enabled.
+	Prices *v1.ResourceList `json:"prices,omitempty"`
 	// tolerations are extra tolerations that will be added to the pods admitted in
 	// the quota associated with this resource flavor.
 	//
@@ -60,11 +71,22 @@ type ResourceFlavorSpecApplyConfiguration struct {
 	// cloud.provider.com/preemptible="true":NoSchedule
 	//
 	// tolerations can be up to 8 elements.
-	Tolerations []v1.TolerationApplyConfiguration `json:"tolerations,omitempty"`
+	Tolerations []corev1.TolerationApplyConfiguration `json:"tolerations,omitempty"`
 	// topologyName indicates topology for the TAS ResourceFlavor.
 	// When specified, it enables scraping of the topology information from the
 	// nodes matching to the Resource Flavor node labels.
 	TopologyName *kueuev1beta2.TopologyReference `json:"topologyName,omitempty"`
+	// topologies indicates the topologies for a TAS ResourceFlavor whose nodes
+	// belong to several topologies with different levels, for example TPU
+	// slices and GPU racks. Each topology is scraped from the nodes matching
+	// both the Resource Flavor node labels and the node labels of the topology.
+	// When computing the topology assignment of a PodSet, the topologies are
+	// tried in order, and the topology assignment records the one used.
+	// topologies is mutually exclusive with topologyName.
+	//
+	// topologies can be up to 8 elements.
+	// This field requires the TASMultiTopologyFlavors feature gate to be enabled.
+	Topologies []FlavorTopologyApplyConfiguration `json:"topologies,omitempty"`
 }
 
 // ResourceFlavorSpecApplyConfiguration constructs a declarative configuration of the ResourceFlavorSpec type for use with
@@ -90,7 +112,7 @@ func (b *ResourceFlavorSpecApplyConfiguration) WithNodeLabels(entries map[string
 // WithNodeTaints adds the given value to the NodeTaints field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the NodeTaints field.
-func (b *ResourceFlavorSpecApplyConfiguration) WithNodeTaints(values ...*v1.TaintApplyConfiguration) *ResourceFlavorSpecApplyConfiguration {
+func (b *ResourceFlavorSpecApplyConfiguration) WithNodeTaints(values ...*corev1.TaintApplyConfiguration) *ResourceFlavorSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithNodeTaints")
@@ -100,10 +122,18 @@ func (b *ResourceFlavorSpecApplyConfiguration) WithNodeTaints(values ...*v1.Tain
 	return b
 }
 
+// WithPrices sets the Prices field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prices field is set to the value of the last call.
+func (b *ResourceFlavorSpecApplyConfiguration) WithPrices(value v1.ResourceList) *ResourceFlavorSpecApplyConfiguration {
+	b.Prices = &value
+	return b
+}
+
 // WithTolerations adds the given value to the Tolerations field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Tolerations field.
-func (b *ResourceFlavorSpecApplyConfiguration) WithTolerations(values ...*v1.TolerationApplyConfiguration) *ResourceFlavorSpecApplyConfiguration {
+func (b *ResourceFlavorSpecApplyConfiguration) WithTolerations(values ...*corev1.TolerationApplyConfiguration) *ResourceFlavorSpecApplyConfiguration {
 	for i := range values {
 		if values[i] == nil {
 			panic("nil value passed to WithTolerations")
@@ -120,3 +150,16 @@ func (b *ResourceFlavorSpecApplyConfiguration) WithTopologyName(value kueuev1bet
 	b.TopologyName = &value
 	return b
 }
+
+// WithTopologies adds the given value to the Topologies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Topologies field.
+func (b *ResourceFlavorSpecApplyConfiguration) WithTopologies(values ...*FlavorTopologyApplyConfiguration) *ResourceFlavorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTopologies")
+		}
+		b.Topologies = append(b.Topologies, *values[i])
+	}
+	return b
+}
@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1beta2
 
+import (
+	kueuev1beta2 "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
 // TopologyAssignmentApplyConfiguration represents a declarative configuration of the TopologyAssignment type for use
 // with apply.
 type TopologyAssignmentApplyConfiguration struct {
@@ -28,6 +32,10 @@ type TopologyAssignmentApplyConfiguration struct {
 	// slices represent topology assignments for subsets of pods of a workload.
 	// The full assignment is obtained as a union of all slices.
 	Slices []TopologyAssignmentSliceApplyConfiguration `json:"slices,omitempty"`
+	// topologyName is the name of the Topology used for the assignment. It is
+	// set only when the ResourceFlavor references several topologies, with
+	// the spec.topologies field.
+	TopologyName *kueuev1beta2.TopologyReference `json:"topologyName,omitempty"`
 }
 
 // TopologyAssignmentApplyConfiguration constructs a declarative configuration of the TopologyAssignment type for use with
@@ -58,3 +66,11 @@ func (b *TopologyAssignmentApplyConfiguration) WithSlices(values ...*TopologyAss
 	}
 	return b
 }
+
+// WithTopologyName sets the TopologyName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyName field is set to the value of the last call.
+func (b *TopologyAssignmentApplyConfiguration) WithTopologyName(value kueuev1beta2.TopologyReference) *TopologyAssignmentApplyConfiguration {
+	b.TopologyName = &value
+	return b
+}
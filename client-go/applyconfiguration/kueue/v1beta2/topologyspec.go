@@ -25,6 +25,17 @@ package v1beta2
 type TopologySpecApplyConfiguration struct {
 	// levels define the levels of topology.
 	Levels []TopologyLevelApplyConfiguration `json:"levels,omitempty"`
+	// distances define the network distances between the topology domains of
+	// the levels, e.g. the number of network hops between the blocks.
+	//
+	// When the pods of a PodSet cannot fit within a single topology domain,
+	// Topology Aware Scheduling places them on the set of domains with the
+	// lowest total distance between them, rather than on the domains with the
+	// most free capacity.
+	//
+	// This field is alpha-level and is honored only when the TASNetworkDistance
+	// feature gate is enabled.
+	Distances []TopologyLevelDistancesApplyConfiguration `json:"distances,omitempty"`
 }
 
 // TopologySpecApplyConfiguration constructs a declarative configuration of the TopologySpec type for use with
@@ -45,3 +56,16 @@ func (b *TopologySpecApplyConfiguration) WithLevels(values ...*TopologyLevelAppl
 	}
 	return b
 }
+
+// WithDistances adds the given value to the Distances field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Distances field.
+func (b *TopologySpecApplyConfiguration) WithDistances(values ...*TopologyLevelDistancesApplyConfiguration) *TopologySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDistances")
+		}
+		b.Distances = append(b.Distances, *values[i])
+	}
+	return b
+}
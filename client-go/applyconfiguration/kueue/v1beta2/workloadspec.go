@@ -61,6 +61,17 @@ type WorkloadSpecApplyConfiguration struct {
 	// can trigger preemptions.
 	// The gates are closed by default.
 	PreemptionGates []PreemptionGateApplyConfiguration `json:"preemptionGates,omitempty"`
+	// podSetShapeConstraints keep the counts of coupled podSets proportional
+	// when the workload is partially admitted, for example 8 workers per
+	// parameter server. Each constraint sets the count of a podSet to a
+	// multiple of the count of another podSet.
+	//
+	// The counts of the podSets must satisfy the constraints. The minCount of
+	// a constrained podSet is optional and further bounds its reduced count.
+	// podSetShapeConstraints cannot be changed while .status.admission is not null.
+	//
+	// This is an alpha field and requires enabling PodSetShapeConstraints feature gate.
+	PodSetShapeConstraints []PodSetShapeConstraintApplyConfiguration `json:"podSetShapeConstraints,omitempty"`
 }
 
 // WorkloadSpecApplyConfiguration constructs a declarative configuration of the WorkloadSpec type for use with
@@ -134,3 +145,16 @@ func (b *WorkloadSpecApplyConfiguration) WithPreemptionGates(values ...*Preempti
 	}
 	return b
 }
+
+// WithPodSetShapeConstraints adds the given value to the PodSetShapeConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PodSetShapeConstraints field.
+func (b *WorkloadSpecApplyConfiguration) WithPodSetShapeConstraints(values ...*PodSetShapeConstraintApplyConfiguration) *WorkloadSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPodSetShapeConstraints")
+		}
+		b.PodSetShapeConstraints = append(b.PodSetShapeConstraints, *values[i])
+	}
+	return b
+}
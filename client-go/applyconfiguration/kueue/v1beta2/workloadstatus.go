@@ -81,6 +81,16 @@ type WorkloadStatusApplyConfiguration struct {
 	// preemptionGates is a list of states of gates governing whether the workload
 	// can trigger preemptions.
 	PreemptionGates []PreemptionGateStateApplyConfiguration `json:"preemptionGates,omitempty"`
+	// migrations records the migrations of the workload between MultiKueue
+	// worker clusters, the most recent last. Only the 8 most recent
+	// migrations are kept.
+	// Requires enabling the MultiKueueWorkloadMigration feature gate.
+	Migrations []WorkloadMigrationApplyConfiguration `json:"migrations,omitempty"`
+	// topologyPlacementExplanations explains why Topology-Aware Scheduling
+	// could not place the PodSets of a pending workload. It is cleared when
+	// the workload reserves quota.
+	// Requires enabling the TASPlacementExplanation feature gate.
+	TopologyPlacementExplanations []TopologyPlacementExplanationApplyConfiguration `json:"topologyPlacementExplanations,omitempty"`
 }
 
 // WorkloadStatusApplyConfiguration constructs a declarative configuration of the WorkloadStatus type for use with
@@ -216,3 +226,29 @@ func (b *WorkloadStatusApplyConfiguration) WithPreemptionGates(values ...*Preemp
 	}
 	return b
 }
+
+// WithMigrations adds the given value to the Migrations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Migrations field.
+func (b *WorkloadStatusApplyConfiguration) WithMigrations(values ...*WorkloadMigrationApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMigrations")
+		}
+		b.Migrations = append(b.Migrations, *values[i])
+	}
+	return b
+}
+
+// WithTopologyPlacementExplanations adds the given value to the TopologyPlacementExplanations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologyPlacementExplanations field.
+func (b *WorkloadStatusApplyConfiguration) WithTopologyPlacementExplanations(values ...*TopologyPlacementExplanationApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTopologyPlacementExplanations")
+		}
+		b.TopologyPlacementExplanations = append(b.TopologyPlacementExplanations, *values[i])
+	}
+	return b
+}
@@ -194,18 +194,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.AdmissionCheckStrategyRuleApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("AdmissionScope"):
 		return &kueuev1beta2.AdmissionScopeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("BackfillWorkloadReference"):
+		return &kueuev1beta2.BackfillWorkloadReferenceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BorrowWithinCohort"):
 		return &kueuev1beta2.BorrowWithinCohortApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ClusterProfileReference"):
 		return &kueuev1beta2.ClusterProfileReferenceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ClusterQueue"):
 		return &kueuev1beta2.ClusterQueueApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ClusterQueueBackfillStatus"):
+		return &kueuev1beta2.ClusterQueueBackfillStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ClusterQueuePreemption"):
 		return &kueuev1beta2.ClusterQueuePreemptionApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ClusterQueueQuotaWindowStatus"):
+		return &kueuev1beta2.ClusterQueueQuotaWindowStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ClusterQueueSpec"):
 		return &kueuev1beta2.ClusterQueueSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ClusterQueueStatus"):
 		return &kueuev1beta2.ClusterQueueStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ClusterQueueTopologyDefragmentationStatus"):
+		return &kueuev1beta2.ClusterQueueTopologyDefragmentationStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ClusterSource"):
 		return &kueuev1beta2.ClusterSourceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Cohort"):
@@ -228,8 +236,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.FlavorFungibilityApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("FlavorQuotas"):
 		return &kueuev1beta2.FlavorQuotasApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("FlavorTopology"):
+		return &kueuev1beta2.FlavorTopologyApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("FlavorUsage"):
 		return &kueuev1beta2.FlavorUsageApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("JobIntegration"):
+		return &kueuev1beta2.JobIntegrationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("JobIntegrationPodSet"):
+		return &kueuev1beta2.JobIntegrationPodSetApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("JobIntegrationSpec"):
+		return &kueuev1beta2.JobIntegrationSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("JobIntegrationStatus"):
+		return &kueuev1beta2.JobIntegrationStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("KubeConfig"):
 		return &kueuev1beta2.KubeConfigApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueue"):
@@ -246,6 +264,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.LocalQueueSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueStatus"):
 		return &kueuev1beta2.LocalQueueStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LocalQueueUsageAccounting"):
+		return &kueuev1beta2.LocalQueueUsageAccountingApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueCluster"):
 		return &kueuev1beta2.MultiKueueClusterApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MultiKueueClusterSpec"):
@@ -262,6 +282,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.PodSetAssignmentApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetRequest"):
 		return &kueuev1beta2.PodSetRequestApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("PodSetShapeConstraint"):
+		return &kueuev1beta2.PodSetShapeConstraintApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodsetSliceRequiredTopologyConstraint"):
 		return &kueuev1beta2.PodsetSliceRequiredTopologyConstraintApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PodSetTopologyRequest"):
@@ -284,10 +306,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.ProvisioningRequestPodSetUpdatesNodeSelectorApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ProvisioningRequestRetryStrategy"):
 		return &kueuev1beta2.ProvisioningRequestRetryStrategyApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QuotaSchedule"):
+		return &kueuev1beta2.QuotaScheduleApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QuotaWindow"):
+		return &kueuev1beta2.QuotaWindowApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ReclaimablePod"):
 		return &kueuev1beta2.ReclaimablePodApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("RequeueState"):
 		return &kueuev1beta2.RequeueStateApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("Reservation"):
+		return &kueuev1beta2.ReservationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReservationFlavor"):
+		return &kueuev1beta2.ReservationFlavorApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReservationResource"):
+		return &kueuev1beta2.ReservationResourceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReservationSpec"):
+		return &kueuev1beta2.ReservationSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ReservationStatus"):
+		return &kueuev1beta2.ReservationStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceFlavor"):
 		return &kueuev1beta2.ResourceFlavorApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceFlavorSpec"):
@@ -298,6 +334,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.ResourceQuotaApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResourceUsage"):
 		return &kueuev1beta2.ResourceUsageApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("ResourceUsageAccounting"):
+		return &kueuev1beta2.ResourceUsageAccountingApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SchedulingStats"):
 		return &kueuev1beta2.SchedulingStatsApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Topology"):
@@ -312,14 +350,32 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta2.TopologyAssignmentSliceLevelValuesApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("TopologyAssignmentSlicePodCounts"):
 		return &kueuev1beta2.TopologyAssignmentSlicePodCountsApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyDefragmentation"):
+		return &kueuev1beta2.TopologyDefragmentationApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyDefragmentationWorkloadReference"):
+		return &kueuev1beta2.TopologyDefragmentationWorkloadReferenceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyDomainDistance"):
+		return &kueuev1beta2.TopologyDomainDistanceApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("TopologyLevel"):
 		return &kueuev1beta2.TopologyLevelApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyLevelDistances"):
+		return &kueuev1beta2.TopologyLevelDistancesApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyPlacementCandidate"):
+		return &kueuev1beta2.TopologyPlacementCandidateApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyPlacementExclusion"):
+		return &kueuev1beta2.TopologyPlacementExclusionApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("TopologyPlacementExplanation"):
+		return &kueuev1beta2.TopologyPlacementExplanationApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("TopologySpec"):
 		return &kueuev1beta2.TopologySpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("UnhealthyNode"):
 		return &kueuev1beta2.UnhealthyNodeApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("UsageAccountingPeriod"):
+		return &kueuev1beta2.UsageAccountingPeriodApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Workload"):
 		return &kueuev1beta2.WorkloadApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("WorkloadMigration"):
+		return &kueuev1beta2.WorkloadMigrationApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadPriorityClass"):
 		return &kueuev1beta2.WorkloadPriorityClassApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("WorkloadSchedulingStatsEviction"):
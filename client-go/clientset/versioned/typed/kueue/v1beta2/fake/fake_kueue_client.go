@@ -40,6 +40,10 @@ func (c *FakeKueueV1beta2) Cohorts() v1beta2.CohortInterface {
 	return newFakeCohorts(c)
 }
 
+func (c *FakeKueueV1beta2) JobIntegrations() v1beta2.JobIntegrationInterface {
+	return newFakeJobIntegrations(c)
+}
+
 func (c *FakeKueueV1beta2) LocalQueues(namespace string) v1beta2.LocalQueueInterface {
 	return newFakeLocalQueues(c, namespace)
 }
@@ -56,6 +60,10 @@ func (c *FakeKueueV1beta2) ProvisioningRequestConfigs() v1beta2.ProvisioningRequ
 	return newFakeProvisioningRequestConfigs(c)
 }
 
+func (c *FakeKueueV1beta2) Reservations() v1beta2.ReservationInterface {
+	return newFakeReservations(c)
+}
+
 func (c *FakeKueueV1beta2) ResourceFlavors() v1beta2.ResourceFlavorInterface {
 	return newFakeResourceFlavors(c)
 }
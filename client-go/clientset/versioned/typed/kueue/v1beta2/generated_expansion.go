@@ -24,6 +24,8 @@ type ClusterQueueExpansion interface{}
 
 type CohortExpansion interface{}
 
+type JobIntegrationExpansion interface{}
+
 type LocalQueueExpansion interface{}
 
 type MultiKueueClusterExpansion interface{}
@@ -32,6 +34,8 @@ type MultiKueueConfigExpansion interface{}
 
 type ProvisioningRequestConfigExpansion interface{}
 
+type ReservationExpansion interface{}
+
 type ResourceFlavorExpansion interface{}
 
 type TopologyExpansion interface{}
@@ -31,10 +31,12 @@ type KueueV1beta2Interface interface {
 	AdmissionChecksGetter
 	ClusterQueuesGetter
 	CohortsGetter
+	JobIntegrationsGetter
 	LocalQueuesGetter
 	MultiKueueClustersGetter
 	MultiKueueConfigsGetter
 	ProvisioningRequestConfigsGetter
+	ReservationsGetter
 	ResourceFlavorsGetter
 	TopologiesGetter
 	WorkloadsGetter
@@ -58,6 +60,10 @@ func (c *KueueV1beta2Client) Cohorts() CohortInterface {
 	return newCohorts(c)
 }
 
+func (c *KueueV1beta2Client) JobIntegrations() JobIntegrationInterface {
+	return newJobIntegrations(c)
+}
+
 func (c *KueueV1beta2Client) LocalQueues(namespace string) LocalQueueInterface {
 	return newLocalQueues(c, namespace)
 }
@@ -74,6 +80,10 @@ func (c *KueueV1beta2Client) ProvisioningRequestConfigs() ProvisioningRequestCon
 	return newProvisioningRequestConfigs(c)
 }
 
+func (c *KueueV1beta2Client) Reservations() ReservationInterface {
+	return newReservations(c)
+}
+
 func (c *KueueV1beta2Client) ResourceFlavors() ResourceFlavorInterface {
 	return newResourceFlavors(c)
 }
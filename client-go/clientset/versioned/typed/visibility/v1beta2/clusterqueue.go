@@ -48,6 +48,7 @@ type ClusterQueueInterface interface {
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *visibilityv1beta2.ClusterQueue, err error)
 	Apply(ctx context.Context, clusterQueue *applyconfigurationvisibilityv1beta2.ClusterQueueApplyConfiguration, opts v1.ApplyOptions) (result *visibilityv1beta2.ClusterQueue, err error)
 	GetPendingWorkloadsSummary(ctx context.Context, clusterQueueName string, options v1.GetOptions) (*visibilityv1beta2.PendingWorkloadsSummary, error)
+	GetUsageReport(ctx context.Context, clusterQueueName string, options v1.GetOptions) (*visibilityv1beta2.UsageReport, error)
 
 	ClusterQueueExpansion
 }
@@ -83,3 +84,16 @@ func (c *clusterQueues) GetPendingWorkloadsSummary(ctx context.Context, clusterQ
 		Into(result)
 	return
 }
+
+// GetUsageReport takes name of the clusterQueue, and returns the corresponding visibilityv1beta2.UsageReport object, and an error if there is any.
+func (c *clusterQueues) GetUsageReport(ctx context.Context, clusterQueueName string, options v1.GetOptions) (result *visibilityv1beta2.UsageReport, err error) {
+	result = &visibilityv1beta2.UsageReport{}
+	err = c.GetClient().Get().
+		Resource("clusterqueues").
+		Name(clusterQueueName).
+		SubResource("usage").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
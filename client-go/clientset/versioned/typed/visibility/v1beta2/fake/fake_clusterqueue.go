@@ -66,3 +66,14 @@ func (c *fakeClusterQueues) GetPendingWorkloadsSummary(ctx context.Context, clus
 	}
 	return obj.(*v1beta2.PendingWorkloadsSummary), err
 }
+
+// GetUsageReport takes name of the clusterQueue, and returns the corresponding usageReport object, and an error if there is any.
+func (c *fakeClusterQueues) GetUsageReport(ctx context.Context, clusterQueueName string, options v1.GetOptions) (result *v1beta2.UsageReport, err error) {
+	emptyResult := &v1beta2.UsageReport{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetSubresourceActionWithOptions(c.Resource(), "usage", clusterQueueName, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta2.UsageReport), err
+}
@@ -65,3 +65,27 @@ func (c *fakeLocalQueues) GetPendingWorkloadsSummary(ctx context.Context, localQ
 	}
 	return obj.(*v1beta2.PendingWorkloadsSummary), err
 }
+
+// SimulateAdmission takes the representation of a admissionSimulation and creates it.  Returns the server's representation of the admissionSimulation, and an error, if there is any.
+func (c *fakeLocalQueues) SimulateAdmission(ctx context.Context, localQueueName string, admissionSimulation *v1beta2.AdmissionSimulation, opts v1.CreateOptions) (result *v1beta2.AdmissionSimulation, err error) {
+	emptyResult := &v1beta2.AdmissionSimulation{}
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateSubresourceActionWithOptions(c.Resource(), localQueueName, "admissionsimulation", c.Namespace(), admissionSimulation, opts), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta2.AdmissionSimulation), err
+}
+
+// GetUsageReport takes name of the localQueue, and returns the corresponding usageReport object, and an error if there is any.
+func (c *fakeLocalQueues) GetUsageReport(ctx context.Context, localQueueName string, options v1.GetOptions) (result *v1beta2.UsageReport, err error) {
+	emptyResult := &v1beta2.UsageReport{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceActionWithOptions(c.Resource(), c.Namespace(), "usage", localQueueName, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta2.UsageReport), err
+}
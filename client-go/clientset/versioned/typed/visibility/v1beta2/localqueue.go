@@ -48,6 +48,8 @@ type LocalQueueInterface interface {
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *visibilityv1beta2.LocalQueue, err error)
 	Apply(ctx context.Context, localQueue *applyconfigurationvisibilityv1beta2.LocalQueueApplyConfiguration, opts v1.ApplyOptions) (result *visibilityv1beta2.LocalQueue, err error)
 	GetPendingWorkloadsSummary(ctx context.Context, localQueueName string, options v1.GetOptions) (*visibilityv1beta2.PendingWorkloadsSummary, error)
+	SimulateAdmission(ctx context.Context, localQueueName string, admissionSimulation *visibilityv1beta2.AdmissionSimulation, opts v1.CreateOptions) (*visibilityv1beta2.AdmissionSimulation, error)
+	GetUsageReport(ctx context.Context, localQueueName string, options v1.GetOptions) (*visibilityv1beta2.UsageReport, error)
 
 	LocalQueueExpansion
 }
@@ -84,3 +86,32 @@ func (c *localQueues) GetPendingWorkloadsSummary(ctx context.Context, localQueue
 		Into(result)
 	return
 }
+
+// SimulateAdmission takes the representation of a admissionSimulation and creates it.  Returns the server's representation of the admissionSimulation, and an error, if there is any.
+func (c *localQueues) SimulateAdmission(ctx context.Context, localQueueName string, admissionSimulation *visibilityv1beta2.AdmissionSimulation, opts v1.CreateOptions) (result *visibilityv1beta2.AdmissionSimulation, err error) {
+	result = &visibilityv1beta2.AdmissionSimulation{}
+	err = c.GetClient().Post().
+		Namespace(c.GetNamespace()).
+		Resource("localqueues").
+		Name(localQueueName).
+		SubResource("admissionsimulation").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(admissionSimulation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// GetUsageReport takes name of the localQueue, and returns the corresponding visibilityv1beta2.UsageReport object, and an error if there is any.
+func (c *localQueues) GetUsageReport(ctx context.Context, localQueueName string, options v1.GetOptions) (result *visibilityv1beta2.UsageReport, err error) {
+	result = &visibilityv1beta2.UsageReport{}
+	err = c.GetClient().Get().
+		Namespace(c.GetNamespace()).
+		Resource("localqueues").
+		Name(localQueueName).
+		SubResource("usage").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
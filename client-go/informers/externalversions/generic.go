@@ -86,6 +86,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().ClusterQueues().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("cohorts"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().Cohorts().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("jobintegrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().JobIntegrations().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("localqueues"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().LocalQueues().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("multikueueclusters"):
@@ -94,6 +96,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().MultiKueueConfigs().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("provisioningrequestconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().ProvisioningRequestConfigs().Informer()}, nil
+	case v1beta2.SchemeGroupVersion.WithResource("reservations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().Reservations().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("resourceflavors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta2().ResourceFlavors().Informer()}, nil
 	case v1beta2.SchemeGroupVersion.WithResource("topologies"):
@@ -30,6 +30,8 @@ type Interface interface {
 	ClusterQueues() ClusterQueueInformer
 	// Cohorts returns a CohortInformer.
 	Cohorts() CohortInformer
+	// JobIntegrations returns a JobIntegrationInformer.
+	JobIntegrations() JobIntegrationInformer
 	// LocalQueues returns a LocalQueueInformer.
 	LocalQueues() LocalQueueInformer
 	// MultiKueueClusters returns a MultiKueueClusterInformer.
@@ -38,6 +40,8 @@ type Interface interface {
 	MultiKueueConfigs() MultiKueueConfigInformer
 	// ProvisioningRequestConfigs returns a ProvisioningRequestConfigInformer.
 	ProvisioningRequestConfigs() ProvisioningRequestConfigInformer
+	// Reservations returns a ReservationInformer.
+	Reservations() ReservationInformer
 	// ResourceFlavors returns a ResourceFlavorInformer.
 	ResourceFlavors() ResourceFlavorInformer
 	// Topologies returns a TopologyInformer.
@@ -74,6 +78,11 @@ func (v *version) Cohorts() CohortInformer {
 	return &cohortInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// JobIntegrations returns a JobIntegrationInformer.
+func (v *version) JobIntegrations() JobIntegrationInformer {
+	return &jobIntegrationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // LocalQueues returns a LocalQueueInformer.
 func (v *version) LocalQueues() LocalQueueInformer {
 	return &localQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -94,6 +103,11 @@ func (v *version) ProvisioningRequestConfigs() ProvisioningRequestConfigInformer
 	return &provisioningRequestConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// Reservations returns a ReservationInformer.
+func (v *version) Reservations() ReservationInformer {
+	return &reservationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ResourceFlavors returns a ResourceFlavorInformer.
 func (v *version) ResourceFlavors() ResourceFlavorInformer {
 	return &resourceFlavorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -30,6 +30,10 @@ type ClusterQueueListerExpansion interface{}
 // CohortLister.
 type CohortListerExpansion interface{}
 
+// JobIntegrationListerExpansion allows custom methods to be added to
+// JobIntegrationLister.
+type JobIntegrationListerExpansion interface{}
+
 // LocalQueueListerExpansion allows custom methods to be added to
 // LocalQueueLister.
 type LocalQueueListerExpansion interface{}
@@ -50,6 +54,10 @@ type MultiKueueConfigListerExpansion interface{}
 // ProvisioningRequestConfigLister.
 type ProvisioningRequestConfigListerExpansion interface{}
 
+// ReservationListerExpansion allows custom methods to be added to
+// ReservationLister.
+type ReservationListerExpansion interface{}
+
 // ResourceFlavorListerExpansion allows custom methods to be added to
 // ResourceFlavorLister.
 type ResourceFlavorListerExpansion interface{}
@@ -39,6 +39,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	inventoryv1alpha1 "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -53,6 +54,8 @@ import (
 	kueuealpha "sigs.k8s.io/kueue/apis/kueue/v1alpha1"
 	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibilityv1beta2 "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/accounting"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/cache/scheduler/was"
@@ -68,6 +71,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/failurerecovery"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/controller/jobs"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/jobintegration"
 	"sigs.k8s.io/kueue/pkg/controller/tas"
 	tasindexer "sigs.k8s.io/kueue/pkg/controller/tas/indexer"
 	"sigs.k8s.io/kueue/pkg/controller/workloaddispatcher"
@@ -77,8 +81,10 @@ import (
 	"sigs.k8s.io/kueue/pkg/metrics"
 	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/framework"
 	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
+	"sigs.k8s.io/kueue/pkg/scheduler/trace"
 	"sigs.k8s.io/kueue/pkg/util/cert"
 	utildra "sigs.k8s.io/kueue/pkg/util/dra"
 	"sigs.k8s.io/kueue/pkg/util/expectations"
@@ -90,7 +96,9 @@ import (
 	"sigs.k8s.io/kueue/pkg/util/waitforpodsready"
 	"sigs.k8s.io/kueue/pkg/version"
 	"sigs.k8s.io/kueue/pkg/visibility"
+	"sigs.k8s.io/kueue/pkg/visibility/federation"
 	"sigs.k8s.io/kueue/pkg/webhooks"
+	"sigs.k8s.io/kueue/pkg/workload"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -115,6 +123,7 @@ func init() {
 	utilruntime.Must(configapi.AddToScheme(scheme))
 	utilruntime.Must(autoscaling.AddToScheme(scheme))
 	utilruntime.Must(inventoryv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(visibilityv1beta2.AddToScheme(scheme))
 	// Add any additional framework integration types.
 	utilruntime.Must(
 		integrationManager.ForEachIntegration(func(_ string, cb jobframework.IntegrationCallbacks) error {
@@ -140,6 +149,16 @@ func main() {
 		"A set of key=value pairs that describe feature gates for alpha/experimental features.",
 	)
 
+	var schedulingTraceFile string
+	flag.StringVar(&schedulingTraceFile, "scheduling-trace-file", "",
+		"If set, the inputs and outcomes of the scheduling cycles are recorded to this file, "+
+			"so that they can be replayed offline. Omit this flag to disable the recording. ")
+
+	var schedulingTraceMaxCycles int
+	flag.IntVar(&schedulingTraceMaxCycles, "scheduling-trace-max-cycles", 1000,
+		"The maximum number of scheduling cycles recorded to the scheduling trace file. "+
+			"A value of 0 means no limit. ")
+
 	customLogProcessor := zaplog.WrapCore(utillogging.NewCustomLogProcessor)
 
 	zapOptions := zap.Options{
@@ -372,6 +391,19 @@ func main() {
 	if draBackedResources != nil {
 		queueOptions = append(queueOptions, qcache.WithDRABackedResources(draBackedResources))
 	}
+	var schedulingFramework *framework.Framework
+	if features.Enabled(features.SchedulingFramework) {
+		schedulingFramework, err = framework.New(cfg.SchedulingProfile, framework.Handle{
+			WorkloadOrdering: workload.Ordering{PodsReadyRequeuingTimestamp: podsReadyRequeuingTimestamp(&cfg)},
+			Clock:            clock.RealClock{},
+			Log:              ctrl.Log.WithName("scheduling-framework"),
+		})
+		if err != nil {
+			setupLog.Error(err, "Unable to build the scheduling framework")
+			os.Exit(1)
+		}
+		queueOptions = append(queueOptions, qcache.WithQueueSort(schedulingFramework.QueueSortFunc()))
+	}
 	queues := qcache.NewManager(mgr.GetClient(), cCache, requeuer, queueOptions...)
 
 	resourceSliceAPIAvailable := utildra.CheckResourceSliceAPIAvailable(mgr)
@@ -417,7 +449,11 @@ func main() {
 		ResourceFormatter:         resourceFormatter,
 		ResourceSliceAPIAvailable: resourceSliceAPIAvailable,
 	}
-	if err := setupControllers(ctx, mgr, cCache, queues, &cfg, serverVersionFetcher, integrationManager, controllerOpts); err != nil {
+	if features.Enabled(features.UsageAccounting) {
+		controllerOpts.UsageLedger = accounting.NewLedger(mgr.GetClient())
+	}
+	workerClusters := multikueue.NewWorkerClusters()
+	if err := setupControllers(ctx, mgr, cCache, queues, &cfg, serverVersionFetcher, integrationManager, workerClusters, controllerOpts); err != nil {
 		setupLog.Error(err, "Unable to setup controllers")
 		os.Exit(1)
 	}
@@ -430,20 +466,46 @@ func main() {
 	go queues.CleanUpOnContext(ctx)
 	go cCache.CleanUpOnContext(ctx)
 
+	schedulerOpts := []scheduler.Option{scheduler.WithFramework(schedulingFramework)}
+	if schedulingTraceFile != "" {
+		traceRecorder, err := trace.NewFileRecorder(schedulingTraceFile, schedulingTraceMaxCycles)
+		if err != nil {
+			setupLog.Error(err, "Unable to create the scheduling trace recorder")
+			os.Exit(1)
+		}
+		defer traceRecorder.Close()
+		setupLog.Info("Recording the scheduling cycles", "file", schedulingTraceFile, "maxCycles", schedulingTraceMaxCycles)
+		schedulerOpts = append(schedulerOpts, scheduler.WithTraceRecorder(traceRecorder, schedulingTraceConfig(&cfg)))
+	}
+
+	sched, err := setupScheduler(mgr, cCache, queues, &cfg, roleTracker, preemptionExpectations, customLabels, resourceFormatter, schedulerOpts...)
+	if err != nil {
+		setupLog.Error(err, "Could not setup scheduler")
+		os.Exit(1)
+	}
+
 	if features.Enabled(features.VisibilityOnDemand) {
+		var visibilityOpts []visibility.Option
+		if features.Enabled(features.AdmissionSimulation) {
+			visibilityOpts = append(visibilityOpts, visibility.WithAdmissionSimulator(sched))
+		}
+		if features.Enabled(features.AdmissionTimeEstimation) {
+			visibilityOpts = append(visibilityOpts, visibility.WithAdmissionHistory(cCache))
+		}
+		if controllerOpts.UsageLedger != nil {
+			visibilityOpts = append(visibilityOpts, visibility.WithUsageReporter(controllerOpts.UsageLedger))
+		}
+		if features.Enabled(features.MultiKueue) && features.Enabled(features.MultiKueueFederatedVisibility) {
+			visibilityOpts = append(visibilityOpts, visibility.WithRemoteWorkloads(federation.NewRemoteWorkloads(mgr.GetClient(), workerClusters)))
+		}
 		go func() {
-			if err := visibility.CreateAndStartVisibilityServer(ctx, queues, &cfg, kubeConfig, parsedTLSConfig); err != nil {
+			if err := visibility.CreateAndStartVisibilityServer(ctx, queues, &cfg, kubeConfig, parsedTLSConfig, visibilityOpts...); err != nil {
 				setupLog.Error(err, "Unable to create and start visibility server")
 				os.Exit(1)
 			}
 		}()
 	}
 
-	if err := setupScheduler(mgr, cCache, queues, &cfg, roleTracker, preemptionExpectations, customLabels, resourceFormatter); err != nil {
-		setupLog.Error(err, "Could not setup scheduler")
-		os.Exit(1)
-	}
-
 	setupLog.Info("Starting manager")
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "Could not run manager")
@@ -506,6 +568,7 @@ func setupControllers(
 	cfg *configapi.Configuration,
 	serverVersionFetcher *kubeversion.ServerVersionFetcher,
 	integrationManager *jobframework.IntegrationManager,
+	workerClusters *multikueue.WorkerClusters,
 	opts core.SetupControllersOpts,
 ) error {
 	if failedCtrl, err := core.SetupControllers(mgr, queues, cCache, cfg, opts); err != nil {
@@ -562,11 +625,12 @@ func setupControllers(
 			multikueue.WithDispatcherName(ptr.Deref(cfg.MultiKueue.DispatcherName, configapi.MultiKueueDispatcherModeAllAtOnce)),
 			multikueue.WithClusterProfiles(cfg.MultiKueue.ClusterProfile),
 			multikueue.WithRoleTracker(opts.RoleTracker),
+			multikueue.WithWorkerClusters(workerClusters),
 		); err != nil {
 			return fmt.Errorf("could not setup MultiKueue controller: %w", err)
 		}
 
-		if failedDispatcher, err := workloaddispatcher.SetupControllers(mgr, cfg, opts.RoleTracker); err != nil {
+		if failedDispatcher, err := workloaddispatcher.SetupControllers(mgr, cfg, opts.RoleTracker, workerClusters); err != nil {
 			return fmt.Errorf("could not setup Dispatcher controller %q for MultiKueue: %w", failedDispatcher, err)
 		}
 	}
@@ -621,6 +685,12 @@ func setupControllers(
 		)
 	}
 
+	if features.Enabled(features.JobIntegrations) {
+		if err := jobintegration.NewReconciler(mgr, jobintegration.NewRegistry(), jfOpts...).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create JobIntegration controller: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -671,12 +741,9 @@ func setupScheduler(
 	preemptionExpectations *expectations.Store,
 	customLabels *metrics.CustomLabels,
 	resourceFormatter *resources.ResourceFormatter,
-) error {
-	sched := scheduler.New(
-		queues,
-		cCache,
-		mgr.GetClient(),
-		mgr.GetEventRecorder(constants.AdmissionName),
+	extraOpts ...scheduler.Option,
+) (*scheduler.Scheduler, error) {
+	opts := []scheduler.Option{
 		scheduler.WithPodsReadyRequeuingTimestamp(podsReadyRequeuingTimestamp(cfg)),
 		scheduler.WithFairSharing(cfg.FairSharing),
 		scheduler.WithAdmissionFairSharing(cfg.AdmissionFairSharing),
@@ -685,11 +752,18 @@ func setupScheduler(
 		scheduler.WithPreemptionExpectations(preemptionExpectations),
 		scheduler.WithCustomLabels(customLabels),
 		scheduler.WithResourceFormatter(resourceFormatter),
+	}
+	sched := scheduler.New(
+		queues,
+		cCache,
+		mgr.GetClient(),
+		mgr.GetEventRecorder(constants.AdmissionName),
+		append(opts, extraOpts...)...,
 	)
 	if err := mgr.Add(sched); err != nil {
-		return fmt.Errorf("unable to add scheduler to manager: %w", err)
+		return nil, fmt.Errorf("unable to add scheduler to manager: %w", err)
 	}
-	return nil
+	return sched, nil
 }
 
 func setupServerVersionFetcher(mgr ctrl.Manager, kubeConfig *rest.Config) (*kubeversion.ServerVersionFetcher, error) {
@@ -744,6 +818,26 @@ func quotaCheckStrategy(cfg *configapi.Configuration) configapi.QuotaCheckStrate
 	return configapi.QuotaCheckBlockUndeclared
 }
 
+// schedulingTraceConfig returns the configuration recorded along with the
+// scheduling cycles.
+func schedulingTraceConfig(cfg *configapi.Configuration) trace.Config {
+	traceCfg := trace.Config{
+		FairSharing:                 cfg.FairSharing,
+		AdmissionFairSharing:        cfg.AdmissionFairSharing,
+		QuotaCheckStrategy:          quotaCheckStrategy(cfg),
+		PodsReadyRequeuingTimestamp: podsReadyRequeuingTimestamp(cfg),
+		BlockForPodsReady:           blockForPodsReady(cfg),
+	}
+	if cfg.Resources != nil {
+		traceCfg.ExcludeResourcePrefixes = cfg.Resources.ExcludeResourcePrefixes
+		traceCfg.ResourceTransformations = cfg.Resources.Transformations
+	}
+	if features.Enabled(features.SchedulingFramework) {
+		traceCfg.SchedulingProfile = cfg.SchedulingProfile
+	}
+	return traceCfg
+}
+
 func apply(configFile string) (ctrl.Options, configapi.Configuration, error) {
 	options, cfg, err := config.Load(scheme, configFile)
 	if err != nil {
@@ -28,9 +28,12 @@ import (
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/create"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/list"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/migrate"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/passthrough"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/resume"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/simulate"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/stop"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/usage"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/version"
 )
 
@@ -78,7 +81,10 @@ func NewKueuectlCmd(o KueuectlOptions) *cobra.Command {
 	cmd.AddCommand(create.NewCreateCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(resume.NewResumeCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(stop.NewStopCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(migrate.NewMigrateCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(list.NewListCmd(clientGetter, o.IOStreams, o.Clock))
+	cmd.AddCommand(simulate.NewSimulateCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(usage.NewUsageCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(passthrough.NewCommands(clientGetter, o.IOStreams)...)
 	cmd.AddCommand(version.NewVersionCmd(clientGetter, o.IOStreams))
 
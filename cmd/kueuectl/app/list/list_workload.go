@@ -33,6 +33,7 @@ import (
 	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/kubectl/pkg/util/templates"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
@@ -51,6 +52,9 @@ var (
 	wlExample = templates.Examples(`
 		# List Workload 
   		kueuectl list kueueworkload
+
+		# List Workload with the state in the MultiKueue worker clusters
+  		kueuectl list kueueworkload -o wide
 	`)
 )
 
@@ -236,12 +240,18 @@ func (o *WorkloadOptions) Complete(clientGetter clientgetter.ClientGetter, cmd *
 	return nil
 }
 
+// wideOutput returns whether the additional columns of the table are requested.
+func (o *WorkloadOptions) wideOutput() bool {
+	return ptr.Deref(o.PrintFlags.OutputFormat, "") == "wide"
+}
+
 func (o *WorkloadOptions) ToPrinter(r *listWorkloadResources, headers bool) (printers.ResourcePrinterFunc, error) {
-	if !o.PrintFlags.OutputFlagSpecified() {
+	if !o.PrintFlags.OutputFlagSpecified() || o.wideOutput() {
 		printer := newWorkloadTablePrinter().
 			WithResources(r).
 			WithNamespace(o.AllNamespaces).
 			WithHeaders(headers).
+			WithWide(o.wideOutput()).
 			WithClock(o.Clock)
 		return printer.PrintObj, nil
 	}
@@ -438,7 +448,8 @@ func (o *WorkloadOptions) pendingWorkloads(ctx context.Context, list *kueue.Work
 	pendingWorkloadsSummaries := make(map[kueue.ClusterQueueReference]*visibility.PendingWorkloadsSummary)
 
 	for _, wl := range list.Items {
-		if !workloadPending(&wl) {
+		// The dispatched workloads are only listed for their remote statuses.
+		if !workloadPending(&wl) && (!o.wideOutput() || !workloadDispatched(&wl)) {
 			continue
 		}
 		var clusterQueueName kueue.ClusterQueueReference
@@ -498,6 +509,13 @@ func workloadPending(wl *kueue.Workload) bool {
 	return workload.Status(wl) == workload.StatusPending
 }
 
+// workloadDispatched returns whether the workload is dispatched to MultiKueue
+// worker clusters and not admitted yet.
+func workloadDispatched(wl *kueue.Workload) bool {
+	return workload.Status(wl) == workload.StatusQuotaReserved &&
+		(workload.ClusterName(wl) != "" || len(wl.Status.NominatedClusterNames) > 0)
+}
+
 func localQueueKeyForWorkload(wl *kueue.Workload) string {
 	return fmt.Sprintf("%s/%s", wl.Namespace, wl.Spec.QueueName)
 }
@@ -76,8 +76,10 @@ func (p *listWorkloadPrinter) PrintObj(obj runtime.Object, out io.Writer) error
 			{Name: "ClusterQueue", Type: "string"},
 			{Name: "Status", Type: "string"},
 			{Name: "Position in Queue", Type: "string"},
+			{Name: "Est. Admission", Type: "string", Priority: 1},
 			{Name: "Exec Time", Type: "string"},
 			{Name: "Age", Type: "string"},
+			{Name: "Remote Status", Type: "string", Priority: 1},
 		},
 		Rows: p.printWorkloadList(list),
 	}
@@ -95,6 +97,11 @@ func (p *listWorkloadPrinter) WithHeaders(f bool) *listWorkloadPrinter {
 	return p
 }
 
+func (p *listWorkloadPrinter) WithWide(f bool) *listWorkloadPrinter {
+	p.printOptions.Wide = f
+	return p
+}
+
 func (p *listWorkloadPrinter) WithResources(r *listWorkloadResources) *listWorkloadPrinter {
 	if r == nil {
 		r = newListWorkloadResources()
@@ -135,9 +142,18 @@ func (p *listWorkloadPrinter) printWorkload(wl *kueue.Workload) metav1.TableRow
 		clusterQueueName = string(lq.Spec.ClusterQueue)
 	}
 
-	var positionInQueue string
+	var positionInQueue, estimatedAdmission, remoteStatus string
 	if pendingWorkload, ok := p.resources.pendingWorkloads[workload.Key(wl)]; ok {
-		positionInQueue = fmt.Sprintf("%d", pendingWorkload.PositionInLocalQueue)
+		if workloadDispatched(wl) {
+			remoteStatus = remoteStatuses(pendingWorkload)
+		} else {
+			positionInQueue = fmt.Sprintf("%d", pendingWorkload.PositionInLocalQueue)
+			if pendingWorkload.EstimatedAdmissionTime != nil {
+				estimatedAdmission = fmt.Sprintf("%s (%s)",
+					duration.HumanDuration(max(pendingWorkload.EstimatedAdmissionTime.Sub(p.clock.Now()), 0)),
+					pendingWorkload.EstimatedAdmissionTimeConfidence)
+			}
+		}
 	}
 
 	var execTime string
@@ -161,13 +177,29 @@ func (p *listWorkloadPrinter) printWorkload(wl *kueue.Workload) metav1.TableRow
 		clusterQueueName,
 		strings.ToUpper(workload.Status(wl)),
 		positionInQueue,
+		estimatedAdmission,
 		execTime,
 		duration.HumanDuration(p.clock.Since(wl.CreationTimestamp.Time)),
+		remoteStatus,
 	}
 
 	return row
 }
 
+// remoteStatuses returns the state of the workload in each of the MultiKueue
+// worker clusters, with its position in the LocalQueue when it's pending.
+func remoteStatuses(pendingWorkload *visibility.PendingWorkload) string {
+	statuses := make([]string, 0, len(pendingWorkload.RemoteStatuses))
+	for _, rs := range pendingWorkload.RemoteStatuses {
+		status := fmt.Sprintf("%s=%s", rs.ClusterName, rs.State)
+		if rs.PositionInLocalQueue != nil {
+			status += fmt.Sprintf("(%d)", *rs.PositionInLocalQueue)
+		}
+		statuses = append(statuses, status)
+	}
+	return strings.Join(statuses, ", ")
+}
+
 func (p *listWorkloadPrinter) crdTypes(wl *kueue.Workload) []string {
 	crdTypes := sets.New[string]()
 
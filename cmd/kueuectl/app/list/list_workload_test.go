@@ -861,6 +861,68 @@ wl1    rayjob.ray.io   job-test   lq1          cq1            PENDING
 			wantOut: `NAME   JOB TYPE   JOB NAME   LOCALQUEUE   CLUSTERQUEUE   STATUS    POSITION IN QUEUE   EXEC TIME   AGE
 wl1               j1         lq1          cq1            PENDING   12                              60m
 wl2               j2         lq2          cq2            PENDING   22                              120m
+`,
+		},
+		"should print workload list with remote statuses in wide output": {
+			args: []string{"-o", "wide"},
+			pendingWorkloads: []visibility.PendingWorkload{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "wl1",
+						Namespace: metav1.NamespaceDefault,
+					},
+					LocalQueueName:                   "lq1",
+					PositionInClusterQueue:           0,
+					PositionInLocalQueue:             0,
+					EstimatedAdmissionTime:           new(metav1.NewTime(testStartTime.Add(10 * time.Minute))),
+					EstimatedAdmissionTimeConfidence: visibility.MediumConfidence,
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "wl2",
+						Namespace: metav1.NamespaceDefault,
+					},
+					LocalQueueName:         "lq1",
+					PositionInClusterQueue: 1,
+					PositionInLocalQueue:   1,
+					RemoteStatuses: []visibility.RemoteWorkloadStatus{
+						{
+							ClusterName:            "worker1",
+							State:                  visibility.RemoteWorkloadPending,
+							PositionInClusterQueue: new(int32(5)),
+							PositionInLocalQueue:   new(int32(2)),
+						},
+						{
+							ClusterName: "worker2",
+							State:       visibility.RemoteWorkloadQuotaReserved,
+						},
+					},
+				},
+			},
+			objs: []runtime.Object{
+				utiltestingapi.MakeWorkload("wl1", metav1.NamespaceDefault).
+					OwnerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "j1", "test-uid").
+					Queue("lq1").
+					Active(true).
+					Admission(utiltestingapi.MakeAdmission("cq1").Obj()).
+					Creation(testStartTime.Add(-1 * time.Hour).Truncate(time.Second)).
+					Obj(),
+				utiltestingapi.MakeWorkload("wl2", metav1.NamespaceDefault).
+					OwnerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "j2", "test-uid").
+					Queue("lq1").
+					Active(true).
+					Admission(utiltestingapi.MakeAdmission("cq1").Obj()).
+					Creation(testStartTime.Add(-2 * time.Hour).Truncate(time.Second)).
+					Conditions(metav1.Condition{
+						Type:   kueue.WorkloadQuotaReserved,
+						Status: metav1.ConditionTrue,
+					}).
+					NominatedClusterNames("worker1", "worker2").
+					Obj(),
+			},
+			wantOut: `NAME   JOB TYPE   JOB NAME   LOCALQUEUE   CLUSTERQUEUE   STATUS          POSITION IN QUEUE   EST. ADMISSION   EXEC TIME   AGE    REMOTE STATUS
+wl1               j1         lq1          cq1            PENDING         0                   10m (Medium)                 60m    
+wl2               j2         lq1          cq1            QUOTARESERVED                                                    120m   worker1=Pending(2), worker2=QuotaReserved
 `,
 		},
 		"should print not found error": {
@@ -0,0 +1,95 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestWorkloadCmd(t *testing.T) {
+	testCases := map[string]struct {
+		args            []string
+		workload        *kueue.Workload
+		wantAnnotations map[string]string
+		wantOut         string
+		wantErr         string
+	}{
+		"should request the migration": {
+			args:            []string{"wl1"},
+			workload:        utiltestingapi.MakeWorkload("wl1", metav1.NamespaceDefault).ClusterName("worker1").Obj(),
+			wantAnnotations: map[string]string{kueue.MultiKueueMigrateAnnotation: ""},
+			wantOut:         "workload.kueue.x-k8s.io/wl1 migration requested\n",
+		},
+		"should request the migration with a message": {
+			args:            []string{"wl1", "--message", "maintenance"},
+			workload:        utiltestingapi.MakeWorkload("wl1", metav1.NamespaceDefault).ClusterName("worker1").Obj(),
+			wantAnnotations: map[string]string{kueue.MultiKueueMigrateAnnotation: "maintenance"},
+			wantOut:         "workload.kueue.x-k8s.io/wl1 migration requested\n",
+		},
+		"shouldn't request the migration with client dry run": {
+			args:     []string{"wl1", "--dry-run", "client"},
+			workload: utiltestingapi.MakeWorkload("wl1", metav1.NamespaceDefault).ClusterName("worker1").Obj(),
+			wantOut:  "workload.kueue.x-k8s.io/wl1 migration requested (client dry run)\n",
+		},
+		"should fail when the workload isn't admitted on a worker cluster": {
+			args:     []string{"wl1"},
+			workload: utiltestingapi.MakeWorkload("wl1", metav1.NamespaceDefault).Obj(),
+			wantErr:  `workload "wl1" is not admitted on a MultiKueue worker cluster`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, _ := genericiooptions.NewTestIOStreams()
+
+			clientset := fake.NewSimpleClientset(tc.workload)
+			tcg := cmdtesting.NewTestClientGetter().WithKueueClientset(clientset)
+
+			cmd := NewWorkloadCmd(tcg, streams)
+			cmd.SetArgs(tc.args)
+
+			gotErr := cmd.Execute()
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			gotWl, err := clientset.KueueV1beta2().Workloads(tc.workload.Namespace).Get(context.Background(), tc.workload.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantAnnotations, gotWl.Annotations); diff != "" {
+				t.Errorf("Unexpected annotations (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/scheme"
+	visibilityv1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/visibility/v1beta2"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+)
+
+var (
+	admissionLong = templates.LongDesc(`
+		Simulate the admission of a Workload without creating it.
+
+		The Workload is evaluated by the Kueue scheduler against the current
+		state of the cluster, as if it was at the head of the ClusterQueue of
+		the given LocalQueue. The result shows the flavors that would be
+		assigned, whether borrowing is needed and the workloads that would be
+		preempted. Requires the AdmissionSimulation feature gate.
+	`)
+	admissionExample = templates.Examples(`
+		# Simulate the admission of a workload in the LocalQueue from its spec.queueName
+		kueuectl simulate admission -f my-workload.yaml
+
+		# Simulate the admission of a workload in the given LocalQueue
+		kueuectl simulate admission -f my-workload.yaml --localqueue my-local-queue
+
+		# Simulate the admission of a workload read from stdin
+		cat my-workload.yaml | kueuectl simulate admission -f -
+	`)
+)
+
+type AdmissionOptions struct {
+	PrintFlags *genericclioptions.PrintFlags
+
+	Filename         string
+	LocalQueue       string
+	Namespace        string
+	EnforceNamespace bool
+
+	Client visibilityv1beta2.VisibilityV1beta2Interface
+
+	Workload []byte
+
+	PrintObj printers.ResourcePrinterFunc
+
+	genericiooptions.IOStreams
+}
+
+func NewAdmissionOptions(streams genericiooptions.IOStreams) *AdmissionOptions {
+	return &AdmissionOptions{
+		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(scheme.Scheme),
+		IOStreams:  streams,
+	}
+}
+
+func NewAdmissionCmd(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewAdmissionOptions(streams)
+
+	cmd := &cobra.Command{
+		Use: "admission -f FILENAME [--localqueue LOCAL_QUEUE_NAME]",
+		// To do not add "[flags]" suffix on the end of usage line
+		DisableFlagsInUseLine: true,
+		Short:                 "Simulate the admission of a workload",
+		Long:                  admissionLong,
+		Example:               admissionExample,
+		Args:                  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter)
+			if err != nil {
+				return err
+			}
+			err = o.Validate()
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	o.PrintFlags.AddFlags(cmd)
+
+	cmd.Flags().StringVarP(&o.Filename, "filename", "f", "",
+		"The file that contains the Workload to simulate. Use - to read from stdin (required).")
+	cmd.Flags().StringVarP(&o.LocalQueue, "localqueue", "q", "",
+		"The local queue to simulate the admission in. Defaults to spec.queueName of the Workload.")
+
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("localqueue", completion.LocalQueueNameFunc(clientGetter, nil)))
+
+	_ = cmd.MarkFlagRequired("filename")
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *AdmissionOptions) Complete(clientGetter clientgetter.ClientGetter) error {
+	var err error
+
+	o.Namespace, o.EnforceNamespace, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	o.Workload, err = o.readWorkload()
+	if err != nil {
+		return err
+	}
+
+	wl := &kueue.Workload{}
+	if err := yaml.Unmarshal(o.Workload, wl); err != nil {
+		return fmt.Errorf("invalid workload: %w", err)
+	}
+	if len(o.LocalQueue) == 0 {
+		o.LocalQueue = string(wl.Spec.QueueName)
+	}
+	if !o.EnforceNamespace && len(wl.Namespace) > 0 {
+		o.Namespace = wl.Namespace
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.VisibilityV1beta2()
+
+	if o.PrintFlags.OutputFlagSpecified() {
+		printer, err := o.PrintFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+		o.PrintObj = printer.PrintObj
+	} else {
+		o.PrintObj = printAdmissionSimulation
+	}
+
+	return nil
+}
+
+// Validate validates required fields are set to support structured generation
+func (o *AdmissionOptions) Validate() error {
+	if len(o.LocalQueue) == 0 {
+		return errors.New("localqueue must be specified")
+	}
+	if len(o.Namespace) == 0 {
+		return errors.New("namespace must be specified")
+	}
+	return nil
+}
+
+// Run performs the simulation.
+func (o *AdmissionOptions) Run(ctx context.Context) error {
+	rawWorkload, err := yaml.YAMLToJSON(o.Workload)
+	if err != nil {
+		return err
+	}
+	simulation := &visibility.AdmissionSimulation{
+		Workload: runtime.RawExtension{Raw: rawWorkload},
+	}
+	simulation, err = o.Client.LocalQueues(o.Namespace).SimulateAdmission(ctx, o.LocalQueue, simulation, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	return o.PrintObj(simulation, o.Out)
+}
+
+func (o *AdmissionOptions) readWorkload() ([]byte, error) {
+	if o.Filename == "-" {
+		return io.ReadAll(o.In)
+	}
+	return os.ReadFile(o.Filename)
+}
+
+func printAdmissionSimulation(obj runtime.Object, out io.Writer) error {
+	simulation, ok := obj.(*visibility.AdmissionSimulation)
+	if !ok {
+		return errors.New("invalid object type")
+	}
+	result := simulation.Result
+	if result == nil {
+		return errors.New("no simulation result")
+	}
+
+	w := printers.GetNewTabWriter(out)
+	fmt.Fprintf(w, "ClusterQueue:\t%s\n", result.ClusterQueue)
+	fmt.Fprintf(w, "Admissible:\t%t\n", result.Admissible)
+	fmt.Fprintf(w, "Mode:\t%s\n", result.Mode)
+	fmt.Fprintf(w, "Borrowing:\t%t\n", result.Borrowing)
+	if len(result.Message) > 0 {
+		fmt.Fprintf(w, "Message:\t%s\n", result.Message)
+	}
+	if len(result.PodSetAssignments) > 0 {
+		fmt.Fprintln(w, "PodSets:")
+		fmt.Fprintln(w, "  NAME\tCOUNT\tFLAVORS\tUSAGE")
+		for _, psa := range result.PodSetAssignments {
+			fmt.Fprintf(w, "  %s\t%d\t%s\t%s\n", psa.Name, psa.Count, formatFlavors(psa.Flavors), formatUsage(psa.ResourceUsage))
+		}
+	}
+	if len(result.PreemptionTargets) > 0 {
+		fmt.Fprintln(w, "Preemption targets:")
+		fmt.Fprintln(w, "  NAMESPACE\tNAME\tCLUSTERQUEUE\tPRIORITY\tREASON")
+		for _, target := range result.PreemptionTargets {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%s\n", orRedacted(target.Namespace), orRedacted(target.Name), target.ClusterQueue, target.Priority, target.Reason)
+		}
+	}
+	return w.Flush()
+}
+
+// orRedacted returns the value, or a placeholder when the server redacted it,
+// as for the preemption targets in other namespaces.
+func orRedacted(value string) string {
+	if value == "" {
+		return "<redacted>"
+	}
+	return value
+}
+
+func formatFlavors(flavors map[corev1.ResourceName]kueue.ResourceFlavorReference) string {
+	parts := make([]string, 0, len(flavors))
+	for res, flv := range flavors {
+		parts = append(parts, fmt.Sprintf("%s=%s", res, flv))
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, ",")
+}
+
+func formatUsage(usage corev1.ResourceList) string {
+	parts := make([]string, 0, len(usage))
+	for res, q := range usage {
+		parts = append(parts, fmt.Sprintf("%s=%s", res, q.String()))
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,187 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubetesting "k8s.io/client-go/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+)
+
+const testWorkload = `apiVersion: kueue.x-k8s.io/v1beta2
+kind: Workload
+metadata:
+  name: wl
+  namespace: ns1
+spec:
+  queueName: lq1
+  podSets:
+  - name: main
+    count: 2
+    template:
+      spec:
+        containers:
+        - name: c
+          resources:
+            requests:
+              cpu: "1"
+`
+
+func TestAdmissionCmd(t *testing.T) {
+	testCases := map[string]struct {
+		ns            string
+		args          []string
+		result        *visibility.AdmissionSimulationResult
+		wantNamespace string
+		wantLQ        string
+		wantOut       string
+		wantErr       string
+	}{
+		"should simulate in the queue of the workload": {
+			args: []string{"-f", "-"},
+			result: &visibility.AdmissionSimulationResult{
+				ClusterQueue: "cq1",
+				Mode:         "Fit",
+				Admissible:   true,
+				PodSetAssignments: []visibility.SimulatedPodSetAssignment{{
+					Name:          "main",
+					Count:         2,
+					Flavors:       map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "default"},
+					ResourceUsage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				}},
+			},
+			wantNamespace: metav1.NamespaceDefault,
+			wantLQ:        "lq1",
+			wantOut: `ClusterQueue:   cq1
+Admissible:     true
+Mode:           Fit
+Borrowing:      false
+PodSets:
+  NAME          COUNT   FLAVORS       USAGE
+  main          2       cpu=default   cpu=2
+`,
+		},
+		"should simulate in the given queue and namespace": {
+			ns:   "ns2",
+			args: []string{"-f", "-", "--localqueue", "lq2"},
+			result: &visibility.AdmissionSimulationResult{
+				ClusterQueue: "cq2",
+				Mode:         "Preempt",
+				Admissible:   true,
+				Borrowing:    true,
+				PreemptionTargets: []visibility.SimulatedPreemptionTarget{
+					{
+						Name:         "low",
+						Namespace:    "ns2",
+						ClusterQueue: "cq2",
+						Priority:     -1,
+						Reason:       kueue.InClusterQueueReason,
+					},
+					{
+						ClusterQueue: "cq3",
+						Priority:     -2,
+						Reason:       kueue.InCohortReclamationReason,
+					},
+				},
+			},
+			wantNamespace: "ns2",
+			wantLQ:        "lq2",
+			wantOut: `ClusterQueue:   cq2
+Admissible:     true
+Mode:           Preempt
+Borrowing:      true
+Preemption targets:
+  NAMESPACE     NAME         CLUSTERQUEUE   PRIORITY   REASON
+  ns2           low          cq2            -1         InClusterQueue
+  <redacted>    <redacted>   cq3            -2         InCohortReclamation
+`,
+		},
+		"should print the result with jsonpath": {
+			args: []string{"-f", "-", "-o", "jsonpath={.result.message}"},
+			result: &visibility.AdmissionSimulationResult{
+				ClusterQueue: "cq1",
+				Mode:         "NoFit",
+				Message:      "insufficient quota",
+			},
+			wantNamespace: metav1.NamespaceDefault,
+			wantLQ:        "lq1",
+			wantOut:       "insufficient quota",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, in, out, _ := genericiooptions.NewTestIOStreams()
+			in.WriteString(testWorkload)
+
+			clientset := fake.NewSimpleClientset()
+			tcg := cmdtesting.NewTestClientGetter().WithKueueClientset(clientset)
+			if len(tc.ns) > 0 {
+				tcg.WithNamespace(tc.ns)
+			}
+
+			var gotNamespace, gotLQ string
+			clientset.PrependReactor("create", "localqueues", func(action kubetesting.Action) (bool, runtime.Object, error) {
+				createAction := action.(kubetesting.CreateActionImpl)
+				gotNamespace = createAction.GetNamespace()
+				gotLQ = createAction.Name
+				simulation := createAction.GetObject().(*visibility.AdmissionSimulation).DeepCopy()
+				wl := &kueue.Workload{}
+				if err := json.Unmarshal(simulation.Workload.Raw, wl); err != nil {
+					t.Errorf("Unexpected error decoding workload: %v", err)
+				}
+				if wl.Name != "wl" {
+					t.Errorf("Unexpected workload name %q", wl.Name)
+				}
+				simulation.Result = tc.result
+				return true, simulation, nil
+			})
+
+			cmd := NewAdmissionCmd(tcg, streams)
+			cmd.SetArgs(tc.args)
+
+			gotErr := cmd.Execute()
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantNamespace, gotNamespace); diff != "" {
+				t.Errorf("Unexpected namespace (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantLQ, gotLQ); diff != "" {
+				t.Errorf("Unexpected localqueue (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
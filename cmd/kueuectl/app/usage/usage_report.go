@@ -0,0 +1,376 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/inf.v0"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	kueuev1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta2"
+	visibilityv1beta2 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/visibility/v1beta2"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/clientgetter"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/flags"
+)
+
+const (
+	outputCSV  = "csv"
+	outputJSON = "json"
+
+	unitSeconds = "seconds"
+	unitHours   = "hours"
+
+	monthLayout = "2006-01"
+)
+
+var (
+	reportLong = templates.LongDesc(`
+		Report the quota reserved and used over time by the workloads, per
+		LocalQueue, ClusterQueue, flavor and resource.
+
+		The usage is accounted by Kueue in resource-seconds, for example
+		CPU-seconds or GPU-seconds. The usage of a LocalQueue includes the
+		workloads which no longer exist, while the usage of a workload is
+		only accounted since the start of the Kueue manager. Requires the
+		UsageAccounting feature gate.
+
+		Kueue keeps the usage of each calendar month, in UTC, for the last 12
+		months. With --since or --until, the usage is the sum of the months
+		in the range, both included.
+	`)
+	reportExample = templates.Examples(`
+		# Export the usage of the LocalQueues in the current namespace as CSV
+		kueuectl usage report
+
+		# Export the GPU-hours of all the LocalQueues as CSV
+		kueuectl usage report --all-namespaces --unit hours
+
+		# Export the usage of the workloads of a LocalQueue as JSON
+		kueuectl usage report --localqueue my-local-queue --workloads -o json
+
+		# Export the usage in a ClusterQueue across all the namespaces
+		kueuectl usage report --clusterqueue my-cluster-queue -A
+
+		# Export the usage of the LocalQueues from January to March 2026
+		kueuectl usage report --since 2026-01 --until 2026-03
+	`)
+)
+
+type ReportOptions struct {
+	AllNamespaces bool
+	Namespace     string
+	LocalQueue    string
+	ClusterQueue  string
+	Workloads     bool
+	Output        string
+	Unit          string
+	Since         string
+	Until         string
+
+	since time.Time
+	until time.Time
+
+	KueueClient      kueuev1beta2.KueueV1beta2Interface
+	VisibilityClient visibilityv1beta2.VisibilityV1beta2Interface
+
+	genericiooptions.IOStreams
+}
+
+func NewReportOptions(streams genericiooptions.IOStreams) *ReportOptions {
+	return &ReportOptions{
+		Output:    outputCSV,
+		Unit:      unitSeconds,
+		IOStreams: streams,
+	}
+}
+
+func NewReportCmd(clientGetter clientgetter.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewReportOptions(streams)
+
+	cmd := &cobra.Command{
+		Use: "report [--localqueue LOCAL_QUEUE_NAME] [--clusterqueue CLUSTER_QUEUE_NAME] [--workloads] [--all-namespaces] [--since YYYY-MM] [--until YYYY-MM] [--unit seconds|hours] [-o csv|json]",
+		// To do not add "[flags]" suffix on the end of usage line
+		DisableFlagsInUseLine: true,
+		Short:                 "Report the quota usage of LocalQueues and workloads",
+		Long:                  reportLong,
+		Example:               reportExample,
+		Args:                  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter)
+			if err != nil {
+				return err
+			}
+			err = o.Validate()
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	flags.AddAllNamespacesFlagVar(cmd, &o.AllNamespaces)
+	cmd.Flags().StringVarP(&o.LocalQueue, "localqueue", "q", "",
+		"Report the usage of the given LocalQueue only.")
+	cmd.Flags().StringVarP(&o.ClusterQueue, "clusterqueue", "c", "",
+		"Report the usage in the given ClusterQueue only.")
+	cmd.Flags().BoolVar(&o.Workloads, "workloads", false,
+		"If present, report the usage per workload instead of per LocalQueue.")
+	cmd.Flags().StringVarP(&o.Output, "output", "o", o.Output,
+		`Output format. One of: "csv" or "json".`)
+	cmd.Flags().StringVar(&o.Unit, "unit", o.Unit,
+		`Time unit of the usage in the CSV output. One of: "seconds" or "hours".`)
+	cmd.Flags().StringVar(&o.Since, "since", "",
+		"Report the usage from the given month, in the YYYY-MM format, in UTC.")
+	cmd.Flags().StringVar(&o.Until, "until", "",
+		"Report the usage up to the given month, included, in the YYYY-MM format, in UTC.")
+
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("localqueue", completion.LocalQueueNameFunc(clientGetter, nil)))
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("clusterqueue", completion.ClusterQueueNameFunc(clientGetter, nil)))
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *ReportOptions) Complete(clientGetter clientgetter.ClientGetter) error {
+	var err error
+
+	o.Namespace, _, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.KueueClient = clientset.KueueV1beta2()
+	o.VisibilityClient = clientset.VisibilityV1beta2()
+
+	return nil
+}
+
+// Validate validates the options
+func (o *ReportOptions) Validate() error {
+	if o.Output != outputCSV && o.Output != outputJSON {
+		return fmt.Errorf("invalid output format %q, must be one of %q or %q", o.Output, outputCSV, outputJSON)
+	}
+	if o.Unit != unitSeconds && o.Unit != unitHours {
+		return fmt.Errorf("invalid unit %q, must be one of %q or %q", o.Unit, unitSeconds, unitHours)
+	}
+	if len(o.LocalQueue) > 0 && o.AllNamespaces {
+		return fmt.Errorf("--localqueue can't be used with --all-namespaces")
+	}
+	var err error
+	if len(o.Since) > 0 {
+		if o.since, err = time.Parse(monthLayout, o.Since); err != nil {
+			return fmt.Errorf("invalid --since %q, must be a month in the YYYY-MM format", o.Since)
+		}
+	}
+	if len(o.Until) > 0 {
+		if o.until, err = time.Parse(monthLayout, o.Until); err != nil {
+			return fmt.Errorf("invalid --until %q, must be a month in the YYYY-MM format", o.Until)
+		}
+	}
+	if !o.since.IsZero() && !o.until.IsZero() && o.since.After(o.until) {
+		return fmt.Errorf("--since can't be after --until")
+	}
+	return nil
+}
+
+// Run fetches the usage and prints it.
+func (o *ReportOptions) Run(ctx context.Context) error {
+	report, err := o.fetchReport(ctx)
+	if err != nil {
+		return err
+	}
+	o.filterReport(report)
+	if o.Output == outputJSON {
+		return printJSON(report, o.Out)
+	}
+	return o.printCSV(report, o.Out)
+}
+
+func (o *ReportOptions) fetchReport(ctx context.Context) (*visibility.UsageReport, error) {
+	switch {
+	case len(o.LocalQueue) > 0:
+		return o.VisibilityClient.LocalQueues(o.Namespace).GetUsageReport(ctx, o.LocalQueue, metav1.GetOptions{})
+	case len(o.ClusterQueue) > 0:
+		return o.VisibilityClient.ClusterQueues().GetUsageReport(ctx, o.ClusterQueue, metav1.GetOptions{})
+	}
+
+	namespace := o.Namespace
+	if o.AllNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	lqs, err := o.KueueClient.LocalQueues(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	report := &visibility.UsageReport{}
+	for _, lq := range lqs.Items {
+		lqReport, err := o.VisibilityClient.LocalQueues(lq.Namespace).GetUsageReport(ctx, lq.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			// Nothing is accounted for the LocalQueue yet.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if lqReport.Time.After(report.Time.Time) {
+			report.Time = lqReport.Time
+		}
+		report.LocalQueues = append(report.LocalQueues, lqReport.LocalQueues...)
+		report.Workloads = append(report.Workloads, lqReport.Workloads...)
+	}
+	return report, nil
+}
+
+// filterReport keeps the usage of the selected namespace and ClusterQueue.
+func (o *ReportOptions) filterReport(report *visibility.UsageReport) {
+	matchesNamespace := func(namespace string) bool {
+		return o.AllNamespaces || namespace == o.Namespace
+	}
+	filterResources := func(resources []visibility.ResourceUsageAccounting) []visibility.ResourceUsageAccounting {
+		if len(o.ClusterQueue) == 0 {
+			return resources
+		}
+		return slices.DeleteFunc(resources, func(r visibility.ResourceUsageAccounting) bool {
+			return r.ClusterQueue != kueue.ClusterQueueReference(o.ClusterQueue)
+		})
+	}
+	report.LocalQueues = slices.DeleteFunc(report.LocalQueues, func(lq visibility.LocalQueueUsage) bool {
+		return !matchesNamespace(lq.Namespace)
+	})
+	for i := range report.LocalQueues {
+		lq := &report.LocalQueues[i]
+		lq.Resources, lq.Periods = o.selectPeriods(lq.Resources, lq.Periods)
+		lq.Resources = filterResources(lq.Resources)
+	}
+	report.Workloads = slices.DeleteFunc(report.Workloads, func(wl visibility.WorkloadUsage) bool {
+		return !matchesNamespace(wl.Namespace)
+	})
+	for i := range report.Workloads {
+		wl := &report.Workloads[i]
+		wl.Resources, wl.Periods = o.selectPeriods(wl.Resources, wl.Periods)
+		wl.Resources = filterResources(wl.Resources)
+	}
+}
+
+// selectPeriods keeps the periods between --since and --until, and replaces
+// the usage with their sum. It keeps the usage unchanged when neither is set.
+func (o *ReportOptions) selectPeriods(resources []visibility.ResourceUsageAccounting, periods []visibility.UsagePeriod) ([]visibility.ResourceUsageAccounting, []visibility.UsagePeriod) {
+	if o.since.IsZero() && o.until.IsZero() {
+		return resources, periods
+	}
+	periods = slices.DeleteFunc(periods, func(p visibility.UsagePeriod) bool {
+		return p.Start.Time.Before(o.since) || (!o.until.IsZero() && p.Start.Time.After(o.until))
+	})
+	type key struct {
+		clusterQueue kueue.ClusterQueueReference
+		flavor       kueue.ResourceFlavorReference
+		name         string
+	}
+	sums := make(map[key]*visibility.ResourceUsageAccounting)
+	for _, p := range periods {
+		for _, r := range p.Resources {
+			k := key{clusterQueue: r.ClusterQueue, flavor: r.Flavor, name: string(r.Name)}
+			sum, found := sums[k]
+			if !found {
+				sums[k] = r.DeepCopy()
+				continue
+			}
+			sum.Reserved.Add(r.Reserved)
+			sum.Used.Add(r.Used)
+		}
+	}
+	resources = make([]visibility.ResourceUsageAccounting, 0, len(sums))
+	for _, sum := range sums {
+		resources = append(resources, *sum)
+	}
+	slices.SortFunc(resources, func(a, b visibility.ResourceUsageAccounting) int {
+		return cmp.Or(cmp.Compare(a.ClusterQueue, b.ClusterQueue), cmp.Compare(a.Flavor, b.Flavor), cmp.Compare(a.Name, b.Name))
+	})
+	return resources, periods
+}
+
+func printJSON(report *visibility.UsageReport, out io.Writer) error {
+	report.APIVersion = visibility.SchemeGroupVersion.String()
+	report.Kind = "UsageReport"
+	data, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(data))
+	return err
+}
+
+func (o *ReportOptions) printCSV(report *visibility.UsageReport, out io.Writer) error {
+	w := csv.NewWriter(out)
+	reserved, used := "reserved_"+o.Unit, "used_"+o.Unit
+	if o.Workloads {
+		if err := w.Write([]string{"namespace", "localqueue", "workload", "clusterqueue", "flavor", "resource", reserved, used}); err != nil {
+			return err
+		}
+		for _, wl := range report.Workloads {
+			for _, r := range wl.Resources {
+				if err := w.Write([]string{wl.Namespace, string(wl.LocalQueueName), wl.Name, string(r.ClusterQueue), string(r.Flavor), string(r.Name), o.formatUsage(r.Reserved), o.formatUsage(r.Used)}); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		if err := w.Write([]string{"namespace", "localqueue", "clusterqueue", "flavor", "resource", reserved, used}); err != nil {
+			return err
+		}
+		for _, lq := range report.LocalQueues {
+			for _, r := range lq.Resources {
+				if err := w.Write([]string{lq.Namespace, string(lq.Name), string(r.ClusterQueue), string(r.Flavor), string(r.Name), o.formatUsage(r.Reserved), o.formatUsage(r.Used)}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// formatUsage formats resource-seconds as a plain decimal in the unit.
+func (o *ReportOptions) formatUsage(q resource.Quantity) string {
+	value := q.AsDec()
+	if o.Unit == unitHours {
+		value = new(inf.Dec).QuoRound(value, inf.NewDec(3600, 0), 3, inf.RoundHalfUp)
+	}
+	return value.String()
+}
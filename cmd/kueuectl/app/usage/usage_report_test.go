@@ -0,0 +1,227 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	kubetesting "k8s.io/client-go/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestReportCmd(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC))
+	resources := func(cq kueue.ClusterQueueReference, reserved, used string) []visibility.ResourceUsageAccounting {
+		return []visibility.ResourceUsageAccounting{{
+			ClusterQueue: cq,
+			Flavor:       "default",
+			Name:         corev1.ResourceCPU,
+			Reserved:     resource.MustParse(reserved),
+			Used:         resource.MustParse(used),
+		}}
+	}
+	september := metav1.NewTime(time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC))
+	october := metav1.NewTime(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	lqReports := map[string]*visibility.UsageReport{
+		"default/lq1": {
+			Time: now,
+			LocalQueues: []visibility.LocalQueueUsage{
+				{
+					Name:      "lq1",
+					Namespace: metav1.NamespaceDefault,
+					Resources: resources("cq1", "7200", "3600.5"),
+					Periods: []visibility.UsagePeriod{
+						{Start: september, Resources: resources("cq1", "3600", "1800")},
+						{Start: october, Resources: resources("cq1", "3600", "1800.5")},
+					},
+				},
+			},
+			Workloads: []visibility.WorkloadUsage{
+				{Name: "wl1", Namespace: metav1.NamespaceDefault, LocalQueueName: "lq1", Resources: resources("cq1", "3600", "1800")},
+			},
+		},
+		"ns2/lq3": {
+			Time: now,
+			LocalQueues: []visibility.LocalQueueUsage{
+				{Name: "lq3", Namespace: "ns2", Resources: resources("cq2", "60", "30")},
+			},
+		},
+	}
+	cqReports := map[string]*visibility.UsageReport{
+		"cq1": {
+			Time: now,
+			LocalQueues: []visibility.LocalQueueUsage{
+				{Name: "lq1", Namespace: metav1.NamespaceDefault, Resources: resources("cq1", "7200", "3600.5")},
+				{Name: "lq4", Namespace: "ns2", Resources: resources("cq1", "120", "0")},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		ns      string
+		args    []string
+		wantOut string
+		wantErr string
+	}{
+		"should report the LocalQueues of the namespace": {
+			wantOut: `namespace,localqueue,clusterqueue,flavor,resource,reserved_seconds,used_seconds
+default,lq1,cq1,default,cpu,7200,3600.5
+`,
+		},
+		"should report the LocalQueues of all namespaces": {
+			args: []string{"-A"},
+			wantOut: `namespace,localqueue,clusterqueue,flavor,resource,reserved_seconds,used_seconds
+default,lq1,cq1,default,cpu,7200,3600.5
+ns2,lq3,cq2,default,cpu,60,30
+`,
+		},
+		"should report the workloads of a LocalQueue in hours": {
+			args: []string{"--localqueue", "lq1", "--workloads", "--unit", "hours"},
+			wantOut: `namespace,localqueue,workload,clusterqueue,flavor,resource,reserved_hours,used_hours
+default,lq1,wl1,cq1,default,cpu,1.000,0.500
+`,
+		},
+		"should report a ClusterQueue across namespaces": {
+			args: []string{"--clusterqueue", "cq1", "-A"},
+			wantOut: `namespace,localqueue,clusterqueue,flavor,resource,reserved_seconds,used_seconds
+default,lq1,cq1,default,cpu,7200,3600.5
+ns2,lq4,cq1,default,cpu,120,0
+`,
+		},
+		"should report as json": {
+			ns:   "ns2",
+			args: []string{"-o", "json"},
+			wantOut: `{
+    "kind": "UsageReport",
+    "apiVersion": "visibility.kueue.x-k8s.io/v1beta2",
+    "metadata": {},
+    "time": "2026-10-01T12:00:00Z",
+    "localQueues": [
+        {
+            "name": "lq3",
+            "namespace": "ns2",
+            "resources": [
+                {
+                    "clusterQueue": "cq2",
+                    "flavor": "default",
+                    "name": "cpu",
+                    "reserved": "60",
+                    "used": "30"
+                }
+            ]
+        }
+    ]
+}
+`,
+		},
+		"should report the months since --since": {
+			args: []string{"--since", "2026-10"},
+			wantOut: `namespace,localqueue,clusterqueue,flavor,resource,reserved_seconds,used_seconds
+default,lq1,cq1,default,cpu,3600,1800.5
+`,
+		},
+		"should report the months until --until": {
+			args: []string{"--until", "2026-09"},
+			wantOut: `namespace,localqueue,clusterqueue,flavor,resource,reserved_seconds,used_seconds
+default,lq1,cq1,default,cpu,3600,1800
+`,
+		},
+		"should report the sum of the months between --since and --until": {
+			args: []string{"--since", "2026-09", "--until", "2026-10"},
+			wantOut: `namespace,localqueue,clusterqueue,flavor,resource,reserved_seconds,used_seconds
+default,lq1,cq1,default,cpu,7200,3600.5
+`,
+		},
+		"should report no usage outside the months": {
+			args: []string{"--since", "2025-01", "--until", "2025-12"},
+			wantOut: `namespace,localqueue,clusterqueue,flavor,resource,reserved_seconds,used_seconds
+`,
+		},
+		"should fail on invalid --since": {
+			args:    []string{"--since", "2026-10-01"},
+			wantErr: `invalid --since "2026-10-01", must be a month in the YYYY-MM format`,
+		},
+		"should fail when --since is after --until": {
+			args:    []string{"--since", "2026-10", "--until", "2026-09"},
+			wantErr: `--since can't be after --until`,
+		},
+		"should fail on invalid output": {
+			args:    []string{"-o", "yaml"},
+			wantErr: `invalid output format "yaml", must be one of "csv" or "json"`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, _ := genericiooptions.NewTestIOStreams()
+
+			clientset := fake.NewSimpleClientset(
+				utiltestingapi.MakeLocalQueue("lq1", metav1.NamespaceDefault).ClusterQueue("cq1").Obj(),
+				utiltestingapi.MakeLocalQueue("lq2", metav1.NamespaceDefault).ClusterQueue("cq1").Obj(),
+				utiltestingapi.MakeLocalQueue("lq3", "ns2").ClusterQueue("cq2").Obj(),
+			)
+			tcg := cmdtesting.NewTestClientGetter().WithKueueClientset(clientset)
+			if len(tc.ns) > 0 {
+				tcg.WithNamespace(tc.ns)
+			}
+
+			clientset.PrependReactor("get", "localqueues", func(action kubetesting.Action) (bool, runtime.Object, error) {
+				getAction := action.(kubetesting.GetActionImpl)
+				report, found := lqReports[getAction.Namespace+"/"+getAction.Name]
+				if !found {
+					return true, nil, apierrors.NewNotFound(visibility.Resource("localqueue"), getAction.Name)
+				}
+				return true, report.DeepCopy(), nil
+			})
+			clientset.PrependReactor("get", "clusterqueues", func(action kubetesting.Action) (bool, runtime.Object, error) {
+				getAction := action.(kubetesting.GetActionImpl)
+				report, found := cqReports[getAction.Name]
+				if !found {
+					return true, nil, apierrors.NewNotFound(visibility.Resource("clusterqueue"), getAction.Name)
+				}
+				return true, report.DeepCopy(), nil
+			})
+
+			cmd := NewReportCmd(tcg, streams)
+			cmd.SetArgs(tc.args)
+
+			gotErr := cmd.Execute()
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -81,6 +81,15 @@ func ResourceFlavorsGVK() schema.GroupVersionKind {
 	}
 }
 
+// TopologiesGVK returns the GroupVersionKind for Topologies
+func TopologiesGVK() schema.GroupVersionKind {
+	return schema.GroupVersionKind{
+		Group:   "kueue.x-k8s.io",
+		Version: "v1beta2",
+		Kind:    "Topology",
+	}
+}
+
 // PodsGVK returns the GroupVersionKind for Pods
 func PodsGVK() schema.GroupVersionKind {
 	return schema.GroupVersionKind{
@@ -159,6 +168,15 @@ func ResourceFlavorsGVR() schema.GroupVersionResource {
 	return resourceFlavorsGVR
 }
 
+// TopologiesGVR defines the GroupVersionResource for Topologies
+func TopologiesGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "kueue.x-k8s.io",
+		Version:  "v1beta2",
+		Resource: "topologies",
+	}
+}
+
 // EventsGVR defines the GroupVersionResource for Events
 func EventsGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
@@ -0,0 +1,509 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueueapi "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// topologyDomain is a domain of the tree of a Topology, with the capacity and
+// the usage of the nodes it contains.
+type topologyDomain struct {
+	Level       string                   `json:"level"`
+	Value       string                   `json:"value"`
+	ID          string                   `json:"id"`
+	Nodes       int                      `json:"nodes"`
+	Capacity    map[string]float64       `json:"capacity"`
+	TASUsage    map[string]float64       `json:"tasUsage"`
+	NonTASUsage map[string]float64       `json:"nonTasUsage"`
+	Workloads   []topologyDomainWorkload `json:"workloads"`
+	Children    []*topologyDomain        `json:"children,omitempty"`
+
+	children map[string]*topologyDomain
+}
+
+// topologyDomainWorkload is the number of pods of a PodSet of a workload
+// assigned to a domain.
+type topologyDomainWorkload struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	PodSet    string `json:"podSet"`
+	Count     int32  `json:"count"`
+}
+
+// TopologiesWebSocketHandler streams all topologies
+func (h *Handlers) TopologiesWebSocketHandler() gin.HandlerFunc {
+	return h.GenericWebSocketHandler(func(ctx context.Context) (any, error) {
+		return h.fetchTopologies(ctx)
+	}, TopologiesGVK(), ResourceFlavorsGVK())
+}
+
+// TopologyDetailsWebSocketHandler streams the domain tree of a specific topology
+// Watches Topologies, ResourceFlavors, Nodes, Pods and Workloads since the capacity and
+// the usage of the domains depend on all of them
+func (h *Handlers) TopologyDetailsWebSocketHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		topologyName := c.Param("topology_name")
+
+		h.GenericWebSocketHandler(func(ctx context.Context) (any, error) {
+			return h.fetchTopologyDetails(ctx, topologyName)
+		},
+			TopologiesGVK(),
+			ResourceFlavorsGVK(),
+			NodesGVK(),
+			PodsGVK(),
+			WorkloadsGVK(),
+		)(c)
+	}
+}
+
+// Fetch all topologies
+func (h *Handlers) fetchTopologies(ctx context.Context) ([]map[string]any, error) {
+	tl := &kueueapi.TopologyList{}
+	if err := h.client.List(ctx, tl); err != nil {
+		return nil, fmt.Errorf("error fetching topologies: %v", err)
+	}
+	rfl := &kueueapi.ResourceFlavorList{}
+	if err := h.client.List(ctx, rfl); err != nil {
+		return nil, fmt.Errorf("error fetching resource flavors: %v", err)
+	}
+
+	result := make([]map[string]any, 0, len(tl.Items))
+	for _, item := range tl.Items {
+		flavors := []string{}
+		for _, rf := range flavorsForTopology(rfl.Items, item.Name) {
+			flavors = append(flavors, rf.Name)
+		}
+		result = append(result, map[string]any{
+			"name":    item.Name,
+			"levels":  topologyLevels(&item),
+			"flavors": flavors,
+		})
+	}
+	return result, nil
+}
+
+// Fetch the domain tree of a specific topology
+func (h *Handlers) fetchTopologyDetails(ctx context.Context, topologyName string) (map[string]any, error) {
+	topology := &kueueapi.Topology{}
+	if err := h.client.Get(ctx, ctrlclient.ObjectKey{Name: topologyName}, topology); err != nil {
+		return nil, fmt.Errorf("error fetching topology %s: %v", topologyName, err)
+	}
+	rfl := &kueueapi.ResourceFlavorList{}
+	if err := h.client.List(ctx, rfl); err != nil {
+		return nil, fmt.Errorf("error fetching resource flavors: %v", err)
+	}
+	nl := &corev1.NodeList{}
+	if err := h.client.List(ctx, nl); err != nil {
+		return nil, fmt.Errorf("error fetching nodes: %v", err)
+	}
+	pl := &corev1.PodList{}
+	if err := h.client.List(ctx, pl); err != nil {
+		return nil, fmt.Errorf("error fetching pods: %v", err)
+	}
+	wl := &kueueapi.WorkloadList{}
+	if err := h.client.List(ctx, wl); err != nil {
+		return nil, fmt.Errorf("error fetching workloads: %v", err)
+	}
+
+	levels := topologyLevels(topology)
+	flavors := flavorsForTopology(rfl.Items, topologyName)
+	flavorNames := make([]string, 0, len(flavors))
+	for _, rf := range flavors {
+		flavorNames = append(flavorNames, rf.Name)
+	}
+
+	tree := newTopologyTree(levels)
+	for i := range nl.Items {
+		node := &nl.Items[i]
+		if !nodeBelongsToTopology(node, topologyName, levels, flavors) {
+			continue
+		}
+		tree.addNode(node)
+	}
+	for i := range pl.Items {
+		tree.addNonTASPod(&pl.Items[i])
+	}
+	for i := range wl.Items {
+		tree.addWorkload(&wl.Items[i], flavorNames)
+	}
+
+	return map[string]any{
+		"name":    topologyName,
+		"levels":  levels,
+		"flavors": flavorNames,
+		"domains": tree.finalize(),
+	}, nil
+}
+
+// topologyLevels returns the node labels of the levels of the topology.
+func topologyLevels(topology *kueueapi.Topology) []string {
+	levels := make([]string, 0, len(topology.Spec.Levels))
+	for _, level := range topology.Spec.Levels {
+		levels = append(levels, level.NodeLabel)
+	}
+	return levels
+}
+
+// flavorsForTopology returns the resource flavors referencing the topology,
+// either by spec.topologyName or in spec.topologies.
+func flavorsForTopology(flavors []kueueapi.ResourceFlavor, topologyName string) []kueueapi.ResourceFlavor {
+	var result []kueueapi.ResourceFlavor
+	for _, rf := range flavors {
+		if _, found := flavorTopologyNodeLabels(&rf, topologyName); found {
+			result = append(result, rf)
+		}
+	}
+	return result
+}
+
+// flavorTopologyNodeLabels returns the node labels selecting the nodes of the
+// topology in the resource flavor: the node labels of the flavor, plus those of
+// the topology when it is listed in spec.topologies.
+func flavorTopologyNodeLabels(rf *kueueapi.ResourceFlavor, topologyName string) (map[string]string, bool) {
+	if rf.Spec.TopologyName != nil {
+		return rf.Spec.NodeLabels, string(*rf.Spec.TopologyName) == topologyName
+	}
+	for _, topology := range rf.Spec.Topologies {
+		if string(topology.Name) != topologyName {
+			continue
+		}
+		nodeLabels := make(map[string]string, len(rf.Spec.NodeLabels)+len(topology.NodeLabels))
+		maps.Copy(nodeLabels, rf.Spec.NodeLabels)
+		maps.Copy(nodeLabels, topology.NodeLabels)
+		return nodeLabels, true
+	}
+	return nil, false
+}
+
+// nodeBelongsToTopology checks if a node has a label for every level of the
+// topology and matches the node labels one of its resource flavors selects the
+// nodes of the topology with. When no flavor references the topology, all the
+// nodes with the level labels belong to it.
+func nodeBelongsToTopology(node *corev1.Node, topologyName string, levels []string, flavors []kueueapi.ResourceFlavor) bool {
+	for _, level := range levels {
+		if _, found := node.Labels[level]; !found {
+			return false
+		}
+	}
+	if len(flavors) == 0 {
+		return true
+	}
+	for _, rf := range flavors {
+		if nodeLabels, found := flavorTopologyNodeLabels(&rf, topologyName); found && hasMatchingLabels(node.Labels, nodeLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// topologyTree accumulates the capacity and the usage of the nodes in the
+// domains of a topology.
+type topologyTree struct {
+	levels []string
+	roots  map[string]*topologyDomain
+	// leaves are the domains of the lowest level, by node name.
+	leaves map[string]*topologyDomain
+	// leavesByAssignment indexes the leaves by the values of the levels used
+	// by the topology assignments of the workloads.
+	leavesByAssignment map[string]map[string]*topologyDomain
+	nodes              []*corev1.Node
+}
+
+func newTopologyTree(levels []string) *topologyTree {
+	return &topologyTree{
+		levels:             levels,
+		roots:              make(map[string]*topologyDomain),
+		leaves:             make(map[string]*topologyDomain),
+		leavesByAssignment: make(map[string]map[string]*topologyDomain),
+	}
+}
+
+func newTopologyDomain(level string, levelValues []string) *topologyDomain {
+	return &topologyDomain{
+		Level:       level,
+		Value:       levelValues[len(levelValues)-1],
+		ID:          strings.Join(levelValues, ","),
+		Capacity:    map[string]float64{},
+		TASUsage:    map[string]float64{},
+		NonTASUsage: map[string]float64{},
+		Workloads:   []topologyDomainWorkload{},
+		children:    make(map[string]*topologyDomain),
+	}
+}
+
+// addNode adds the node, and its allocatable resources, to the leaf of its
+// level values, creating the missing domains on the way.
+func (t *topologyTree) addNode(node *corev1.Node) {
+	if len(t.levels) == 0 {
+		return
+	}
+	levelValues := make([]string, 0, len(t.levels))
+	domains := t.roots
+	var domain *topologyDomain
+	for idx, level := range t.levels {
+		levelValues = append(levelValues, node.Labels[level])
+		value := levelValues[idx]
+		child, found := domains[value]
+		if !found {
+			child = newTopologyDomain(level, slices.Clone(levelValues))
+			domains[value] = child
+		}
+		domain = child
+		domains = child.children
+	}
+	domain.Nodes++
+	for name, q := range node.Status.Allocatable {
+		domain.Capacity[string(name)] += quantityToFloat64(q)
+	}
+	t.leaves[node.Name] = domain
+	t.nodes = append(t.nodes, node)
+}
+
+// addNonTASPod adds the requests of a running pod, which is not managed by
+// TopologyAwareScheduling, to the usage of the leaf of its node.
+func (t *topologyTree) addNonTASPod(pod *corev1.Pod) {
+	if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return
+	}
+	if isTASPod(pod) {
+		return
+	}
+	leaf, found := t.leaves[pod.Spec.NodeName]
+	if !found {
+		return
+	}
+	for name, q := range podRequests(pod) {
+		leaf.NonTASUsage[string(name)] += quantityToFloat64(q)
+	}
+	leaf.NonTASUsage[string(corev1.ResourcePods)]++
+}
+
+// addWorkload adds the usage of the PodSets of a workload, which reserve quota
+// in a flavor of the topology, to the leaves of their topology assignment.
+func (t *topologyTree) addWorkload(wl *kueueapi.Workload, flavorNames []string) {
+	if wl.Status.Admission == nil || meta.IsStatusConditionTrue(wl.Status.Conditions, kueueapi.WorkloadFinished) {
+		return
+	}
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		ta := psa.TopologyAssignment
+		if ta == nil || psa.Count == nil || *psa.Count == 0 || !usesAnyFlavor(psa.Flavors, flavorNames) {
+			continue
+		}
+		podCount := float64(*psa.Count)
+		for values, count := range topologyAssignmentDomains(ta) {
+			leaf := t.leafForAssignment(ta.Levels, values)
+			if leaf == nil {
+				continue
+			}
+			for name, q := range psa.ResourceUsage {
+				leaf.TASUsage[string(name)] += quantityToFloat64(q) / podCount * float64(count)
+			}
+			leaf.TASUsage[string(corev1.ResourcePods)] += float64(count)
+			leaf.Workloads = append(leaf.Workloads, topologyDomainWorkload{
+				Namespace: wl.Namespace,
+				Name:      wl.Name,
+				PodSet:    string(psa.Name),
+				Count:     count,
+			})
+		}
+	}
+}
+
+// leafForAssignment returns the leaf identified by the values of the levels of
+// a topology assignment.
+func (t *topologyTree) leafForAssignment(levels, values []string) *topologyDomain {
+	levelsKey := strings.Join(levels, ",")
+	index, found := t.leavesByAssignment[levelsKey]
+	if !found {
+		index = make(map[string]*topologyDomain, len(t.nodes))
+		for _, node := range t.nodes {
+			nodeValues := make([]string, 0, len(levels))
+			for _, level := range levels {
+				nodeValues = append(nodeValues, node.Labels[level])
+			}
+			index[strings.Join(nodeValues, ",")] = t.leaves[node.Name]
+		}
+		t.leavesByAssignment[levelsKey] = index
+	}
+	return index[strings.Join(values, ",")]
+}
+
+// finalize sums the capacity, the usage and the workloads of the leaves into
+// their ancestors, and returns the root domains sorted by value.
+func (t *topologyTree) finalize() []*topologyDomain {
+	return finalizeDomains(t.roots)
+}
+
+func finalizeDomains(domains map[string]*topologyDomain) []*topologyDomain {
+	result := make([]*topologyDomain, 0, len(domains))
+	for _, domain := range domains {
+		if len(domain.children) > 0 {
+			domain.Children = finalizeDomains(domain.children)
+			workloads := make(map[topologyDomainWorkload]int32)
+			for _, child := range domain.Children {
+				domain.Nodes += child.Nodes
+				addResources(domain.Capacity, child.Capacity)
+				addResources(domain.TASUsage, child.TASUsage)
+				addResources(domain.NonTASUsage, child.NonTASUsage)
+				for _, w := range child.Workloads {
+					count := w.Count
+					w.Count = 0
+					workloads[w] += count
+				}
+			}
+			for w, count := range workloads {
+				w.Count = count
+				domain.Workloads = append(domain.Workloads, w)
+			}
+		}
+		slices.SortFunc(domain.Workloads, func(a, b topologyDomainWorkload) int {
+			return cmp.Or(
+				cmp.Compare(a.Namespace, b.Namespace),
+				cmp.Compare(a.Name, b.Name),
+				cmp.Compare(a.PodSet, b.PodSet),
+			)
+		})
+		result = append(result, domain)
+	}
+	slices.SortFunc(result, func(a, b *topologyDomain) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+	return result
+}
+
+func addResources(dst, src map[string]float64) {
+	for name, value := range src {
+		dst[name] += value
+	}
+}
+
+// usesAnyFlavor checks if one of the flavors assigned to the resources of a
+// PodSet is in flavorNames.
+func usesAnyFlavor(assigned map[corev1.ResourceName]kueueapi.ResourceFlavorReference, flavorNames []string) bool {
+	for _, flavor := range assigned {
+		if slices.Contains(flavorNames, string(flavor)) {
+			return true
+		}
+	}
+	return false
+}
+
+// topologyAssignmentDomains iterates over the domains of a topology assignment,
+// yielding the values of the levels of each domain and its number of pods.
+func topologyAssignmentDomains(ta *kueueapi.TopologyAssignment) iter.Seq2[[]string, int32] {
+	return func(yield func([]string, int32) bool) {
+		for _, slice := range ta.Slices {
+			if len(slice.ValuesPerLevel) != len(ta.Levels) {
+				continue
+			}
+			for i := range int(slice.DomainCount) {
+				values := make([]string, 0, len(ta.Levels))
+				for _, levelValues := range slice.ValuesPerLevel {
+					values = append(values, topologyAssignmentValue(levelValues, i))
+				}
+				if !yield(values, topologyAssignmentCount(slice.PodCounts, i)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func topologyAssignmentValue(values kueueapi.TopologyAssignmentSliceLevelValues, idx int) string {
+	if values.Universal != nil {
+		return *values.Universal
+	}
+	if values.Individual == nil || idx >= len(values.Individual.Roots) {
+		return ""
+	}
+	var prefix, suffix string
+	if values.Individual.Prefix != nil {
+		prefix = *values.Individual.Prefix
+	}
+	if values.Individual.Suffix != nil {
+		suffix = *values.Individual.Suffix
+	}
+	return prefix + values.Individual.Roots[idx] + suffix
+}
+
+func topologyAssignmentCount(counts kueueapi.TopologyAssignmentSlicePodCounts, idx int) int32 {
+	if counts.Universal != nil {
+		return *counts.Universal
+	}
+	if idx >= len(counts.Individual) {
+		return 0
+	}
+	return counts.Individual[idx]
+}
+
+// isTASPod checks if a pod is placed by TopologyAwareScheduling, in which case its
+// usage is accounted for by the topology assignment of its workload.
+func isTASPod(pod *corev1.Pod) bool {
+	for _, annotation := range []string{
+		kueueapi.PodSetRequiredTopologyAnnotation,
+		kueueapi.PodSetPreferredTopologyAnnotation,
+		kueueapi.PodSetUnconstrainedTopologyAnnotation,
+		kueueapi.PodSetSliceRequiredTopologyAnnotation,
+		kueueapi.PodSetSliceRequiredTopologyConstraintsAnnotation,
+	} {
+		if _, found := pod.Annotations[annotation]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// podRequests returns the effective requests of a pod: the largest of the sum
+// of the requests of its containers and the requests of each init container,
+// plus the pod overhead.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, c := range pod.Spec.Containers {
+		for name, q := range c.Resources.Requests {
+			sum := requests[name]
+			sum.Add(q)
+			requests[name] = sum
+		}
+	}
+	for _, c := range pod.Spec.InitContainers {
+		for name, q := range c.Resources.Requests {
+			if current, found := requests[name]; !found || q.Cmp(current) > 0 {
+				requests[name] = q.DeepCopy()
+			}
+		}
+	}
+	for name, q := range pod.Spec.Overhead {
+		sum := requests[name]
+		sum.Add(q)
+		requests[name] = sum
+	}
+	return requests
+}
@@ -0,0 +1,266 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueueapi "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+const (
+	testBlockLabel = "cloud.provider.com/topology-block"
+	testHostLabel  = "kubernetes.io/hostname"
+)
+
+type fakeTopologyClient struct {
+	topology  kueueapi.Topology
+	flavors   []kueueapi.ResourceFlavor
+	nodes     []corev1.Node
+	pods      []corev1.Pod
+	workloads []kueueapi.Workload
+}
+
+func (f *fakeTopologyClient) Get(_ context.Context, key ctrlclient.ObjectKey, obj ctrlclient.Object, _ ...ctrlclient.GetOption) error {
+	topology, ok := obj.(*kueueapi.Topology)
+	if !ok || key.Name != f.topology.Name {
+		return fmt.Errorf("unexpected get of %T %s", obj, key)
+	}
+	f.topology.DeepCopyInto(topology)
+	return nil
+}
+
+func (f *fakeTopologyClient) List(_ context.Context, list ctrlclient.ObjectList, _ ...ctrlclient.ListOption) error {
+	switch l := list.(type) {
+	case *kueueapi.TopologyList:
+		l.Items = []kueueapi.Topology{f.topology}
+	case *kueueapi.ResourceFlavorList:
+		l.Items = f.flavors
+	case *corev1.NodeList:
+		l.Items = f.nodes
+	case *corev1.PodList:
+		l.Items = f.pods
+	case *kueueapi.WorkloadList:
+		l.Items = f.workloads
+	default:
+		return fmt.Errorf("unexpected list type %T", list)
+	}
+	return nil
+}
+
+func (f *fakeTopologyClient) GetInformerForKind(_ context.Context, _ schema.GroupVersionKind, _ ...ctrlcache.InformerGetOption) (ctrlcache.Informer, error) {
+	return nil, nil
+}
+
+func makeTopologyNode(name, block, cpu string, labels map[string]string) corev1.Node {
+	node := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{testBlockLabel: block, testHostLabel: name},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+		},
+	}
+	for k, v := range labels {
+		node.Labels[k] = v
+	}
+	return node
+}
+
+func makeTopologyPod(name, nodeName, cpu string, annotations map[string]string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestFetchTopologyDetails(t *testing.T) {
+	pool := map[string]string{"pool": "tas"}
+	client := &fakeTopologyClient{
+		topology: kueueapi.Topology{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: kueueapi.TopologySpec{
+				Levels: []kueueapi.TopologyLevel{{NodeLabel: testBlockLabel}, {NodeLabel: testHostLabel}},
+			},
+		},
+		flavors: []kueueapi.ResourceFlavor{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "tas"},
+				Spec: kueueapi.ResourceFlavorSpec{
+					NodeLabels:   pool,
+					TopologyName: new(kueueapi.TopologyReference("default")),
+				},
+			},
+			{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		},
+		nodes: []corev1.Node{
+			makeTopologyNode("x1", "b1", "4", pool),
+			makeTopologyNode("x2", "b1", "4", pool),
+			makeTopologyNode("x3", "b2", "4", pool),
+			makeTopologyNode("outside", "b2", "4", nil),
+		},
+		pods: []corev1.Pod{
+			makeTopologyPod("daemon", "x1", "500m", nil),
+			makeTopologyPod("tas", "x1", "1", map[string]string{kueueapi.PodSetRequiredTopologyAnnotation: testBlockLabel}),
+			makeTopologyPod("outside", "outside", "1", nil),
+		},
+		workloads: []kueueapi.Workload{{
+			ObjectMeta: metav1.ObjectMeta{Name: "gang", Namespace: "default"},
+			Status: kueueapi.WorkloadStatus{
+				Admission: &kueueapi.Admission{
+					ClusterQueue: "cq",
+					PodSetAssignments: []kueueapi.PodSetAssignment{{
+						Name:          "main",
+						Flavors:       map[corev1.ResourceName]kueueapi.ResourceFlavorReference{corev1.ResourceCPU: "tas"},
+						ResourceUsage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")},
+						Count:         new(int32(3)),
+						TopologyAssignment: &kueueapi.TopologyAssignment{
+							Levels: []string{testHostLabel},
+							Slices: []kueueapi.TopologyAssignmentSlice{
+								{
+									DomainCount: 1,
+									ValuesPerLevel: []kueueapi.TopologyAssignmentSliceLevelValues{
+										{Universal: new("x1")},
+									},
+									PodCounts: kueueapi.TopologyAssignmentSlicePodCounts{Universal: new(int32(1))},
+								},
+								{
+									DomainCount: 1,
+									ValuesPerLevel: []kueueapi.TopologyAssignmentSliceLevelValues{{
+										Individual: &kueueapi.TopologyAssignmentSliceLevelIndividualValues{
+											Prefix: new("x"),
+											Roots:  []string{"2"},
+										},
+									}},
+									PodCounts: kueueapi.TopologyAssignmentSlicePodCounts{Individual: []int32{2}},
+								},
+							},
+						},
+					}},
+				},
+			},
+		}},
+	}
+	h := &Handlers{client: client}
+
+	got, err := h.fetchTopologyDetails(t.Context(), "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if flavors := got["flavors"]; !reflect.DeepEqual(flavors, []string{"tas"}) {
+		t.Errorf("flavors = %v, want [tas]", flavors)
+	}
+	domains, ok := got["domains"].([]*topologyDomain)
+	if !ok {
+		t.Fatalf("unexpected domains type %T", got["domains"])
+	}
+	if len(domains) != 2 {
+		t.Fatalf("got %d blocks, want 2", len(domains))
+	}
+
+	b1 := domains[0]
+	if b1.Value != "b1" || b1.Nodes != 2 || len(b1.Children) != 2 {
+		t.Fatalf("unexpected block b1: value=%s nodes=%d children=%d", b1.Value, b1.Nodes, len(b1.Children))
+	}
+	if want := map[string]float64{"cpu": 8}; !reflect.DeepEqual(b1.Capacity, want) {
+		t.Errorf("b1 capacity = %v, want %v", b1.Capacity, want)
+	}
+	if want := map[string]float64{"cpu": 3, "pods": 3}; !reflect.DeepEqual(b1.TASUsage, want) {
+		t.Errorf("b1 TAS usage = %v, want %v", b1.TASUsage, want)
+	}
+	if want := map[string]float64{"cpu": 0.5, "pods": 1}; !reflect.DeepEqual(b1.NonTASUsage, want) {
+		t.Errorf("b1 non-TAS usage = %v, want %v", b1.NonTASUsage, want)
+	}
+	wantWorkloads := []topologyDomainWorkload{{Namespace: "default", Name: "gang", PodSet: "main", Count: 3}}
+	if !reflect.DeepEqual(b1.Workloads, wantWorkloads) {
+		t.Errorf("b1 workloads = %v, want %v", b1.Workloads, wantWorkloads)
+	}
+
+	x2 := b1.Children[1]
+	if x2.ID != "b1,x2" {
+		t.Errorf("x2 id = %s, want b1,x2", x2.ID)
+	}
+	wantWorkloads = []topologyDomainWorkload{{Namespace: "default", Name: "gang", PodSet: "main", Count: 2}}
+	if !reflect.DeepEqual(x2.Workloads, wantWorkloads) {
+		t.Errorf("x2 workloads = %v, want %v", x2.Workloads, wantWorkloads)
+	}
+
+	b2 := domains[1]
+	if b2.Nodes != 1 {
+		t.Errorf("b2 has %d nodes, want 1", b2.Nodes)
+	}
+	if len(b2.NonTASUsage) != 0 || len(b2.TASUsage) != 0 {
+		t.Errorf("unexpected b2 usage: TAS=%v non-TAS=%v", b2.TASUsage, b2.NonTASUsage)
+	}
+}
+
+func TestFlavorsWithSeveralTopologies(t *testing.T) {
+	levels := []string{testBlockLabel, testHostLabel}
+	flavors := []kueueapi.ResourceFlavor{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "multi"},
+			Spec: kueueapi.ResourceFlavorSpec{
+				NodeLabels: map[string]string{"pool": "tas"},
+				Topologies: []kueueapi.FlavorTopology{
+					{Name: "default", NodeLabels: map[string]string{"rack": "a"}},
+					{Name: "other", NodeLabels: map[string]string{"rack": "b"}},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "single"},
+			Spec: kueueapi.ResourceFlavorSpec{
+				TopologyName: new(kueueapi.TopologyReference("other")),
+			},
+		},
+	}
+
+	got := flavorsForTopology(flavors, "default")
+	if len(got) != 1 || got[0].Name != "multi" {
+		t.Fatalf("flavorsForTopology(default) = %v, want [multi]", got)
+	}
+	nodes := map[string]bool{
+		"a1": true,
+		"b1": false,
+	}
+	for name, want := range nodes {
+		node := makeTopologyNode(name, "b1", "4", map[string]string{"pool": "tas", "rack": name[:1]})
+		if belongs := nodeBelongsToTopology(&node, "default", levels, got); belongs != want {
+			t.Errorf("node %s belongs to the topology = %v, want %v", name, belongs, want)
+		}
+	}
+}
@@ -40,6 +40,7 @@ const Navbar = () => {
     { label: 'Cluster Queues', to: '/cluster-queues' },
     { label: 'Cohorts', to: '/cohorts' },
     { label: 'Resource Flavors', to: '/resource-flavors' },
+    { label: 'Topologies', to: '/topologies' },
   ];
 
   return (
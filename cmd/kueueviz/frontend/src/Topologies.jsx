@@ -0,0 +1,96 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import React from 'react';
+import { Link } from 'react-router-dom';
+import { Typography, Paper, Table, TableBody, TableCell, TableContainer, TableHead, TableRow, CircularProgress, Box, Chip } from '@mui/material';
+import useWebSocket from './useWebSocket';
+import './App.css';
+import ErrorMessage from './ErrorMessage';
+import ViewYamlButton from './ViewYamlButton';
+
+const Topologies = () => {
+  const { data, error } = useWebSocket('/ws/topologies');
+  const topologies = React.useMemo(() => {
+    if (!data || !Array.isArray(data)) return [];
+    return [...data].sort((a, b) => (a.name || '').localeCompare(b.name || ''));
+  }, [data]);
+
+  if (error) return <ErrorMessage error={error} />;
+
+  return (
+    <Paper className="parentContainer">
+      <Typography variant="h4" gutterBottom>Topologies</Typography>
+      {data === null ? (
+        <Box display="flex" justifyContent="center" my={4}>
+          <CircularProgress />
+        </Box>
+      ) : topologies.length === 0 ? (
+        <Typography>No Topologies found.</Typography>
+      ) : (
+        <TableContainer component={Paper} className="tableContainerWithBorder">
+          <Table>
+            <TableHead>
+              <TableRow>
+                <TableCell>Name</TableCell>
+                <TableCell>Levels</TableCell>
+                <TableCell>Resource Flavors</TableCell>
+                <TableCell align="right">Actions</TableCell>
+              </TableRow>
+            </TableHead>
+            <TableBody>
+              {topologies.map((topology) => (
+                <TableRow key={topology.name}>
+                  <TableCell>
+                    <Link to={`/topology/${topology.name}`}>{topology.name}</Link>
+                  </TableCell>
+                  <TableCell>
+                    <Box display="flex" flexWrap="wrap" gap={0.5}>
+                      {(topology.levels || []).map((level) => (
+                        <Chip key={level} label={level} size="small" variant="outlined" />
+                      ))}
+                    </Box>
+                  </TableCell>
+                  <TableCell>
+                    {topology.flavors?.length ? (
+                      <Box display="flex" flexWrap="wrap" gap={0.5}>
+                        {topology.flavors.map((flavor) => (
+                          <Link key={flavor} to={`/resource-flavor/${flavor}`}>{flavor}</Link>
+                        ))}
+                      </Box>
+                    ) : (
+                      <Typography variant="body2" color="text.secondary">None</Typography>
+                    )}
+                  </TableCell>
+                  <TableCell align="right">
+                    <Box display="flex" justifyContent="flex-end">
+                      <ViewYamlButton
+                        resourceType="topology"
+                        resourceName={topology.name}
+                      />
+                    </Box>
+                  </TableCell>
+                </TableRow>
+              ))}
+            </TableBody>
+          </Table>
+        </TableContainer>
+      )}
+    </Paper>
+  );
+};
+
+export default Topologies;
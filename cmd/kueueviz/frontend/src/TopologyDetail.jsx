@@ -0,0 +1,286 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import React, { useState, useMemo } from 'react';
+import { useParams, Link } from 'react-router-dom';
+import {
+  Typography, Paper, Table, TableBody, TableCell, TableContainer, TableHead, TableRow,
+  CircularProgress, Box, Chip, Breadcrumbs, FormControl, InputLabel, Select, MenuItem, Tooltip,
+} from '@mui/material';
+import useWebSocket from './useWebSocket';
+import './App.css';
+import ErrorMessage from './ErrorMessage';
+import { toNumber, formatResourceValue } from './UsageBar';
+
+/**
+ * Compute the free capacity of a domain for a resource.
+ *
+ * @param {object} domain - A topology domain from the backend
+ * @param {string} resourceName - e.g. "cpu", "memory", "pods"
+ * @returns {{ capacity: number, tasUsage: number, nonTasUsage: number, free: number, freeRatio: number }}
+ *   freeRatio is in [0, 1], and 0 when the domain has no capacity for the resource
+ */
+export function domainFreeCapacity(domain, resourceName) {
+  const capacity = toNumber(domain?.capacity?.[resourceName]);
+  const tasUsage = toNumber(domain?.tasUsage?.[resourceName]);
+  const nonTasUsage = toNumber(domain?.nonTasUsage?.[resourceName]);
+  const free = Math.max(0, capacity - tasUsage - nonTasUsage);
+  const freeRatio = capacity > 0 ? Math.min(1, free / capacity) : 0;
+  return { capacity, tasUsage, nonTasUsage, free, freeRatio };
+}
+
+/**
+ * Map the free ratio of a domain to a heatmap color, from red (full) to green (empty).
+ */
+export function heatmapColor(freeRatio) {
+  const ratio = Math.min(1, Math.max(0, toNumber(freeRatio)));
+  return `hsl(${Math.round(ratio * 120)}, 65%, 45%)`;
+}
+
+/**
+ * Find the path of domains from the roots to the domain with the given id.
+ * Returns an empty array when the domain is not found, e.g. after it was removed.
+ */
+export function findDomainPath(domains, id) {
+  for (const domain of domains || []) {
+    if (domain.id === id) return [domain];
+    const path = findDomainPath(domain.children, id);
+    if (path.length > 0) return [domain, ...path];
+  }
+  return [];
+}
+
+/**
+ * Merge the workloads assigned to several domains, summing the pods of each PodSet.
+ */
+export function mergeDomainWorkloads(domains) {
+  const merged = new Map();
+  for (const domain of domains || []) {
+    for (const workload of domain.workloads || []) {
+      const key = `${workload.namespace}/${workload.name}/${workload.podSet}`;
+      const current = merged.get(key);
+      merged.set(key, current ? { ...current, count: current.count + workload.count } : { ...workload });
+    }
+  }
+  return [...merged.values()].sort((a, b) =>
+    a.namespace.localeCompare(b.namespace) || a.name.localeCompare(b.name) || a.podSet.localeCompare(b.podSet));
+}
+
+/**
+ * List the resource names with capacity in the domains, with cpu, memory and pods first.
+ */
+export function discoverTopologyResources(domains) {
+  const names = new Set();
+  for (const domain of domains || []) {
+    Object.keys(domain.capacity || {}).forEach((name) => names.add(name));
+  }
+  const preferred = ['cpu', 'memory', 'pods'];
+  return [...names].sort((a, b) => {
+    const ia = preferred.indexOf(a);
+    const ib = preferred.indexOf(b);
+    if (ia !== -1 || ib !== -1) return (ia === -1 ? preferred.length : ia) - (ib === -1 ? preferred.length : ib);
+    return a.localeCompare(b);
+  });
+}
+
+const DomainTile = ({ domain, resourceName, onZoom }) => {
+  const { capacity, tasUsage, nonTasUsage, free, freeRatio } = domainFreeCapacity(domain, resourceName);
+  const canZoom = domain.children?.length > 0;
+  const tooltip = (
+    <Box>
+      <Typography variant="body2"><strong>{domain.level}: {domain.value}</strong></Typography>
+      <Typography variant="body2">Nodes: {domain.nodes}</Typography>
+      <Typography variant="body2">Capacity: {formatResourceValue(capacity, resourceName)}</Typography>
+      <Typography variant="body2">TAS usage: {formatResourceValue(tasUsage, resourceName)}</Typography>
+      <Typography variant="body2">Non-TAS usage: {formatResourceValue(nonTasUsage, resourceName)}</Typography>
+      <Typography variant="body2">Free: {formatResourceValue(free, resourceName)}</Typography>
+      {domain.workloads?.length > 0 && (
+        <Typography variant="body2">Workloads: {domain.workloads.length}</Typography>
+      )}
+    </Box>
+  );
+  return (
+    <Tooltip title={tooltip} arrow>
+      <Box
+        onClick={canZoom ? () => onZoom(domain.id) : undefined}
+        sx={{
+          backgroundColor: heatmapColor(freeRatio),
+          color: '#fff',
+          borderRadius: 1,
+          p: 1,
+          minHeight: 64,
+          cursor: canZoom ? 'zoom-in' : 'default',
+          overflow: 'hidden',
+        }}
+      >
+        <Typography variant="body2" noWrap><strong>{domain.value}</strong></Typography>
+        <Typography variant="caption" component="div" noWrap>
+          {formatResourceValue(free, resourceName)} / {formatResourceValue(capacity, resourceName)} free
+        </Typography>
+        {domain.workloads?.length > 0 && (
+          <Typography variant="caption" component="div" noWrap>
+            {domain.workloads.length} workload{domain.workloads.length > 1 ? 's' : ''}
+          </Typography>
+        )}
+      </Box>
+    </Tooltip>
+  );
+};
+
+const TopologyDetail = () => {
+  const { topologyName } = useParams();
+  const { data: topology, error } = useWebSocket(`/ws/topology/${topologyName}`);
+  const [zoomedDomainId, setZoomedDomainId] = useState(null);
+  const [selectedResource, setSelectedResource] = useState('cpu');
+
+  const roots = useMemo(() => topology?.domains || [], [topology]);
+  const path = useMemo(() => (zoomedDomainId ? findDomainPath(roots, zoomedDomainId) : []), [roots, zoomedDomainId]);
+  const zoomed = path.length > 0 ? path[path.length - 1] : null;
+  const domains = zoomed ? zoomed.children || [] : roots;
+  const resourceNames = useMemo(() => discoverTopologyResources(roots), [roots]);
+  const resourceName = resourceNames.includes(selectedResource) ? selectedResource : (resourceNames[0] || 'cpu');
+  const workloads = useMemo(() => mergeDomainWorkloads(zoomed ? [zoomed] : roots), [zoomed, roots]);
+
+  if (error) return <ErrorMessage error={error} />;
+
+  if (!topology || !topology.name) {
+    return (
+      <Paper className="parentContainer">
+        <Typography variant="h6">Loading...</Typography>
+        <CircularProgress />
+      </Paper>
+    );
+  }
+
+  const level = domains.length > 0 ? domains[0].level : null;
+
+  return (
+    <Paper className="parentContainer">
+      <Typography variant="h4" gutterBottom>Topology Detail: {topologyName}</Typography>
+      <Box display="flex" flexDirection="column" gap={1.5} width="100%">
+        <Box>
+          <Typography variant="body2" color="text.secondary" gutterBottom><strong>Levels</strong></Typography>
+          <Box display="flex" flexWrap="wrap" gap={0.5}>
+            {(topology.levels || []).map((l) => (
+              <Chip key={l} label={l} size="small" variant="outlined" />
+            ))}
+          </Box>
+        </Box>
+        <Box>
+          <Typography variant="body2" color="text.secondary" gutterBottom><strong>Resource Flavors</strong></Typography>
+          {topology.flavors?.length ? (
+            <Box display="flex" flexWrap="wrap" gap={1}>
+              {topology.flavors.map((flavor) => (
+                <Link key={flavor} to={`/resource-flavor/${flavor}`}>{flavor}</Link>
+              ))}
+            </Box>
+          ) : (
+            <Typography variant="body2" color="text.secondary">None</Typography>
+          )}
+        </Box>
+      </Box>
+
+      <Box mt={5} width="100%">
+        <Box display="flex" alignItems="center" justifyContent="space-between" gap={2} mb={2}>
+          <Typography variant="h5" sx={{ mb: 0 }}>Free Capacity</Typography>
+          <FormControl size="small" sx={{ minWidth: 160 }}>
+            <InputLabel id="topology-resource-label">Resource</InputLabel>
+            <Select
+              labelId="topology-resource-label"
+              label="Resource"
+              value={resourceNames.length > 0 ? resourceName : ''}
+              onChange={(e) => setSelectedResource(e.target.value)}
+            >
+              {resourceNames.map((name) => (
+                <MenuItem key={name} value={name}>{name}</MenuItem>
+              ))}
+            </Select>
+          </FormControl>
+        </Box>
+        <Breadcrumbs sx={{ mb: 2 }}>
+          <Link to="#" onClick={(e) => { e.preventDefault(); setZoomedDomainId(null); }}>{topologyName}</Link>
+          {path.map((domain, index) => (
+            index === path.length - 1 ? (
+              <Typography key={domain.id} color="text.primary">{domain.value}</Typography>
+            ) : (
+              <Link key={domain.id} to="#" onClick={(e) => { e.preventDefault(); setZoomedDomainId(domain.id); }}>
+                {domain.value}
+              </Link>
+            )
+          ))}
+        </Breadcrumbs>
+        {domains.length === 0 ? (
+          <Typography>No nodes belong to this topology.</Typography>
+        ) : (
+          <>
+            {level && (
+              <Typography variant="body2" color="text.secondary" gutterBottom>
+                {domains.length} domain{domains.length > 1 ? 's' : ''} of the level {level}
+              </Typography>
+            )}
+            <Box
+              sx={{
+                display: 'grid',
+                gridTemplateColumns: 'repeat(auto-fill, minmax(120px, 1fr))',
+                gap: 1,
+              }}
+            >
+              {domains.map((domain) => (
+                <DomainTile key={domain.id} domain={domain} resourceName={resourceName} onZoom={setZoomedDomainId} />
+              ))}
+            </Box>
+          </>
+        )}
+      </Box>
+
+      <Box mt={5} width="100%">
+        <Typography variant="h5" gutterBottom>
+          Assigned Workloads {workloads.length > 0 && <Chip label={workloads.length} size="small" sx={{ ml: 1 }} />}
+        </Typography>
+        {workloads.length === 0 ? (
+          <Typography>No workloads are assigned to {zoomed ? 'this domain' : 'this topology'}.</Typography>
+        ) : (
+          <TableContainer component={Paper} className="tableContainerWithBorder">
+            <Table size="small">
+              <TableHead>
+                <TableRow>
+                  <TableCell>Namespace</TableCell>
+                  <TableCell>Workload</TableCell>
+                  <TableCell>Pod Set</TableCell>
+                  <TableCell align="right">Pods</TableCell>
+                </TableRow>
+              </TableHead>
+              <TableBody>
+                {workloads.map((workload) => (
+                  <TableRow key={`${workload.namespace}/${workload.name}/${workload.podSet}`}>
+                    <TableCell>{workload.namespace}</TableCell>
+                    <TableCell>
+                      <Link to={`/workload/${workload.namespace}/${workload.name}`}>{workload.name}</Link>
+                    </TableCell>
+                    <TableCell>{workload.podSet}</TableCell>
+                    <TableCell align="right">{workload.count}</TableCell>
+                  </TableRow>
+                ))}
+              </TableBody>
+            </Table>
+          </TableContainer>
+        )}
+      </Box>
+    </Paper>
+  );
+};
+
+export default TopologyDetail;
@@ -0,0 +1,115 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+import { describe, it, expect } from 'vitest';
+import {
+  domainFreeCapacity,
+  heatmapColor,
+  findDomainPath,
+  mergeDomainWorkloads,
+  discoverTopologyResources,
+} from './TopologyDetail';
+
+const x1 = {
+  id: 'b1,x1',
+  value: 'x1',
+  capacity: { cpu: 4, pods: 10 },
+  tasUsage: { cpu: 2, pods: 2 },
+  nonTasUsage: { cpu: 1, pods: 1 },
+  workloads: [{ namespace: 'default', name: 'gang', podSet: 'main', count: 2 }],
+};
+const x2 = {
+  id: 'b1,x2',
+  value: 'x2',
+  capacity: { cpu: 4, pods: 10 },
+  tasUsage: { cpu: 1, pods: 1 },
+  nonTasUsage: {},
+  workloads: [{ namespace: 'default', name: 'gang', podSet: 'main', count: 1 }],
+};
+const b1 = { id: 'b1', value: 'b1', capacity: { cpu: 8, pods: 20 }, children: [x1, x2] };
+
+// ---------------------------------------------------------------------------
+// domainFreeCapacity
+// ---------------------------------------------------------------------------
+describe('domainFreeCapacity', () => {
+  it('subtracts the TAS and non-TAS usage from the capacity', () => {
+    expect(domainFreeCapacity(x1, 'cpu')).toEqual({
+      capacity: 4,
+      tasUsage: 2,
+      nonTasUsage: 1,
+      free: 1,
+      freeRatio: 0.25,
+    });
+  });
+
+  it('never returns a negative free capacity', () => {
+    const overcommitted = { capacity: { cpu: 1 }, tasUsage: { cpu: 1 }, nonTasUsage: { cpu: 1 } };
+    expect(domainFreeCapacity(overcommitted, 'cpu').free).toBe(0);
+  });
+
+  it('returns a zero ratio for resources without capacity', () => {
+    expect(domainFreeCapacity(x1, 'nvidia.com/gpu').freeRatio).toBe(0);
+  });
+});
+
+// ---------------------------------------------------------------------------
+// heatmapColor
+// ---------------------------------------------------------------------------
+describe('heatmapColor', () => {
+  it('maps full domains to red and empty domains to green', () => {
+    expect(heatmapColor(0)).toBe('hsl(0, 65%, 45%)');
+    expect(heatmapColor(1)).toBe('hsl(120, 65%, 45%)');
+  });
+
+  it('clamps out of range ratios', () => {
+    expect(heatmapColor(-1)).toBe('hsl(0, 65%, 45%)');
+    expect(heatmapColor(2)).toBe('hsl(120, 65%, 45%)');
+  });
+});
+
+// ---------------------------------------------------------------------------
+// findDomainPath
+// ---------------------------------------------------------------------------
+describe('findDomainPath', () => {
+  it('returns the domains from the root to the target', () => {
+    expect(findDomainPath([b1], 'b1,x2').map((d) => d.id)).toEqual(['b1', 'b1,x2']);
+  });
+
+  it('returns an empty path for unknown domains', () => {
+    expect(findDomainPath([b1], 'b2')).toEqual([]);
+  });
+});
+
+// ---------------------------------------------------------------------------
+// mergeDomainWorkloads
+// ---------------------------------------------------------------------------
+describe('mergeDomainWorkloads', () => {
+  it('sums the pods of the same PodSet across domains', () => {
+    expect(mergeDomainWorkloads([x1, x2])).toEqual([
+      { namespace: 'default', name: 'gang', podSet: 'main', count: 3 },
+    ]);
+  });
+});
+
+// ---------------------------------------------------------------------------
+// discoverTopologyResources
+// ---------------------------------------------------------------------------
+describe('discoverTopologyResources', () => {
+  it('lists cpu, memory and pods first', () => {
+    const domain = { capacity: { 'nvidia.com/gpu': 8, pods: 110, memory: 1024, cpu: 4 } };
+    expect(discoverTopologyResources([domain])).toEqual(['cpu', 'memory', 'pods', 'nvidia.com/gpu']);
+  });
+});
@@ -0,0 +1,172 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/component-base/featuregate"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/scheduler/trace"
+)
+
+const (
+	TraceFlag        = "trace"
+	CycleFlag        = "cycle"
+	VerbosityFlag    = "verbose"
+	VerboseFlagShort = "v"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "replayer",
+		Short: "Replay the scheduling cycles recorded by the Kueue scheduler",
+		Long: `Replay the scheduling cycles recorded by the Kueue scheduler with the
+--scheduling-trace-file flag, and report the cycles in which the replayed
+outcomes diverge from the recorded ones.`,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			v, _ := cmd.Flags().GetCount(VerbosityFlag)
+			level := (v + 1) * -1
+			ctrl.SetLogger(zap.New(
+				zap.UseDevMode(true),
+				zap.ConsoleEncoder(),
+				zap.Level(zapcore.Level(level)),
+			))
+			return nil
+		},
+		RunE:         replayCmd,
+		SilenceUsage: true,
+	}
+)
+
+func init() {
+	rootCmd.Flags().String(TraceFlag, "", "the scheduling trace file")
+	rootCmd.Flags().Int64(CycleFlag, 0, "the scheduling cycle to replay, all the recorded cycles are replayed if not set")
+	rootCmd.PersistentFlags().CountP(VerbosityFlag, VerboseFlagShort, "verbosity (specify multiple times to increase the log level)")
+	_ = rootCmd.MarkFlagRequired(TraceFlag)
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func replayCmd(cmd *cobra.Command, _ []string) error {
+	traceFile, err := cmd.Flags().GetString(TraceFlag)
+	if err != nil {
+		return err
+	}
+	cycleNumber, err := cmd.Flags().GetInt64(CycleFlag)
+	if err != nil {
+		return err
+	}
+	cycles, err := trace.ReadFile(traceFile)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	replayed, diverged := 0, 0
+	for i := range cycles {
+		c := &cycles[i]
+		if cycleNumber != 0 && c.SchedulingCycle != cycleNumber {
+			continue
+		}
+		replayed++
+		if err := setFeatureGates(c.FeatureGates); err != nil {
+			return fmt.Errorf("cycle %d: %w", c.SchedulingCycle, err)
+		}
+		cl, err := newClient(cmd.Context())
+		if err != nil {
+			return err
+		}
+		outcomes, err := replay(cmd.Context(), cl, c)
+		if err != nil {
+			return fmt.Errorf("cycle %d: %w", c.SchedulingCycle, err)
+		}
+		if diff := trace.DiffOutcomes(c.Outcomes, outcomes); diff != "" {
+			diverged++
+			fmt.Fprintf(out, "Cycle %d diverged (-recorded,+replayed):\n%s\n", c.SchedulingCycle, diff)
+		} else {
+			fmt.Fprintf(out, "Cycle %d matched\n", c.SchedulingCycle)
+		}
+	}
+	if replayed == 0 {
+		return fmt.Errorf("no scheduling cycle to replay in %s", traceFile)
+	}
+	fmt.Fprintf(out, "Replayed %d cycle(s), %d diverged\n", replayed, diverged)
+	if diverged > 0 {
+		return fmt.Errorf("%d scheduling cycle(s) diverged", diverged)
+	}
+	return nil
+}
+
+// setFeatureGates applies the recorded feature gates. Gates unknown to this
+// build are ignored, and only the gates with a different enablement are set, so
+// that the locked gates don't cause an error.
+func setFeatureGates(recorded map[string]bool) error {
+	current := features.Enablement()
+	changes := make(map[string]bool)
+	for name, enabled := range recorded {
+		if cur, ok := current[featuregate.Feature(name)]; ok && cur != enabled {
+			changes[name] = enabled
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return utilfeature.DefaultMutableFeatureGate.SetFromMap(changes)
+}
+
+// fakeIndexer registers the indexes of the controllers on the fake client.
+type fakeIndexer struct {
+	*fake.ClientBuilder
+}
+
+func (b *fakeIndexer) IndexField(_ context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	b.ClientBuilder = b.WithIndex(obj, field, extractValue)
+	return nil
+}
+
+func newClient(ctx context.Context) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(kueue.AddToScheme(scheme))
+	b := &fakeIndexer{
+		ClientBuilder: fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&kueue.Workload{}),
+	}
+	if err := indexer.Setup(ctx, b); err != nil {
+		return nil, err
+	}
+	return b.Build(), nil
+}
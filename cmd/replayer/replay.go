@@ -0,0 +1,236 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/tools/events"
+	testingclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/framework"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
+	"sigs.k8s.io/kueue/pkg/scheduler/trace"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const replayHeadsTimeout = 10 * time.Second
+
+var (
+	errReplayNoHeads       = errors.New("no heads obtained from the queues")
+	errQuotaWindowMismatch = errors.New("the quota window applied at the recorded time differs from the recorded one")
+)
+
+// traceCapture keeps the last recorded cycle in memory.
+type traceCapture struct {
+	cycle *trace.Cycle
+}
+
+func (c *traceCapture) Record(cycle *trace.Cycle) error {
+	c.cycle = cycle
+	return nil
+}
+
+// replay runs the recorded scheduling cycle and returns its outcomes.
+//
+// The recorded state is created through the client, which is expected to be
+// empty and to serve the indexes used by the caches, typically a fake client.
+// The caches and the queues are rebuilt from it, and a single scheduling cycle
+// is executed with the recorded configuration and at the recorded time. The
+// feature gates are not changed, the caller is responsible for setting them.
+//
+// The quota windows are applied from the quota schedules at the recorded time.
+// A cycle recorded while the cache applied a different window, typically
+// right after a window boundary, is refused.
+//
+// The Topology Aware Scheduling state (nodes and pods) and the usage of the
+// Admission Fair Sharing are not part of the trace, so the cycles depending on
+// them are not reproduced faithfully.
+func replay(ctx context.Context, cl client.Client, c *trace.Cycle) ([]trace.Outcome, error) {
+	if err := createTraceState(ctx, cl, c); err != nil {
+		return nil, err
+	}
+	log := ctrl.LoggerFrom(ctx)
+	fakeClock := testingclock.NewFakeClock(c.Time.Time)
+	preemptionExpectations := preemptexpectations.New()
+	requeuingTimestamp := cmp.Or(c.Config.PodsReadyRequeuingTimestamp, config.EvictionTimestamp)
+
+	cacheOpts := []schdcache.Option{
+		schdcache.WithClock(fakeClock),
+		schdcache.WithPodsReadyTracking(c.Config.BlockForPodsReady),
+		schdcache.WithFairSharing(fairsharing.Enabled(c.Config.FairSharing)),
+		schdcache.WithExcludedResourcePrefixes(c.Config.ExcludeResourcePrefixes),
+		schdcache.WithResourceTransformations(c.Config.ResourceTransformations),
+	}
+	queueOpts := []qcache.Option{
+		qcache.WithClock(fakeClock),
+		qcache.WithPodsReadyRequeuingTimestamp(requeuingTimestamp),
+		qcache.WithPreemptionExpectations(preemptionExpectations),
+		qcache.WithExcludedResourcePrefixes(c.Config.ExcludeResourcePrefixes),
+		qcache.WithResourceTransformations(c.Config.ResourceTransformations),
+	}
+	if c.Config.AdmissionFairSharing != nil {
+		cacheOpts = append(cacheOpts, schdcache.WithAdmissionFairSharing(c.Config.AdmissionFairSharing))
+		queueOpts = append(queueOpts, qcache.WithAdmissionFairSharing(c.Config.AdmissionFairSharing))
+	}
+	var schedulingFramework *framework.Framework
+	if c.Config.SchedulingProfile != nil && features.Enabled(features.SchedulingFramework) {
+		var err error
+		schedulingFramework, err = framework.New(c.Config.SchedulingProfile, framework.Handle{
+			WorkloadOrdering: workload.Ordering{PodsReadyRequeuingTimestamp: requeuingTimestamp},
+			Clock:            fakeClock,
+			Log:              log.WithName("scheduling-framework"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("building the scheduling framework: %w", err)
+		}
+		queueOpts = append(queueOpts, qcache.WithQueueSort(schedulingFramework.QueueSortFunc()))
+	}
+	cache := schdcache.New(cl, cacheOpts...)
+	queues := qcache.NewManager(cl, cache, qcache.NewRequeuer(), queueOpts...)
+
+	for i := range c.State.ResourceFlavors {
+		cache.AddOrUpdateResourceFlavor(log, &c.State.ResourceFlavors[i])
+	}
+	for i := range c.State.Topologies {
+		cache.AddOrUpdateTopology(log, &c.State.Topologies[i])
+	}
+	for i := range c.State.AdmissionChecks {
+		cache.AddOrUpdateAdmissionCheck(log, &c.State.AdmissionChecks[i])
+	}
+	for i := range c.State.Reservations {
+		cache.AddOrUpdateReservation(&c.State.Reservations[i])
+	}
+	for i := range c.State.Cohorts {
+		if err := cache.AddOrUpdateCohort(&c.State.Cohorts[i]); err != nil {
+			return nil, fmt.Errorf("adding cohort %q: %w", c.State.Cohorts[i].Name, err)
+		}
+		queues.AddOrUpdateCohort(ctx, &c.State.Cohorts[i])
+	}
+	for i := range c.State.ClusterQueues {
+		cq := &c.State.ClusterQueues[i]
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			return nil, fmt.Errorf("adding cluster queue %q to the cache: %w", cq.Name, err)
+		}
+		if err := queues.AddClusterQueue(ctx, cq); err != nil {
+			return nil, fmt.Errorf("adding cluster queue %q to the queues: %w", cq.Name, err)
+		}
+	}
+	if err := checkQuotaWindows(cache, c); err != nil {
+		return nil, err
+	}
+	for i := range c.State.LocalQueues {
+		lq := &c.State.LocalQueues[i]
+		if err := queues.AddLocalQueue(ctx, lq); err != nil {
+			return nil, fmt.Errorf("adding local queue %q: %w", client.ObjectKeyFromObject(lq), err)
+		}
+	}
+
+	capture := &traceCapture{}
+	s := scheduler.New(queues, cache, cl, &events.FakeRecorder{},
+		scheduler.WithFairSharing(c.Config.FairSharing),
+		scheduler.WithAdmissionFairSharing(c.Config.AdmissionFairSharing),
+		scheduler.WithQuotaCheckStrategy(c.Config.QuotaCheckStrategy),
+		scheduler.WithPodsReadyRequeuingTimestamp(requeuingTimestamp),
+		scheduler.WithPreemptionExpectations(preemptionExpectations),
+		scheduler.WithTraceRecorder(capture, c.Config),
+		scheduler.WithFramework(schedulingFramework),
+		scheduler.WithReplay(fakeClock, c.SchedulingCycle),
+	)
+
+	// Heads blocks while the queues are empty, bound the wait in case none of
+	// the recorded heads made it to the queues.
+	headsCtx, cancel := context.WithTimeout(ctx, replayHeadsTimeout)
+	defer cancel()
+	go queues.CleanUpOnContext(headsCtx)
+	s.RunCycle(headsCtx)
+
+	if capture.cycle == nil {
+		return nil, errReplayNoHeads
+	}
+	return capture.cycle.Outcomes, nil
+}
+
+func createTraceState(ctx context.Context, cl client.Client, c *trace.Cycle) error {
+	var objs []client.Object
+	for i := range c.State.Namespaces {
+		objs = append(objs, &c.State.Namespaces[i])
+	}
+	for i := range c.State.ResourceFlavors {
+		objs = append(objs, &c.State.ResourceFlavors[i])
+	}
+	for i := range c.State.Topologies {
+		objs = append(objs, &c.State.Topologies[i])
+	}
+	for i := range c.State.AdmissionChecks {
+		objs = append(objs, &c.State.AdmissionChecks[i])
+	}
+	for i := range c.State.Reservations {
+		objs = append(objs, &c.State.Reservations[i])
+	}
+	for i := range c.State.Cohorts {
+		objs = append(objs, &c.State.Cohorts[i])
+	}
+	for i := range c.State.ClusterQueues {
+		objs = append(objs, &c.State.ClusterQueues[i])
+	}
+	for i := range c.State.LocalQueues {
+		objs = append(objs, &c.State.LocalQueues[i])
+	}
+	for i := range c.State.Workloads {
+		objs = append(objs, &c.State.Workloads[i])
+	}
+	for i := range c.Heads {
+		objs = append(objs, &c.Heads[i].Workload)
+	}
+	for _, obj := range objs {
+		obj := obj.DeepCopyObject().(client.Object)
+		obj.SetResourceVersion("")
+		if err := cl.Create(ctx, obj); client.IgnoreAlreadyExists(err) != nil {
+			return fmt.Errorf("creating %T %q: %w", obj, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+	return nil
+}
+
+// checkQuotaWindows verifies that the cache applies the recorded quota windows.
+func checkQuotaWindows(cache *schdcache.Cache, c *trace.Cycle) error {
+	recorded := make(map[kueue.ClusterQueueReference]string, len(c.QuotaWindows))
+	for _, w := range c.QuotaWindows {
+		recorded[w.ClusterQueue] = w.Active
+	}
+	for i := range c.State.ClusterQueues {
+		name := kueue.ClusterQueueReference(c.State.ClusterQueues[i].Name)
+		if active := cache.QuotaWindow(name).Active; active != recorded[name] {
+			return fmt.Errorf("%w: cluster queue %q applies %q, recorded %q", errQuotaWindowMismatch, name, active, recorded[name])
+		}
+	}
+	return nil
+}
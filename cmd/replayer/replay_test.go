@@ -0,0 +1,213 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/scheduler/trace"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestReplay(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+	makeCQ := func(name, cpu string) kueue.ClusterQueue {
+		return *utiltestingapi.MakeClusterQueue(name).
+			ResourceGroup(
+				*utiltestingapi.MakeFlavorQuotas(rf.Name).
+					Resource(corev1.ResourceCPU, cpu).
+					Obj(),
+			).
+			Preemption(kueue.ClusterQueuePreemption{
+				WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+			}).
+			Obj()
+	}
+	makeLQ := func(name, cq string) kueue.LocalQueue {
+		return *utiltestingapi.MakeLocalQueue(name, metav1.NamespaceDefault).ClusterQueue(cq).Obj()
+	}
+	makeHead := func(cq, name, lq, cpu string) trace.Head {
+		return trace.Head{
+			ClusterQueue: kueue.ClusterQueueReference(cq),
+			Workload: *utiltestingapi.MakeWorkload(name, metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq)).
+				Request(corev1.ResourceCPU, cpu).
+				Obj(),
+		}
+	}
+	cycle := &trace.Cycle{
+		SchedulingCycle: 7,
+		Time:            metav1.NewTime(now),
+		State: trace.State{
+			Namespaces:      []corev1.Namespace{*utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()},
+			ResourceFlavors: []kueue.ResourceFlavor{*rf},
+			ClusterQueues:   []kueue.ClusterQueue{makeCQ("cq-a", "4"), makeCQ("cq-b", "2"), makeCQ("cq-c", "2")},
+			LocalQueues:     []kueue.LocalQueue{makeLQ("lq-a", "cq-a"), makeLQ("lq-b", "cq-b"), makeLQ("lq-c", "cq-c")},
+			Workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("low", metav1.NamespaceDefault).
+					Queue("lq-a").
+					Priority(-1).
+					Request(corev1.ResourceCPU, "3").
+					SimpleReserveQuota("cq-a", rf.Name, now).
+					Obj(),
+			},
+		},
+		Heads: []trace.Head{
+			makeHead("cq-a", "high", "lq-a", "2"),
+			makeHead("cq-b", "big", "lq-b", "5"),
+			makeHead("cq-c", "small", "lq-c", "1"),
+		},
+	}
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl, err := newClient(ctx)
+	if err != nil {
+		t.Fatalf("Creating the client: %v", err)
+	}
+	outcomes, err := replay(ctx, cl, cycle)
+	if err != nil {
+		t.Fatalf("Replaying the cycle: %v", err)
+	}
+	gotModes := make(map[string]string)
+	for _, o := range outcomes {
+		gotModes[o.Name] = o.Mode
+	}
+	wantModes := map[string]string{"high": "Preempt", "big": "NoFit", "small": "Fit"}
+	if diff := cmp.Diff(wantModes, gotModes); diff != "" {
+		t.Errorf("Unexpected replayed modes (-want,+got):\n%s", diff)
+	}
+}
+
+func TestReplayReservation(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.CapacityReservations, true)
+	now := time.Now().Truncate(time.Second)
+
+	rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+	cycle := &trace.Cycle{
+		SchedulingCycle: 3,
+		Time:            metav1.NewTime(now),
+		State: trace.State{
+			Namespaces:      []corev1.Namespace{*utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()},
+			ResourceFlavors: []kueue.ResourceFlavor{*rf},
+			ClusterQueues: []kueue.ClusterQueue{
+				*utiltestingapi.MakeClusterQueue("cq").
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas(rf.Name).Resource(corev1.ResourceCPU, "4").Obj()).
+					Reservations("maintenance").
+					Obj(),
+			},
+			LocalQueues: []kueue.LocalQueue{*utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue("cq").Obj()},
+			Reservations: []kueue.Reservation{
+				*utiltestingapi.MakeReservation("maintenance", now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource(kueue.ResourceFlavorReference(rf.Name), corev1.ResourceCPU, "3").
+					Obj(),
+			},
+		},
+		Heads: []trace.Head{{
+			ClusterQueue: "cq",
+			Workload: *utiltestingapi.MakeWorkload("wl", metav1.NamespaceDefault).
+				Queue("lq").
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+		}},
+	}
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl, err := newClient(ctx)
+	if err != nil {
+		t.Fatalf("Creating the client: %v", err)
+	}
+	outcomes, err := replay(ctx, cl, cycle)
+	if err != nil {
+		t.Fatalf("Replaying the cycle: %v", err)
+	}
+	// Only the quota booked by the reservation could make room for the
+	// workload, and it holds no workload to preempt.
+	if len(outcomes) != 1 || outcomes[0].Mode != "Preempt" || len(outcomes[0].Preempts) != 0 {
+		t.Errorf("Unexpected replayed outcomes, want the workload not to fit in the quota left by the reservation: %+v", outcomes)
+	}
+}
+
+func TestReplayQuotaWindowMismatch(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.TimeWindowedQuotas, true)
+	noon := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+
+	rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+	cycle := &trace.Cycle{
+		SchedulingCycle: 3,
+		Time:            metav1.NewTime(noon),
+		QuotaWindows:    []trace.QuotaWindow{{ClusterQueue: "cq", Active: "night"}},
+		State: trace.State{
+			ResourceFlavors: []kueue.ResourceFlavor{*rf},
+			ClusterQueues: []kueue.ClusterQueue{
+				*utiltestingapi.MakeClusterQueue("cq").
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas(rf.Name).Resource(corev1.ResourceCPU, "4").Obj()).
+					QuotaSchedule(kueue.QuotaSchedule{
+						Windows: []kueue.QuotaWindow{{
+							Name:            "night",
+							Schedule:        "0 20 * * *",
+							DurationMinutes: 12 * 60,
+							Flavors:         []kueue.FlavorQuotas{*utiltestingapi.MakeFlavorQuotas(rf.Name).Resource(corev1.ResourceCPU, "2").Obj()},
+						}},
+					}).
+					Obj(),
+			},
+		},
+	}
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl, err := newClient(ctx)
+	if err != nil {
+		t.Fatalf("Creating the client: %v", err)
+	}
+	if _, err := replay(ctx, cl, cycle); !errors.Is(err, errQuotaWindowMismatch) {
+		t.Errorf("Unexpected error replaying the cycle, want %v, got %v", errQuotaWindowMismatch, err)
+	}
+}
+
+func TestReplaySchedulingProfile(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.SchedulingFramework, true)
+	cycle := &trace.Cycle{
+		SchedulingCycle: 3,
+		Time:            metav1.Now(),
+		Config: trace.Config{
+			SchedulingProfile: &config.SchedulingProfile{
+				Plugins: config.SchedulingPlugins{PreFilter: []string{"Unknown"}},
+			},
+		},
+	}
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl, err := newClient(ctx)
+	if err != nil {
+		t.Fatalf("Creating the client: %v", err)
+	}
+	if _, err := replay(ctx, cl, cycle); err == nil {
+		t.Error("Expected an error building the recorded scheduling profile")
+	}
+}
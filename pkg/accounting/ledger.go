@@ -0,0 +1,580 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package accounting integrates, over time, the quota reserved and used by the
+// Workloads, for chargeback.
+package accounting
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"gopkg.in/inf.v0"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
+	workloadfinish "sigs.k8s.io/kueue/pkg/workload/finish"
+)
+
+const (
+	// outputScale is the number of decimal digits of the reported amounts.
+	outputScale = 3
+	// maxStatusResources is the maximum number of resources in the status of
+	// a LocalQueue, overall and per period.
+	maxStatusResources = 256
+	// maxPeriods is the number of periods kept, including the current one.
+	maxPeriods = 12
+)
+
+// Key identifies the quota a usage is accounted for.
+type Key struct {
+	ClusterQueue kueue.ClusterQueueReference
+	Flavor       kueue.ResourceFlavorReference
+	Resource     corev1.ResourceName
+}
+
+// Usage is the quota reserved and used for a Key, in resource-seconds.
+type Usage struct {
+	Key
+	Reserved resource.Quantity
+	Used     resource.Quantity
+}
+
+// PeriodUsage is the usage accounted during a period, a calendar month in
+// UTC starting at Start.
+type PeriodUsage struct {
+	Start     time.Time
+	Resources []Usage
+}
+
+// WorkloadUsage is the usage accounted for a Workload.
+type WorkloadUsage struct {
+	LocalQueue utilqueue.LocalQueueReference
+	Resources  []Usage
+	Periods    []PeriodUsage
+}
+
+// Report is the accounted usage at a point in time.
+type Report struct {
+	Time              time.Time
+	LocalQueues       map[utilqueue.LocalQueueReference][]Usage
+	LocalQueuePeriods map[utilqueue.LocalQueueReference][]PeriodUsage
+	Workloads         map[workload.Reference]WorkloadUsage
+}
+
+// Filter selects the usage included in a Report. Empty fields match all.
+type Filter struct {
+	Namespace    string
+	LocalQueue   utilqueue.LocalQueueReference
+	ClusterQueue kueue.ClusterQueueReference
+}
+
+func (f Filter) matchesLocalQueue(lqKey utilqueue.LocalQueueReference) bool {
+	if f.LocalQueue != "" && f.LocalQueue != lqKey {
+		return false
+	}
+	if f.Namespace != "" {
+		namespace, _, err := utilqueue.ParseLocalQueueReference(lqKey)
+		return err == nil && namespace == f.Namespace
+	}
+	return true
+}
+
+func (f Filter) matchesKey(key Key) bool {
+	return f.ClusterQueue == "" || f.ClusterQueue == key.ClusterQueue
+}
+
+type amounts struct {
+	reserved inf.Dec
+	used     inf.Dec
+}
+
+// totals are the accounted resource-seconds, at millisecond precision, with
+// CPU in millicores.
+type totals map[Key]*amounts
+
+func (t totals) get(key Key) *amounts {
+	a, found := t[key]
+	if !found {
+		a = &amounts{}
+		t[key] = a
+	}
+	return a
+}
+
+// accrue adds the quota held during elapsed to the reserved or used totals.
+func (t totals) accrue(quota map[Key]int64, elapsed time.Duration, used bool) {
+	if elapsed <= 0 {
+		return
+	}
+	for key, value := range quota {
+		a := t.get(key)
+		amount := inf.NewDec(value, 0)
+		amount.Mul(amount, inf.NewDec(elapsed.Milliseconds(), 3))
+		if used {
+			a.used.Add(&a.used, amount)
+		} else {
+			a.reserved.Add(&a.reserved, amount)
+		}
+	}
+}
+
+func (t totals) clone() totals {
+	c := make(totals, len(t))
+	for key, a := range t {
+		n := &amounts{}
+		n.reserved.Set(&a.reserved)
+		n.used.Set(&a.used)
+		c[key] = n
+	}
+	return c
+}
+
+func (t totals) usage(filter Filter) []Usage {
+	var result []Usage
+	for key, a := range t {
+		if !filter.matchesKey(key) {
+			continue
+		}
+		result = append(result, Usage{
+			Key:      key,
+			Reserved: toQuantity(key.Resource, &a.reserved),
+			Used:     toQuantity(key.Resource, &a.used),
+		})
+	}
+	slices.SortFunc(result, func(a, b Usage) int {
+		return cmp.Or(
+			cmp.Compare(a.ClusterQueue, b.ClusterQueue),
+			cmp.Compare(a.Flavor, b.Flavor),
+			cmp.Compare(a.Resource, b.Resource),
+		)
+	})
+	return result
+}
+
+// PeriodStart returns the start of the period including t, the first day of
+// its calendar month in UTC.
+func PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// usageTotals are the totals accounted overall, and per period for the last
+// maxPeriods periods.
+type usageTotals struct {
+	all     totals
+	periods map[time.Time]totals
+}
+
+func newUsageTotals() *usageTotals {
+	return &usageTotals{
+		all:     make(totals),
+		periods: make(map[time.Time]totals),
+	}
+}
+
+func (u *usageTotals) period(start time.Time) totals {
+	t, found := u.periods[start]
+	if !found {
+		t = make(totals)
+		u.periods[start] = t
+	}
+	return t
+}
+
+// accrue adds the quota held between from and to to the reserved or used
+// totals, split at the boundaries of the periods.
+func (u *usageTotals) accrue(quota map[Key]int64, from, to time.Time, used bool) {
+	if !to.After(from) {
+		return
+	}
+	u.all.accrue(quota, to.Sub(from), used)
+	for start := PeriodStart(from); start.Before(to); start = start.AddDate(0, 1, 0) {
+		end := start.AddDate(0, 1, 0)
+		u.period(start).accrue(quota, earlier(end, to).Sub(later(start, from)), used)
+	}
+	u.prune(to)
+}
+
+// prune drops the periods older than the last maxPeriods periods at now.
+func (u *usageTotals) prune(now time.Time) {
+	oldest := PeriodStart(now).AddDate(0, 1-maxPeriods, 0)
+	for start := range u.periods {
+		if start.Before(oldest) {
+			delete(u.periods, start)
+		}
+	}
+}
+
+func (u *usageTotals) clone() *usageTotals {
+	c := &usageTotals{
+		all:     u.all.clone(),
+		periods: make(map[time.Time]totals, len(u.periods)),
+	}
+	for start, t := range u.periods {
+		c.periods[start] = t.clone()
+	}
+	return c
+}
+
+// periodUsage returns the usage matching the filter per period, sorted by
+// start.
+func (u *usageTotals) periodUsage(filter Filter) []PeriodUsage {
+	var result []PeriodUsage
+	for start, t := range u.periods {
+		if usage := t.usage(filter); len(usage) > 0 {
+			result = append(result, PeriodUsage{Start: start, Resources: usage})
+		}
+	}
+	slices.SortFunc(result, func(a, b PeriodUsage) int {
+		return a.Start.Compare(b.Start)
+	})
+	return result
+}
+
+// toQuantity converts an accounted amount to resource-seconds.
+func toQuantity(name corev1.ResourceName, amount *inf.Dec) resource.Quantity {
+	value := new(inf.Dec).Set(amount)
+	if name == corev1.ResourceCPU {
+		value.SetScale(value.Scale() + 3)
+	}
+	value.Round(value, outputScale, inf.RoundHalfUp)
+	return *resource.NewDecimalQuantity(*value, resource.DecimalSI)
+}
+
+// fromQuantity converts resource-seconds to an accounted amount.
+func fromQuantity(name corev1.ResourceName, q resource.Quantity) inf.Dec {
+	var value inf.Dec
+	value.Set(q.AsDec())
+	if name == corev1.ResourceCPU {
+		value.SetScale(value.Scale() - 3)
+	}
+	return value
+}
+
+type workloadEntry struct {
+	localQueue utilqueue.LocalQueueReference
+	// quota is the quota assigned to the Workload, with CPU in millicores.
+	quota map[Key]int64
+	// reservedSince and usedSince are the start of the open intervals, zero
+	// when the quota is not reserved or not used.
+	reservedSince time.Time
+	usedSince     time.Time
+	totals        *usageTotals
+}
+
+type localQueueEntry struct {
+	totals *usageTotals
+	// baseline is the time up to which the persisted totals account the
+	// usage. The intervals are only accrued from it, so that the usage of the
+	// Workloads running across a restart is not accounted twice.
+	baseline time.Time
+	// loaded records whether the persisted totals are folded in the entry.
+	loaded bool
+}
+
+// Ledger accounts the quota reserved and used by the Workloads, per
+// LocalQueue, ClusterQueue, ResourceFlavor and resource, without decay.
+//
+// It is fed with the Workload updates, and seeds the totals of a LocalQueue
+// from its status the first time the LocalQueue is accounted.
+type Ledger struct {
+	client client.Reader
+	clock  clock.Clock
+
+	sync.Mutex
+	workloads   map[workload.Reference]*workloadEntry
+	localQueues map[utilqueue.LocalQueueReference]*localQueueEntry
+}
+
+// Option configures the Ledger.
+type Option func(*Ledger)
+
+// WithClock sets the clock of the Ledger.
+func WithClock(c clock.Clock) Option {
+	return func(l *Ledger) {
+		l.clock = c
+	}
+}
+
+// NewLedger creates an empty Ledger, reading the persisted totals with the
+// client.
+func NewLedger(c client.Reader, opts ...Option) *Ledger {
+	l := &Ledger{
+		client:      c,
+		clock:       clock.RealClock{},
+		workloads:   make(map[workload.Reference]*workloadEntry),
+		localQueues: make(map[utilqueue.LocalQueueReference]*localQueueEntry),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// NotifyWorkloadUpdate settles the usage of the Workload up to now and starts
+// accounting its new state.
+func (l *Ledger) NotifyWorkloadUpdate(oldWl, newWl *kueue.Workload) {
+	l.Lock()
+	defer l.Unlock()
+	now := l.clock.Now()
+
+	if newWl == nil {
+		if oldWl == nil {
+			return
+		}
+		wlKey := workload.Key(oldWl)
+		if entry, found := l.workloads[wlKey]; found {
+			l.settle(entry, now)
+			delete(l.workloads, wlKey)
+		}
+		return
+	}
+
+	wlKey := workload.Key(newWl)
+	reserved := workload.HasQuotaReservation(newWl) && !workloadfinish.IsFinished(newWl)
+	used := reserved && workload.IsAdmitted(newWl)
+	entry, found := l.workloads[wlKey]
+	if !found {
+		if !reserved {
+			return
+		}
+		entry = &workloadEntry{
+			totals:        newUsageTotals(),
+			reservedSince: conditionTime(newWl, kueue.WorkloadQuotaReserved, now),
+		}
+		if used {
+			entry.usedSince = conditionTime(newWl, kueue.WorkloadAdmitted, now)
+		}
+	} else {
+		l.settle(entry, now)
+		entry.reservedSince = time.Time{}
+		if reserved {
+			entry.reservedSince = now
+		}
+		entry.usedSince = time.Time{}
+		if used {
+			entry.usedSince = now
+		}
+	}
+	entry.localQueue = utilqueue.KeyFromWorkload(newWl)
+	entry.quota = nil
+	if reserved {
+		entry.quota = quota(newWl)
+		l.localQueue(entry.localQueue, nil)
+	}
+	l.workloads[wlKey] = entry
+}
+
+// conditionTime returns when the condition became true, not after now.
+func conditionTime(wl *kueue.Workload, conditionType string, now time.Time) time.Time {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, conditionType)
+	if cond == nil || cond.LastTransitionTime.After(now) {
+		return now
+	}
+	return cond.LastTransitionTime.Time
+}
+
+func quota(wl *kueue.Workload) map[Key]int64 {
+	cq := wl.Status.Admission.ClusterQueue
+	assigned := workload.NewInfo(wl).ResourceUsage().Assigned
+	result := make(map[Key]int64, len(assigned))
+	for fr, amount := range assigned {
+		result[Key{ClusterQueue: cq, Flavor: fr.Flavor, Resource: fr.Resource}] = amount.Int64()
+	}
+	return result
+}
+
+// settle accrues the open intervals of the Workload up to now.
+func (l *Ledger) settle(entry *workloadEntry, now time.Time) {
+	lq := l.localQueue(entry.localQueue, nil)
+	for _, t := range []*usageTotals{entry.totals, lq.totals} {
+		accrueOpenIntervals(t, entry, lq.baseline, now)
+	}
+}
+
+func accrueOpenIntervals(t *usageTotals, entry *workloadEntry, baseline, now time.Time) {
+	if !entry.reservedSince.IsZero() {
+		t.accrue(entry.quota, later(entry.reservedSince, baseline), now, false)
+	}
+	if !entry.usedSince.IsZero() {
+		t.accrue(entry.quota, later(entry.usedSince, baseline), now, true)
+	}
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// localQueue returns the entry of the LocalQueue, seeding it from the status
+// of lq, or of the LocalQueue read with the client when lq is nil.
+func (l *Ledger) localQueue(lqKey utilqueue.LocalQueueReference, lq *kueue.LocalQueue) *localQueueEntry {
+	entry, found := l.localQueues[lqKey]
+	if !found {
+		entry = &localQueueEntry{totals: newUsageTotals()}
+		l.localQueues[lqKey] = entry
+	}
+	if entry.loaded {
+		return entry
+	}
+	if lq == nil {
+		namespace, name := utilqueue.MustParseLocalQueueReference(lqKey)
+		lq = &kueue.LocalQueue{}
+		if err := l.client.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: string(name)}, lq); err != nil {
+			// Not loaded yet, it is retried the next time the entry is needed.
+			return entry
+		}
+	}
+	entry.loaded = true
+	if accounting := lq.Status.UsageAccounting; accounting != nil {
+		entry.baseline = accounting.LastUpdateTime.Time
+		seedTotals(entry.totals.all, accounting.Resources)
+		for _, p := range accounting.Periods {
+			seedTotals(entry.totals.period(PeriodStart(p.Start.Time)), p.Resources)
+		}
+		entry.totals.prune(l.clock.Now())
+	}
+	return entry
+}
+
+// seedTotals adds the persisted usage to the totals.
+func seedTotals(t totals, resources []kueue.ResourceUsageAccounting) {
+	for _, r := range resources {
+		a := t.get(Key{ClusterQueue: r.ClusterQueue, Flavor: r.Flavor, Resource: r.Name})
+		reserved := fromQuantity(r.Name, r.Reserved)
+		used := fromQuantity(r.Name, r.Used)
+		a.reserved.Add(&a.reserved, &reserved)
+		a.used.Add(&a.used, &used)
+	}
+}
+
+// Report returns the usage matching the filter, including the open intervals
+// up to now.
+func (l *Ledger) Report(filter Filter) Report {
+	l.Lock()
+	defer l.Unlock()
+	now := l.clock.Now()
+	report := Report{
+		Time:              now,
+		LocalQueues:       make(map[utilqueue.LocalQueueReference][]Usage),
+		LocalQueuePeriods: make(map[utilqueue.LocalQueueReference][]PeriodUsage),
+		Workloads:         make(map[workload.Reference]WorkloadUsage),
+	}
+	lqTotals := make(map[utilqueue.LocalQueueReference]*usageTotals)
+	for lqKey, lq := range l.localQueues {
+		if filter.matchesLocalQueue(lqKey) {
+			lqTotals[lqKey] = lq.totals.clone()
+		}
+	}
+	for wlKey, entry := range l.workloads {
+		t, found := lqTotals[entry.localQueue]
+		if !found {
+			continue
+		}
+		wlTotals := entry.totals.clone()
+		baseline := l.localQueues[entry.localQueue].baseline
+		accrueOpenIntervals(wlTotals, entry, baseline, now)
+		accrueOpenIntervals(t, entry, baseline, now)
+		wlTotals.prune(now)
+		if usage := wlTotals.all.usage(filter); len(usage) > 0 {
+			report.Workloads[wlKey] = WorkloadUsage{
+				LocalQueue: entry.localQueue,
+				Resources:  usage,
+				Periods:    wlTotals.periodUsage(filter),
+			}
+		}
+	}
+	for lqKey, t := range lqTotals {
+		t.prune(now)
+		if usage := t.all.usage(filter); len(usage) > 0 || filter.LocalQueue == lqKey {
+			report.LocalQueues[lqKey] = usage
+			report.LocalQueuePeriods[lqKey] = t.periodUsage(filter)
+		}
+	}
+	return report
+}
+
+// LocalQueueStatus returns the usage accounted for the LocalQueue, to be
+// persisted in its status.
+func (l *Ledger) LocalQueueStatus(lq *kueue.LocalQueue) *kueue.LocalQueueUsageAccounting {
+	l.Lock()
+	defer l.Unlock()
+	now := l.clock.Now()
+	lqKey := utilqueue.Key(lq)
+	entry := l.localQueue(lqKey, lq)
+	t := entry.totals.clone()
+	for _, wl := range l.workloads {
+		if wl.localQueue == lqKey {
+			accrueOpenIntervals(t, wl, entry.baseline, now)
+		}
+	}
+	t.prune(now)
+	status := &kueue.LocalQueueUsageAccounting{
+		LastUpdateTime: metav1.NewTime(now),
+		Resources:      statusResources(t.all.usage(Filter{})),
+	}
+	for _, p := range t.periodUsage(Filter{}) {
+		status.Periods = append(status.Periods, kueue.UsageAccountingPeriod{
+			Start:     metav1.NewTime(p.Start),
+			Resources: statusResources(p.Resources),
+		})
+	}
+	return status
+}
+
+func statusResources(usage []Usage) []kueue.ResourceUsageAccounting {
+	if len(usage) > maxStatusResources {
+		usage = usage[:maxStatusResources]
+	}
+	var resources []kueue.ResourceUsageAccounting
+	for _, u := range usage {
+		resources = append(resources, kueue.ResourceUsageAccounting{
+			ClusterQueue: u.ClusterQueue,
+			Flavor:       u.Flavor,
+			Name:         u.Resource,
+			Reserved:     u.Reserved,
+			Used:         u.Used,
+		})
+	}
+	return resources
+}
+
+// DeleteLocalQueue drops the totals of the LocalQueue.
+func (l *Ledger) DeleteLocalQueue(lqKey utilqueue.LocalQueueReference) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.localQueues, lqKey)
+}
@@ -0,0 +1,367 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accounting
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestLedger(t *testing.T) {
+	start := time.Now().Truncate(time.Second)
+	lqKey := utilqueue.NewLocalQueueReference("ns", "lq")
+	cpuKey := Key{ClusterQueue: "cq", Flavor: "on-demand", Resource: corev1.ResourceCPU}
+	memKey := Key{ClusterQueue: "cq", Flavor: "on-demand", Resource: corev1.ResourceMemory}
+	admission := utiltestingapi.MakeAdmission("cq").
+		PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "on-demand", "1500m").
+			Assignment(corev1.ResourceMemory, "on-demand", "1Gi").
+			Obj()).
+		Obj()
+	base := utiltestingapi.MakeWorkload("wl", "ns").
+		Queue("lq").
+		Request(corev1.ResourceCPU, "1500m").
+		Request(corev1.ResourceMemory, "1Gi")
+	pending := base.Clone().Obj()
+	reserved := base.Clone().ReserveQuotaAt(admission, start).Obj()
+	admitted := base.Clone().ReserveQuotaAt(admission, start).AdmittedAt(true, start.Add(10*time.Second)).Obj()
+	finished := base.Clone().ReserveQuotaAt(admission, start).AdmittedAt(true, start.Add(10*time.Second)).FinishedAt(start.Add(70 * time.Second)).Obj()
+
+	lq := utiltestingapi.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj()
+	persisted := lq.DeepCopy()
+	persisted.Status.UsageAccounting = &kueue.LocalQueueUsageAccounting{
+		LastUpdateTime: metav1.NewTime(start.Add(30 * time.Second)),
+		Resources: []kueue.ResourceUsageAccounting{{
+			ClusterQueue: "cq",
+			Flavor:       "on-demand",
+			Name:         corev1.ResourceCPU,
+			Reserved:     resource.MustParse("100"),
+			Used:         resource.MustParse("50"),
+		}},
+	}
+
+	cases := map[string]struct {
+		localQueue     *kueue.LocalQueue
+		updates        []func(l *Ledger, clock *testingclock.FakeClock)
+		wantLocalQueue []Usage
+		wantWorkload   []Usage
+	}{
+		"pending workload is not accounted": {
+			localQueue: lq,
+			updates: []func(*Ledger, *testingclock.FakeClock){
+				func(l *Ledger, clock *testingclock.FakeClock) {
+					l.NotifyWorkloadUpdate(nil, pending)
+					clock.Step(time.Minute)
+				},
+			},
+		},
+		"reserved and admitted workload, with the open intervals": {
+			localQueue: lq,
+			updates: []func(*Ledger, *testingclock.FakeClock){
+				func(l *Ledger, clock *testingclock.FakeClock) {
+					clock.SetTime(start.Add(10 * time.Second))
+					l.NotifyWorkloadUpdate(pending, admitted)
+					clock.SetTime(start.Add(40 * time.Second))
+				},
+			},
+			wantLocalQueue: []Usage{
+				{Key: cpuKey, Reserved: resource.MustParse("60"), Used: resource.MustParse("45")},
+				{Key: memKey, Reserved: resource.MustParse("40Gi"), Used: resource.MustParse("30Gi")},
+			},
+			wantWorkload: []Usage{
+				{Key: cpuKey, Reserved: resource.MustParse("60"), Used: resource.MustParse("45")},
+				{Key: memKey, Reserved: resource.MustParse("40Gi"), Used: resource.MustParse("30Gi")},
+			},
+		},
+		"finished workload stops being accounted": {
+			localQueue: lq,
+			updates: []func(*Ledger, *testingclock.FakeClock){
+				func(l *Ledger, clock *testingclock.FakeClock) {
+					clock.SetTime(start)
+					l.NotifyWorkloadUpdate(pending, reserved)
+					clock.SetTime(start.Add(10 * time.Second))
+					l.NotifyWorkloadUpdate(reserved, admitted)
+					clock.SetTime(start.Add(70 * time.Second))
+					l.NotifyWorkloadUpdate(admitted, finished)
+					clock.Step(time.Hour)
+				},
+			},
+			wantLocalQueue: []Usage{
+				{Key: cpuKey, Reserved: resource.MustParse("105"), Used: resource.MustParse("90")},
+				{Key: memKey, Reserved: resource.MustParse("70Gi"), Used: resource.MustParse("60Gi")},
+			},
+			wantWorkload: []Usage{
+				{Key: cpuKey, Reserved: resource.MustParse("105"), Used: resource.MustParse("90")},
+				{Key: memKey, Reserved: resource.MustParse("70Gi"), Used: resource.MustParse("60Gi")},
+			},
+		},
+		"deleted workload is kept in the LocalQueue totals": {
+			localQueue: lq,
+			updates: []func(*Ledger, *testingclock.FakeClock){
+				func(l *Ledger, clock *testingclock.FakeClock) {
+					clock.SetTime(start.Add(10 * time.Second))
+					l.NotifyWorkloadUpdate(nil, admitted)
+					clock.SetTime(start.Add(20 * time.Second))
+					l.NotifyWorkloadUpdate(admitted, nil)
+					clock.Step(time.Hour)
+				},
+			},
+			wantLocalQueue: []Usage{
+				{Key: cpuKey, Reserved: resource.MustParse("30"), Used: resource.MustParse("15")},
+				{Key: memKey, Reserved: resource.MustParse("20Gi"), Used: resource.MustParse("10Gi")},
+			},
+		},
+		"persisted totals are not accounted twice": {
+			localQueue: persisted,
+			updates: []func(*Ledger, *testingclock.FakeClock){
+				func(l *Ledger, clock *testingclock.FakeClock) {
+					clock.SetTime(start.Add(40 * time.Second))
+					l.NotifyWorkloadUpdate(nil, admitted)
+				},
+			},
+			wantLocalQueue: []Usage{
+				{Key: cpuKey, Reserved: resource.MustParse("115"), Used: resource.MustParse("65")},
+				{Key: memKey, Reserved: resource.MustParse("10Gi"), Used: resource.MustParse("10Gi")},
+			},
+			wantWorkload: []Usage{
+				{Key: cpuKey, Reserved: resource.MustParse("15"), Used: resource.MustParse("15")},
+				{Key: memKey, Reserved: resource.MustParse("10Gi"), Used: resource.MustParse("10Gi")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cl := utiltesting.NewClientBuilder().WithObjects(tc.localQueue).Build()
+			clock := testingclock.NewFakeClock(start)
+			ledger := NewLedger(cl, WithClock(clock))
+			for _, update := range tc.updates {
+				update(ledger, clock)
+			}
+
+			report := ledger.Report(Filter{})
+			if diff := cmp.Diff(tc.wantLocalQueue, report.LocalQueues[lqKey]); diff != "" {
+				t.Errorf("Unexpected LocalQueue usage (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantWorkload, report.Workloads[workload.Key(admitted)].Resources); diff != "" {
+				t.Errorf("Unexpected Workload usage (-want,+got):\n%s", diff)
+			}
+
+			status := ledger.LocalQueueStatus(tc.localQueue)
+			if !status.LastUpdateTime.Time.Equal(clock.Now()) {
+				t.Errorf("Unexpected last update time, want=%v, got=%v", clock.Now(), status.LastUpdateTime.Time)
+			}
+			var gotStatus []Usage
+			for _, r := range status.Resources {
+				gotStatus = append(gotStatus, Usage{
+					Key:      Key{ClusterQueue: r.ClusterQueue, Flavor: r.Flavor, Resource: r.Name},
+					Reserved: r.Reserved,
+					Used:     r.Used,
+				})
+			}
+			if diff := cmp.Diff(tc.wantLocalQueue, gotStatus); diff != "" {
+				t.Errorf("Unexpected LocalQueue status (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReportFilter(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cl := utiltesting.NewClientBuilder().WithObjects(
+		utiltestingapi.MakeLocalQueue("lq", "ns1").ClusterQueue("cq1").Obj(),
+		utiltestingapi.MakeLocalQueue("lq", "ns2").ClusterQueue("cq2").Obj(),
+	).Build()
+	clock := testingclock.NewFakeClock(now)
+	ledger := NewLedger(cl, WithClock(clock))
+	for _, ns := range []string{"ns1", "ns2"} {
+		cq := kueue.ClusterQueueReference("cq" + ns[2:])
+		ledger.NotifyWorkloadUpdate(nil, utiltestingapi.MakeWorkload("wl", ns).
+			Queue("lq").
+			Request(corev1.ResourceCPU, "1").
+			ReserveQuotaAt(utiltestingapi.MakeAdmission(cq).
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "1").
+					Obj()).
+				Obj(), now).
+			Obj())
+	}
+	clock.Step(time.Second)
+
+	cases := map[string]struct {
+		filter          Filter
+		wantLocalQueues []utilqueue.LocalQueueReference
+		wantWorkloads   []workload.Reference
+	}{
+		"all": {
+			wantLocalQueues: []utilqueue.LocalQueueReference{"ns1/lq", "ns2/lq"},
+			wantWorkloads:   []workload.Reference{"ns1/wl", "ns2/wl"},
+		},
+		"namespace": {
+			filter:          Filter{Namespace: "ns2"},
+			wantLocalQueues: []utilqueue.LocalQueueReference{"ns2/lq"},
+			wantWorkloads:   []workload.Reference{"ns2/wl"},
+		},
+		"LocalQueue": {
+			filter:          Filter{LocalQueue: "ns1/lq"},
+			wantLocalQueues: []utilqueue.LocalQueueReference{"ns1/lq"},
+			wantWorkloads:   []workload.Reference{"ns1/wl"},
+		},
+		"ClusterQueue": {
+			filter:          Filter{ClusterQueue: "cq2"},
+			wantLocalQueues: []utilqueue.LocalQueueReference{"ns2/lq"},
+			wantWorkloads:   []workload.Reference{"ns2/wl"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			report := ledger.Report(tc.filter)
+			var gotLocalQueues []utilqueue.LocalQueueReference
+			for lqKey := range report.LocalQueues {
+				gotLocalQueues = append(gotLocalQueues, lqKey)
+			}
+			var gotWorkloads []workload.Reference
+			for wlKey := range report.Workloads {
+				gotWorkloads = append(gotWorkloads, wlKey)
+			}
+			slices.Sort(gotLocalQueues)
+			slices.Sort(gotWorkloads)
+			if diff := cmp.Diff(tc.wantLocalQueues, gotLocalQueues); diff != "" {
+				t.Errorf("Unexpected LocalQueues (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloads); diff != "" {
+				t.Errorf("Unexpected Workloads (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLedgerPeriods(t *testing.T) {
+	september := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	october := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	lqKey := utilqueue.NewLocalQueueReference("ns", "lq")
+	cpuKey := Key{ClusterQueue: "cq", Flavor: "default", Resource: corev1.ResourceCPU}
+	cpuUsage := func(reserved, used string) []Usage {
+		return []Usage{{Key: cpuKey, Reserved: resource.MustParse(reserved), Used: resource.MustParse(used)}}
+	}
+	cpuStatus := func(reserved, used string) []kueue.ResourceUsageAccounting {
+		return []kueue.ResourceUsageAccounting{{
+			ClusterQueue: "cq",
+			Flavor:       "default",
+			Name:         corev1.ResourceCPU,
+			Reserved:     resource.MustParse(reserved),
+			Used:         resource.MustParse(used),
+		}}
+	}
+	admittedAt := october.Add(-time.Minute)
+	admitted := utiltestingapi.MakeWorkload("wl", "ns").
+		Queue("lq").
+		Request(corev1.ResourceCPU, "1").
+		ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").
+			PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+				Assignment(corev1.ResourceCPU, "default", "1").
+				Obj()).
+			Obj(), admittedAt).
+		AdmittedAt(true, admittedAt).
+		Obj()
+
+	lq := utiltestingapi.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj()
+	persisted := lq.DeepCopy()
+	persisted.Status.UsageAccounting = &kueue.LocalQueueUsageAccounting{
+		LastUpdateTime: metav1.NewTime(october.Add(-30 * time.Second)),
+		Resources:      cpuStatus("200", "200"),
+		Periods: []kueue.UsageAccountingPeriod{
+			{Start: metav1.NewTime(september.AddDate(0, -11, 0)), Resources: cpuStatus("100", "100")},
+			{Start: metav1.NewTime(september), Resources: cpuStatus("100", "100")},
+		},
+	}
+
+	cases := map[string]struct {
+		localQueue     *kueue.LocalQueue
+		wantLocalQueue []PeriodUsage
+		wantWorkload   []PeriodUsage
+	}{
+		"usage is split at the start of the month": {
+			localQueue: lq,
+			wantLocalQueue: []PeriodUsage{
+				{Start: september, Resources: cpuUsage("60", "60")},
+				{Start: october, Resources: cpuUsage("60", "60")},
+			},
+			wantWorkload: []PeriodUsage{
+				{Start: september, Resources: cpuUsage("60", "60")},
+				{Start: october, Resources: cpuUsage("60", "60")},
+			},
+		},
+		"persisted periods are seeded and the oldest ones dropped": {
+			localQueue: persisted,
+			wantLocalQueue: []PeriodUsage{
+				{Start: september, Resources: cpuUsage("130", "130")},
+				{Start: october, Resources: cpuUsage("60", "60")},
+			},
+			wantWorkload: []PeriodUsage{
+				{Start: september, Resources: cpuUsage("30", "30")},
+				{Start: october, Resources: cpuUsage("60", "60")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cl := utiltesting.NewClientBuilder().WithObjects(tc.localQueue).Build()
+			clock := testingclock.NewFakeClock(admittedAt)
+			ledger := NewLedger(cl, WithClock(clock))
+			ledger.NotifyWorkloadUpdate(nil, admitted)
+			clock.SetTime(october.Add(time.Minute))
+
+			report := ledger.Report(Filter{})
+			if diff := cmp.Diff(tc.wantLocalQueue, report.LocalQueuePeriods[lqKey]); diff != "" {
+				t.Errorf("Unexpected LocalQueue periods (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantWorkload, report.Workloads[workload.Key(admitted)].Periods); diff != "" {
+				t.Errorf("Unexpected Workload periods (-want,+got):\n%s", diff)
+			}
+
+			status := ledger.LocalQueueStatus(tc.localQueue)
+			var gotStatus []PeriodUsage
+			for _, p := range status.Periods {
+				var resources []Usage
+				for _, r := range p.Resources {
+					resources = append(resources, Usage{
+						Key:      Key{ClusterQueue: r.ClusterQueue, Flavor: r.Flavor, Resource: r.Name},
+						Reserved: r.Reserved,
+						Used:     r.Used,
+					})
+				}
+				gotStatus = append(gotStatus, PeriodUsage{Start: p.Start.Time, Resources: resources})
+			}
+			if diff := cmp.Diff(tc.wantLocalQueue, gotStatus); diff != "" {
+				t.Errorf("Unexpected LocalQueue status periods (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
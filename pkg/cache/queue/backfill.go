@@ -0,0 +1,173 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// BackfillReservation is the reservation held by the blocked head of a
+// ClusterQueue with the Backfill queueing strategy. The workloads behind the
+// head are only admitted if they finish before the ShadowTime.
+type BackfillReservation struct {
+	// Head is the workload which couldn't be admitted.
+	Head *workload.Info
+	// ShadowTime is the time at which the head is expected to fit, once the
+	// admitted workloads reach their maximum execution time. Nil when it can't
+	// be estimated, in which case no workload can be backfilled.
+	ShadowTime *time.Time
+}
+
+// FitsBefore reports whether a workload admitted at now is guaranteed to
+// finish before the shadow time.
+func (r *BackfillReservation) FitsBefore(wl *kueue.Workload, now time.Time) bool {
+	if r.ShadowTime == nil || wl.Spec.MaximumExecutionTimeSeconds == nil {
+		return false
+	}
+	maxExecution := time.Duration(*wl.Spec.MaximumExecutionTimeSeconds) * time.Second
+	return !now.Add(maxExecution).After(*r.ShadowTime)
+}
+
+func (c *ClusterQueue) backfillEnabled() bool {
+	return features.Enabled(features.BackfillQueueing) && c.queueingStrategy == kueue.Backfill
+}
+
+// BackfillEnabled reports whether the ClusterQueue uses the Backfill queueing strategy.
+func (c *ClusterQueue) BackfillEnabled() bool {
+	c.rwm.RLock()
+	defer c.rwm.RUnlock()
+	return c.backfillEnabled()
+}
+
+// BackfillReservation returns a copy of the reservation held by the blocked
+// head of the ClusterQueue, or nil if there is none.
+func (c *ClusterQueue) BackfillReservation() *BackfillReservation {
+	c.rwm.RLock()
+	defer c.rwm.RUnlock()
+	if c.backfill == nil || !c.backfillEnabled() {
+		return nil
+	}
+	resv := *c.backfill
+	return &resv
+}
+
+// BackfillReservationAhead returns the reservation held by a head ordered
+// ahead of the given workload, or nil if the workload isn't behind the head.
+func (c *ClusterQueue) BackfillReservationAhead(wInfo *workload.Info) *BackfillReservation {
+	c.rwm.RLock()
+	defer c.rwm.RUnlock()
+	if c.backfill == nil || !c.backfillEnabled() {
+		return nil
+	}
+	if workloadKey(c.backfill.Head) == workloadKey(wInfo) || c.compareFunc(c.backfill.Head, wInfo) >= 0 {
+		return nil
+	}
+	resv := *c.backfill
+	return &resv
+}
+
+// SetBackfillReservation records the reservation of the blocked head.
+func (c *ClusterQueue) SetBackfillReservation(head *workload.Info, shadowTime *time.Time) {
+	c.rwm.Lock()
+	defer c.rwm.Unlock()
+	if !c.backfillEnabled() {
+		c.backfill = nil
+		return
+	}
+	c.backfill = &BackfillReservation{Head: head, ShadowTime: shadowTime}
+}
+
+// ClearBackfillReservation removes the reservation if it is held by the given
+// workload. Returns true if the reservation was removed.
+func (c *ClusterQueue) ClearBackfillReservation(key workload.Reference) bool {
+	c.rwm.Lock()
+	defer c.rwm.Unlock()
+	return c.clearBackfillReservation(key)
+}
+
+func (c *ClusterQueue) clearBackfillReservation(key workload.Reference) bool {
+	if c.backfill == nil || workloadKey(c.backfill.Head) != key {
+		return false
+	}
+	c.backfill = nil
+	return true
+}
+
+// BackfillEnabled reports whether the ClusterQueue uses the Backfill queueing strategy.
+func (m *Manager) BackfillEnabled(cqName kueue.ClusterQueueReference) bool {
+	if !features.Enabled(features.BackfillQueueing) {
+		return false
+	}
+	cq := m.getClusterQueue(cqName)
+	return cq != nil && cq.BackfillEnabled()
+}
+
+// BackfillReservation returns the reservation held by the blocked head of the
+// ClusterQueue, or nil if there is none.
+func (m *Manager) BackfillReservation(cqName kueue.ClusterQueueReference) *BackfillReservation {
+	if !features.Enabled(features.BackfillQueueing) {
+		return nil
+	}
+	cq := m.getClusterQueue(cqName)
+	if cq == nil {
+		return nil
+	}
+	return cq.BackfillReservation()
+}
+
+// BackfillReservationAhead returns the reservation held by a head ordered
+// ahead of the workload in its ClusterQueue, or nil if there is none.
+func (m *Manager) BackfillReservationAhead(wInfo *workload.Info) *BackfillReservation {
+	if !features.Enabled(features.BackfillQueueing) {
+		return nil
+	}
+	cq := m.getClusterQueue(wInfo.ClusterQueue)
+	if cq == nil {
+		return nil
+	}
+	return cq.BackfillReservationAhead(wInfo)
+}
+
+// SetBackfillReservation records the reservation of the blocked head of its ClusterQueue.
+func (m *Manager) SetBackfillReservation(head *workload.Info, shadowTime *time.Time) {
+	cq := m.getClusterQueue(head.ClusterQueue)
+	if cq == nil {
+		return
+	}
+	cq.SetBackfillReservation(head, shadowTime)
+}
+
+// ClearBackfillReservation removes the reservation held by the workload and
+// requeues the inadmissible workloads which were blocked behind it.
+func (m *Manager) ClearBackfillReservation(wInfo *workload.Info) {
+	if !features.Enabled(features.BackfillQueueing) {
+		return
+	}
+	m.RLock()
+	defer m.RUnlock()
+	cq := m.getClusterQueueLockless(wInfo.ClusterQueue)
+	if cq == nil || !cq.ClearBackfillReservation(workloadKey(wInfo)) {
+		return
+	}
+	notifyRetryInadmissibleWithoutLock(m, sets.New(cq.name))
+}
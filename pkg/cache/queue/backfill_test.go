@@ -0,0 +1,160 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestBackfillReservation(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	head := workload.NewInfo(utiltestingapi.MakeWorkload("head", defaultNamespace).
+		Creation(now.Add(-2 * time.Second)).
+		Obj())
+	behind := workload.NewInfo(utiltestingapi.MakeWorkload("behind", defaultNamespace).
+		Creation(now.Add(-time.Second)).
+		Obj())
+	ahead := workload.NewInfo(utiltestingapi.MakeWorkload("ahead", defaultNamespace).
+		Priority(highPriority).
+		Creation(now).
+		Obj())
+
+	cases := map[string]struct {
+		disableGate      bool
+		strategy         kueue.QueueingStrategy
+		wantReservation  bool
+		wantAheadOfOther bool
+	}{
+		"backfill strategy": {
+			strategy:         kueue.Backfill,
+			wantReservation:  true,
+			wantAheadOfOther: true,
+		},
+		"best effort FIFO strategy": {
+			strategy: kueue.BestEffortFIFO,
+		},
+		"feature gate disabled": {
+			disableGate: true,
+			strategy:    kueue.Backfill,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.BackfillQueueing, !tc.disableGate)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cq, err := newClusterQueue(ctx, nil, utiltestingapi.MakeClusterQueue("cq").QueueingStrategy(tc.strategy).Obj(), nil, defaultOrdering, nil, nil)
+			if err != nil {
+				t.Fatalf("Failed to create ClusterQueue: %v", err)
+			}
+
+			cq.SetBackfillReservation(head, new(now.Add(time.Hour)))
+			if got := cq.BackfillReservation() != nil; got != tc.wantReservation {
+				t.Errorf("Unexpected reservation, want=%t, got=%t", tc.wantReservation, got)
+			}
+			if got := cq.BackfillReservationAhead(behind) != nil; got != tc.wantAheadOfOther {
+				t.Errorf("Unexpected reservation ahead of a later workload, want=%t, got=%t", tc.wantAheadOfOther, got)
+			}
+			if cq.BackfillReservationAhead(head) != nil {
+				t.Error("The head has a reservation ahead of itself")
+			}
+			if cq.BackfillReservationAhead(ahead) != nil {
+				t.Error("A higher priority workload is behind the head")
+			}
+			if cq.ClearBackfillReservation(workload.Key(behind.Obj)) {
+				t.Error("The reservation was cleared by another workload")
+			}
+			if got := cq.ClearBackfillReservation(workload.Key(head.Obj)); got != tc.wantReservation {
+				t.Errorf("Unexpected clearing of the reservation by the head, want=%t, got=%t", tc.wantReservation, got)
+			}
+			if cq.BackfillReservation() != nil {
+				t.Error("The reservation wasn't cleared")
+			}
+		})
+	}
+}
+
+func TestBackfillReservationClearedOnStrategyUpdate(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.BackfillQueueing, true)
+	ctx, _ := utiltesting.ContextWithLog(t)
+	apiCQ := utiltestingapi.MakeClusterQueue("cq").QueueingStrategy(kueue.Backfill).Obj()
+	cq, err := newClusterQueue(ctx, nil, apiCQ, nil, defaultOrdering, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create ClusterQueue: %v", err)
+	}
+	cq.SetBackfillReservation(workload.NewInfo(utiltestingapi.MakeWorkload("head", defaultNamespace).Obj()), nil)
+
+	apiCQ.Spec.QueueingStrategy = kueue.BestEffortFIFO
+	if err := cq.Update(apiCQ); err != nil {
+		t.Fatalf("Failed to update ClusterQueue: %v", err)
+	}
+	apiCQ.Spec.QueueingStrategy = kueue.Backfill
+	if err := cq.Update(apiCQ); err != nil {
+		t.Fatalf("Failed to update ClusterQueue: %v", err)
+	}
+	if cq.BackfillReservation() != nil {
+		t.Error("The reservation wasn't cleared when the queueing strategy changed")
+	}
+}
+
+func TestBackfillReservationFitsBefore(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cases := map[string]struct {
+		shadowTime   *time.Time
+		maxExecution *int32
+		want         bool
+	}{
+		"finishes before the shadow time": {
+			shadowTime:   new(now.Add(time.Hour)),
+			maxExecution: new(int32(1800)),
+			want:         true,
+		},
+		"finishes at the shadow time": {
+			shadowTime:   new(now.Add(time.Hour)),
+			maxExecution: new(int32(3600)),
+			want:         true,
+		},
+		"finishes after the shadow time": {
+			shadowTime:   new(now.Add(time.Hour)),
+			maxExecution: new(int32(3601)),
+		},
+		"no maximum execution time": {
+			shadowTime: new(now.Add(time.Hour)),
+		},
+		"unknown shadow time": {
+			maxExecution: new(int32(1)),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltestingapi.MakeWorkload("wl", defaultNamespace)
+			if tc.maxExecution != nil {
+				wl.MaximumExecutionTimeSeconds(*tc.maxExecution)
+			}
+			resv := &BackfillReservation{ShadowTime: tc.shadowTime}
+			if got := resv.FitsBefore(wl.Obj(), now); got != tc.want {
+				t.Errorf("Unexpected FitsBefore, want=%t, got=%t", tc.want, got)
+			}
+		})
+	}
+}
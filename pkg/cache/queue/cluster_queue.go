@@ -44,7 +44,6 @@ import (
 	"sigs.k8s.io/kueue/pkg/metrics"
 	afs "sigs.k8s.io/kueue/pkg/util/admissionfairsharing"
 	"sigs.k8s.io/kueue/pkg/util/heap"
-	utilpriority "sigs.k8s.io/kueue/pkg/util/priority"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -84,7 +83,7 @@ var (
 )
 
 // preemptorWorkload is the workload at the ClusterQueue head which is
-// currently preempting workloads. For BestEffortFIFO policy, isSticky is
+// currently preempting workloads. For BestEffortFIFO and Backfill policies, isSticky is
 // set to true and prevents skipped over ineligible workloads from going back
 // to the head of the queue. A workload is considered a preemptor until it is
 // admitted, unschedulable, or deleted.
@@ -203,6 +202,10 @@ type ClusterQueue struct {
 
 	pw *preemptorWorkload
 
+	// backfill is the reservation held by the blocked head when the
+	// ClusterQueue uses the Backfill queueing strategy. Guarded by rwm.
+	backfill *BackfillReservation
+
 	ConcurrentAdmissionPolicy *kueue.ConcurrentAdmissionPolicy
 }
 
@@ -224,6 +227,7 @@ type clusterQueueOptions struct {
 	fsResWeights      map[corev1.ResourceName]float64
 	enableAdmissionFs bool
 	afsUsageLedger    *queueafs.AfsUsageLedger
+	queueSort         QueueSortFunc
 }
 
 func withFSResWeights(weights map[corev1.ResourceName]float64) clusterQueueOption {
@@ -244,6 +248,12 @@ func withAfsUsageLedger(ledger *queueafs.AfsUsageLedger) clusterQueueOption {
 	}
 }
 
+func withQueueSort(queueSort QueueSortFunc) clusterQueueOption {
+	return func(o *clusterQueueOptions) {
+		o.queueSort = queueSort
+	}
+}
+
 func newClusterQueue(
 	ctx context.Context,
 	client client.Client,
@@ -252,6 +262,7 @@ func newClusterQueue(
 	wo workload.Ordering,
 	afsConfig *configapi.AdmissionFairSharing,
 	afsUsageLedger *queueafs.AfsUsageLedger,
+	opts ...clusterQueueOption,
 ) (*ClusterQueue, error) {
 	enableAdmissionFs, fsResWeights := afs.ResourceWeights(cq.Spec.AdmissionScope, afsConfig)
 	cqImpl := newClusterQueueImpl(
@@ -260,9 +271,11 @@ func newClusterQueue(
 		cl,
 		wo,
 		realClock,
-		withFSResWeights(fsResWeights),
-		withEnableAdmissionFs(enableAdmissionFs),
-		withAfsUsageLedger(afsUsageLedger),
+		append([]clusterQueueOption{
+			withFSResWeights(fsResWeights),
+			withEnableAdmissionFs(enableAdmissionFs),
+			withAfsUsageLedger(afsUsageLedger),
+		}, opts...)...,
 	)
 	err := cqImpl.Update(cq)
 	if err != nil {
@@ -288,13 +301,13 @@ func newClusterQueueImpl(ctx context.Context, client client.Client, cl *metrics.
 	}
 	// The comparator reads the sticky workload and cached weights live; safe
 	// because those writes and heap operations all hold rwm.
-	compareFunc := queueOrderingFunc(ctx, getLQWeight, wo, options.fsResWeights, options.enableAdmissionFs, options.afsUsageLedger, pw.stickyMatches)
+	compareFunc := queueOrderingFunc(ctx, getLQWeight, wo, options.queueSort, options.fsResWeights, options.enableAdmissionFs, options.afsUsageLedger, pw.stickyMatches)
 	// Derive lessFunc from compareFunc for the heap.
 	lessFunc := func(a, b *workload.Info) bool { return compareFunc(a, b) < 0 }
 	// Snapshot sorts without the lock, so it captures the sticky workload once
 	// per sort rather than reading it live. See Kueue#12740.
 	snapshotSort := buildSnapshotSort(
-		ctx, wo, &pw, client,
+		ctx, wo, options.queueSort, &pw, client,
 		options.enableAdmissionFs, options.fsResWeights,
 		options.afsUsageLedger,
 	)
@@ -327,6 +340,9 @@ func (c *ClusterQueue) Update(apiCQ *kueue.ClusterQueue) error {
 	defer c.rwm.Unlock()
 	c.name = kueue.ClusterQueueReference(apiCQ.Name)
 	c.queueingStrategy = apiCQ.Spec.QueueingStrategy
+	if !c.backfillEnabled() {
+		c.backfill = nil
+	}
 	nsSelector, err := metav1.LabelSelectorAsSelector(apiCQ.Spec.NamespaceSelector)
 	if err != nil {
 		return err
@@ -519,6 +535,7 @@ func (c *ClusterQueue) DeleteFromLocalQueue(log logr.Logger, q *LocalQueue, role
 	for _, w := range q.items {
 		wlKey := workloadKey(w)
 		c.delete(log, wlKey)
+		c.clearBackfillReservation(wlKey)
 	}
 	for fw := range q.finishedWorkloads {
 		c.finishedWorkloads.Delete(fw)
@@ -552,14 +569,14 @@ func (c *ClusterQueue) requeueIfNotPresent(log logr.Logger, wInfo *workload.Info
 	defer c.rwm.Unlock()
 	key := workload.Key(wInfo.Obj)
 	// When preemptions are in-progress, track the preempting workload (see documentation
-	// of preemptorWorkload). For BestEffortFIFO queues, this also makes it sticky at the head.
+	// of preemptorWorkload). For BestEffortFIFO and Backfill queues, this also makes it sticky at the head.
 	// The preemptor workload is set under the lock so heap operations, which also hold the lock,
 	// never observe it changing mid-sort. See Kueue#12740.
 	if reason == RequeueReasonPendingPreemption || reason == RequeueReasonPendingMigration {
 		if logV := log.V(5); logV.Enabled() {
 			logV.Info("Setting preemptor workload", "clusterQueue", wInfo.ClusterQueue, "workload", key)
 		}
-		c.pw.set(key, c.queueingStrategy == kueue.BestEffortFIFO, wInfo.LastEvaluatedGeneration)
+		c.pw.set(key, c.queueingStrategy != kueue.StrictFIFO, wInfo.LastEvaluatedGeneration)
 	}
 	c.workloads.ForgetInflightByKey(key)
 
@@ -584,7 +601,7 @@ func (c *ClusterQueue) requeueIfNotPresent(log logr.Logger, wInfo *workload.Info
 
 	c.workloads.InsertInadmissible(key, wInfo)
 	logMsg := "Workload couldn't be admitted."
-	if c.queueingStrategy == kueue.BestEffortFIFO {
+	if c.queueingStrategy != kueue.StrictFIFO {
 		logMsg += " Moving the head of this ClusterQueue to the consecutive Workload."
 	}
 	log.V(2).Info(logMsg, "clusterQueue", c.name, "workload", key)
@@ -717,6 +734,7 @@ func (c *ClusterQueue) Snapshot() []*workload.Info {
 func buildSnapshotSort(
 	ctx context.Context,
 	wo workload.Ordering,
+	queueSort QueueSortFunc,
 	pw *preemptorWorkload,
 	cl client.Client,
 	enableAdmissionFs bool,
@@ -726,7 +744,7 @@ func buildSnapshotSort(
 	log := ctrl.LoggerFrom(ctx)
 	if !enableAdmissionFs {
 		return func(elements []*workload.Info) {
-			slices.SortFunc(elements, baseCompareFunc(log, wo, pw.capturedStickyMatcher()))
+			slices.SortFunc(elements, baseCompareFunc(log, wo, queueSort, pw.capturedStickyMatcher()))
 		}
 	}
 
@@ -746,7 +764,7 @@ func buildSnapshotSort(
 	return func(elements []*workload.Info) {
 		// Capture the sticky workload once so the sort stays transitive without
 		// holding the lock. See Kueue#12740.
-		baseCmp := baseCompareFunc(log, wo, pw.capturedStickyMatcher())
+		baseCmp := baseCompareFunc(log, wo, queueSort, pw.capturedStickyMatcher())
 		usageCache := make(map[utilqueue.LocalQueueReference]float64)
 		for _, wInfo := range elements {
 			lqKey := utilqueue.KeyFromWorkload(wInfo.Obj)
@@ -837,11 +855,12 @@ func (c *ClusterQueue) RequeueIfNotPresent(ctx context.Context, wInfo *workload.
 	return c.requeueIfNotPresent(log, wInfo, immediate, reason, quotaReservedReason)
 }
 
-// baseCompareFunc orders workloads by sticky status, priority, timestamp, and UID.
+// baseCompareFunc orders workloads by sticky status, queue sort, and UID.
+// The queue sort defaults to the priority and timestamp ordering of wo.
 // stickyMatches reports whether a workload is the sticky one; callers pass a
 // live matcher (stickyWorkload.matches) for the heap or a captured one
 // (stickyWorkload.capturedMatcher) for the lock-free Snapshot sort. See Kueue#12740.
-func baseCompareFunc(log logr.Logger, wo workload.Ordering, stickyMatches func(workload.Reference) bool) func(a, b *workload.Info) int {
+func baseCompareFunc(log logr.Logger, wo workload.Ordering, queueSort QueueSortFunc, stickyMatches func(workload.Reference) bool) func(a, b *workload.Info) int {
 	return func(a, b *workload.Info) int {
 		aSticky := stickyMatches(workload.Key(a.Obj))
 		bSticky := stickyMatches(workload.Key(b.Obj))
@@ -854,20 +873,12 @@ func baseCompareFunc(log logr.Logger, wo workload.Ordering, stickyMatches func(w
 			return 1
 		}
 
-		p1 := utilpriority.EffectivePriority(log, a.Obj)
-		p2 := utilpriority.EffectivePriority(log, b.Obj)
-		// Higher priority comes first (reverse order).
-		if cmpResult := cmp.Compare(p2, p1); cmpResult != 0 {
-			return cmpResult
-		}
-
-		tA := wo.GetQueueOrderTimestamp(a.Obj)
-		tB := wo.GetQueueOrderTimestamp(b.Obj)
-		if !tA.Equal(tB) {
-			if tA.Before(tB) {
-				return -1
+		if queueSort != nil {
+			if cmpResult := queueSort(a, b); cmpResult != 0 {
+				return cmpResult
 			}
-			return 1
+		} else if cmpResult := wo.Compare(log, a.Obj, b.Obj); cmpResult != 0 {
+			return cmpResult
 		}
 		return cmp.Compare(a.Obj.UID, b.Obj.UID)
 	}
@@ -881,13 +892,14 @@ func queueOrderingFunc(
 	ctx context.Context,
 	getLQWeight func(utilqueue.LocalQueueReference) float64,
 	wo workload.Ordering,
+	queueSort QueueSortFunc,
 	fsResWeights map[corev1.ResourceName]float64,
 	enableAdmissionFs bool,
 	afsUsageLedger *queueafs.AfsUsageLedger,
 	stickyMatches func(workload.Reference) bool,
 ) func(a, b *workload.Info) int {
 	log := ctrl.LoggerFrom(ctx)
-	baseCmp := baseCompareFunc(log, wo, stickyMatches)
+	baseCmp := baseCompareFunc(log, wo, queueSort, stickyMatches)
 	if !enableAdmissionFs {
 		return baseCmp
 	}
@@ -185,6 +185,14 @@ func NotifyRetryInadmissible(m *Manager, cqNames sets.Set[kueue.ClusterQueueRefe
 	notifyRetryInadmissibleWithoutLock(m, cqNames)
 }
 
+// NotifyRetryInadmissibleAfter calls NotifyRetryInadmissible for the
+// ClusterQueues after the delay.
+func NotifyRetryInadmissibleAfter(m *Manager, cqNames sets.Set[kueue.ClusterQueueReference], delay time.Duration) {
+	m.clock.AfterFunc(delay, func() {
+		NotifyRetryInadmissible(m, cqNames)
+	})
+}
+
 func notifyRetryInadmissibleWithoutLock(m *Manager, cqNames sets.Set[kueue.ClusterQueueReference]) {
 	for name := range cqNames {
 		cq := m.hm.ClusterQueue(name)
@@ -59,6 +59,11 @@ var (
 // Option configures the manager.
 type Option func(*Manager)
 
+// QueueSortFunc compares two pending workloads of a ClusterQueue. It returns
+// a negative number when a should be popped before b, and a positive number
+// when b should be popped first.
+type QueueSortFunc func(a, b *workload.Info) int
+
 // WithClock allows to specify a custom clock
 func WithClock(c clock.WithDelayedExecution) Option {
 	return func(m *Manager) {
@@ -93,6 +98,14 @@ func WithExcludedResourcePrefixes(excludedPrefixes []string) Option {
 	}
 }
 
+// WithQueueSort sets the function ordering the pending workloads within the
+// ClusterQueues, instead of their priority and queue order timestamp.
+func WithQueueSort(queueSort QueueSortFunc) Option {
+	return func(m *Manager) {
+		m.queueSort = queueSort
+	}
+}
+
 // WithResourceTransformations sets the resource transformations.
 func WithResourceTransformations(transforms []config.ResourceTransformation) Option {
 	return func(m *Manager) {
@@ -168,6 +181,7 @@ type Manager struct {
 	unadmittedWorkloads *unadmittedWorkloads
 
 	workloadOrdering workload.Ordering
+	queueSort        QueueSortFunc
 
 	workloadInfoOptions []workload.InfoOption
 
@@ -331,7 +345,7 @@ func (m *Manager) AddClusterQueue(ctx context.Context, cq *kueue.ClusterQueue) e
 	if afs.Enabled(m.admissionFairSharingConfig) {
 		afsUsageLedger = m.AfsUsageLedger
 	}
-	cqImpl, err := newClusterQueue(ctx, m.client, cq, m.customLabels, m.workloadOrdering, m.admissionFairSharingConfig, afsUsageLedger)
+	cqImpl, err := newClusterQueue(ctx, m.client, cq, m.customLabels, m.workloadOrdering, m.admissionFairSharingConfig, afsUsageLedger, withQueueSort(m.queueSort))
 	if err != nil {
 		return err
 	}
@@ -844,6 +858,9 @@ func (m *Manager) deleteWorkloadWithoutLock(log logr.Logger, wlKey workload.Refe
 	cq := m.hm.ClusterQueue(q.ClusterQueue)
 	if cq != nil {
 		cq.Delete(log, wlKey)
+		if cq.ClearBackfillReservation(wlKey) {
+			notifyRetryInadmissibleWithoutLock(m, sets.New(cq.name))
+		}
 		reportCQPendingWorkloads(m, cq)
 	}
 	reportLQPendingWorkloads(m, q)
@@ -976,6 +993,12 @@ func (m *Manager) PendingWorkloadsInfo(cqName kueue.ClusterQueueReference) []*wo
 	return cq.Snapshot()
 }
 
+// NewWorkloadInfo builds the Info for a Workload with the same options that
+// are used for the workloads pending in the queues.
+func (m *Manager) NewWorkloadInfo(w *kueue.Workload) *workload.Info {
+	return workload.NewInfo(w, m.workloadInfoOptions...)
+}
+
 // ClusterQueueFromLocalQueue returns ClusterQueue name and whether it's found,
 // given a QueueKey(namespace/localQueueName) as the parameter
 func (m *Manager) ClusterQueueFromLocalQueue(localQueueKey queue.LocalQueueReference) (kueue.ClusterQueueReference, bool) {
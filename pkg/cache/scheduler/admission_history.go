@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"slices"
+	"time"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// ExpectedReleases returns the sorted times at which the workloads admitted
+// in the ClusterQueue reach their maximum execution time, and the number of
+// admitted workloads which don't declare one.
+func (c *Cache) ExpectedReleases(cqName kueue.ClusterQueueReference) ([]time.Time, int) {
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.hm.ClusterQueue(cqName)
+	if cq == nil {
+		return nil, 0
+	}
+	releases := make([]time.Time, 0, len(cq.Workloads))
+	unbounded := 0
+	for _, wl := range cq.Workloads {
+		if end := workload.ExpectedEndTime(wl.Obj); end != nil {
+			releases = append(releases, *end)
+		} else {
+			unbounded++
+		}
+	}
+	slices.SortFunc(releases, time.Time.Compare)
+	return releases, unbounded
+}
@@ -0,0 +1,59 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestExpectedReleases(t *testing.T) {
+	now := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	ctx, log := utiltesting.ContextWithLog(t)
+	cache := New(utiltesting.NewFakeClient())
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		Obj()
+	cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Adding the cluster queue: %v", err)
+	}
+	for name, maxExecution := range map[string]int32{"long": 3600, "short": 600, "unbounded": 0} {
+		wl := utiltestingapi.MakeWorkload(name, "default").
+			Request(corev1.ResourceCPU, "1").
+			SimpleReserveQuota("cq", "default", now).
+			AdmittedAt(true, now)
+		if maxExecution > 0 {
+			wl.MaximumExecutionTimeSeconds(maxExecution)
+		}
+		cache.AddOrUpdateWorkload(log, wl.Obj())
+	}
+
+	releases, unbounded := cache.ExpectedReleases("cq")
+	if diff := cmp.Diff([]time.Time{now.Add(10 * time.Minute), now.Add(time.Hour)}, releases); diff != "" {
+		t.Errorf("Unexpected releases (-want,+got):\n%s", diff)
+	}
+	if unbounded != 1 {
+		t.Errorf("Unexpected number of workloads without maximum execution time, want=1, got=%d", unbounded)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -45,6 +46,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/util/queue"
 	"sigs.k8s.io/kueue/pkg/util/resourcegroups"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 	"sigs.k8s.io/kueue/pkg/workload"
 	"sigs.k8s.io/kueue/pkg/workload/concurrentadmission"
 )
@@ -133,6 +135,14 @@ func WithCustomLabels(cl *metrics.CustomLabels) Option {
 	}
 }
 
+// WithClock sets the clock used to evaluate the quota schedules of the
+// ClusterQueues.
+func WithClock(clock clock.PassiveClock) Option {
+	return func(c *Cache) {
+		c.clock = clock
+	}
+}
+
 // WithLocalQueueMetrics sets the configuration for local queue metrics.
 func WithLocalQueueMetrics(value *metrics.LocalQueueMetricsConfig) Option {
 	return func(c *Cache) {
@@ -166,6 +176,10 @@ type Cache struct {
 	lqMetrics    *metrics.LocalQueueMetricsConfig
 
 	schedulingSimulator simulator.SchedulingSimulator
+
+	reservations map[kueue.ReservationReference]*reservation
+
+	clock clock.PassiveClock
 }
 
 func New(client client.Client, options ...Option) *Cache {
@@ -178,6 +192,8 @@ func New(client client.Client, options ...Option) *Cache {
 		hm:                     hierarchy.NewManager(newCohort),
 		resourceFormatter:      resourceFormatter,
 		schedulingSimulator:    newDefaultSimulator(),
+		reservations:           make(map[kueue.ReservationReference]*reservation),
+		clock:                  clock.RealClock{},
 	}
 	for _, option := range options {
 		option(cache)
@@ -203,10 +219,12 @@ func (c *Cache) newClusterQueue(log logr.Logger, cq *kueue.ClusterQueue) (*clust
 		roleTracker:         c.roleTracker,
 		lqMetrics:           c.lqMetrics,
 		customLabels:        c.customLabels,
+		workflows:           make(map[workflowKey]*workflowState),
+		clock:               c.clock,
 	}
 	c.hm.AddClusterQueue(cqImpl)
 	c.hm.UpdateClusterQueueEdge(kueue.ClusterQueueReference(cq.Name), cq.Spec.CohortName)
-	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, nil); err != nil {
+	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, nil, c.clock.Now()); err != nil {
 		return nil, err
 	}
 
@@ -518,7 +536,7 @@ func (c *Cache) UpdateClusterQueue(log logr.Logger, cq *kueue.ClusterQueue) erro
 	}
 	oldParent := cqImpl.Parent()
 	c.hm.UpdateClusterQueueEdge(kueue.ClusterQueueReference(cq.Name), cq.Spec.CohortName)
-	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, oldParent); err != nil {
+	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, oldParent, c.clock.Now()); err != nil {
 		return err
 	}
 	c.handleParentUpdate(oldParent)
@@ -1059,7 +1077,7 @@ func (c *Cache) LocalQueueUsage(qObj *kueue.LocalQueue) (*LocalQueueUsageStats,
 }
 
 func handleTASFlavor(rf *kueue.ResourceFlavor) bool {
-	return features.Enabled(features.TopologyAwareScheduling) && rf.Spec.TopologyName != nil
+	return features.Enabled(features.TopologyAwareScheduling) && utiltas.IsTASFlavor(rf)
 }
 
 func (c *Cache) filterLocalQueueUsage(orig resources.FlavorResourceQuantities, resourceGroups []resourcegroups.ResourceGroup) []kueue.LocalQueueFlavorUsage {
@@ -1106,9 +1124,10 @@ func (c *Cache) ClusterQueuesUsingTopology(tName kueue.TopologyReference) []kueu
 	var cqs []kueue.ClusterQueueReference
 
 	for _, cq := range c.hm.ClusterQueues() {
-		for _, tRef := range cq.tasFlavors {
-			if tRef == tName {
+		for _, tRefs := range cq.tasFlavors {
+			if slices.Contains(tRefs, tName) {
 				cqs = append(cqs, cq.Name)
+				break
 			}
 		}
 	}
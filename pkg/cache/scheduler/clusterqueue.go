@@ -23,13 +23,16 @@ import (
 	"math"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 
 	cfg "sigs.k8s.io/kueue/apis/config/v1beta2"
@@ -42,9 +45,11 @@ import (
 	"sigs.k8s.io/kueue/pkg/util/api"
 	utilmath "sigs.k8s.io/kueue/pkg/util/math"
 	"sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/util/quotaschedule"
 	"sigs.k8s.io/kueue/pkg/util/resourcegroups"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	stringsutils "sigs.k8s.io/kueue/pkg/util/strings"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
@@ -81,7 +86,7 @@ type clusterQueue struct {
 	multiKueueAdmissionChecks          []kueue.AdmissionCheckReference
 	provisioningAdmissionChecks        []kueue.AdmissionCheckReference
 	perFlavorMultiKueueAdmissionChecks []kueue.AdmissionCheckReference
-	tasFlavors                         map[kueue.ResourceFlavorReference]kueue.TopologyReference
+	tasFlavors                         map[kueue.ResourceFlavorReference][]kueue.TopologyReference
 	admittedWorkloadsCount             int
 	isStopped                          bool
 	workloadInfoOptions                []workload.InfoOption
@@ -102,6 +107,35 @@ type clusterQueue struct {
 
 	ConcurrentAdmissionPolicy *kueue.ConcurrentAdmissionPolicy
 
+	// specResourceGroups are the resource groups of the spec, before the
+	// quotas of the active quota window are applied.
+	specResourceGroups []kueue.ResourceGroup
+	quotaScheduleSpec  *kueue.QuotaSchedule
+	quotaSchedule      *quotaschedule.Schedule
+	// activeQuotaWindow is the name of the quota window whose quotas are
+	// applied, empty if none.
+	activeQuotaWindow string
+	// quotaWindowTransition is the time at which the active quota window
+	// changed to activeQuotaWindow.
+	quotaWindowTransition time.Time
+	// quotaWindowEvaluated is the last time at which the active quota window
+	// was evaluated, zero if never.
+	quotaWindowEvaluated time.Time
+	// quotaWindowChanged is whether the active quota window changed since the
+	// last call to RefreshQuotaWindow.
+	quotaWindowChanged bool
+
+	// reservations are the Reservations booking quota of the ClusterQueue.
+	reservations []kueue.ReservationReference
+
+	// workflows are the workflows with workloads in the ClusterQueue, or
+	// whose reserved quota is kept after their last workload finished.
+	workflows map[workflowKey]*workflowState
+	// workflowReservationLimit caps the quota a workflow can reserve in the
+	// ClusterQueue.
+	workflowReservationLimit corev1.ResourceList
+	clock                    clock.PassiveClock
+
 	roleTracker *roletracker.RoleTracker
 
 	// allows access to values extracted from K8s labels/annotations, used as custom Prometheus metric labels
@@ -154,21 +188,12 @@ func (c *clusterQueue) updateClusterQueue(
 	resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor,
 	admissionChecks map[kueue.AdmissionCheckReference]AdmissionCheck,
 	oldParent *cohort,
+	now time.Time,
 ) error {
-	if c.updateQuotasAndResourceGroups(in.Spec.ResourceGroups) || oldParent != c.Parent() {
-		if oldParent != nil && oldParent != c.Parent() {
-			updateCohortTreeResourcesIfNoCycle(oldParent)
-		}
-		if c.HasParent() {
-			// clusterQueue will be updated as part of tree update.
-			if err := updateCohortTreeResources(c.Parent()); err != nil {
-				return err
-			}
-		} else {
-			// since ClusterQueue has no parent, it won't be updated
-			// as part of tree update.
-			updateClusterQueueResourceNode(c)
-		}
+	c.specResourceGroups = in.Spec.ResourceGroups
+	c.setQuotaSchedule(log, in.Spec.QuotaSchedule)
+	if err := c.updateResourceNodes(c.quotaResourceGroups(now), oldParent); err != nil {
+		return err
 	}
 
 	nsSelector, err := metav1.LabelSelectorAsSelector(in.Spec.NamespaceSelector)
@@ -204,12 +229,35 @@ func (c *clusterQueue) updateClusterQueue(
 
 	c.FairWeight = parseFairWeight(in.Spec.FairSharing)
 	c.AdmissionScope = in.Spec.AdmissionScope
+	c.reservations = reservationReferences(in.Spec.Reservations)
+	c.workflowReservationLimit = in.Spec.WorkflowReservationLimit
 	if features.Enabled(features.ConcurrentAdmission) {
 		c.ConcurrentAdmissionPolicy = in.Spec.ConcurrentAdmissionPolicy
 	}
 	return nil
 }
 
+// updateResourceNodes updates the quotas of the ClusterQueue and, if they or
+// the parent changed, the resource nodes of the Cohort trees.
+func (c *clusterQueue) updateResourceNodes(rgs []kueue.ResourceGroup, oldParent *cohort) error {
+	if c.updateQuotasAndResourceGroups(rgs) || oldParent != c.Parent() {
+		if oldParent != nil && oldParent != c.Parent() {
+			updateCohortTreeResourcesIfNoCycle(oldParent)
+		}
+		if c.HasParent() {
+			// clusterQueue will be updated as part of tree update.
+			if err := updateCohortTreeResources(c.Parent()); err != nil {
+				return err
+			}
+		} else {
+			// since ClusterQueue has no parent, it won't be updated
+			// as part of tree update.
+			updateClusterQueueResourceNode(c)
+		}
+	}
+	return nil
+}
+
 func (c *clusterQueue) ConcurrentAdmissionEnabled() bool {
 	if !features.Enabled(features.ConcurrentAdmission) {
 		return false
@@ -349,10 +397,16 @@ func (c *clusterQueue) inactiveReason() (string, string) {
 		}
 
 		if features.Enabled(features.TopologyAwareScheduling) && len(c.tasFlavors) > 0 {
-			for tasFlavor, topology := range c.tasFlavors {
+			for tasFlavor, topologies := range c.tasFlavors {
 				if c.tasCache.Get(tasFlavor) == nil {
-					reasons = append(reasons, kueue.ClusterQueueActiveReasonTopologyNotFound)
-					messages = append(messages, fmt.Sprintf("there is no Topology %q for TAS flavor %q", topology, tasFlavor))
+					missing := c.tasCache.MissingTopologies(topologies)
+					if len(missing) == 0 {
+						missing = topologies
+					}
+					for _, topology := range missing {
+						reasons = append(reasons, kueue.ClusterQueueActiveReasonTopologyNotFound)
+						messages = append(messages, fmt.Sprintf("there is no Topology %q for TAS flavor %q", topology, tasFlavor))
+					}
 				}
 			}
 		}
@@ -395,7 +449,7 @@ func (c *clusterQueue) updateFlavorMetadata(log logr.Logger, flavors map[kueue.R
 		rg := &c.ResourceGroups[i]
 		for _, fName := range rg.Flavors {
 			if flv, exist := flavors[fName]; exist {
-				if flv.Spec.TopologyName != nil {
+				if utiltas.IsTASFlavor(flv) {
 					// A TAS flavor which was not tracked before may come with a freshly created,
 					// empty TAS flavor cache (e.g., the ResourceFlavor was deleted and re-created),
 					// so force a resync to account the usage of admitted workloads again.
@@ -404,9 +458,9 @@ func (c *clusterQueue) updateFlavorMetadata(log logr.Logger, flavors map[kueue.R
 						c.isTASSynced = false
 					}
 					if c.tasFlavors == nil {
-						c.tasFlavors = make(map[kueue.ResourceFlavorReference]kueue.TopologyReference, 1)
+						c.tasFlavors = make(map[kueue.ResourceFlavorReference][]kueue.TopologyReference, 1)
 					}
-					c.tasFlavors[fName] = *flv.Spec.TopologyName
+					c.tasFlavors[fName] = utiltas.TopologyNames(flv)
 				}
 			} else {
 				c.missingFlavors = append(c.missingFlavors, fName)
@@ -494,6 +548,7 @@ func (c *clusterQueue) addOrUpdateWorkload(log logr.Logger, w *kueue.Workload) {
 		c.customLabels.Store(cfg.SourceKindWorkload, string(k), w.Labels, w.Annotations)
 	}
 	c.updateWorkloadUsage(log, wi, add)
+	c.updateWorkflow(wi, add)
 	if c.podsReadyTracking && !apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadPodsReady) {
 		c.WorkloadsNotReady.Insert(k)
 	}
@@ -510,6 +565,7 @@ func (c *clusterQueue) deleteWorkload(log logr.Logger, wlKey workload.Reference)
 		return
 	}
 	c.updateWorkloadUsage(log, wi, subtract)
+	c.updateWorkflow(wi, subtract)
 	if c.podsReadyTracking {
 		c.WorkloadsNotReady.Delete(wlKey)
 	}
@@ -66,6 +66,12 @@ type ClusterQueueSnapshot struct {
 	// In case its empty, it means an AdmissionCheck should apply to all ResourceFlavor
 	AdmissionChecks workload.AdmissionChecks
 	Status          metrics.ClusterQueueStatus
+	// QuotaWindow is the name of the quota window whose quotas are applied,
+	// empty if none.
+	QuotaWindow string
+	// Draining is whether the admission is stopped until the usage fits in
+	// the quota of the active quota window.
+	Draining bool
 	// AllocatableResourceGeneration will be increased when some admitted workloads are
 	// deleted, or the resource groups are changed.
 	AllocatableResourceGeneration int64
@@ -78,6 +84,12 @@ type ClusterQueueSnapshot struct {
 
 	flavorsForProvReqACs sets.Set[kueue.ResourceFlavorReference]
 	hasMultiKueueAC      bool
+
+	// reservations are the Reservations booking quota of the ClusterQueue
+	// or its ancestors, whose window didn't end.
+	reservations []*reservationSnapshot
+
+	workflowReservationLimit corev1.ResourceList
 }
 
 // RGByResource returns the ResourceGroup which contains capacity
@@ -123,8 +135,12 @@ func (c *ClusterQueueSnapshot) updateTASUsage(usage workload.TASUsage, op usageO
 		for tasFlavor, tasUsage := range usage {
 			if tasFlvCache := c.TASFlavors[tasFlavor]; tasFlvCache != nil {
 				for _, tr := range tasUsage {
+					topologySnapshot := tasFlvCache.forTopology(tr.Topology)
+					if topologySnapshot == nil {
+						continue
+					}
 					domainID := utiltas.DomainID(tr.Values)
-					tasFlvCache.updateTASUsage(domainID, tr.TotalRequests(), op, tr.Count)
+					topologySnapshot.updateTASUsage(domainID, tr.TotalRequests(), op, tr.Count)
 				}
 			}
 		}
@@ -230,6 +246,9 @@ func (c *ClusterQueueSnapshot) FindTopologyAssignmentsForWorkload(
 		flvResult := tasFlavorCache.FindTopologyAssignmentsForFlavor(ctx, flavorTASRequests, flvOpts...)
 		for psName, res := range flvResult {
 			res.Flavor = tasFlavor
+			if res.PlacementExplanation != nil {
+				res.PlacementExplanation.Flavor = tasFlavor
+			}
 			result[psName] = res
 		}
 	}
@@ -32,6 +32,9 @@ type cohort struct {
 
 	FairWeight float64
 
+	// reservations are the Reservations booking quota of the Cohort.
+	reservations []kueue.ReservationReference
+
 	admittedWorkloadsCount int
 }
 
@@ -45,6 +48,7 @@ func newCohort(name kueue.CohortReference) *cohort {
 
 func (c *cohort) updateCohort(apiCohort *kueue.Cohort, oldParent *cohort) error {
 	c.FairWeight = parseFairWeight(apiCohort.Spec.FairSharing)
+	c.reservations = reservationReferences(apiCohort.Spec.Reservations)
 
 	c.resourceNode.Quotas = createResourceQuotas(apiCohort.Spec.ResourceGroups)
 	if oldParent != nil && oldParent != c.Parent() {
@@ -0,0 +1,258 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/util/quotaschedule"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// setQuotaSchedule parses the quota schedule of the ClusterQueue. A schedule
+// failing to parse, which the webhook prevents, is ignored.
+func (c *clusterQueue) setQuotaSchedule(log logr.Logger, qs *kueue.QuotaSchedule) {
+	c.quotaScheduleSpec, c.quotaSchedule = nil, nil
+	if qs == nil || !features.Enabled(features.TimeWindowedQuotas) {
+		return
+	}
+	s, err := quotaschedule.Parse(qs)
+	if err != nil {
+		log.Error(err, "Ignoring the invalid quota schedule", "clusterQueue", c.Name)
+		return
+	}
+	c.quotaScheduleSpec, c.quotaSchedule = qs, s
+}
+
+// quotaResourceGroups sets the quota window active at the given time, and
+// returns the resource groups with its quotas. When the active window changed
+// since the previous evaluation, the transition is stamped with the window
+// boundary crossed in between, or the given time if no boundary explains the
+// change, for instance when the schedule was updated.
+func (c *clusterQueue) quotaResourceGroups(now time.Time) []kueue.ResourceGroup {
+	oldWindow := c.activeQuotaWindow
+	c.activeQuotaWindow = ""
+	if c.quotaSchedule != nil {
+		c.activeQuotaWindow = c.quotaSchedule.Active(now)
+	}
+	switch {
+	case c.quotaWindowEvaluated.IsZero():
+		c.quotaWindowTransition = now
+	case c.activeQuotaWindow != oldWindow:
+		c.quotaWindowTransition = now
+		if c.quotaSchedule != nil {
+			if t := c.quotaSchedule.LastTransition(c.quotaWindowEvaluated, now); !t.IsZero() {
+				c.quotaWindowTransition = t
+			}
+		}
+		c.quotaWindowChanged = true
+	}
+	c.quotaWindowEvaluated = now
+	return quotaschedule.ResourceGroups(c.specResourceGroups, c.quotaScheduleSpec, c.activeQuotaWindow)
+}
+
+// RefreshQuotaWindow applies the quotas of the quota window of the
+// ClusterQueue active at the current time, and returns whether the active
+// window changed since the previous call, including when the change was
+// applied by an update of the ClusterQueue.
+func (c *Cache) RefreshQuotaWindow(log logr.Logger, name kueue.ClusterQueueReference) (bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	cq := c.hm.ClusterQueue(name)
+	if cq == nil {
+		return false, ErrCqNotFound
+	}
+	oldWindow := cq.activeQuotaWindow
+	rgs := cq.quotaResourceGroups(c.clock.Now())
+	if cq.activeQuotaWindow != oldWindow {
+		log.V(2).Info("Switching the quota window", "oldWindow", oldWindow, "newWindow", cq.activeQuotaWindow)
+		if err := cq.updateResourceNodes(rgs, cq.Parent()); err != nil {
+			return true, err
+		}
+		for _, lq := range cq.localQueues {
+			lq.resetFlavorsAndResources(cq.resourceNode.Usage, cq.AdmittedUsage)
+		}
+	}
+	changed := cq.quotaWindowChanged
+	cq.quotaWindowChanged = false
+	return changed, nil
+}
+
+// QuotaWindowState is the state of the quota schedule of a ClusterQueue.
+type QuotaWindowState struct {
+	// Active is the name of the quota window whose quotas are applied, empty
+	// if none.
+	Active string
+	// LastTransition is the time at which the active window changed.
+	LastTransition time.Time
+	// NextTransition is the next time at which a window starts or ends, zero
+	// if no window ever starts again.
+	NextTransition time.Time
+}
+
+// QuotaWindow returns the state of the quota schedule of the ClusterQueue,
+// the zero state if the ClusterQueue has no quota schedule.
+func (c *Cache) QuotaWindow(name kueue.ClusterQueueReference) QuotaWindowState {
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.hm.ClusterQueue(name)
+	if cq == nil || cq.quotaSchedule == nil {
+		return QuotaWindowState{}
+	}
+	return QuotaWindowState{
+		Active:         cq.activeQuotaWindow,
+		LastTransition: cq.quotaWindowTransition,
+		NextTransition: cq.quotaSchedule.NextTransition(c.clock.Now()),
+	}
+}
+
+// ClusterQueueOverQuota returns whether the usage of the ClusterQueue exceeds
+// the quota available to it for any flavor and resource.
+func (c *Cache) ClusterQueueOverQuota(name kueue.ClusterQueueReference) bool {
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.hm.ClusterQueue(name)
+	if cq == nil || (cq.HasParent() && hierarchy.HasCycle(cq.Parent())) {
+		return false
+	}
+	return len(overQuotaFlavorResources(cq, cq.resourceNode.Usage)) > 0
+}
+
+// isDraining returns whether the admission to the ClusterQueue is stopped by
+// the Drain overQuotaPolicy, until its usage fits in the quota again.
+func (c *clusterQueue) isDraining() bool {
+	return c.quotaSchedule != nil &&
+		c.quotaScheduleSpec.OverQuotaPolicy == kueue.OverQuotaPolicyDrain &&
+		len(overQuotaFlavorResources(c, c.resourceNode.Usage)) > 0
+}
+
+// overQuotaFlavorResources returns the flavors and resources whose usage
+// exceeds the quota of the ClusterQueue, that is its own quota plus, in a
+// cohort, its borrowing limit. The quota the cohort could lend beyond the
+// borrowing limit doesn't count, so that borrowing doesn't hide the quota
+// removed by a window.
+func overQuotaFlavorResources(node hierarchicalResourceNode, usage resources.FlavorResourceQuantities) []resources.FlavorResource {
+	r := node.getResourceNode()
+	var frs []resources.FlavorResource
+	for fr, v := range usage {
+		limit := r.SubtreeQuota[fr]
+		if borrowingLimit := r.Quotas[fr].BorrowingLimit; node.HasParent() && borrowingLimit != nil {
+			limit = limit.Add(*borrowingLimit)
+		}
+		if v.Cmp(limit) > 0 {
+			frs = append(frs, fr)
+		}
+	}
+	return frs
+}
+
+// OverQuotaWorkloads returns the workloads of the ClusterQueue to remove for
+// its usage to fit in the available quota. Only the workloads which reserved
+// quota before the given time are considered, starting from the lowest
+// priority and, for the same priority, the most recent reservation. The
+// workloads already evicted are expected to release their quota and are not
+// returned.
+func (s *Snapshot) OverQuotaWorkloads(log logr.Logger, name kueue.ClusterQueueReference, reservedBefore time.Time) []*workload.Info {
+	cq := s.ClusterQueue(name)
+	if cq == nil {
+		return nil
+	}
+	var evicted []*workload.Info
+	for _, wl := range cq.Workloads {
+		if apimeta.IsStatusConditionTrue(wl.Obj.Status.Conditions, kueue.WorkloadEvicted) {
+			evicted = append(evicted, wl)
+		}
+	}
+	for _, wl := range evicted {
+		s.RemoveWorkload(wl)
+	}
+	defer func() {
+		for _, wl := range evicted {
+			s.AddWorkload(wl)
+		}
+	}()
+
+	overQuota := overQuotaFlavorResources(cq, cq.ResourceNode.Usage)
+	if len(overQuota) == 0 {
+		return nil
+	}
+	var candidates []*workload.Info
+	for _, wl := range cq.Workloads {
+		if reservationTime(wl.Obj).Before(reservedBefore) && usesAny(wl, overQuota) {
+			candidates = append(candidates, wl)
+		}
+	}
+	slices.SortFunc(candidates, func(a, b *workload.Info) int {
+		if c := cmp.Compare(priority.EffectivePriority(log, a.Obj), priority.EffectivePriority(log, b.Obj)); c != 0 {
+			return c
+		}
+		if c := reservationTime(b.Obj).Compare(reservationTime(a.Obj)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Obj.UID, b.Obj.UID)
+	})
+
+	var targets []*workload.Info
+	for _, wl := range candidates {
+		if len(overQuotaFlavorResources(cq, cq.ResourceNode.Usage)) == 0 {
+			break
+		}
+		s.RemoveWorkload(wl)
+		targets = append(targets, wl)
+	}
+	// Keep the targets which fit again once the others are removed, starting
+	// from the last removed one, then restore the snapshot.
+	var result []*workload.Info
+	for i := len(targets) - 1; i >= 0; i-- {
+		s.AddWorkload(targets[i])
+		if len(overQuotaFlavorResources(cq, cq.ResourceNode.Usage)) > 0 {
+			s.RemoveWorkload(targets[i])
+			result = append(result, targets[i])
+		}
+	}
+	for _, wl := range result {
+		s.AddWorkload(wl)
+	}
+	return result
+}
+
+func usesAny(wl *workload.Info, frs []resources.FlavorResource) bool {
+	usage := wl.Usage().Quota.Assigned
+	return slices.ContainsFunc(frs, func(fr resources.FlavorResource) bool {
+		_, found := usage[fr]
+		return found
+	})
+}
+
+func reservationTime(wl *kueue.Workload) time.Time {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return time.Time{}
+	}
+	return cond.LastTransitionTime.Time
+}
@@ -0,0 +1,350 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func nightQuotaSchedule(policy kueue.OverQuotaPolicy, cpu string) kueue.QuotaSchedule {
+	return kueue.QuotaSchedule{
+		OverQuotaPolicy: policy,
+		Windows: []kueue.QuotaWindow{{
+			Name:            "night",
+			Schedule:        "0 20 * * *",
+			DurationMinutes: 12 * 60,
+			Flavors:         []kueue.FlavorQuotas{*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, cpu).Obj()},
+		}},
+	}
+}
+
+func TestRefreshQuotaWindow(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.TimeWindowedQuotas, true)
+	ctx, log := utiltesting.ContextWithLog(t)
+	noon := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	fakeClock := testingclock.NewFakeClock(noon)
+
+	cache := New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+	cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+		QuotaSchedule(nightQuotaSchedule(kueue.OverQuotaPolicyEvict, "2")).
+		Obj()
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Adding the ClusterQueue: %v", err)
+	}
+	cache.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload("wl", metav1.NamespaceDefault).
+		Request(corev1.ResourceCPU, "3").
+		SimpleReserveQuota("cq", "default", noon).
+		Obj())
+
+	fr := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	steps := []struct {
+		now                time.Time
+		wantChanged        bool
+		wantActive         string
+		wantLastTransition time.Time
+		wantTransition     time.Time
+		wantNominal        int64
+		wantOverQuota      bool
+	}{
+		{
+			now:                noon,
+			wantLastTransition: noon,
+			wantTransition:     noon.Add(8 * time.Hour),
+			wantNominal:        4000,
+		},
+		{
+			now:                noon.Add(8 * time.Hour),
+			wantChanged:        true,
+			wantActive:         "night",
+			wantLastTransition: noon.Add(8 * time.Hour),
+			wantTransition:     noon.Add(20 * time.Hour),
+			wantNominal:        2000,
+			wantOverQuota:      true,
+		},
+		{
+			now:                noon.Add(10 * time.Hour),
+			wantActive:         "night",
+			wantLastTransition: noon.Add(8 * time.Hour),
+			wantTransition:     noon.Add(20 * time.Hour),
+			wantNominal:        2000,
+			wantOverQuota:      true,
+		},
+		{
+			// Refreshed late, the transition is stamped at the window end.
+			now:                noon.Add(21 * time.Hour),
+			wantChanged:        true,
+			wantLastTransition: noon.Add(20 * time.Hour),
+			wantTransition:     noon.Add(32 * time.Hour),
+			wantNominal:        4000,
+		},
+	}
+	for i, step := range steps {
+		fakeClock.SetTime(step.now)
+		changed, err := cache.RefreshQuotaWindow(log, "cq")
+		if err != nil {
+			t.Fatalf("Step %d: refreshing the quota window: %v", i, err)
+		}
+		if changed != step.wantChanged {
+			t.Errorf("Step %d: RefreshQuotaWindow() = %v, want %v", i, changed, step.wantChanged)
+		}
+		state := cache.QuotaWindow("cq")
+		if state.Active != step.wantActive {
+			t.Errorf("Step %d: active window = %q, want %q", i, state.Active, step.wantActive)
+		}
+		if !state.LastTransition.Equal(step.wantLastTransition) {
+			t.Errorf("Step %d: last transition = %v, want %v", i, state.LastTransition, step.wantLastTransition)
+		}
+		if !state.NextTransition.Equal(step.wantTransition) {
+			t.Errorf("Step %d: next transition = %v, want %v", i, state.NextTransition, step.wantTransition)
+		}
+		if got := cache.hm.ClusterQueue("cq").resourceNode.Quotas[fr].Nominal.Int64(); got != step.wantNominal {
+			t.Errorf("Step %d: nominal quota = %d, want %d", i, got, step.wantNominal)
+		}
+		if got := cache.ClusterQueueOverQuota("cq"); got != step.wantOverQuota {
+			t.Errorf("Step %d: ClusterQueueOverQuota() = %v, want %v", i, got, step.wantOverQuota)
+		}
+	}
+}
+
+func TestRefreshQuotaWindowAfterClusterQueueUpdate(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.TimeWindowedQuotas, true)
+	ctx, log := utiltesting.ContextWithLog(t)
+	evening := time.Date(2026, 3, 2, 19, 0, 0, 0, time.UTC)
+	fakeClock := testingclock.NewFakeClock(evening)
+
+	cache := New(utiltesting.NewFakeClient(), WithClock(fakeClock))
+	cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+		QuotaSchedule(nightQuotaSchedule(kueue.OverQuotaPolicyEvict, "2")).
+		Obj()
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Adding the ClusterQueue: %v", err)
+	}
+
+	// The update of the ClusterQueue after the window start applies the
+	// window, the refresh still reports the switch.
+	fakeClock.SetTime(evening.Add(90 * time.Minute))
+	if err := cache.UpdateClusterQueue(log, cq); err != nil {
+		t.Fatalf("Updating the ClusterQueue: %v", err)
+	}
+	changed, err := cache.RefreshQuotaWindow(log, "cq")
+	if err != nil {
+		t.Fatalf("Refreshing the quota window: %v", err)
+	}
+	if !changed {
+		t.Error("RefreshQuotaWindow() = false, want true")
+	}
+	want := QuotaWindowState{
+		Active:         "night",
+		LastTransition: evening.Add(time.Hour),
+		NextTransition: evening.Add(13 * time.Hour),
+	}
+	if diff := cmp.Diff(want, cache.QuotaWindow("cq")); diff != "" {
+		t.Errorf("Unexpected quota window state (-want,+got):\n%s", diff)
+	}
+	if changed, _ := cache.RefreshQuotaWindow(log, "cq"); changed {
+		t.Error("RefreshQuotaWindow() = true on the second call, want false")
+	}
+}
+
+func TestOverQuotaWorkloads(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.TimeWindowedQuotas, true)
+	transition := time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)
+	before := transition.Add(-time.Hour)
+
+	cases := map[string]struct {
+		nightCPU string
+		wls      []*kueue.Workload
+		want     []string
+	}{
+		"within quota": {
+			nightCPU: "6",
+			wls: []*kueue.Workload{
+				utiltestingapi.MakeWorkload("a", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "3").SimpleReserveQuota("cq", "default", before).Obj(),
+				utiltestingapi.MakeWorkload("b", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "3").SimpleReserveQuota("cq", "default", before).Obj(),
+			},
+		},
+		"lowest priority first": {
+			nightCPU: "4",
+			wls: []*kueue.Workload{
+				utiltestingapi.MakeWorkload("high", metav1.NamespaceDefault).Priority(10).Request(corev1.ResourceCPU, "3").SimpleReserveQuota("cq", "default", before).Obj(),
+				utiltestingapi.MakeWorkload("low", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "3").SimpleReserveQuota("cq", "default", before).Obj(),
+			},
+			want: []string{"low"},
+		},
+		"most recent reservation first, keeping the workloads fitting again": {
+			nightCPU: "4",
+			wls: []*kueue.Workload{
+				utiltestingapi.MakeWorkload("old", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "2").SimpleReserveQuota("cq", "default", before.Add(-time.Hour)).Obj(),
+				utiltestingapi.MakeWorkload("small", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "1").SimpleReserveQuota("cq", "default", before).Obj(),
+				utiltestingapi.MakeWorkload("big", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "3").SimpleReserveQuota("cq", "default", before.Add(-30*time.Minute)).Obj(),
+			},
+			want: []string{"big"},
+		},
+		"workloads reserved after the transition are kept": {
+			nightCPU: "2",
+			wls: []*kueue.Workload{
+				utiltestingapi.MakeWorkload("before", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "2").SimpleReserveQuota("cq", "default", before).Obj(),
+				utiltestingapi.MakeWorkload("after", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "2").SimpleReserveQuota("cq", "default", transition.Add(time.Minute)).Obj(),
+			},
+			want: []string{"before"},
+		},
+		"evicted workloads release their quota": {
+			nightCPU: "3",
+			wls: []*kueue.Workload{
+				utiltestingapi.MakeWorkload("evicted", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "2").SimpleReserveQuota("cq", "default", before).EvictedAt(transition).Obj(),
+				utiltestingapi.MakeWorkload("running", metav1.NamespaceDefault).Request(corev1.ResourceCPU, "3").SimpleReserveQuota("cq", "default", before).Obj(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(transition)))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			cq := utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "8").Obj()).
+				QuotaSchedule(nightQuotaSchedule(kueue.OverQuotaPolicyEvict, tc.nightCPU)).
+				Obj()
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Adding the ClusterQueue: %v", err)
+			}
+			for _, wl := range tc.wls {
+				cache.AddOrUpdateWorkload(log, wl)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Taking the snapshot: %v", err)
+			}
+			wantUsage := snapshot.ClusterQueue("cq").ResourceNode.Usage.Clone()
+
+			var got []string
+			for _, wl := range snapshot.OverQuotaWorkloads(log, "cq", transition) {
+				got = append(got, wl.Obj.Name)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected workloads (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(wantUsage, snapshot.ClusterQueue("cq").ResourceNode.Usage); diff != "" {
+				t.Errorf("The snapshot was not restored (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotDraining(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.TimeWindowedQuotas, true)
+	night := time.Date(2026, 3, 2, 21, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		policy              kueue.OverQuotaPolicy
+		nightCPU            string
+		nightBorrowingLimit string
+		cohortLenderCPU     string
+		want                bool
+	}{
+		"drain, over quota": {
+			policy:   kueue.OverQuotaPolicyDrain,
+			nightCPU: "2",
+			want:     true,
+		},
+		"drain, within quota": {
+			policy:   kueue.OverQuotaPolicyDrain,
+			nightCPU: "3",
+		},
+		"keep, over quota": {
+			policy:   kueue.OverQuotaPolicyKeep,
+			nightCPU: "2",
+		},
+		"evict, over quota": {
+			policy:   kueue.OverQuotaPolicyEvict,
+			nightCPU: "2",
+		},
+		"drain, over the quota and the borrowing limit, in a cohort able to lend": {
+			policy:              kueue.OverQuotaPolicyDrain,
+			nightCPU:            "1",
+			nightBorrowingLimit: "1",
+			cohortLenderCPU:     "4",
+			want:                true,
+		},
+		"drain, within the quota and the borrowing limit, in a cohort": {
+			policy:              kueue.OverQuotaPolicyDrain,
+			nightCPU:            "2",
+			nightBorrowingLimit: "1",
+			cohortLenderCPU:     "4",
+		},
+		"drain, over quota without a borrowing limit, in a cohort able to lend": {
+			policy:          kueue.OverQuotaPolicyDrain,
+			nightCPU:        "1",
+			cohortLenderCPU: "4",
+			want:            true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(night)))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			schedule := nightQuotaSchedule(tc.policy, tc.nightCPU)
+			if tc.nightBorrowingLimit != "" {
+				schedule.Windows[0].Flavors[0] = *utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, tc.nightCPU, tc.nightBorrowingLimit).Obj()
+			}
+			cqWrapper := utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+				QuotaSchedule(schedule)
+			if tc.cohortLenderCPU != "" {
+				cqWrapper.Cohort("team")
+				lender := utiltestingapi.MakeClusterQueue("lender").
+					Cohort("team").
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, tc.cohortLenderCPU).Obj()).
+					Obj()
+				if err := cache.AddClusterQueue(ctx, lender); err != nil {
+					t.Fatalf("Adding the lending ClusterQueue: %v", err)
+				}
+			}
+			if err := cache.AddClusterQueue(ctx, cqWrapper.Obj()); err != nil {
+				t.Fatalf("Adding the ClusterQueue: %v", err)
+			}
+			cache.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload("wl", metav1.NamespaceDefault).
+				Request(corev1.ResourceCPU, "3").
+				SimpleReserveQuota("cq", "default", night.Add(-2*time.Hour)).
+				Obj())
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Taking the snapshot: %v", err)
+			}
+			if got := snapshot.ClusterQueue("cq").Draining; got != tc.want {
+				t.Errorf("Draining = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
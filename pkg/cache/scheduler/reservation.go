@@ -0,0 +1,291 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// reservation holds the window and the quantities of a Reservation.
+type reservation struct {
+	start      time.Time
+	end        time.Time
+	quantities resources.FlavorResourceQuantities
+}
+
+func newReservation(r *kueue.Reservation) *reservation {
+	res := &reservation{
+		start:      r.Spec.StartTime.Time,
+		end:        r.Spec.EndTime.Time,
+		quantities: make(resources.FlavorResourceQuantities),
+	}
+	for _, f := range r.Spec.Flavors {
+		for _, rr := range f.Resources {
+			fr := resources.FlavorResource{Flavor: f.Name, Resource: rr.Name}
+			res.quantities[fr] = resources.AmountFromQuantity(rr.Name, rr.Quantity)
+		}
+	}
+	return res
+}
+
+// reservationReferences returns the Reservations referenced by a ClusterQueue
+// or a Cohort, if the CapacityReservations feature is enabled.
+func reservationReferences(refs []kueue.ReservationReference) []kueue.ReservationReference {
+	if !features.Enabled(features.CapacityReservations) {
+		return nil
+	}
+	return refs
+}
+
+// AddOrUpdateReservation stores the Reservation, and returns the
+// ClusterQueues whose quota it books.
+func (c *Cache) AddOrUpdateReservation(r *kueue.Reservation) sets.Set[kueue.ClusterQueueReference] {
+	c.Lock()
+	defer c.Unlock()
+	name := kueue.ReservationReference(r.Name)
+	c.reservations[name] = newReservation(r)
+	return c.clusterQueuesUsingReservation(name)
+}
+
+// DeleteReservation removes the Reservation, and returns the ClusterQueues
+// whose quota it booked.
+func (c *Cache) DeleteReservation(name kueue.ReservationReference) sets.Set[kueue.ClusterQueueReference] {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.reservations, name)
+	return c.clusterQueuesUsingReservation(name)
+}
+
+// ClusterQueuesUsingReservation returns the ClusterQueues referencing the
+// Reservation, directly or through one of their ancestor Cohorts.
+func (c *Cache) ClusterQueuesUsingReservation(name kueue.ReservationReference) sets.Set[kueue.ClusterQueueReference] {
+	c.RLock()
+	defer c.RUnlock()
+	return c.clusterQueuesUsingReservation(name)
+}
+
+func (c *Cache) clusterQueuesUsingReservation(name kueue.ReservationReference) sets.Set[kueue.ClusterQueueReference] {
+	cqs := sets.New[kueue.ClusterQueueReference]()
+	for _, cq := range c.hm.ClusterQueues() {
+		if slices.Contains(cq.reservations, name) {
+			cqs.Insert(cq.Name)
+			continue
+		}
+		if !cq.HasParent() || hierarchy.HasCycle(cq.Parent()) {
+			continue
+		}
+		for ancestor := range cq.Parent().PathSelfToRoot() {
+			if slices.Contains(ancestor.reservations, name) {
+				cqs.Insert(cq.Name)
+				break
+			}
+		}
+	}
+	return cqs
+}
+
+// reservationSnapshot books the quota of a Reservation on the ClusterQueue or
+// Cohort referencing it. The booked quota is the reserved quantity not used
+// by the workloads tagged for the Reservation in the subtree of the node.
+//
+// It also books the quota reserved for a workflow in a ClusterQueue, with the
+// workloads of the workflow as the tagged workloads.
+type reservationSnapshot struct {
+	name kueue.ReservationReference
+	// workflow is the workflow the quota is reserved for, empty for a
+	// Reservation.
+	workflow   workflowKey
+	node       hierarchicalResourceNode
+	start      time.Time
+	quantities resources.FlavorResourceQuantities
+	// now is the time of the snapshot.
+	now time.Time
+	// tagged is the usage of the workloads tagged for the Reservation.
+	tagged resources.FlavorResourceQuantities
+	// booked is the usage added to the node while the Reservation is
+	// applied, nil otherwise.
+	booked resources.FlavorResourceQuantities
+}
+
+func newReservationSnapshot(name kueue.ReservationReference, r *reservation, node hierarchicalResourceNode, now time.Time) *reservationSnapshot {
+	rs := &reservationSnapshot{
+		name:       name,
+		node:       node,
+		start:      r.start,
+		now:        now,
+		quantities: make(resources.FlavorResourceQuantities, len(r.quantities)),
+		tagged:     make(resources.FlavorResourceQuantities, len(r.quantities)),
+	}
+	// Only the resources with quota in the subtree of the node are booked.
+	subtreeQuota := node.getResourceNode().SubtreeQuota
+	for fr, v := range r.quantities {
+		if _, found := subtreeQuota[fr]; found {
+			rs.quantities[fr] = v
+		}
+	}
+	return rs
+}
+
+// appliesTo returns whether the quota booked by the Reservation is
+// unavailable to the workload. That is, the workload isn't tagged for the
+// Reservation, and the window is open or the workload could still be running
+// when it opens.
+func (r *reservationSnapshot) appliesTo(wl *kueue.Workload) bool {
+	if r.workflow != "" {
+		key, found := workflowKeyOf(wl)
+		return !found || key != r.workflow
+	}
+	if wl.Labels[kueue.ReservationLabel] == string(r.name) {
+		return false
+	}
+	if !r.now.Before(r.start) {
+		return true
+	}
+	maxExec := wl.Spec.MaximumExecutionTimeSeconds
+	return maxExec == nil || r.now.Add(time.Duration(*maxExec)*time.Second).After(r.start)
+}
+
+func (r *reservationSnapshot) book() {
+	r.booked = make(resources.FlavorResourceQuantities, len(r.quantities))
+	for fr, v := range r.quantities {
+		if free := v.Sub(r.tagged[fr]); free.CmpInt64(0) > 0 {
+			r.booked[fr] = free
+			addUsage(r.node, fr, free)
+		}
+	}
+}
+
+func (r *reservationSnapshot) release() {
+	for fr, v := range r.booked {
+		removeUsage(r.node, fr, v)
+	}
+	r.booked = nil
+}
+
+func (r *reservationSnapshot) updateTagged(usage resources.FlavorResourceQuantities, op usageOp) {
+	applied := r.booked != nil
+	if applied {
+		r.release()
+	}
+	for fr, v := range usage {
+		if _, found := r.quantities[fr]; !found {
+			continue
+		}
+		if op == add {
+			r.tagged[fr] = r.tagged[fr].Add(v)
+		} else {
+			r.tagged[fr] = r.tagged[fr].Sub(v)
+		}
+	}
+	if applied {
+		r.book()
+	}
+}
+
+// updateReservationUsage tracks the usage of a workload tagged for one of the
+// Reservations booking the quota of the ClusterQueue or its ancestors.
+func (c *ClusterQueueSnapshot) updateReservationUsage(wl *kueue.Workload, usage resources.FlavorResourceQuantities, op usageOp) {
+	name, tagged := wl.Labels[kueue.ReservationLabel]
+	key, member := workflowKeyOf(wl)
+	if !tagged && !member {
+		return
+	}
+	for _, r := range c.reservations {
+		if r.workflow != "" {
+			if member && r.workflow == key {
+				r.updateTagged(usage, op)
+			}
+		} else if tagged && string(r.name) == name {
+			r.updateTagged(usage, op)
+		}
+	}
+}
+
+// ApplyReservations books, in the ClusterQueue of the workload and its
+// ancestors, the quota of the Reservations that the workload can't use. It
+// returns a function releasing the quota booked by this call.
+func (s *Snapshot) ApplyReservations(wl *workload.Info) func() {
+	cq := s.ClusterQueue(wl.ClusterQueue)
+	if cq == nil || len(cq.reservations) == 0 {
+		return func() {}
+	}
+	var applied []*reservationSnapshot
+	for _, r := range cq.reservations {
+		if r.booked == nil && r.appliesTo(wl.Obj) {
+			r.book()
+			applied = append(applied, r)
+		}
+	}
+	return func() {
+		for _, r := range applied {
+			r.release()
+		}
+	}
+}
+
+// AddReservationUsage tracks the usage added to the ClusterQueue of a
+// workload tagged for a Reservation.
+func (s *Snapshot) AddReservationUsage(wl *workload.Info, usage workload.Usage) {
+	if cq := s.ClusterQueue(wl.ClusterQueue); cq != nil {
+		cq.updateReservationUsage(wl.Obj, usage.Quota.Assigned, add)
+	}
+}
+
+// snapshotReservations adds to the snapshot the Reservations, referenced by
+// its ClusterQueues and Cohorts, whose window didn't end.
+func (c *Cache) snapshotReservations(snap *Snapshot) {
+	if !features.Enabled(features.CapacityReservations) || len(c.reservations) == 0 {
+		return
+	}
+	now := c.clock.Now()
+	addReservations := func(refs []kueue.ReservationReference, node hierarchicalResourceNode, cqs []*ClusterQueueSnapshot) {
+		for _, name := range refs {
+			r, found := c.reservations[name]
+			if !found || !now.Before(r.end) {
+				continue
+			}
+			rs := newReservationSnapshot(name, r, node, now)
+			for _, cq := range cqs {
+				cq.reservations = append(cq.reservations, rs)
+				for _, wl := range cq.Workloads {
+					if wl.Obj.Labels[kueue.ReservationLabel] == string(name) {
+						rs.updateTagged(wl.Usage().Quota.Assigned, add)
+					}
+				}
+			}
+		}
+	}
+	for _, cohort := range c.hm.Cohorts() {
+		if cohortSnapshot := snap.Cohort(cohort.Name); cohortSnapshot != nil && len(cohort.reservations) > 0 {
+			addReservations(cohort.reservations, cohortSnapshot, cohortSnapshot.SubtreeClusterQueues())
+		}
+	}
+	for _, cq := range c.hm.ClusterQueues() {
+		if cqSnapshot := snap.ClusterQueue(cq.Name); cqSnapshot != nil && len(cq.reservations) > 0 {
+			addReservations(cq.reservations, cqSnapshot, []*ClusterQueueSnapshot{cqSnapshot})
+		}
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestSnapshotApplyReservations(t *testing.T) {
+	now := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	milliCPU := func(v string) int64 {
+		return resources.AmountFromQuantity(corev1.ResourceCPU, resource.MustParse(v)).Int64()
+	}
+
+	cases := map[string]struct {
+		disableGate   bool
+		onCohort      bool
+		start, end    time.Time
+		tagged        bool
+		maxExecution  *int32
+		wantAvailable string
+	}{
+		"open window, untagged workload": {
+			start:         now.Add(-time.Hour),
+			end:           now.Add(time.Hour),
+			wantAvailable: "6",
+		},
+		"open window, tagged workload": {
+			start:         now.Add(-time.Hour),
+			end:           now.Add(time.Hour),
+			tagged:        true,
+			wantAvailable: "9",
+		},
+		"ended window": {
+			start:         now.Add(-2 * time.Hour),
+			end:           now.Add(-time.Hour),
+			wantAvailable: "9",
+		},
+		"future window, workload without maximum execution time": {
+			start:         now.Add(time.Hour),
+			end:           now.Add(2 * time.Hour),
+			wantAvailable: "6",
+		},
+		"future window, workload finishing before the window": {
+			start:         now.Add(time.Hour),
+			end:           now.Add(2 * time.Hour),
+			maxExecution:  new(int32(1800)),
+			wantAvailable: "9",
+		},
+		"future window, workload overlapping the window": {
+			start:         now.Add(time.Hour),
+			end:           now.Add(2 * time.Hour),
+			maxExecution:  new(int32(7200)),
+			wantAvailable: "6",
+		},
+		"reservation on the cohort": {
+			onCohort:      true,
+			start:         now.Add(-time.Hour),
+			end:           now.Add(time.Hour),
+			wantAvailable: "6",
+		},
+		"feature gate disabled": {
+			disableGate:   true,
+			start:         now.Add(-time.Hour),
+			end:           now.Add(time.Hour),
+			wantAvailable: "9",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.CapacityReservations, !tc.disableGate)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(now)))
+
+			cohort := utiltestingapi.MakeCohort("team").Obj()
+			cq := utiltestingapi.MakeClusterQueue("cq").
+				Cohort("team").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+				Obj()
+			if tc.onCohort {
+				cohort.Spec.Reservations = []kueue.ReservationReference{"resv"}
+			} else {
+				cq.Spec.Reservations = []kueue.ReservationReference{"resv"}
+			}
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cache.AddOrUpdateCohort(cohort); err != nil {
+				t.Fatalf("Adding the cohort: %v", err)
+			}
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Adding the cluster queue: %v", err)
+			}
+			gotCQs := cache.AddOrUpdateReservation(utiltestingapi.MakeReservation("resv", tc.start, tc.end).
+				Resource("default", corev1.ResourceCPU, "4").
+				Obj())
+			wantCQs := sets.New[kueue.ClusterQueueReference]("cq")
+			if tc.disableGate {
+				wantCQs = sets.New[kueue.ClusterQueueReference]()
+			}
+			if diff := cmp.Diff(wantCQs, gotCQs); diff != "" {
+				t.Errorf("Unexpected cluster queues using the reservation (-want,+got):\n%s", diff)
+			}
+			cache.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload("admitted", "ns").
+				Label(kueue.ReservationLabel, "resv").
+				Request(corev1.ResourceCPU, "1").
+				SimpleReserveQuota("cq", "default", now).
+				Obj())
+
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Taking the snapshot: %v", err)
+			}
+			wl := utiltestingapi.MakeWorkload("pending", "ns").Request(corev1.ResourceCPU, "1")
+			if tc.tagged {
+				wl.Label(kueue.ReservationLabel, "resv")
+			}
+			if tc.maxExecution != nil {
+				wl.MaximumExecutionTimeSeconds(*tc.maxExecution)
+			}
+			info := workload.NewInfo(wl.Obj())
+			info.ClusterQueue = "cq"
+
+			snapCQ := snapshot.ClusterQueue("cq")
+			revert := snapshot.ApplyReservations(info)
+			if got, want := snapCQ.Available(cpu).Int64(), milliCPU(tc.wantAvailable); got != want {
+				t.Errorf("Unexpected available CPU with the reservations applied, got %d, want %d", got, want)
+			}
+			// Applying the reservations again doesn't book the quota twice.
+			snapshot.ApplyReservations(info)()
+			if got, want := snapCQ.Available(cpu).Int64(), milliCPU(tc.wantAvailable); got != want {
+				t.Errorf("Unexpected available CPU after applying the reservations twice, got %d, want %d", got, want)
+			}
+			revert()
+			if got, want := snapCQ.Available(cpu).Int64(), milliCPU("9"); got != want {
+				t.Errorf("Unexpected available CPU after reverting the reservations, got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestSnapshotReservationTaggedUsage(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.CapacityReservations, true)
+	now := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	milliCPU := func(v string) int64 {
+		return resources.AmountFromQuantity(corev1.ResourceCPU, resource.MustParse(v)).Int64()
+	}
+	ctx, log := utiltesting.ContextWithLog(t)
+	cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(now)))
+	cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	if err := cache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		Reservations("resv").
+		Obj()); err != nil {
+		t.Fatalf("Adding the cluster queue: %v", err)
+	}
+	cache.AddOrUpdateReservation(utiltestingapi.MakeReservation("resv", now.Add(-time.Hour), now.Add(time.Hour)).
+		Resource("default", corev1.ResourceCPU, "4").
+		Obj())
+	snapshot, err := cache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Taking the snapshot: %v", err)
+	}
+	snapCQ := snapshot.ClusterQueue("cq")
+	pending := workload.NewInfo(utiltestingapi.MakeWorkload("pending", "ns").Request(corev1.ResourceCPU, "1").Obj())
+	pending.ClusterQueue = "cq"
+	tagged := workload.NewInfo(utiltestingapi.MakeWorkload("tagged", "ns").
+		Label(kueue.ReservationLabel, "resv").
+		Request(corev1.ResourceCPU, "3").
+		SimpleReserveQuota("cq", "default", now).
+		Obj())
+
+	defer snapshot.ApplyReservations(pending)()
+	if got, want := snapCQ.Available(cpu).Int64(), milliCPU("6"); got != want {
+		t.Errorf("Unexpected available CPU before adding the tagged workload, got %d, want %d", got, want)
+	}
+	// The tagged workload consumes the booked quota.
+	snapshot.AddWorkload(tagged)
+	if got, want := snapCQ.Available(cpu).Int64(), milliCPU("6"); got != want {
+		t.Errorf("Unexpected available CPU after adding the tagged workload, got %d, want %d", got, want)
+	}
+	revert := snapshot.SimulateWorkloadUsageRemoval([]*workload.Info{tagged})
+	if got, want := snapCQ.Available(cpu).Int64(), milliCPU("6"); got != want {
+		t.Errorf("Unexpected available CPU while simulating the removal of the tagged workload, got %d, want %d", got, want)
+	}
+	revert()
+	snapshot.RemoveWorkload(tagged)
+	if got, want := snapCQ.Available(cpu).Int64(), milliCPU("6"); got != want {
+		t.Errorf("Unexpected available CPU after removing the tagged workload, got %d, want %d", got, want)
+	}
+}
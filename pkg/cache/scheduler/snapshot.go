@@ -55,6 +55,9 @@ type Snapshot struct {
 	ResourceFlavors          map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor
 	InactiveClusterQueueSets sets.Set[kueue.ClusterQueueReference]
 	SimulatorSnapshot        simulator.SimulatorSnapshot
+	// workflowUsage is the usage of the workloads of each workflow, regardless
+	// of the flavors.
+	workflowUsage map[workflowKey]resources.Requests
 }
 
 // RemoveWorkload removes a workload from its corresponding ClusterQueue and
@@ -62,7 +65,10 @@ type Snapshot struct {
 func (s *Snapshot) RemoveWorkload(wl *workload.Info) {
 	cq := s.ClusterQueue(wl.ClusterQueue)
 	delete(cq.Workloads, workload.Key(wl.Obj))
-	cq.RemoveUsage(wl.Usage())
+	usage := wl.Usage()
+	cq.RemoveUsage(usage)
+	cq.updateReservationUsage(wl.Obj, usage.Quota.Assigned, subtract)
+	s.updateWorkflowUsage(wl.Obj, usage.Quota.Assigned, subtract)
 }
 
 // AddWorkload adds a workload to its corresponding ClusterQueue and
@@ -70,7 +76,10 @@ func (s *Snapshot) RemoveWorkload(wl *workload.Info) {
 func (s *Snapshot) AddWorkload(wl *workload.Info) {
 	cq := s.ClusterQueue(wl.ClusterQueue)
 	cq.Workloads[workload.Key(wl.Obj)] = wl
-	cq.AddUsage(wl.Usage())
+	usage := wl.Usage()
+	cq.AddUsage(usage)
+	cq.updateReservationUsage(wl.Obj, usage.Quota.Assigned, add)
+	s.updateWorkflowUsage(wl.Obj, usage.Quota.Assigned, add)
 }
 
 // SimulateWorkloadUsageRemoval modifies the snapshot by removing the usage
@@ -80,18 +89,23 @@ func (s *Snapshot) AddWorkload(wl *workload.Info) {
 func (s *Snapshot) SimulateWorkloadUsageRemoval(workloads []*workload.Info) func() {
 	type cqUsage struct {
 		cq    kueue.ClusterQueueReference
+		wl    *kueue.Workload
 		usage workload.Usage
 	}
 	cqUsages := make([]cqUsage, 0, len(workloads))
 	for _, w := range workloads {
-		cqUsages = append(cqUsages, cqUsage{cq: w.ClusterQueue, usage: w.Usage()})
+		cqUsages = append(cqUsages, cqUsage{cq: w.ClusterQueue, wl: w.Obj, usage: w.Usage()})
 	}
 	for _, cqUsage := range cqUsages {
-		s.ClusterQueue(cqUsage.cq).RemoveUsage(cqUsage.usage)
+		cq := s.ClusterQueue(cqUsage.cq)
+		cq.RemoveUsage(cqUsage.usage)
+		cq.updateReservationUsage(cqUsage.wl, cqUsage.usage.Quota.Assigned, subtract)
 	}
 	return func() {
 		for _, cqUsage := range cqUsages {
-			s.ClusterQueue(cqUsage.cq).AddUsage(cqUsage.usage)
+			cq := s.ClusterQueue(cqUsage.cq)
+			cq.AddUsage(cqUsage.usage)
+			cq.updateReservationUsage(cqUsage.wl, cqUsage.usage.Quota.Assigned, add)
 		}
 	}
 }
@@ -219,23 +233,21 @@ func (c *Cache) Snapshot(ctx context.Context, options ...SnapshotOption) (*Snaps
 		if features.Enabled(features.TASHandleOverlappingFlavors) {
 			aggregatedDomainUsages = make(map[utiltas.TopologyDomainID]resources.Requests)
 			for _, cache := range flvTASCache {
-				c.snapshotTopologyDomainUsages(cache, aggregatedDomainUsages)
+				for _, topologyCache := range cache.topologyCaches() {
+					c.snapshotTopologyDomainUsages(topologyCache, aggregatedDomainUsages)
+				}
 			}
 			log.V(4).Info("Aggregated TAS usage across flavors")
 		}
 		for flavor, cache := range flvTASCache {
-			// Only when this flavor is aggregation targets,
-			// we should propagate aggregated domain usages to snapshot constructions.
-			var aggregatedDomainUsagesForFlavor map[utiltas.TopologyDomainID]resources.Requests
-			if features.Enabled(features.TASHandleOverlappingFlavors) && utiltas.IsLowestLevelHostname(cache.topology.Levels) {
-				aggregatedDomainUsagesForFlavor = aggregatedDomainUsages
-			}
+			// The snapshot propagates the aggregated domain usages only to the
+			// topologies which are aggregation targets.
 			var err error
 			tasSnapshots[flavor], err = cache.snapshot(
 				ctx,
 				log,
 				snap.SimulatorSnapshot,
-				aggregatedDomainUsagesForFlavor,
+				aggregatedDomainUsages,
 			)
 			if err != nil {
 				return nil, err
@@ -262,6 +274,8 @@ func (c *Cache) Snapshot(ctx context.Context, options ...SnapshotOption) (*Snaps
 			}
 		}
 	}
+	c.snapshotReservations(&snap)
+	c.snapshotWorkflows(&snap)
 	// Shallow copy is enough
 	maps.Copy(snap.ResourceFlavors, c.resourceFlavors)
 	return &snap, nil
@@ -320,6 +334,8 @@ func (c *Cache) snapshotClusterQueue(
 		Preemption:                    cq.Preemption,
 		NamespaceSelector:             cq.NamespaceSelector,
 		Status:                        cq.Status,
+		QuotaWindow:                   cq.activeQuotaWindow,
+		Draining:                      cq.isDraining(),
 		AdmissionChecks:               utilmaps.DeepCopySets(cq.AdmissionChecks),
 		ResourceNode:                  cq.resourceNode.Clone(),
 		ConcurrentAdmissionPolicy:     cq.ConcurrentAdmissionPolicy,
@@ -327,6 +343,7 @@ func (c *Cache) snapshotClusterQueue(
 		tasOnly:                       cq.isTASOnly(),
 		flavorsForProvReqACs:          cq.flavorsWithProvReqAdmissionCheck(),
 		hasMultiKueueAC:               cq.hasMultiKueueAdmissionCheck(),
+		workflowReservationLimit:      cq.workflowReservationLimit,
 	}
 	for i, rg := range cq.ResourceGroups {
 		cc.ResourceGroups[i] = rg.Clone()
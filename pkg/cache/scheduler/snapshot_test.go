@@ -53,6 +53,7 @@ var snapCmpOpts = cmp.Options{
 	cmpopts.IgnoreUnexported(hierarchy.Manager[*ClusterQueueSnapshot, *CohortSnapshot]{}),
 	cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
 	cmpopts.IgnoreFields(Snapshot{}, "SimulatorSnapshot"),
+	cmpopts.IgnoreUnexported(Snapshot{}),
 }
 
 func TestSnapshot(t *testing.T) {
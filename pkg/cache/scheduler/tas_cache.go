@@ -35,7 +35,7 @@ import (
 type tasCache struct {
 	sync.RWMutex
 	client            client.Client
-	flavors           map[kueue.ResourceFlavorReference]flavorInformation
+	flavors           map[kueue.ResourceFlavorReference][]flavorInformation
 	topologies        map[kueue.TopologyReference]topologyInformation
 	flavorCache       map[kueue.ResourceFlavorReference]*TASFlavorCache
 	resourceFormatter *resources.ResourceFormatter
@@ -48,7 +48,7 @@ type tasCache struct {
 func NewTASCache(client client.Client, schedulingSimulator simulator.SchedulingSimulator, resourceFormatter *resources.ResourceFormatter) tasCache {
 	return tasCache{
 		client:            client,
-		flavors:           make(map[kueue.ResourceFlavorReference]flavorInformation),
+		flavors:           make(map[kueue.ResourceFlavorReference][]flavorInformation),
 		topologies:        make(map[kueue.TopologyReference]topologyInformation),
 		flavorCache:       make(map[kueue.ResourceFlavorReference]*TASFlavorCache),
 		resourceFormatter: resourceFormatter,
@@ -80,26 +80,54 @@ func (t *tasCache) AddOrUpdateFlavor(flavor *kueue.ResourceFlavor) {
 	defer t.Unlock()
 	name := kueue.ResourceFlavorReference(flavor.Name)
 	tolerations := slices.Clone(flavor.Spec.Tolerations)
-	nodeLabels := maps.Clone(flavor.Spec.NodeLabels)
-	if flavorInfo, ok := t.flavors[name]; ok {
-		flavorInfo.Tolerations = tolerations
-		flavorInfo.NodeLabels = nodeLabels
-		t.flavors[name] = flavorInfo
+	flavorTopologies := utiltas.FlavorTopologies(flavor)
+	// A flavor referencing several topologies has one flavorInformation per
+	// topology, in the order in which they are tried.
+	flavorInfos := make([]flavorInformation, 0, len(flavorTopologies))
+	for _, topology := range flavorTopologies {
+		flavorInfos = append(flavorInfos, flavorInformation{
+			TopologyName: topology.Name,
+			NodeLabels:   topology.NodeLabels,
+			Tolerations:  tolerations,
+		})
+	}
+	if _, ok := t.flavors[name]; ok {
+		t.flavors[name] = flavorInfos
 		if flavorCache, ok := t.flavorCache[name]; ok {
-			flavorCache.updateTolerations(tolerations)
-			flavorCache.updateNodeLabels(nodeLabels)
+			for _, flavorInfo := range flavorInfos {
+				if topologyCache := flavorCache.forTopology(flavorInfo.TopologyName); topologyCache != nil {
+					topologyCache.updateTolerations(flavorInfo.Tolerations)
+					topologyCache.updateNodeLabels(flavorInfo.NodeLabels)
+				}
+			}
 		}
 		return
 	}
-	flavorInfo := flavorInformation{
-		TopologyName: *flavor.Spec.TopologyName,
-		NodeLabels:   nodeLabels,
-		Tolerations:  tolerations,
+	t.flavors[name] = flavorInfos
+	if flavorCache := t.newFlavorCache(flavorInfos); flavorCache != nil {
+		t.flavorCache[name] = flavorCache
 	}
-	t.flavors[name] = flavorInfo
-	if tInfo, ok := t.topologies[flavorInfo.TopologyName]; ok {
-		t.flavorCache[name] = t.NewTASFlavorCache(tInfo, flavorInfo)
+}
+
+// newFlavorCache returns the cache of a flavor, or nil if any of the topologies
+// referenced by the flavor does not exist. For a flavor referencing several
+// topologies, the returned cache is the one of the first topology, holding the
+// caches of the other topologies as alternatives.
+func (t *tasCache) newFlavorCache(flavorInfos []flavorInformation) *TASFlavorCache {
+	var flavorCache *TASFlavorCache
+	for _, flavorInfo := range flavorInfos {
+		tInfo, ok := t.topologies[flavorInfo.TopologyName]
+		if !ok {
+			return nil
+		}
+		topologyCache := t.NewTASFlavorCache(tInfo, flavorInfo)
+		if flavorCache == nil {
+			flavorCache = topologyCache
+		} else {
+			flavorCache.alternatives = append(flavorCache.alternatives, topologyCache)
+		}
 	}
+	return flavorCache
 }
 
 func (t *tasCache) AddTopology(topology *kueue.Topology) {
@@ -107,19 +135,24 @@ func (t *tasCache) AddTopology(topology *kueue.Topology) {
 	defer t.Unlock()
 	name := kueue.TopologyReference(topology.Name)
 	tInfo := topologyInformation{
-		Levels: utiltas.Levels(topology),
+		Levels:    utiltas.Levels(topology),
+		Distances: topologyDistances(topology),
 	}
 	t.topologies[name] = tInfo
-	for fName, flavorInfo := range t.flavors {
-		if flavorInfo.TopologyName != name {
+	for fName, flavorInfos := range t.flavors {
+		if !slices.ContainsFunc(flavorInfos, func(flavorInfo flavorInformation) bool {
+			return flavorInfo.TopologyName == name
+		}) {
 			continue
 		}
 		if c, ok := t.flavorCache[fName]; ok {
-			// Update the levels in place: rebuilding the cache entry would drop
-			// the usage accumulated from admitted workloads.
-			c.updateTopology(tInfo)
-		} else {
-			t.flavorCache[fName] = t.NewTASFlavorCache(tInfo, flavorInfo)
+			// Update the levels and distances in place: rebuilding the cache
+			// entry would drop the usage accumulated from admitted workloads.
+			if topologyCache := c.forTopology(name); topologyCache != nil {
+				topologyCache.updateTopology(tInfo)
+			}
+		} else if c := t.newFlavorCache(flavorInfos); c != nil {
+			t.flavorCache[fName] = c
 		}
 	}
 }
@@ -136,12 +169,26 @@ func (t *tasCache) DeleteTopology(name kueue.TopologyReference) {
 	defer t.Unlock()
 	delete(t.topologies, name)
 	for flavor, c := range t.flavorCache {
-		if c.flavor.TopologyName == name {
+		if c.forTopology(name) != nil {
 			delete(t.flavorCache, flavor)
 		}
 	}
 }
 
+// MissingTopologies returns the topologies, among the given ones, which do
+// not exist.
+func (t *tasCache) MissingTopologies(names []kueue.TopologyReference) []kueue.TopologyReference {
+	t.RLock()
+	defer t.RUnlock()
+	var missing []kueue.TopologyReference
+	for _, name := range names {
+		if _, ok := t.topologies[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
 // UpdateNonTASUsage updates the non-TAS resource usage cache for the pod.
 // Returns the node name when capacity may have been freed on a node.
 func (t *tasCache) UpdateNonTASUsage(pod *corev1.Pod, log logr.Logger) string {
@@ -0,0 +1,346 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// maxDefragmentationCandidates bounds the number of pending workloads, from
+	// the head of the ClusterQueue, for which a defragmentation plan is searched.
+	maxDefragmentationCandidates = 8
+
+	// maxDefragmentationDomains bounds the number of topology domains, with the
+	// lowest deficit of free capacity, for which the evictions are simulated.
+	maxDefragmentationDomains = 8
+)
+
+// TASDefragmentationPlan is a set of admitted workloads whose eviction opens
+// a topology domain required by a PodSet of a pending workload, and which can
+// all be placed again after the pending workload.
+type TASDefragmentationPlan struct {
+	// Workload is the pending workload.
+	Workload *workload.Info
+
+	// PodSet is the PodSet of the pending workload requiring the domain.
+	PodSet kueue.PodSetReference
+
+	// Flavor is the ResourceFlavor of the domain.
+	Flavor kueue.ResourceFlavorReference
+
+	// Levels are the node labels of the levels, down to the required level.
+	Levels []string
+
+	// Domain are the node label values of the levels identifying the domain.
+	Domain []string
+
+	// Evictions are the admitted workloads to evict.
+	Evictions []*workload.Info
+}
+
+// PlanTASDefragmentation returns the defragmentation plan for the first
+// pending workload of the ClusterQueue, in the queueing order, which has one,
+// or nil when there is none.
+//
+// A plan is only searched for the PodSets with a required topology which
+// don't fit in any domain of a TAS flavor, but would fit in an empty one.
+// The evicted workloads are admitted workloads of the ClusterQueue with a
+// lower priority than the pending workload, using only the flavor, and at
+// most maxEvictions of them are evicted. Among the plans of a workload, the
+// one with the fewest evictions wins.
+//
+// The snapshot is left unchanged.
+func (s *Snapshot) PlanTASDefragmentation(ctx context.Context, cqName kueue.ClusterQueueReference, pending []*workload.Info, maxEvictions int) *TASDefragmentationPlan {
+	cq := s.ClusterQueue(cqName)
+	if cq == nil || len(cq.TASFlavors) == 0 {
+		return nil
+	}
+	for _, wl := range pending[:min(len(pending), maxDefragmentationCandidates)] {
+		if plan := s.planTASDefragmentation(ctx, cq, wl, maxEvictions); plan != nil {
+			return plan
+		}
+	}
+	return nil
+}
+
+func (s *Snapshot) planTASDefragmentation(ctx context.Context, cq *ClusterQueueSnapshot, wl *workload.Info, maxEvictions int) *TASDefragmentationPlan {
+	var best *TASDefragmentationPlan
+	for i := range wl.Obj.Spec.PodSets {
+		ps := &wl.Obj.Spec.PodSets[i]
+		// The PodSets grouped with a leader are placed together, which the
+		// plan doesn't simulate.
+		if !isRequired(ps.TopologyRequest) || ps.TopologyRequest.PodSetGroupName != nil {
+			continue
+		}
+		psResources := podSetResources(wl, ps.Name)
+		if psResources == nil || psResources.Count == 0 {
+			continue
+		}
+		for _, rg := range cq.ResourceGroups {
+			for _, flavor := range rg.Flavors {
+				tasFlavor := cq.TASFlavors[flavor]
+				// Flavors referencing several topologies are not compacted, as
+				// the plans are computed for the domains of a single topology.
+				if tasFlavor == nil || len(tasFlavor.alternatives) > 0 || !tasFlavor.HasLevel(ps.TopologyRequest) {
+					continue
+				}
+				quota := make(resources.FlavorResourceQuantities)
+				psResources.Requests.ForEach(func(name corev1.ResourceName, value int64) {
+					if rg.CoveredResources.Has(name) {
+						quota[resources.FlavorResource{Flavor: flavor, Resource: name}] = resources.NewAmount(value)
+					}
+				})
+				if len(quota) == 0 || cq.Fits(workload.Usage{Quota: workload.ResourceUsage{Assigned: quota}}) != FitsCheckOk {
+					continue
+				}
+				tr := TASPodSetRequests{
+					PodSet:            ps,
+					SinglePodRequests: resources.NewRequestsFromPodSpec(&ps.Template.Spec),
+					Count:             psResources.Count,
+					Flavor:            flavor,
+				}
+				plan := s.planTASDefragmentationForPodSet(ctx, cq, tasFlavor, wl, tr, maxEvictions)
+				if plan != nil && (best == nil || len(plan.Evictions) < len(best.Evictions)) {
+					best = plan
+				}
+			}
+		}
+	}
+	return best
+}
+
+func (s *Snapshot) planTASDefragmentationForPodSet(ctx context.Context, cq *ClusterQueueSnapshot, tasFlavor *TASFlavorSnapshot, wl *workload.Info, tr TASPodSetRequests, maxEvictions int) *TASDefragmentationPlan {
+	requests := FlavorTASRequests{tr}
+	if tasFlavor.FindTopologyAssignmentsForFlavor(ctx, requests, WithWorkload(wl.Obj)).Failure() == nil {
+		// The PodSet fits already, so the workload is pending for another reason.
+		return nil
+	}
+	if tasFlavor.FindTopologyAssignmentsForFlavor(ctx, requests, WithWorkload(wl.Obj), WithSimulateEmpty(true)).Failure() != nil {
+		return nil
+	}
+	levelIdx, _ := tasFlavor.resolveLevelIdx(*tr.PodSet.TopologyRequest.Required)
+	podRequests := tr.SinglePodRequests.Clone()
+	podRequests.Add(resources.OnePodRequest)
+
+	// Start from the domains missing the fewest pods to fit the PodSet.
+	free := tasFlavor.freePodsPerDomain(levelIdx, podRequests, false)
+	capacity := tasFlavor.freePodsPerDomain(levelIdx, podRequests, true)
+	var domains []*domain
+	for _, d := range tasFlavor.domainsPerLevel[levelIdx] {
+		if capacity[utiltas.DomainID(d.levelValues)] >= tr.Count {
+			domains = append(domains, d)
+		}
+	}
+	slices.SortFunc(domains, func(a, b *domain) int {
+		if c := cmp.Compare(free[utiltas.DomainID(b.levelValues)], free[utiltas.DomainID(a.levelValues)]); c != 0 {
+			return c
+		}
+		return tasFlavor.compareDomainLevelValues(a, b)
+	})
+
+	var best *TASDefragmentationPlan
+	for _, d := range domains[:min(len(domains), maxDefragmentationDomains)] {
+		plan := s.planTASDefragmentationForDomain(ctx, cq, tasFlavor, wl, tr, d, levelIdx, maxEvictions)
+		if plan != nil && (best == nil || len(plan.Evictions) < len(best.Evictions)) {
+			best = plan
+		}
+	}
+	return best
+}
+
+// planTASDefragmentationForDomain evicts the candidate workloads using the
+// domain, starting from the lowest priority, until the PodSet fits. The plan
+// is only returned when all the evicted workloads can be placed again after
+// the PodSet.
+func (s *Snapshot) planTASDefragmentationForDomain(ctx context.Context, cq *ClusterQueueSnapshot, tasFlavor *TASFlavorSnapshot, wl *workload.Info, tr TASPodSetRequests, d *domain, levelIdx int, maxEvictions int) *TASDefragmentationPlan {
+	log := log.FromContext(ctx)
+	// The IDs of the leaves hold only the hostname for the topologies with
+	// the hostname level, so the domain is identified by its level values.
+	domainID := utiltas.DomainID(d.levelValues)
+	wlPriority := priority.EffectivePriority(log, wl.Obj)
+	type candidate struct {
+		wl       *workload.Info
+		priority int64
+		pods     int32
+	}
+	var candidates []candidate
+	for _, admitted := range cq.Workloads {
+		if !workload.IsAdmitted(admitted.Obj) || apimeta.IsStatusConditionTrue(admitted.Obj.Status.Conditions, kueue.WorkloadEvicted) {
+			continue
+		}
+		usage := admitted.TASUsage()
+		if len(usage) != 1 || usage[tr.Flavor] == nil {
+			continue
+		}
+		p := priority.EffectivePriority(log, admitted.Obj)
+		if p >= wlPriority {
+			continue
+		}
+		if pods := tasFlavor.podsInDomain(usage[tr.Flavor], domainID); pods > 0 {
+			candidates = append(candidates, candidate{wl: admitted, priority: p, pods: pods})
+		}
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if c := cmp.Compare(a.priority, b.priority); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.pods, a.pods); c != 0 {
+			return c
+		}
+		return cmp.Compare(workload.Key(a.wl.Obj), workload.Key(b.wl.Obj))
+	})
+
+	podRequests := tr.SinglePodRequests.Clone()
+	podRequests.Add(resources.OnePodRequest)
+	var evictions []*workload.Info
+	for _, c := range candidates {
+		if len(evictions) == maxEvictions {
+			return nil
+		}
+		evictions = append(evictions, c.wl)
+		restore := s.SimulateWorkloadRemoval([]*workload.Info{c.wl})
+		defer restore()
+
+		if tasFlavor.freePodsPerDomain(levelIdx, podRequests, false)[domainID] < tr.Count {
+			continue
+		}
+		result := tasFlavor.FindTopologyAssignmentsForFlavor(ctx, FlavorTASRequests{tr}, WithWorkload(wl.Obj))
+		if result.Failure() != nil {
+			continue
+		}
+		assignment := result[tr.PodSet.Name].TopologyAssignment
+		if !tasFlavor.fitsAfter(ctx, assignment, tr, evictions) {
+			return nil
+		}
+		leaf := tasFlavor.leaves[utiltas.DomainID(assignment.Domains[0].Values)]
+		return &TASDefragmentationPlan{
+			Workload:  wl,
+			PodSet:    tr.PodSet.Name,
+			Flavor:    tr.Flavor,
+			Levels:    slices.Clone(tasFlavor.levelKeys[:levelIdx+1]),
+			Domain:    slices.Clone(leaf.levelValues[:levelIdx+1]),
+			Evictions: evictions,
+		}
+	}
+	return nil
+}
+
+// fitsAfter reports whether the evicted workloads can all be placed again,
+// from the highest priority, after the PodSet is placed with the assignment.
+func (s *TASFlavorSnapshot) fitsAfter(ctx context.Context, assignment *utiltas.TopologyAssignment, tr TASPodSetRequests, evictions []*workload.Info) bool {
+	s.updateAssignmentUsage(assignment, tr.SinglePodRequests, add)
+	defer s.updateAssignmentUsage(assignment, tr.SinglePodRequests, subtract)
+
+	evictions = slices.Clone(evictions)
+	slices.Reverse(evictions)
+	for _, evicted := range evictions {
+		requests := evictedTASRequests(evicted, tr.Flavor)
+		result := s.FindTopologyAssignmentsForFlavor(ctx, requests)
+		if result.Failure() != nil {
+			return false
+		}
+		for _, r := range requests {
+			placement := result[r.PodSet.Name].TopologyAssignment
+			s.updateAssignmentUsage(placement, r.SinglePodRequests, add)
+			defer s.updateAssignmentUsage(placement, r.SinglePodRequests, subtract)
+		}
+	}
+	return true
+}
+
+// evictedTASRequests returns the TAS requests placing the PodSets of the
+// admitted workload in the flavor again.
+func evictedTASRequests(wl *workload.Info, flavor kueue.ResourceFlavorReference) FlavorTASRequests {
+	var requests FlavorTASRequests
+	for _, psResources := range wl.TotalRequests {
+		if psResources.TopologyRequest == nil || psResources.Count == 0 {
+			continue
+		}
+		idx := slices.IndexFunc(wl.Obj.Spec.PodSets, func(ps kueue.PodSet) bool { return ps.Name == psResources.Name })
+		if idx == -1 {
+			continue
+		}
+		ps := &wl.Obj.Spec.PodSets[idx]
+		tr := TASPodSetRequests{
+			PodSet:            ps,
+			SinglePodRequests: resources.NewRequestsFromPodSpec(&ps.Template.Spec),
+			Count:             psResources.Count,
+			Flavor:            flavor,
+			Implied:           ps.TopologyRequest == nil,
+		}
+		if ps.TopologyRequest != nil {
+			tr.PodSetGroupName = ps.TopologyRequest.PodSetGroupName
+		}
+		requests = append(requests, tr)
+	}
+	return requests
+}
+
+func podSetResources(wl *workload.Info, name kueue.PodSetReference) *workload.PodSetResources {
+	for i := range wl.TotalRequests {
+		if wl.TotalRequests[i].Name == name {
+			return &wl.TotalRequests[i]
+		}
+	}
+	return nil
+}
+
+// updateAssignmentUsage adds, or subtracts, the usage of the pods placed
+// with the topology assignment.
+func (s *TASFlavorSnapshot) updateAssignmentUsage(assignment *utiltas.TopologyAssignment, singlePodRequests resources.Requests, op usageOp) {
+	for _, d := range assignment.Domains {
+		s.updateTASUsage(utiltas.DomainID(d.Values), singlePodRequests.ScaledUp(int64(d.Count)), op, d.Count)
+	}
+}
+
+// freePodsPerDomain returns the number of pods with the requests which fit in
+// the free capacity of each domain of the level, as reported by
+// SerializeFreeCapacityPerDomain for the leaves of the domain. When
+// simulateEmpty is set, the usage of the TAS workloads is ignored.
+func (s *TASFlavorSnapshot) freePodsPerDomain(levelIdx int, requests resources.Requests, simulateEmpty bool) map[utiltas.TopologyDomainID]int32 {
+	result := make(map[utiltas.TopologyDomainID]int32, len(s.domainsPerLevel[levelIdx]))
+	for _, leaf := range s.leaves {
+		remaining := s.remainingCapacityForLeaf(leaf, simulateEmpty, false)
+		result[utiltas.DomainID(leaf.levelValues[:levelIdx+1])] += requests.CountIn(remaining.Get())
+	}
+	return result
+}
+
+// podsInDomain returns the number of pods of the TAS usage placed in the
+// domain.
+func (s *TASFlavorSnapshot) podsInDomain(usage workload.TASFlavorUsage, domainID utiltas.TopologyDomainID) int32 {
+	var count int32
+	for _, tr := range usage {
+		if leaf := s.leaves[utiltas.DomainID(tr.Values)]; leaf != nil && utiltas.DomainID(leaf.levelValues).BelongsTo(domainID) {
+			count += tr.Count
+		}
+	}
+	return count
+}
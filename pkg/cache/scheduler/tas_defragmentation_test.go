@@ -0,0 +1,221 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestPlanTASDefragmentation(t *testing.T) {
+	const tasBlockLabel = utiltesting.DefaultBlockTopologyLevel
+	now := time.Now().Truncate(time.Second)
+
+	//      b1          b2
+	//   /     \     /     \
+	//  x1     x2   x3     x4
+	//  2      2    2      2    (cpu)
+	makeNode := func(block, host string) *corev1.Node {
+		return testingnode.MakeNode(host).
+			Label(tasBlockLabel, block).
+			Label(corev1.LabelHostname, host).
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("2"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj()
+	}
+	nodes := []*corev1.Node{
+		makeNode("b1", "x1"),
+		makeNode("b1", "x2"),
+		makeNode("b2", "x3"),
+		makeNode("b2", "x4"),
+	}
+	admitted := func(name string, priority int32, hosts ...string) *kueue.Workload {
+		ta := utiltestingapi.MakeTopologyAssignment([]string{corev1.LabelHostname})
+		for _, host := range hosts {
+			ta.Domain(utiltestingapi.MakeTopologyDomainAssignment([]string{host}, 1).Obj())
+		}
+		count := int32(len(hosts))
+		return utiltestingapi.MakeWorkload(name, metav1.NamespaceDefault).
+			Priority(priority).
+			PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, int(count)).
+				PreferredTopologyRequest(tasBlockLabel).
+				Request(corev1.ResourceCPU, "1").
+				Obj()).
+			ReserveQuotaAt(utiltestingapi.MakeAdmission("cq").
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "tas", resource.NewQuantity(int64(count), resource.DecimalSI).String()).
+					Count(count).
+					TopologyAssignment(ta.Obj()).
+					Obj()).
+				Obj(), now).
+			AdmittedAt(true, now).
+			Obj()
+	}
+	pending := func(count int) *kueue.Workload {
+		return utiltestingapi.MakeWorkload("pending", metav1.NamespaceDefault).
+			Priority(10).
+			PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, count).
+				RequiredTopologyRequest(tasBlockLabel).
+				Request(corev1.ResourceCPU, "1").
+				Obj()).
+			Obj()
+	}
+
+	cases := map[string]struct {
+		admitted      []*kueue.Workload
+		pending       *kueue.Workload
+		maxEvictions  int
+		wantDomain    []string
+		wantEvictions []string
+	}{
+		"evicts a workload of the domain missing the fewest pods": {
+			admitted: []*kueue.Workload{
+				admitted("b1-low", 0, "x1"),
+				admitted("b2-low", 0, "x3"),
+			},
+			pending:       pending(4),
+			maxEvictions:  4,
+			wantDomain:    []string{"b1"},
+			wantEvictions: []string{"b1-low"},
+		},
+		"evicts the lowest priority first": {
+			admitted: []*kueue.Workload{
+				admitted("b1-mid", 5, "x1"),
+				admitted("b1-low", 0, "x2"),
+				admitted("b2-high", 20, "x3", "x3", "x4"),
+			},
+			pending:       pending(3),
+			maxEvictions:  4,
+			wantDomain:    []string{"b1"},
+			wantEvictions: []string{"b1-low"},
+		},
+		"workloads with a higher priority are kept": {
+			admitted: []*kueue.Workload{
+				admitted("b1-high", 20, "x1"),
+				admitted("b2-high", 20, "x3"),
+			},
+			pending:      pending(4),
+			maxEvictions: 4,
+		},
+		"fewest evictions win": {
+			admitted: []*kueue.Workload{
+				admitted("b1-low-1", 0, "x1"),
+				admitted("b1-low-2", 0, "x2"),
+				admitted("b2-low", 0, "x3", "x4"),
+			},
+			pending:       pending(4),
+			maxEvictions:  4,
+			wantDomain:    []string{"b2"},
+			wantEvictions: []string{"b2-low"},
+		},
+		"more evictions needed than allowed": {
+			admitted: []*kueue.Workload{
+				admitted("b1-low-1", 0, "x1"),
+				admitted("b1-low-2", 0, "x2"),
+				admitted("b2-low-1", 0, "x3"),
+				admitted("b2-low-2", 0, "x4"),
+			},
+			pending:      pending(4),
+			maxEvictions: 1,
+		},
+		"evicted workloads can't be placed again": {
+			admitted: []*kueue.Workload{
+				admitted("b1-low", 0, "x1"),
+				admitted("b2-high", 20, "x3", "x3", "x4", "x4"),
+			},
+			pending:      pending(4),
+			maxEvictions: 4,
+		},
+		"the pending workload fits already": {
+			admitted: []*kueue.Workload{
+				admitted("b1-low", 0, "x1"),
+			},
+			pending:      pending(4),
+			maxEvictions: 4,
+		},
+		"the pending workload doesn't fit in an empty domain": {
+			admitted: []*kueue.Workload{
+				admitted("b1-low", 0, "x1"),
+			},
+			pending:      pending(5),
+			maxEvictions: 4,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient())
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("tas").TopologyName("topology").Obj())
+			cache.AddOrUpdateTopology(log, utiltestingapi.MakeTopology("topology").Levels(tasBlockLabel, corev1.LabelHostname).Obj())
+			cq := utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("tas").Resource(corev1.ResourceCPU, "100").Obj()).
+				Obj()
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Adding the ClusterQueue: %v", err)
+			}
+			for _, n := range nodes {
+				cache.TASCache().SyncNode(n)
+			}
+			for _, wl := range tc.admitted {
+				cache.AddOrUpdateWorkload(log, wl)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Taking the snapshot: %v", err)
+			}
+			tasFlavor := snapshot.ClusterQueue("cq").TASFlavors["tas"]
+			onePod := resources.NewRequestsFromMap(map[corev1.ResourceName]int64{corev1.ResourceCPU: 1000, corev1.ResourcePods: 1})
+			wantFreePods := tasFlavor.freePodsPerDomain(1, onePod, false)
+
+			plan := snapshot.PlanTASDefragmentation(ctx, "cq", []*workload.Info{workload.NewInfo(tc.pending)}, tc.maxEvictions)
+			var gotDomain, gotEvictions []string
+			if plan != nil {
+				gotDomain = plan.Domain
+				for _, wl := range plan.Evictions {
+					gotEvictions = append(gotEvictions, wl.Obj.Name)
+				}
+				if diff := cmp.Diff([]string{tasBlockLabel}, plan.Levels); diff != "" {
+					t.Errorf("Unexpected levels (-want,+got):\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.wantDomain, gotDomain); diff != "" {
+				t.Errorf("Unexpected domain (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantEvictions, gotEvictions); diff != "" {
+				t.Errorf("Unexpected evictions (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(wantFreePods, tasFlavor.freePodsPerDomain(1, onePod, false)); diff != "" {
+				t.Errorf("The snapshot was not restored (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
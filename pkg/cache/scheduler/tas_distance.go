@@ -0,0 +1,199 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"slices"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+// maxClosestDomainsSeeds bounds the number of domains from which the search
+// for the closest set of domains is started, so that the search remains
+// affordable for the levels with many domains.
+const maxClosestDomainsSeeds = 32
+
+// domainPair is an unordered pair of the node label values of two topology
+// domains of a level.
+type domainPair struct {
+	a, b string
+}
+
+func newDomainPair(a, b string) domainPair {
+	if a > b {
+		a, b = b, a
+	}
+	return domainPair{a: a, b: b}
+}
+
+// levelDistances holds the network distances between the topology domains of
+// a level, as defined in the Topology spec.distances field.
+type levelDistances struct {
+	distances map[domainPair]int32
+
+	// unlisted is the distance between the domains without a listed distance,
+	// which is the largest distance listed for the level.
+	unlisted int32
+}
+
+func (d *levelDistances) between(a, b string) int32 {
+	if a == b {
+		return 0
+	}
+	if distance, found := d.distances[newDomainPair(a, b)]; found {
+		return distance
+	}
+	return d.unlisted
+}
+
+// topologyDistances returns the distances between the domains of the levels
+// of the topology, keyed by the node label of the level.
+func topologyDistances(topology *kueue.Topology) map[string]*levelDistances {
+	if len(topology.Spec.Distances) == 0 {
+		return nil
+	}
+	result := make(map[string]*levelDistances, len(topology.Spec.Distances))
+	for _, level := range topology.Spec.Distances {
+		distances := &levelDistances{
+			distances: make(map[domainPair]int32, len(level.Domains)),
+		}
+		for _, d := range level.Domains {
+			distances.distances[newDomainPair(d.From, d.To)] = d.Distance
+			distances.unlisted = max(distances.unlisted, d.Distance)
+		}
+		result[level.NodeLabel] = distances
+	}
+	return result
+}
+
+// distancesAt returns the distances between the domains of the level, or nil
+// when the topology does not define them.
+func (s *TASFlavorSnapshot) distancesAt(levelIdx int) *levelDistances {
+	if !features.Enabled(features.TASNetworkDistance) || levelIdx >= len(s.levelKeys) {
+		return nil
+	}
+	return s.distances[s.levelKeys[levelIdx]]
+}
+
+// closestDomainsForState narrows down the domains, sorted in the order of
+// preference of the assignment algorithm, to the closest ones which fit the
+// needed number of pods, or slices.
+//
+// The domains are left unchanged for the PodSets with leaders, which are
+// placed in a dedicated pass, and for the unconstrained PodSets, which have no
+// preference for close domains.
+func (s *TASFlavorSnapshot) closestDomainsForState(domains []*domain, levelIdx int, needed int32, slices bool, state *findTopologyAssignmentState) []*domain {
+	if state.leaderCount > 0 || state.unconstrained {
+		return domains
+	}
+	countForDomain := func(d *domain) int32 {
+		return s.domainStateOf(d).podCount
+	}
+	if slices {
+		countForDomain = func(d *domain) int32 {
+			return s.domainStateOf(d).sliceCount
+		}
+	}
+	return s.closestDomains(domains, levelIdx, needed, countForDomain)
+}
+
+// closestDomains selects, among the domains sorted in the order of preference
+// of the assignment algorithm, a set of domains which together fit the needed
+// count, with the lowest total distance between the pairs of selected domains.
+//
+// The set is built greedily from each of the first domains, adding the domain
+// closest to the ones already selected, and the set with the lowest total
+// distance wins. Ties are broken by the number of domains, and then by the
+// order of preference. The selected domains are returned in their order of
+// preference, so that the domain which is only partially used is the last.
+//
+// The domains are returned unchanged when the first domain alone fits the
+// needed count, when the topology does not define the distances for the
+// level, or when the domains cannot fit the needed count together.
+func (s *TASFlavorSnapshot) closestDomains(domains []*domain, levelIdx int, needed int32, countForDomain domainCountFunc) []*domain {
+	distances := s.distancesAt(levelIdx)
+	if distances == nil || len(domains) < 2 || countForDomain(domains[0]) >= needed {
+		return domains
+	}
+	candidates := make([]*domain, 0, len(domains))
+	var capacity int32
+	for _, d := range domains {
+		if count := countForDomain(d); count > 0 {
+			candidates = append(candidates, d)
+			capacity += count
+		}
+	}
+	if capacity < needed {
+		return domains
+	}
+
+	values := make([]string, len(candidates))
+	for i, d := range candidates {
+		values[i] = d.levelValues[len(d.levelValues)-1]
+	}
+
+	var best []int
+	var bestCost int64
+	for seed := range min(len(candidates), maxClosestDomainsSeeds) {
+		selected, cost := closestDomainsFrom(seed, candidates, values, distances, needed, countForDomain)
+		if best == nil || cost < bestCost || (cost == bestCost && len(selected) < len(best)) {
+			best, bestCost = selected, cost
+		}
+	}
+
+	slices.Sort(best)
+	result := make([]*domain, 0, len(best))
+	for _, i := range best {
+		result = append(result, candidates[i])
+	}
+	return result
+}
+
+// closestDomainsFrom greedily selects the candidates closest to the seed
+// until the needed count fits. It returns the indexes of the selected
+// candidates, along with the total distance between the pairs of them.
+func closestDomainsFrom(seed int, candidates []*domain, values []string, distances *levelDistances, needed int32, countForDomain domainCountFunc) ([]int, int64) {
+	selected := make([]bool, len(candidates))
+	// distanceToSelected is the total distance of each candidate to the
+	// selected candidates.
+	distanceToSelected := make([]int64, len(candidates))
+	var result []int
+	var cost int64
+	var count int32
+	next := seed
+	for {
+		selected[next] = true
+		result = append(result, next)
+		cost += distanceToSelected[next]
+		count += countForDomain(candidates[next])
+		if count >= needed {
+			return result, cost
+		}
+		for i := range candidates {
+			if !selected[i] {
+				distanceToSelected[i] += int64(distances.between(values[next], values[i]))
+			}
+		}
+		next = -1
+		for i := range candidates {
+			if !selected[i] && (next == -1 || distanceToSelected[i] < distanceToSelected[next]) {
+				next = i
+			}
+		}
+	}
+}
@@ -0,0 +1,274 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	tasindexer "sigs.k8s.io/kueue/pkg/controller/tas/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/tas"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestTopologyDistances(t *testing.T) {
+	const (
+		tasBlockLabel = "cloud.com/topology-block"
+		tasRackLabel  = "cloud.com/topology-rack"
+	)
+	topology := &kueue.Topology{
+		Spec: kueue.TopologySpec{
+			Levels: []kueue.TopologyLevel{{NodeLabel: tasBlockLabel}, {NodeLabel: tasRackLabel}},
+			Distances: []kueue.TopologyLevelDistances{{
+				NodeLabel: tasBlockLabel,
+				Domains: []kueue.TopologyDomainDistance{
+					{From: "b1", To: "b2", Distance: 1},
+					{From: "b3", To: "b1", Distance: 5},
+				},
+			}},
+		},
+	}
+	distances := topologyDistances(topology)
+	if _, found := distances[tasRackLabel]; found {
+		t.Errorf("Unexpected distances for the level %q", tasRackLabel)
+	}
+	blockDistances := distances[tasBlockLabel]
+	if blockDistances == nil {
+		t.Fatalf("Missing distances for the level %q", tasBlockLabel)
+	}
+	cases := map[string]struct {
+		a, b string
+		want int32
+	}{
+		"listed pair":               {a: "b1", b: "b2", want: 1},
+		"listed pair; reversed":     {a: "b1", b: "b3", want: 5},
+		"unlisted pair":             {a: "b2", b: "b3", want: 5},
+		"unlisted domain":           {a: "b1", b: "b4", want: 5},
+		"same domain":               {a: "b4", b: "b4", want: 0},
+		"same domain; listed level": {a: "b1", b: "b1", want: 0},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := blockDistances.between(tc.a, tc.b); got != tc.want {
+				t.Errorf("Unexpected distance between %q and %q, want=%d, got=%d", tc.a, tc.b, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFindTopologyAssignmentsWithDistances(t *testing.T) {
+	const (
+		tasBlockLabel = "cloud.com/topology-block"
+		tasRackLabel  = "cloud.com/topology-rack"
+	)
+	makeNode := func(block, rack string, cpu string) corev1.Node {
+		return *testingnode.MakeNode(block+"-"+rack).
+			Label(tasBlockLabel, block).
+			Label(tasRackLabel, rack).
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse(cpu),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj()
+	}
+	//   b1    b2    b3    b4
+	//   4     3     3     2    (cpu)
+	blockNodes := []corev1.Node{
+		makeNode("b1", "r1", "4"),
+		makeNode("b2", "r1", "3"),
+		makeNode("b3", "r1", "3"),
+		makeNode("b4", "r1", "2"),
+	}
+	//              b1
+	//   /      /       \      \
+	//  r1     r2       r3     r4
+	//  4      3        3      2    (cpu)
+	rackNodes := []corev1.Node{
+		makeNode("b1", "r1", "4"),
+		makeNode("b1", "r2", "3"),
+		makeNode("b1", "r3", "3"),
+		makeNode("b1", "r4", "2"),
+	}
+	closeDistances := func(level, a, b string) []kueue.TopologyLevelDistances {
+		return []kueue.TopologyLevelDistances{{
+			NodeLabel: level,
+			Domains: []kueue.TopologyDomainDistance{
+				{From: a, To: b, Distance: 1},
+				{From: "b1", To: "b4", Distance: 10},
+				{From: "r1", To: "r4", Distance: 10},
+			},
+		}}
+	}
+	blockLevels := []string{tasBlockLabel}
+	rackLevels := []string{tasBlockLabel, tasRackLabel}
+
+	cases := map[string]struct {
+		enableDistances bool
+		nodes           []corev1.Node
+		levels          []string
+		distances       []kueue.TopologyLevelDistances
+		topologyRequest *kueue.PodSetTopologyRequest
+		count           int32
+		wantAssignment  *tas.TopologyAssignment
+	}{
+		"block preferred; feature disabled; BestFit": {
+			nodes:           blockNodes,
+			levels:          blockLevels,
+			distances:       closeDistances(tasBlockLabel, "b2", "b3"),
+			topologyRequest: &kueue.PodSetTopologyRequest{Preferred: new(tasBlockLabel)},
+			count:           6,
+			wantAssignment: &tas.TopologyAssignment{
+				Levels: blockLevels,
+				Domains: []tas.TopologyDomainAssignment{
+					{Count: 4, Values: []string{"b1"}},
+					{Count: 2, Values: []string{"b4"}},
+				},
+			},
+		},
+		"block preferred; no distances; BestFit": {
+			enableDistances: true,
+			nodes:           blockNodes,
+			levels:          blockLevels,
+			topologyRequest: &kueue.PodSetTopologyRequest{Preferred: new(tasBlockLabel)},
+			count:           6,
+			wantAssignment: &tas.TopologyAssignment{
+				Levels: blockLevels,
+				Domains: []tas.TopologyDomainAssignment{
+					{Count: 4, Values: []string{"b1"}},
+					{Count: 2, Values: []string{"b4"}},
+				},
+			},
+		},
+		"block preferred; spills to the closest blocks": {
+			enableDistances: true,
+			nodes:           blockNodes,
+			levels:          blockLevels,
+			distances:       closeDistances(tasBlockLabel, "b2", "b3"),
+			topologyRequest: &kueue.PodSetTopologyRequest{Preferred: new(tasBlockLabel)},
+			count:           6,
+			wantAssignment: &tas.TopologyAssignment{
+				Levels: blockLevels,
+				Domains: []tas.TopologyDomainAssignment{
+					{Count: 3, Values: []string{"b2"}},
+					{Count: 3, Values: []string{"b3"}},
+				},
+			},
+		},
+		"block preferred; fits in a single block; distances ignored": {
+			enableDistances: true,
+			nodes:           blockNodes,
+			levels:          blockLevels,
+			distances:       closeDistances(tasBlockLabel, "b2", "b3"),
+			topologyRequest: &kueue.PodSetTopologyRequest{Preferred: new(tasBlockLabel)},
+			count:           3,
+			wantAssignment: &tas.TopologyAssignment{
+				Levels: blockLevels,
+				Domains: []tas.TopologyDomainAssignment{
+					{Count: 3, Values: []string{"b2"}},
+				},
+			},
+		},
+		"block required; spills to the closest racks": {
+			enableDistances: true,
+			nodes:           rackNodes,
+			levels:          rackLevels,
+			distances:       closeDistances(tasRackLabel, "r2", "r3"),
+			topologyRequest: &kueue.PodSetTopologyRequest{Required: new(tasBlockLabel)},
+			count:           6,
+			wantAssignment: &tas.TopologyAssignment{
+				Levels: rackLevels,
+				Domains: []tas.TopologyDomainAssignment{
+					{Count: 3, Values: []string{"b1", "r2"}},
+					{Count: 3, Values: []string{"b1", "r3"}},
+				},
+			},
+		},
+		"block required; distances defined for another level": {
+			enableDistances: true,
+			nodes:           rackNodes,
+			levels:          rackLevels,
+			distances:       closeDistances(tasBlockLabel, "r2", "r3"),
+			topologyRequest: &kueue.PodSetTopologyRequest{Required: new(tasBlockLabel)},
+			count:           6,
+			wantAssignment: &tas.TopologyAssignment{
+				Levels: rackLevels,
+				Domains: []tas.TopologyDomainAssignment{
+					{Count: 4, Values: []string{"b1", "r1"}},
+					{Count: 2, Values: []string{"b1", "r4"}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			features.SetFeatureGateDuringTest(t, features.TASNetworkDistance, tc.enableDistances)
+
+			initialObjects := make([]client.Object, 0, len(tc.nodes))
+			for i := range tc.nodes {
+				initialObjects = append(initialObjects, &tc.nodes[i])
+			}
+			clientBuilder := utiltesting.NewClientBuilder().WithObjects(initialObjects...)
+			_ = tasindexer.SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder))
+
+			tasCache := NewTASCache(clientBuilder.Build(), newDefaultSimulator(), resources.NewResourceFormatter())
+			for i := range tc.nodes {
+				tasCache.SyncNode(&tc.nodes[i])
+			}
+			levels := make([]kueue.TopologyLevel, 0, len(tc.levels))
+			for _, level := range tc.levels {
+				levels = append(levels, kueue.TopologyLevel{NodeLabel: level})
+			}
+			topology := &kueue.Topology{
+				Spec: kueue.TopologySpec{Levels: levels, Distances: tc.distances},
+			}
+			tasFlavorCache := tasCache.NewTASFlavorCache(
+				topologyInformation{Levels: tc.levels, Distances: topologyDistances(topology)},
+				flavorInformation{TopologyName: "default"},
+			)
+			snapshot, err := tasFlavorCache.snapshot(ctx, log, newDefaultSimulatorSnapshot(), nil)
+			if err != nil {
+				t.Fatalf("TASFlavorSnapshot creation failed: %v", err)
+			}
+			gotResult := snapshot.FindTopologyAssignmentsForFlavor(ctx, []TASPodSetRequests{{
+				PodSet: &kueue.PodSet{
+					Name:            kueue.DefaultPodSetName,
+					TopologyRequest: tc.topologyRequest,
+				},
+				SinglePodRequests: resources.NewRequestsFromMap(map[corev1.ResourceName]int64{
+					corev1.ResourceCPU: 1000,
+				}),
+				Count: tc.count,
+			}})
+			wantResult := TASAssignmentsResult{
+				kueue.DefaultPodSetName: {TopologyAssignment: tc.wantAssignment},
+			}
+			if diff := cmp.Diff(wantResult, gotResult); diff != "" {
+				t.Errorf("unexpected topology assignment (-want,+got): %s", diff)
+			}
+		})
+	}
+}
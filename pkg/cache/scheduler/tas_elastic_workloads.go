@@ -107,9 +107,9 @@ func (s *TASFlavorSnapshot) handleScaleUp(
 		placementLeader = nil
 	}
 
-	deltaAssignments, reason := s.findTopologyAssignment(ctx, deltaRequest, placementLeader, assumedUsage, opts.simulateEmpty, "", opts.workload)
+	deltaAssignments, reason, explanation := s.findTopologyAssignment(ctx, deltaRequest, placementLeader, assumedUsage, opts.simulateEmpty, "", opts.workload)
 	if reason != "" {
-		result[workers.PodSet.Name] = tasPodSetAssignmentResult{FailureReason: reason}
+		result[workers.PodSet.Name] = tasPodSetAssignmentResult{FailureReason: reason, PlacementExplanation: explanation}
 		return elasticPlacementResult{applied: true, assignments: result}
 	}
 
@@ -67,6 +67,10 @@ type topologyInformation struct {
 	// levels is a list of levels defined in the Topology object referenced
 	// by the flavor corresponding to the cache.
 	Levels []string
+
+	// distances holds the network distances between the topology domains,
+	// keyed by the node label of the level.
+	Distances map[string]*levelDistances
 }
 
 type TASFlavorCache struct {
@@ -111,6 +115,10 @@ type TASFlavorCache struct {
 	// tree.generation. It is shared read-only by the snapshots of the flavor
 	// and reused across scheduling cycles until the node set changes.
 	tree *topologyTree
+	// alternatives holds the caches of the other topologies of a flavor
+	// referencing several topologies, in the order in which they are tried.
+	// The receiver is the cache of the first topology.
+	alternatives []*TASFlavorCache
 }
 
 func (t *tasCache) NewTASFlavorCache(topologyInfo topologyInformation,
@@ -128,6 +136,27 @@ func (t *tasCache) NewTASFlavorCache(topologyInfo topologyInformation,
 	}
 }
 
+// topologyCaches returns the caches of all the topologies of the flavor, in
+// the order in which they are tried.
+func (c *TASFlavorCache) topologyCaches() []*TASFlavorCache {
+	return append([]*TASFlavorCache{c}, c.alternatives...)
+}
+
+// forTopology returns the cache of the topology with the given name, or nil
+// if the flavor does not reference it. An empty name denotes the first
+// topology, which is the only one of a flavor set with spec.topologyName.
+func (c *TASFlavorCache) forTopology(name kueue.TopologyReference) *TASFlavorCache {
+	if name == "" {
+		return c
+	}
+	for _, topologyCache := range c.topologyCaches() {
+		if topologyCache.flavor.TopologyName == name {
+			return topologyCache
+		}
+	}
+	return nil
+}
+
 func (c *TASFlavorCache) updateTolerations(tolerations []corev1.Toleration) {
 	c.Lock()
 	defer c.Unlock()
@@ -172,6 +201,14 @@ func (c *TASFlavorCache) Topology() kueue.TopologyReference {
 	return c.flavor.TopologyName
 }
 
+// MatchesNode reports whether the node belongs to any of the topologies of the
+// flavor.
+func (c *TASFlavorCache) MatchesNode(node *corev1.Node) bool {
+	return slices.ContainsFunc(c.topologyCaches(), func(topologyCache *TASFlavorCache) bool {
+		return utiltas.NodeMatchesFlavor(node.Labels, topologyCache.NodeLabels(), topologyCache.TopologyLevels())
+	})
+}
+
 // TopologyLevels returns the levels of the topology referenced by the flavor.
 // The returned slice is safe to read without holding the lock, because
 // updateTopology always replaces the whole topologyInformation with one owning
@@ -193,6 +230,10 @@ func (c *TASFlavorCache) snapshot(
 
 	tree, treeReused := c.cachedOrBuiltTree()
 
+	// Only the topologies with the hostname level are aggregation targets, as
+	// the usages are aggregated per node.
+	crossFlavorAggregation := features.Enabled(features.TASHandleOverlappingFlavors) &&
+		aggregatedDomainUsages != nil && utiltas.IsLowestLevelHostname(c.topology.Levels)
 	infoKV := []any{
 		"nodeLabels", c.flavor.NodeLabels,
 		"levels", c.topology.Levels,
@@ -200,13 +241,14 @@ func (c *TASFlavorCache) snapshot(
 		"treeReused", treeReused,
 	}
 	if features.Enabled(features.TASHandleOverlappingFlavors) {
-		infoKV = append(infoKV, "crossFlavorAggregation", aggregatedDomainUsages != nil)
+		infoKV = append(infoKV, "crossFlavorAggregation", crossFlavorAggregation)
 	}
 	log.V(3).Info("Constructing TAS snapshot", infoKV...)
 
-	snapshot := newTASFlavorSnapshot(log, c.flavor.TopologyName, tree, c.flavor.Tolerations, simulatorSnapshot, withResourceFormatter(c.resourceFormatter))
+	snapshot := newTASFlavorSnapshot(log, c.flavor.TopologyName, tree, c.flavor.Tolerations, simulatorSnapshot,
+		withResourceFormatter(c.resourceFormatter), withDistances(c.topology.Distances))
 	tasDomainUsages := c.usage
-	if features.Enabled(features.TASHandleOverlappingFlavors) && aggregatedDomainUsages != nil {
+	if crossFlavorAggregation {
 		tasDomainUsages = aggregatedDomainUsages
 	}
 	snapshot.addTASUsageForHeldDomains(tasDomainUsages)
@@ -215,6 +257,13 @@ func (c *TASFlavorCache) snapshot(
 			snapshot.addNonTASUsage(domainID, usage)
 		}
 	})
+	for _, alternative := range c.alternatives {
+		alternativeSnapshot, err := alternative.snapshot(ctx, log, simulatorSnapshot, aggregatedDomainUsages)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.alternatives = append(snapshot.alternatives, alternativeSnapshot)
+	}
 	return snapshot, nil
 }
 
@@ -262,21 +311,42 @@ func (c *TASFlavorCache) storeTree(tree *topologyTree) *topologyTree {
 }
 
 func (c *TASFlavorCache) addUsage(log logr.Logger, key workload.Reference, topologyRequests []workload.TopologyDomainRequests) {
-	if _, found := c.wlUsage[key]; found {
-		log.V(2).Info("Workload usage already exists in TAS flavor cache, self-healing by replacing it", "workload", key)
-		c.removeUsage(log, key)
+	for _, topologyCache := range c.topologyCaches() {
+		if _, found := topologyCache.wlUsage[key]; found {
+			log.V(2).Info("Workload usage already exists in TAS flavor cache, self-healing by replacing it", "workload", key)
+			topologyCache.removeWorkloadUsage(key)
+		}
+	}
+	requestsPerTopology := make(map[kueue.TopologyReference][]workload.TopologyDomainRequests)
+	for _, tr := range topologyRequests {
+		requestsPerTopology[tr.Topology] = append(requestsPerTopology[tr.Topology], tr)
+	}
+	for topology, requests := range requestsPerTopology {
+		topologyCache := c.forTopology(topology)
+		if topologyCache == nil {
+			log.V(2).Info("Topology used by workload not found in TAS flavor cache", "workload", key, "topology", topology)
+			continue
+		}
+		topologyCache.wlUsage[key] = requests
+		topologyCache.updateUsage(requests, add)
 	}
-	c.wlUsage[key] = slices.Clone(topologyRequests)
-	c.updateUsage(topologyRequests, add)
 }
 
 func (c *TASFlavorCache) removeUsage(log logr.Logger, key workload.Reference) {
-	value, found := c.wlUsage[key]
+	found := false
+	for _, topologyCache := range c.topologyCaches() {
+		if _, ok := topologyCache.wlUsage[key]; ok {
+			topologyCache.removeWorkloadUsage(key)
+			found = true
+		}
+	}
 	if !found {
 		log.V(2).Info("Workload usage not found during removal from TAS flavor cache", "workload", key)
-		return
 	}
-	c.updateUsage(value, subtract)
+}
+
+func (c *TASFlavorCache) removeWorkloadUsage(key workload.Reference) {
+	c.updateUsage(c.wlUsage[key], subtract)
 	delete(c.wlUsage, key)
 }
 
@@ -160,6 +160,36 @@ type TASFlavorSnapshot struct {
 	simulatorSnapshot simulator.SimulatorSnapshot
 
 	resourceFormatter *resources.ResourceFormatter
+
+	// distances holds the network distances between the topology domains,
+	// keyed by the node label of the level.
+	distances map[string]*levelDistances
+
+	// alternatives holds the snapshots of the other topologies of a flavor
+	// referencing several topologies, in the order in which they are tried.
+	// The receiver is the snapshot of the first topology.
+	alternatives []*TASFlavorSnapshot
+}
+
+// topologySnapshots returns the snapshots of all the topologies of the flavor,
+// in the order in which they are tried.
+func (s *TASFlavorSnapshot) topologySnapshots() []*TASFlavorSnapshot {
+	return append([]*TASFlavorSnapshot{s}, s.alternatives...)
+}
+
+// forTopology returns the snapshot of the topology with the given name, or nil
+// if the flavor does not reference it. An empty name denotes the first
+// topology, which is the only one of a flavor set with spec.topologyName.
+func (s *TASFlavorSnapshot) forTopology(name kueue.TopologyReference) *TASFlavorSnapshot {
+	if name == "" {
+		return s
+	}
+	for _, topologySnapshot := range s.topologySnapshots() {
+		if topologySnapshot.topologyName == name {
+			return topologySnapshot
+		}
+	}
+	return nil
 }
 
 // domainStateOf returns the snapshot's mutable state of the given shared domain.
@@ -210,6 +240,7 @@ type matchingLeavesCacheEntry struct {
 
 type tasFlavorSnapshotOptions struct {
 	resourceFormatter *resources.ResourceFormatter
+	distances         map[string]*levelDistances
 }
 
 type tasFlavorSnapshotOption func(*tasFlavorSnapshotOptions)
@@ -220,6 +251,12 @@ func withResourceFormatter(formatter *resources.ResourceFormatter) tasFlavorSnap
 	}
 }
 
+func withDistances(distances map[string]*levelDistances) tasFlavorSnapshotOption {
+	return func(o *tasFlavorSnapshotOptions) {
+		o.distances = distances
+	}
+}
+
 // newTASFlavorSnapshot creates a snapshot backed by the shared topology tree,
 // with fresh per-snapshot state: the leaves start at their static capacity
 // with no usage, and the assignment-algorithm scratch state is zeroed.
@@ -248,6 +285,7 @@ func newTASFlavorSnapshot(
 		tolerations:       slices.Clone(tolerations),
 		simulatorSnapshot: simulatorSnapshot,
 		resourceFormatter: options.resourceFormatter,
+		distances:         options.distances,
 	}
 	for _, leaf := range tree.leaves {
 		snapshot.leafCapacities[leaf.leafIdx].freeCapacity = leaf.capacity.Clone()
@@ -361,11 +399,13 @@ func (s *TASFlavorSnapshot) resourceDetails(requests resources.Requests) map[cor
 func (s *TASFlavorSnapshot) SerializeFreeCapacityPerDomain() (string, error) {
 	details := make(map[utiltas.TopologyDomainID]domainCapacityDetails, len(s.leaves))
 
-	for domainID, leaf := range s.leaves {
-		leafCapacity := s.leafCapacityOf(leaf)
-		details[domainID] = domainCapacityDetails{
-			FreeCapacity: s.resourceDetails(leafCapacity.freeCapacity),
-			TasUsage:     s.resourceDetails(leafCapacity.tasUsage),
+	for _, topologySnapshot := range s.topologySnapshots() {
+		for domainID, leaf := range topologySnapshot.leaves {
+			leafCapacity := topologySnapshot.leafCapacityOf(leaf)
+			details[domainID] = domainCapacityDetails{
+				FreeCapacity: topologySnapshot.resourceDetails(leafCapacity.freeCapacity),
+				TasUsage:     topologySnapshot.resourceDetails(leafCapacity.tasUsage),
+			}
 		}
 	}
 
@@ -404,6 +444,11 @@ type FailureInfo struct {
 
 	// Flavor indicates the resource flavor associated with the failure.
 	Flavor kueue.ResourceFlavorReference
+
+	// PlacementExplanation is the structured explanation of the failure. It
+	// is set only when the TASPlacementExplanation feature gate is enabled
+	// and the PodSet did not fit in the topology.
+	PlacementExplanation *kueue.TopologyPlacementExplanation
 }
 
 type TASAssignmentsResult map[kueue.PodSetReference]tasPodSetAssignmentResult
@@ -412,9 +457,10 @@ func (r TASAssignmentsResult) Failure() *FailureInfo {
 	for psName, psAssignment := range r {
 		if psAssignment.FailureReason != "" {
 			return &FailureInfo{
-				PodSetName: psName,
-				Reason:     psAssignment.FailureReason,
-				Flavor:     psAssignment.Flavor,
+				PodSetName:           psName,
+				Reason:               psAssignment.FailureReason,
+				Flavor:               psAssignment.Flavor,
+				PlacementExplanation: psAssignment.PlacementExplanation,
 			}
 		}
 	}
@@ -422,9 +468,10 @@ func (r TASAssignmentsResult) Failure() *FailureInfo {
 }
 
 type tasPodSetAssignmentResult struct {
-	TopologyAssignment *utiltas.TopologyAssignment
-	FailureReason      string
-	Flavor             kueue.ResourceFlavorReference
+	TopologyAssignment   *utiltas.TopologyAssignment
+	FailureReason        string
+	Flavor               kueue.ResourceFlavorReference
+	PlacementExplanation *kueue.TopologyPlacementExplanation
 }
 
 type FlavorTASRequests []TASPodSetRequests
@@ -433,12 +480,16 @@ type FlavorTASRequests []TASPodSetRequests
 func (s *TASFlavorSnapshot) Fits(flavorUsage workload.TASFlavorUsage) bool {
 	cachingEnabled := features.Enabled(features.TASCachingRemainingResources)
 	for _, domainUsage := range flavorUsage {
+		topologySnapshot := s.forTopology(domainUsage.Topology)
+		if topologySnapshot == nil {
+			return false
+		}
 		domainID := utiltas.DomainID(domainUsage.Values)
-		leaf, found := s.leaves[domainID]
+		leaf, found := topologySnapshot.leaves[domainID]
 		if !found {
 			return false
 		}
-		remainingCapacity := s.remainingCapacityForLeaf(leaf, false, cachingEnabled)
+		remainingCapacity := topologySnapshot.remainingCapacityForLeaf(leaf, false, cachingEnabled)
 		if domainUsage.SinglePodRequests.CountIn(remainingCapacity.Get()) < domainUsage.Count {
 			return false
 		}
@@ -452,6 +503,10 @@ type findTopologyAssignmentsOption struct {
 	aggregatedDomainUsages map[utiltas.TopologyDomainID]resources.Requests
 }
 
+// maxTopologyPlacementExclusions is the maximum number of exclusion reasons
+// reported in a TopologyPlacementExplanation.
+const maxTopologyPlacementExclusions = 8
+
 type tasExclusionStats struct {
 	simulator.NodeExclusionStats
 	TopologyDomain int
@@ -487,6 +542,9 @@ type topologyAssignmentParameters struct {
 type findTopologyAssignmentState struct {
 	topologyAssignmentParameters
 	stats *tasExclusionStats
+	// explanation is set when the TASPlacementExplanation feature gate is
+	// enabled and the pods don't fit in the topology.
+	explanation *kueue.TopologyPlacementExplanation
 }
 
 func newTASExclusionStats() *tasExclusionStats {
@@ -521,6 +579,40 @@ func (s *tasExclusionStats) formatReasons() string {
 	return strings.Join(reasons, ", ")
 }
 
+// exclusions returns the reasons why nodes were excluded, by decreasing
+// number of excluded nodes, keeping at most limit reasons.
+func (s *tasExclusionStats) exclusions(limit int) []kueue.TopologyPlacementExclusion {
+	var exclusions []kueue.TopologyPlacementExclusion
+	appendIfAny := func(exclusion kueue.TopologyPlacementExclusion, nodes int) {
+		if nodes > 0 {
+			exclusion.Nodes = int32(nodes)
+			exclusions = append(exclusions, exclusion)
+		}
+	}
+	appendIfAny(kueue.TopologyPlacementExclusion{Reason: kueue.TopologyPlacementExclusionNodeSelector}, s.NodeSelector)
+	appendIfAny(kueue.TopologyPlacementExclusion{Reason: kueue.TopologyPlacementExclusionNodeAffinity}, s.Affinity)
+	appendIfAny(kueue.TopologyPlacementExclusion{Reason: kueue.TopologyPlacementExclusionTopologyDomain}, s.TopologyDomain)
+	appendIfAny(kueue.TopologyPlacementExclusion{Reason: kueue.TopologyPlacementExclusionSchedulerPlugin}, s.SchedulerLibraryNoFit)
+	for taint, nodes := range s.Taints {
+		appendIfAny(kueue.TopologyPlacementExclusion{Reason: kueue.TopologyPlacementExclusionTaint, Taint: taint}, nodes)
+	}
+	for resource, nodes := range s.Resources {
+		appendIfAny(kueue.TopologyPlacementExclusion{Reason: kueue.TopologyPlacementExclusionInsufficientResource, Resource: resource}, nodes)
+	}
+	slices.SortFunc(exclusions, func(a, b kueue.TopologyPlacementExclusion) int {
+		return cmp.Or(
+			cmp.Compare(b.Nodes, a.Nodes),
+			cmp.Compare(a.Reason, b.Reason),
+			cmp.Compare(a.Resource, b.Resource),
+			cmp.Compare(a.Taint, b.Taint),
+		)
+	})
+	if len(exclusions) > limit {
+		exclusions = exclusions[:limit]
+	}
+	return exclusions
+}
+
 func (s *tasExclusionStats) recordResourceExclusion(res corev1.ResourceName) {
 	if s.Resources == nil {
 		s.Resources = make(map[corev1.ResourceName]int)
@@ -576,6 +668,90 @@ func WithAggregatedDomainUsages(m map[utiltas.TopologyDomainID]resources.Request
 // FindTopologyAssignmentsForFlavor returns TAS assignment, if possible, for all
 // the TAS requests in the flavor handled by the snapshot.
 func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(ctx context.Context, flavorTASRequests FlavorTASRequests, options ...FindTopologyAssignmentsOption) TASAssignmentsResult {
+	if len(s.alternatives) == 0 {
+		return s.findTopologyAssignmentsForTopology(ctx, flavorTASRequests, options...)
+	}
+	opts := &findTopologyAssignmentsOption{}
+	for _, option := range options {
+		option(opts)
+	}
+	// The PodSets which already have an assignment, replacing an unhealthy
+	// node or scaling up a workload slice, stay in the topology of the
+	// assignment.
+	if name, found := assignedTopology(flavorTASRequests, opts.workload); found {
+		topologySnapshot := s.forTopology(name)
+		if topologySnapshot == nil {
+			return failureForAllPodSets(flavorTASRequests, fmt.Sprintf("the flavor no longer references the topology %q of the existing assignment", name))
+		}
+		return topologySnapshot.findTopologyAssignmentsInTopology(ctx, flavorTASRequests, options...)
+	}
+	var reasons []string
+	var firstResult TASAssignmentsResult
+	for _, topologySnapshot := range s.topologySnapshots() {
+		result := topologySnapshot.findTopologyAssignmentsInTopology(ctx, flavorTASRequests, options...)
+		failure := result.Failure()
+		if failure == nil {
+			return result
+		}
+		if firstResult == nil {
+			firstResult = result
+		}
+		reasons = append(reasons, fmt.Sprintf("topology %q: %s", topologySnapshot.topologyName, failure.Reason))
+	}
+	// Report the explanation of the first topology, with the reasons of all
+	// the topologies.
+	for psName, psResult := range firstResult {
+		if psResult.FailureReason != "" {
+			psResult.FailureReason = strings.Join(reasons, "; ")
+			firstResult[psName] = psResult
+		}
+	}
+	return firstResult
+}
+
+// findTopologyAssignmentsInTopology finds the assignments in the topology of
+// the snapshot, one of several of a flavor, and records the topology in them.
+func (s *TASFlavorSnapshot) findTopologyAssignmentsInTopology(ctx context.Context, flavorTASRequests FlavorTASRequests, options ...FindTopologyAssignmentsOption) TASAssignmentsResult {
+	if !s.isLowestLevelNode {
+		// The aggregated usages are keyed by node, so they only apply to the
+		// topologies with the hostname level.
+		options = append(slices.Clone(options), WithAggregatedDomainUsages(nil))
+	}
+	result := s.findTopologyAssignmentsForTopology(ctx, flavorTASRequests, options...)
+	for _, psResult := range result {
+		if psResult.TopologyAssignment != nil {
+			psResult.TopologyAssignment.TopologyName = s.topologyName
+		}
+	}
+	return result
+}
+
+// assignedTopology returns the topology of the existing assignments of the
+// PodSets, if any.
+func assignedTopology(flavorTASRequests FlavorTASRequests, wl *kueue.Workload) (kueue.TopologyReference, bool) {
+	for _, tr := range flavorTASRequests {
+		if tr.PreviousAssignment != nil && tr.PreviousAssignment.TopologyName != nil {
+			return *tr.PreviousAssignment.TopologyName, true
+		}
+		if !workload.HasUnhealthyNodes(wl) {
+			continue
+		}
+		if psa := findPSA(wl, tr.PodSet.Name); psa != nil && psa.TopologyAssignment != nil && psa.TopologyAssignment.TopologyName != nil {
+			return *psa.TopologyAssignment.TopologyName, true
+		}
+	}
+	return "", false
+}
+
+func failureForAllPodSets(flavorTASRequests FlavorTASRequests, reason string) TASAssignmentsResult {
+	result := make(TASAssignmentsResult, len(flavorTASRequests))
+	for _, tr := range flavorTASRequests {
+		result[tr.PodSet.Name] = tasPodSetAssignmentResult{FailureReason: reason}
+	}
+	return result
+}
+
+func (s *TASFlavorSnapshot) findTopologyAssignmentsForTopology(ctx context.Context, flavorTASRequests FlavorTASRequests, options ...FindTopologyAssignmentsOption) TASAssignmentsResult {
 	log := log.FromContext(ctx)
 	opts := &findTopologyAssignmentsOption{}
 	for _, option := range options {
@@ -647,10 +823,10 @@ func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(ctx context.Context
 			}
 
 			// Normal path: no previous assignment or stale assignment
-			assignments, reason := s.findTopologyAssignment(ctx, workers, leader, assumedUsage, opts.simulateEmpty, "", opts.workload)
+			assignments, reason, explanation := s.findTopologyAssignment(ctx, workers, leader, assumedUsage, opts.simulateEmpty, "", opts.workload)
 			for _, tr := range trs {
 				podSetName := tr.PodSet.Name
-				result[podSetName] = tasPodSetAssignmentResult{TopologyAssignment: assignments[podSetName], FailureReason: reason}
+				result[podSetName] = tasPodSetAssignmentResult{TopologyAssignment: assignments[podSetName], FailureReason: reason, PlacementExplanation: explanation}
 			}
 
 			if reason != "" {
@@ -720,7 +896,7 @@ func (s *TASFlavorSnapshot) findReplacementAssignment(
 		trCopy.PodSet.TopologyRequest.PodSetSliceRequiredTopology = effectiveSliceTopology
 		trCopy.PodSet.TopologyRequest.PodSetSliceSize = new(effectiveSliceSize)
 	}
-	replacementAssignment, reason := s.findTopologyAssignment(ctx, trCopy, nil, assumedUsage, false, requiredReplacementDomain, wl)
+	replacementAssignment, reason, _ := s.findTopologyAssignment(ctx, trCopy, nil, assumedUsage, false, requiredReplacementDomain, wl)
 	if reason != "" {
 		return nil, nil, reason
 	}
@@ -816,8 +992,12 @@ func (s *TASFlavorSnapshot) requiredReplacementDomain(tr *TASPodSetRequests, ta
 // that don't exists in the snapshot. It may be cause e.g. by Node deletion, or change
 // in Node's NodeReady condition
 func (s *TASFlavorSnapshot) IsTopologyAssignmentStale(ta *utiltas.TopologyAssignment) (bool, string) {
+	topologySnapshot := s.forTopology(ta.TopologyName)
+	if topologySnapshot == nil {
+		return true, string(ta.TopologyName)
+	}
 	for _, domain := range ta.Domains {
-		if _, found := s.leaves[utiltas.DomainID(domain.Values)]; !found {
+		if _, found := topologySnapshot.leaves[utiltas.DomainID(domain.Values)]; !found {
 			return true, domain.Values[0]
 		}
 	}
@@ -888,7 +1068,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	workersTasPodSetRequests TASPodSetRequests,
 	leaderTasPodSetRequests *TASPodSetRequests,
 	assumedUsage map[utiltas.TopologyDomainID]resources.Requests,
-	simulateEmpty bool, requiredReplacementDomain utiltas.TopologyDomainID, wl *kueue.Workload) (map[kueue.PodSetReference]*utiltas.TopologyAssignment, string) {
+	simulateEmpty bool, requiredReplacementDomain utiltas.TopologyDomainID, wl *kueue.Workload) (map[kueue.PodSetReference]*utiltas.TopologyAssignment, string, *kueue.TopologyPlacementExplanation) {
 	requirements := &topologyAssignmentPodRequirements{
 		assumedUsage:              assumedUsage,
 		requiredReplacementDomain: requiredReplacementDomain,
@@ -913,14 +1093,14 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	info := podset.FromPodSet(workersTasPodSetRequests.PodSet)
 	for _, podSetUpdate := range workersTasPodSetRequests.PodSetUpdates {
 		if err := info.Merge(podset.FromUpdate(podSetUpdate)); err != nil {
-			return nil, fmt.Sprintf("invalid podSetUpdate for PodSet %s, error: %s", workersTasPodSetRequests.PodSet.Name, err.Error())
+			return nil, fmt.Sprintf("invalid podSetUpdate for PodSet %s, error: %s", workersTasPodSetRequests.PodSet.Name, err.Error()), nil
 		}
 	}
 
 	// If slice topology is not requested then we can assume that slice is a single pod
 	sliceSize, reason := getSliceSizeWithSinglePodAsDefault(workersTasPodSetRequests.PodSet.TopologyRequest)
 	if len(reason) > 0 {
-		return nil, reason
+		return nil, reason, nil
 	}
 	state.sliceSize = sliceSize
 
@@ -929,28 +1109,28 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 
 	topologyKey := s.levelKeyWithImpliedFallback(&workersTasPodSetRequests)
 	if topologyKey == nil {
-		return nil, "topology level not specified"
+		return nil, "topology level not specified", nil
 	}
 	requestedLevelIdx, found := s.resolveLevelIdx(*topologyKey)
 	if !found {
-		return nil, fmt.Sprintf("no requested topology level: %s", *topologyKey)
+		return nil, fmt.Sprintf("no requested topology level: %s", *topologyKey), nil
 	}
 	state.requestedLevelIdx = requestedLevelIdx
 
 	sliceTopologyKey := s.sliceLevelKeyWithDefault(workersTasPodSetRequests.PodSet.TopologyRequest, s.lowestLevel())
 	sliceLevelIdx, found := s.resolveLevelIdx(sliceTopologyKey)
 	if !found {
-		return nil, fmt.Sprintf("no requested topology level for slices: %s", sliceTopologyKey)
+		return nil, fmt.Sprintf("no requested topology level for slices: %s", sliceTopologyKey), nil
 	}
 	state.sliceLevelIdx = sliceLevelIdx
 
 	if state.requestedLevelIdx > state.sliceLevelIdx {
-		return nil, fmt.Sprintf("podset slice topology %s is above the podset topology %s", sliceTopologyKey, *topologyKey)
+		return nil, fmt.Sprintf("podset slice topology %s is above the podset topology %s", sliceTopologyKey, *topologyKey), nil
 	}
 
 	sliceSizeAtLevel, reason := s.buildSliceSizeAtLevel(workersTasPodSetRequests, state.sliceSize, state.sliceLevelIdx)
 	if len(reason) > 0 {
-		return nil, reason
+		return nil, reason, nil
 	}
 	state.sliceSizeAtLevel = sliceSizeAtLevel
 
@@ -963,7 +1143,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	if s.isLowestLevelNode {
 		sel, err := labels.ValidatedSelectorFromSet(info.NodeSelector)
 		if err != nil {
-			return nil, fmt.Sprintf("invalid node selectors: %s, reason: %s", info.NodeSelector, err)
+			return nil, fmt.Sprintf("invalid node selectors: %s, reason: %s", info.NodeSelector, err), nil
 		}
 		requirements.podRequirements.Selector = sel
 		if features.Enabled(features.TASCacheNodeMatchResults) && wl != nil && wl.UID != "" {
@@ -980,7 +1160,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 		if requiredAffinity := info.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; requiredAffinity != nil {
 			affinitySelector, err := nodeaffinity.NewNodeSelector(requiredAffinity)
 			if err != nil {
-				return nil, fmt.Sprintf("invalid affinity node selectors: %s, reason: %s", requiredAffinity, err)
+				return nil, fmt.Sprintf("invalid affinity node selectors: %s, reason: %s", requiredAffinity, err), nil
 			}
 			requirements.podRequirements.AffinitySelector = affinitySelector
 		}
@@ -989,7 +1169,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 			if len(preferredAffinity) > 0 {
 				prefTerms, err := nodeaffinity.NewPreferredSchedulingTerms(preferredAffinity)
 				if err != nil {
-					return nil, fmt.Sprintf("invalid preferred node affinity terms: %v, reason: %s", preferredAffinity, err)
+					return nil, fmt.Sprintf("invalid preferred node affinity terms: %v, reason: %s", preferredAffinity, err), nil
 				}
 				requirements.podRequirements.PreferredSchedulingTerms = prefTerms
 			}
@@ -1001,7 +1181,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	// phase 1 - determine the number of pods and slices which can fit in each topology domain
 	err := s.fillInCounts(ctx, requirements, state)
 	if err != nil {
-		return nil, fmt.Sprintf("unable to calculate domain capacities for PodSet %s, error: %s", info.Name, err.Error())
+		return nil, fmt.Sprintf("unable to calculate domain capacities for PodSet %s, error: %s", info.Name, err.Error()), nil
 	}
 
 	// phase 2a: determine the level at which the assignment is done along with
@@ -1025,7 +1205,10 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	if !useBalancedPlacement {
 		fitLevelIdx, currFitDomain, reason = s.findLevelWithFitDomains(state.requestedLevelIdx, state)
 		if len(reason) > 0 {
-			return nil, reason
+			if state.explanation != nil {
+				state.explanation.Name = workersTasPodSetRequests.PodSet.Name
+			}
+			return nil, reason, state.explanation
 		}
 	}
 	// phase 2b: traverse the tree down level-by-level optimizing the number of
@@ -1037,6 +1220,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 		// If we are "above" the requested slice topology level and we don't run the balanced placement algorithm,
 		// we're greedily assigning pods/slices to all domains without checking what we've assigned to parent domains.
 		sortedLowerDomains := s.sortedDomains(s.lowerLevelDomains(currFitDomain), state.unconstrained)
+		sortedLowerDomains = s.closestDomainsForState(sortedLowerDomains, currentLevelIdx+1, state.count/state.sliceSize, true, state)
 		currFitDomain = s.updateCountsToMinimumGeneric(sortedLowerDomains, state.count, state.leaderCount, state.sliceSize, state.unconstrained, true)
 	}
 
@@ -1072,6 +1256,9 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 			}
 
 			domainState := s.domainStateOf(domain)
+			if !useBalancedPlacement {
+				sortedLowerDomains = s.closestDomainsForState(sortedLowerDomains, currentLevelIdx+1, domainState.podCount/sliceSizeOnLevel, sliceSizeOnLevel > 1, state)
+			}
 			addCurrFitDomain := s.updateCountsToMinimumGeneric(sortedLowerDomains, domainState.podCount, domainState.leaderCount, sliceSizeOnLevel, state.unconstrained, sliceSizeOnLevel > 1)
 			newCurrFitDomain = append(newCurrFitDomain, addCurrFitDomain...)
 		}
@@ -1103,7 +1290,7 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 
 	assignments[workersTasPodSetRequests.PodSet.Name] = s.buildAssignment(currFitDomain)
 
-	return assignments, ""
+	return assignments, "", nil
 }
 
 // buildSliceSizeAtLevel builds a map from topology level index to the slice
@@ -1168,6 +1355,12 @@ func (s *TASFlavorSnapshot) buildSliceSizeAtLevel(
 }
 
 func (s *TASFlavorSnapshot) HasLevel(r *kueue.PodSetTopologyRequest) bool {
+	return slices.ContainsFunc(s.topologySnapshots(), func(topologySnapshot *TASFlavorSnapshot) bool {
+		return topologySnapshot.hasLevel(r)
+	})
+}
+
+func (s *TASFlavorSnapshot) hasLevel(r *kueue.PodSetTopologyRequest) bool {
 	mainKey := s.levelKey(r)
 	if mainKey == nil {
 		return false
@@ -1351,6 +1544,9 @@ func (s *TASFlavorSnapshot) findLevelWithFitDomains(
 		topDomain = s.findBestFitDomainForSlices(sortedDomain, sliceCount, state.leaderCount)
 	}
 	notFitReason := func(slicesFitCount, totalRequestsSlicesCount int32) string {
+		if features.Enabled(features.TASPlacementExplanation) {
+			state.explanation = s.placementExplanation(state)
+		}
 		if len(state.multiLayerConstraints) > 0 {
 			return s.multiLayerNotFitMessage(searchLevelIdx, state.count, state.multiLayerConstraints, state.stats)
 		}
@@ -1422,6 +1618,7 @@ func (s *TASFlavorSnapshot) findLevelWithFitDomains(
 		// At this point we have assigned all leaders, so we sort remaining domains based on worker capacity
 		// and assign remaining workers.
 		sortedDomain = s.sortedDomains(sortedDomain[idx:], state.unconstrained)
+		sortedDomain = s.closestDomainsForState(sortedDomain, searchLevelIdx, remainingSliceCount, true, state)
 		for idx := 0; remainingSliceCount > 0 && idx < len(sortedDomain); idx++ {
 			domain := sortedDomain[idx]
 			if useBestFitAlgorithm(state.unconstrained) && s.domainStateOf(sortedDomain[idx]).sliceCount >= remainingSliceCount {
@@ -2016,6 +2213,38 @@ func (s *TASFlavorSnapshot) notFitMessage(slicesFitCount, totalRequestsSlicesCou
 	return builder.String()
 }
 
+// placementExplanation explains why the pods don't fit in the topology, from
+// the pod counts of the domains and the node exclusion stats.
+func (s *TASFlavorSnapshot) placementExplanation(state *findTopologyAssignmentState) *kueue.TopologyPlacementExplanation {
+	explanation := &kueue.TopologyPlacementExplanation{
+		Count:      state.count,
+		TotalNodes: int32(state.stats.TotalNodes),
+		Exclusions: state.stats.exclusions(maxTopologyPlacementExclusions),
+	}
+	candidates := slices.Collect(maps.Values(s.domainsPerLevel[0]))
+	for levelIdx := 0; levelIdx <= state.requestedLevelIdx; levelIdx++ {
+		// Tie-break on domain ID for deterministic explanations, since
+		// domainsPerLevel is map-backed and iteration order is random.
+		var best *domain
+		for _, d := range candidates {
+			if best == nil || s.domainStateOf(d).podCount > s.domainStateOf(best).podCount ||
+				(s.domainStateOf(d).podCount == s.domainStateOf(best).podCount && d.id < best.id) {
+				best = d
+			}
+		}
+		if best == nil {
+			break
+		}
+		explanation.Candidates = append(explanation.Candidates, kueue.TopologyPlacementCandidate{
+			Level:    s.levelKeys[levelIdx],
+			Value:    best.levelValues[levelIdx],
+			PodCount: s.domainStateOf(best).podCount,
+		})
+		candidates = best.children
+	}
+	return explanation
+}
+
 func (s *TASFlavorSnapshot) countSlicesInSubtree(d *domain, currentLevel, targetLevel int, sliceSize int32) int32 {
 	if currentLevel == targetLevel {
 		return s.domainStateOf(d).podCount / sliceSize
@@ -1605,3 +1605,170 @@ func TestUpdateCountsToMinimumGenericLogsLeafSummary(t *testing.T) {
 		}
 	})
 }
+
+func TestFindTopologyAssignmentsPlacementExplanation(t *testing.T) {
+	const tasBlockLabel = "cloud.com/topology-block"
+	makeNode := func(name, block, cpu string) *node.NodeWrapper {
+		return node.MakeNode(name).
+			Label(tasBlockLabel, block).
+			Label(corev1.LabelHostname, name).
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse(cpu),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready()
+	}
+	nodes := []*corev1.Node{
+		makeNode("x1", "b1", "2").Obj(),
+		makeNode("x2", "b1", "2").
+			Taints(corev1.Taint{Key: "key", Value: "value", Effect: corev1.TaintEffectNoSchedule}).
+			Obj(),
+		makeNode("x3", "b2", "1").Obj(),
+		makeNode("x4", "b2", "500m").Obj(),
+	}
+
+	cases := map[string]struct {
+		enableExplanation bool
+		wantExplanation   *kueue.TopologyPlacementExplanation
+	}{
+		"feature disabled": {},
+		"feature enabled": {
+			enableExplanation: true,
+			wantExplanation: &kueue.TopologyPlacementExplanation{
+				Name:       kueue.DefaultPodSetName,
+				Count:      3,
+				TotalNodes: 4,
+				Candidates: []kueue.TopologyPlacementCandidate{
+					{Level: tasBlockLabel, Value: "b1", PodCount: 2},
+					{Level: corev1.LabelHostname, Value: "x1", PodCount: 2},
+				},
+				Exclusions: []kueue.TopologyPlacementExclusion{
+					{Reason: kueue.TopologyPlacementExclusionInsufficientResource, Resource: corev1.ResourceCPU, Nodes: 1},
+					{Reason: kueue.TopologyPlacementExclusionTaint, Taint: "key=value:NoSchedule", Nodes: 1},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			features.SetFeatureGateDuringTest(t, features.TASPlacementExplanation, tc.enableExplanation)
+
+			levels := []string{tasBlockLabel, corev1.LabelHostname}
+			snapshot := newTASFlavorSnapshot(log, "default", newTopologyTree(levels, nodes, 0), nil, newDefaultSimulatorSnapshot())
+			result := snapshot.FindTopologyAssignmentsForFlavor(ctx, []TASPodSetRequests{{
+				PodSet: &kueue.PodSet{
+					Name:            kueue.DefaultPodSetName,
+					TopologyRequest: &kueue.PodSetTopologyRequest{Required: new(corev1.LabelHostname)},
+				},
+				SinglePodRequests: resources.NewRequestsFromMap(map[corev1.ResourceName]int64{
+					corev1.ResourceCPU: 1000,
+				}),
+				Count: 3,
+			}})
+
+			failure := result.Failure()
+			if failure == nil {
+				t.Fatal("Expected the PodSet not to fit")
+			}
+			if diff := cmp.Diff(tc.wantExplanation, failure.PlacementExplanation); diff != "" {
+				t.Errorf("Unexpected placement explanation (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindTopologyAssignmentsMultiTopologyFlavor(t *testing.T) {
+	const (
+		tasBlockLabel = "cloud.com/topology-block"
+		tasRackLabel  = "cloud.com/topology-rack"
+	)
+	makeNode := func(name, levelLabel, levelValue, cpu string) *corev1.Node {
+		return node.MakeNode(name).
+			Label(levelLabel, levelValue).
+			Label(corev1.LabelHostname, name).
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse(cpu),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj()
+	}
+
+	cases := map[string]struct {
+		count            int32
+		required         string
+		wantTopologyName kueue.TopologyReference
+		wantFailure      string
+	}{
+		"fits in the first topology": {
+			count:            1,
+			required:         corev1.LabelHostname,
+			wantTopologyName: "tpu",
+		},
+		"falls back to the second topology": {
+			count:            3,
+			required:         corev1.LabelHostname,
+			wantTopologyName: "gpu",
+		},
+		"level only in the second topology": {
+			count:            1,
+			required:         tasRackLabel,
+			wantTopologyName: "gpu",
+		},
+		"fits in no topology": {
+			count:       5,
+			required:    corev1.LabelHostname,
+			wantFailure: `topology "tpu": `,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+
+			tpuNodes := []*corev1.Node{makeNode("x1", tasBlockLabel, "b1", "2")}
+			gpuNodes := []*corev1.Node{makeNode("y1", tasRackLabel, "r1", "4")}
+			snapshot := newTASFlavorSnapshot(log, "tpu", newTopologyTree([]string{tasBlockLabel, corev1.LabelHostname}, tpuNodes, 0), nil, newDefaultSimulatorSnapshot())
+			snapshot.alternatives = append(snapshot.alternatives,
+				newTASFlavorSnapshot(log, "gpu", newTopologyTree([]string{tasRackLabel, corev1.LabelHostname}, gpuNodes, 0), nil, newDefaultSimulatorSnapshot()))
+
+			podSet := &kueue.PodSet{
+				Name:            kueue.DefaultPodSetName,
+				TopologyRequest: &kueue.PodSetTopologyRequest{Required: new(tc.required)},
+			}
+			if !snapshot.HasLevel(podSet.TopologyRequest) {
+				t.Fatalf("Expected the flavor to have the level %q", tc.required)
+			}
+			result := snapshot.FindTopologyAssignmentsForFlavor(ctx, []TASPodSetRequests{{
+				PodSet: podSet,
+				SinglePodRequests: resources.NewRequestsFromMap(map[corev1.ResourceName]int64{
+					corev1.ResourceCPU: 1000,
+				}),
+				Count: tc.count,
+			}})
+
+			if failure := result.Failure(); failure != nil {
+				if tc.wantFailure == "" {
+					t.Fatalf("Unexpected failure: %s", failure.Reason)
+				}
+				if !strings.HasPrefix(failure.Reason, tc.wantFailure) || !strings.Contains(failure.Reason, `; topology "gpu": `) {
+					t.Errorf("Unexpected failure reason: %s", failure.Reason)
+				}
+				return
+			}
+			if tc.wantFailure != "" {
+				t.Fatal("Expected the PodSet not to fit")
+			}
+			assignment := result[kueue.DefaultPodSetName].TopologyAssignment
+			if assignment == nil {
+				t.Fatal("Expected a topology assignment")
+			}
+			if assignment.TopologyName != tc.wantTopologyName {
+				t.Errorf("Unexpected topology %q, want %q", assignment.TopologyName, tc.wantTopologyName)
+			}
+			if stale, domain := snapshot.IsTopologyAssignmentStale(assignment); stale {
+				t.Errorf("Unexpected stale domain %s", domain)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// WorkflowReservationGracePeriod is how long the quota reserved for a
+// workflow is kept after its last admitted workload finishes, for the next
+// steps of the workflow to be created.
+const WorkflowReservationGracePeriod = 2 * time.Minute
+
+// workflowKey identifies a workflow by namespace/name.
+type workflowKey string
+
+func workflowKeyOf(wl *kueue.Workload) (workflowKey, bool) {
+	if !features.Enabled(features.WorkflowBudgets) {
+		return "", false
+	}
+	name, found := wl.Labels[kueue.WorkflowLabel]
+	if !found {
+		return "", false
+	}
+	return workflowKey(wl.Namespace + "/" + name), true
+}
+
+// ParseWorkflowResources parses the value of the WorkflowBudgetAnnotation or
+// the WorkflowReservationAnnotation, like "cpu=8,memory=32Gi".
+func ParseWorkflowResources(value string) (corev1.ResourceList, error) {
+	rl := make(corev1.ResourceList)
+	for item := range strings.SplitSeq(value, ",") {
+		name, quantity, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid resource %q, expecting name=quantity", item)
+		}
+		q, err := resource.ParseQuantity(quantity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity for %s: %w", name, err)
+		}
+		rl[corev1.ResourceName(name)] = q
+	}
+	return rl, nil
+}
+
+// workflowState tracks the workloads of a workflow with quota reserved in a
+// ClusterQueue, and the quota reserved for the workflow.
+type workflowState struct {
+	members int
+	// requested is the quota requested for the workflow by the
+	// WorkflowReservationAnnotation.
+	requested corev1.ResourceList
+	// reservation is the quota reserved for the workflow, in the flavors
+	// assigned to its workloads.
+	reservation resources.FlavorResourceQuantities
+	// idleSince is when the last workload of the workflow finished, zero
+	// while the workflow has workloads in the ClusterQueue.
+	idleSince time.Time
+}
+
+// reservationActive returns whether the quota reserved for the workflow is
+// kept at the given time.
+func (s *workflowState) reservationActive(now time.Time) bool {
+	if len(s.reservation) == 0 {
+		return false
+	}
+	return s.members > 0 || now.Sub(s.idleSince) < WorkflowReservationGracePeriod
+}
+
+// updateWorkflow tracks a workload of a workflow added to or removed from the
+// ClusterQueue.
+func (c *clusterQueue) updateWorkflow(wi *workload.Info, op usageOp) {
+	key, found := workflowKeyOf(wi.Obj)
+	if !found {
+		return
+	}
+	now := c.clock.Now()
+	for k, s := range c.workflows {
+		if s.members == 0 && !s.reservationActive(now) {
+			delete(c.workflows, k)
+		}
+	}
+	s, found := c.workflows[key]
+	if op == subtract {
+		if !found {
+			return
+		}
+		if s.members--; s.members == 0 {
+			s.idleSince = now
+		}
+		return
+	}
+	if !found {
+		if c.workflows == nil {
+			c.workflows = make(map[workflowKey]*workflowState)
+		}
+		s = &workflowState{}
+		c.workflows[key] = s
+	}
+	s.members++
+	s.idleSince = time.Time{}
+	if value, found := wi.Obj.Annotations[kueue.WorkflowReservationAnnotation]; found {
+		if rl, err := ParseWorkflowResources(value); err == nil {
+			s.requested = rl
+			s.reservation = c.workflowReservationQuantities(rl, wi.Usage().Quota.Assigned)
+		}
+	}
+}
+
+// workflowReservationAboveLimit returns the resources for which the
+// requested reservation is above the limit, sorted by name. The resources
+// missing from the limit can't be reserved.
+func workflowReservationAboveLimit(requested, limit corev1.ResourceList) []corev1.ResourceName {
+	var above []corev1.ResourceName
+	for name, q := range requested {
+		if l, found := limit[name]; !found || q.Cmp(l) > 0 {
+			above = append(above, name)
+		}
+	}
+	slices.Sort(above)
+	return above
+}
+
+// workflowReservationKept returns whether the quota reserved for the
+// workflow is kept at the given time. The reservations admitted before the
+// limit of the ClusterQueue was lowered are not kept.
+func (c *clusterQueue) workflowReservationKept(s *workflowState, now time.Time) bool {
+	return s.reservationActive(now) && c.workflowReservationLimit != nil &&
+		len(workflowReservationAboveLimit(s.requested, c.workflowReservationLimit)) == 0
+}
+
+// WorkflowReservationRejected returns why the workload can't be admitted in
+// the ClusterQueue, if the workload reserves quota for its workflow above the
+// limit of the ClusterQueue.
+func (c *ClusterQueueSnapshot) WorkflowReservationRejected(wl *kueue.Workload) (string, bool) {
+	if _, found := workflowKeyOf(wl); !found {
+		return "", false
+	}
+	value, found := wl.Annotations[kueue.WorkflowReservationAnnotation]
+	if !found {
+		return "", false
+	}
+	requested, err := ParseWorkflowResources(value)
+	if err != nil {
+		return fmt.Sprintf("Invalid workflow reservation: %v", err), true
+	}
+	if c.workflowReservationLimit == nil {
+		return fmt.Sprintf("The ClusterQueue %s doesn't allow workflow reservations", c.Name), true
+	}
+	if above := workflowReservationAboveLimit(requested, c.workflowReservationLimit); len(above) > 0 {
+		return fmt.Sprintf("The workflow reservation is above the limit of the ClusterQueue %s for %v", c.Name, above), true
+	}
+	return "", false
+}
+
+// WorkflowReservationRemaining returns how long the quota reserved for the
+// workflow of the workload is still kept in its ClusterQueue, when the
+// workflow has no workloads left in the ClusterQueue. The inadmissible
+// workloads blocked by the reservation need to be requeued once it expires.
+func (c *Cache) WorkflowReservationRemaining(wl *kueue.Workload) (time.Duration, bool) {
+	key, found := workflowKeyOf(wl)
+	if !found || wl.Status.Admission == nil {
+		return 0, false
+	}
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.hm.ClusterQueue(wl.Status.Admission.ClusterQueue)
+	if cq == nil {
+		return 0, false
+	}
+	s, found := cq.workflows[key]
+	now := c.clock.Now()
+	if !found || s.members > 0 || !cq.workflowReservationKept(s, now) {
+		return 0, false
+	}
+	return WorkflowReservationGracePeriod - now.Sub(s.idleSince), true
+}
+
+// workflowReservationQuantities maps the reserved resources to the flavors
+// assigned to the workload for them, or to the first flavor of the
+// ClusterQueue covering them.
+func (c *clusterQueue) workflowReservationQuantities(rl corev1.ResourceList, assigned resources.FlavorResourceQuantities) resources.FlavorResourceQuantities {
+	flavors := make(map[corev1.ResourceName]kueue.ResourceFlavorReference, len(rl))
+	for fr := range assigned {
+		flavors[fr.Resource] = fr.Flavor
+	}
+	quantities := make(resources.FlavorResourceQuantities, len(rl))
+	for name, q := range rl {
+		flavor, found := flavors[name]
+		if !found {
+			for _, rg := range c.ResourceGroups {
+				if rg.CoveredResources.Has(name) && len(rg.Flavors) > 0 {
+					flavor, found = rg.Flavors[0], true
+					break
+				}
+			}
+		}
+		if found {
+			quantities[resources.FlavorResource{Flavor: flavor, Resource: name}] = resources.AmountFromQuantity(name, q)
+		}
+	}
+	return quantities
+}
+
+// totalRequests returns the requests of the workload, regardless of the
+// flavors.
+func totalRequests(wl *workload.Info) resources.Requests {
+	reqs := resources.NewRequests()
+	for _, ps := range wl.TotalRequests {
+		if ps.Requests != nil {
+			reqs.Add(ps.Requests)
+		}
+	}
+	return reqs
+}
+
+// WorkflowBudgetExceeded returns why the workload can't be admitted, if the
+// requests of the admitted workloads of its workflow would exceed the budget
+// of the workflow.
+func (s *Snapshot) WorkflowBudgetExceeded(wl *workload.Info) (string, bool) {
+	key, found := workflowKeyOf(wl.Obj)
+	if !found {
+		return "", false
+	}
+	value, found := wl.Obj.Annotations[kueue.WorkflowBudgetAnnotation]
+	if !found {
+		return "", false
+	}
+	budget, err := ParseWorkflowResources(value)
+	if err != nil {
+		return fmt.Sprintf("Invalid workflow budget: %v", err), true
+	}
+	total := totalRequests(wl)
+	if usage, found := s.workflowUsage[key]; found {
+		total.Add(usage)
+	}
+	if exceeded := total.GreaterKeysRL(budget); len(exceeded) > 0 {
+		return fmt.Sprintf("The budget of the workflow %s is exceeded for %v", wl.Obj.Labels[kueue.WorkflowLabel], exceeded), true
+	}
+	return "", false
+}
+
+// AddWorkflowUsage tracks the usage added for a workload of a workflow.
+func (s *Snapshot) AddWorkflowUsage(wl *workload.Info, usage workload.Usage) {
+	s.updateWorkflowUsage(wl.Obj, usage.Quota.Assigned, add)
+}
+
+func (s *Snapshot) updateWorkflowUsage(wl *kueue.Workload, usage resources.FlavorResourceQuantities, op usageOp) {
+	key, found := workflowKeyOf(wl)
+	if !found || s.workflowUsage == nil {
+		return
+	}
+	current, found := s.workflowUsage[key]
+	if !found {
+		current = resources.NewRequests()
+		s.workflowUsage[key] = current
+	}
+	if op == add {
+		current.Add(usage.FlattenFlavors())
+	} else {
+		current.Sub(usage.FlattenFlavors())
+	}
+}
+
+// snapshotWorkflows adds to the snapshot the usage of the workflows, and the
+// reservations of the workflows in their ClusterQueues.
+func (c *Cache) snapshotWorkflows(snap *Snapshot) {
+	if !features.Enabled(features.WorkflowBudgets) {
+		return
+	}
+	snap.workflowUsage = make(map[workflowKey]resources.Requests)
+	now := c.clock.Now()
+	for _, cq := range c.hm.ClusterQueues() {
+		if len(cq.workflows) == 0 {
+			continue
+		}
+		for _, wl := range cq.Workloads {
+			snap.updateWorkflowUsage(wl.Obj, wl.Usage().Quota.Assigned, add)
+		}
+		cqSnapshot := snap.ClusterQueue(cq.Name)
+		if cqSnapshot == nil {
+			continue
+		}
+		for key, s := range cq.workflows {
+			if !cq.workflowReservationKept(s, now) {
+				continue
+			}
+			rs := newReservationSnapshot("", &reservation{quantities: s.reservation}, cqSnapshot, now)
+			rs.workflow = key
+			for _, wl := range cqSnapshot.Workloads {
+				if k, found := workflowKeyOf(wl.Obj); found && k == key {
+					rs.updateTagged(wl.Usage().Quota.Assigned, add)
+				}
+			}
+			cqSnapshot.reservations = append(cqSnapshot.reservations, rs)
+		}
+	}
+}
@@ -0,0 +1,338 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestParseWorkflowResources(t *testing.T) {
+	cases := map[string]struct {
+		value   string
+		want    corev1.ResourceList
+		wantErr bool
+	}{
+		"valid": {
+			value: "cpu=8, memory=32Gi",
+			want: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("8"),
+				corev1.ResourceMemory: resource.MustParse("32Gi"),
+			},
+		},
+		"missing quantity": {
+			value:   "cpu",
+			wantErr: true,
+		},
+		"invalid quantity": {
+			value:   "cpu=eight",
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseWorkflowResources(tc.value)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ParseWorkflowResources returned error %v, want error: %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected resources (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotWorkflowBudgetExceeded(t *testing.T) {
+	now := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		disableGate bool
+		workflow    string
+		budget      string
+		request     string
+		wantMsg     string
+	}{
+		"within the budget": {
+			workflow: "pipeline",
+			budget:   "cpu=4",
+			request:  "2",
+		},
+		"budget exceeded": {
+			workflow: "pipeline",
+			budget:   "cpu=4",
+			request:  "3",
+			wantMsg:  "The budget of the workflow pipeline is exceeded for [cpu]",
+		},
+		"other workflow": {
+			workflow: "other",
+			budget:   "cpu=4",
+			request:  "3",
+		},
+		"invalid budget": {
+			workflow: "pipeline",
+			budget:   "cpu",
+			request:  "1",
+			wantMsg:  `Invalid workflow budget: invalid resource "cpu", expecting name=quantity`,
+		},
+		"feature gate disabled": {
+			disableGate: true,
+			workflow:    "pipeline",
+			budget:      "cpu=4",
+			request:     "3",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkflowBudgets, !tc.disableGate)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(now)))
+			cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			for _, name := range []kueue.ClusterQueueReference{"cq-a", "cq-b"} {
+				if err := cache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue(string(name)).
+					ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+					Obj()); err != nil {
+					t.Fatalf("Adding the cluster queue: %v", err)
+				}
+			}
+			// The budget caps the usage of the workflow across ClusterQueues.
+			cache.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload("step-1", "ns").
+				Label(kueue.WorkflowLabel, "pipeline").
+				Request(corev1.ResourceCPU, "1").
+				SimpleReserveQuota("cq-a", "default", now).
+				Obj())
+			cache.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload("step-2", "ns").
+				Label(kueue.WorkflowLabel, "pipeline").
+				Request(corev1.ResourceCPU, "1").
+				SimpleReserveQuota("cq-b", "default", now).
+				Obj())
+
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Taking the snapshot: %v", err)
+			}
+			info := workload.NewInfo(utiltestingapi.MakeWorkload("step-3", "ns").
+				Label(kueue.WorkflowLabel, tc.workflow).
+				Annotation(kueue.WorkflowBudgetAnnotation, tc.budget).
+				Request(corev1.ResourceCPU, tc.request).
+				Obj())
+			info.ClusterQueue = "cq-a"
+			gotMsg, _ := snapshot.WorkflowBudgetExceeded(info)
+			if diff := cmp.Diff(tc.wantMsg, gotMsg); diff != "" {
+				t.Errorf("Unexpected message (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotWorkflowBudgetInCycleUsage(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.WorkflowBudgets, true)
+	now := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	ctx, log := utiltesting.ContextWithLog(t)
+	cache := New(utiltesting.NewFakeClient(), WithClock(testingclock.NewFakeClock(now)))
+	cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	if err := cache.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		Obj()); err != nil {
+		t.Fatalf("Adding the cluster queue: %v", err)
+	}
+	snapshot, err := cache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Taking the snapshot: %v", err)
+	}
+	step := func(name string) *workload.Info {
+		info := workload.NewInfo(utiltestingapi.MakeWorkload(name, "ns").
+			Label(kueue.WorkflowLabel, "pipeline").
+			Annotation(kueue.WorkflowBudgetAnnotation, "cpu=3").
+			Request(corev1.ResourceCPU, "2").
+			Obj())
+		info.ClusterQueue = "cq"
+		return info
+	}
+	first := step("first")
+	if msg, exceeded := snapshot.WorkflowBudgetExceeded(first); exceeded {
+		t.Fatalf("The budget is exceeded for the first step: %s", msg)
+	}
+	usage := workload.Usage{Quota: workload.ResourceUsage{Assigned: resources.FlavorResourceQuantities{
+		{Flavor: "default", Resource: corev1.ResourceCPU}: resources.AmountFromQuantity(corev1.ResourceCPU, resource.MustParse("2")),
+	}}}
+	snapshot.AddWorkflowUsage(first, usage)
+	if _, exceeded := snapshot.WorkflowBudgetExceeded(step("second")); !exceeded {
+		t.Error("The budget is not exceeded for the second step, after the first one was admitted in the cycle")
+	}
+}
+
+func TestSnapshotWorkflowReservation(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.WorkflowBudgets, true)
+	now := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	milliCPU := func(v string) int64 {
+		return resources.AmountFromQuantity(corev1.ResourceCPU, resource.MustParse(v)).Int64()
+	}
+	ctx, log := utiltesting.ContextWithLog(t)
+	clock := testingclock.NewFakeClock(now)
+	cache := New(utiltesting.NewFakeClient(), WithClock(clock))
+	cache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+		WorkflowReservationLimit(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).
+		Obj()
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Adding the cluster queue: %v", err)
+	}
+	step := utiltestingapi.MakeWorkload("step-1", "ns").
+		Label(kueue.WorkflowLabel, "pipeline").
+		Annotation(kueue.WorkflowReservationAnnotation, "cpu=4").
+		Request(corev1.ResourceCPU, "1").
+		SimpleReserveQuota("cq", "default", now).
+		Obj()
+	cache.AddOrUpdateWorkload(log, step)
+
+	newInfo := func(name, workflow string) *workload.Info {
+		wl := utiltestingapi.MakeWorkload(name, "ns").Request(corev1.ResourceCPU, "1")
+		if workflow != "" {
+			wl.Label(kueue.WorkflowLabel, workflow)
+		}
+		info := workload.NewInfo(wl.Obj())
+		info.ClusterQueue = "cq"
+		return info
+	}
+	checkAvailable := func(desc string, info *workload.Info, want string) {
+		t.Helper()
+		snapshot, err := cache.Snapshot(ctx)
+		if err != nil {
+			t.Fatalf("Taking the snapshot: %v", err)
+		}
+		defer snapshot.ApplyReservations(info)()
+		if got := snapshot.ClusterQueue("cq").Available(cpu).Int64(); got != milliCPU(want) {
+			t.Errorf("Unexpected available CPU %s, got %d, want %d", desc, got, milliCPU(want))
+		}
+	}
+
+	// The reserved quota not used by the step is unavailable to other
+	// workloads.
+	checkAvailable("for a workload of another workflow", newInfo("other", "other"), "6")
+	checkAvailable("for a workload without workflow", newInfo("other", ""), "6")
+	checkAvailable("for a step of the workflow", newInfo("step-2", "pipeline"), "9")
+
+	// The reservation is not kept while it is above the limit.
+	lowered := cq.DeepCopy()
+	lowered.Spec.WorkflowReservationLimit = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+	if err := cache.UpdateClusterQueue(log, lowered); err != nil {
+		t.Fatalf("Updating the cluster queue: %v", err)
+	}
+	checkAvailable("with the limit lowered", newInfo("other", ""), "9")
+	if err := cache.UpdateClusterQueue(log, cq); err != nil {
+		t.Fatalf("Updating the cluster queue: %v", err)
+	}
+	checkAvailable("with the limit restored", newInfo("other", ""), "6")
+
+	// The reservation is kept for the grace period after the step finishes.
+	if remaining, held := cache.WorkflowReservationRemaining(step); held {
+		t.Errorf("Unexpected reservation expiring while the step is admitted, remaining %v", remaining)
+	}
+	if err := cache.DeleteWorkload(log, workload.Key(step)); err != nil {
+		t.Fatalf("Deleting the workload: %v", err)
+	}
+	checkAvailable("after the step finished", newInfo("other", ""), "6")
+	clock.Step(time.Minute)
+	if remaining, held := cache.WorkflowReservationRemaining(step); !held || remaining != WorkflowReservationGracePeriod-time.Minute {
+		t.Errorf("Unexpected remaining reservation, got %v (held=%v), want %v", remaining, held, WorkflowReservationGracePeriod-time.Minute)
+	}
+	clock.Step(WorkflowReservationGracePeriod - time.Minute)
+	checkAvailable("after the grace period", newInfo("other", ""), "10")
+	if remaining, held := cache.WorkflowReservationRemaining(step); held {
+		t.Errorf("Unexpected reservation held after the grace period, remaining %v", remaining)
+	}
+}
+
+func TestClusterQueueSnapshotWorkflowReservationRejected(t *testing.T) {
+	cases := map[string]struct {
+		disableGate bool
+		limit       corev1.ResourceList
+		workflow    string
+		reservation string
+		wantMsg     string
+	}{
+		"within the limit": {
+			limit:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			workflow:    "pipeline",
+			reservation: "cpu=4",
+		},
+		"above the limit": {
+			limit:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			workflow:    "pipeline",
+			reservation: "cpu=5",
+			wantMsg:     "The workflow reservation is above the limit of the ClusterQueue cq for [cpu]",
+		},
+		"resource missing from the limit": {
+			limit:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			workflow:    "pipeline",
+			reservation: "cpu=1,memory=1Gi",
+			wantMsg:     "The workflow reservation is above the limit of the ClusterQueue cq for [memory]",
+		},
+		"no limit": {
+			workflow:    "pipeline",
+			reservation: "cpu=1",
+			wantMsg:     "The ClusterQueue cq doesn't allow workflow reservations",
+		},
+		"invalid reservation": {
+			limit:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+			workflow:    "pipeline",
+			reservation: "cpu",
+			wantMsg:     `Invalid workflow reservation: invalid resource "cpu", expecting name=quantity`,
+		},
+		"no reservation": {
+			workflow: "pipeline",
+		},
+		"no workflow": {
+			reservation: "cpu=1",
+		},
+		"feature gate disabled": {
+			disableGate: true,
+			workflow:    "pipeline",
+			reservation: "cpu=1",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkflowBudgets, !tc.disableGate)
+			cq := &ClusterQueueSnapshot{Name: "cq", workflowReservationLimit: tc.limit}
+			wl := utiltestingapi.MakeWorkload("step", "ns").Request(corev1.ResourceCPU, "1")
+			if tc.workflow != "" {
+				wl.Label(kueue.WorkflowLabel, tc.workflow)
+			}
+			if tc.reservation != "" {
+				wl.Annotation(kueue.WorkflowReservationAnnotation, tc.reservation)
+			}
+			gotMsg, _ := cq.WorkflowReservationRejected(wl.Obj())
+			if diff := cmp.Diff(tc.wantMsg, gotMsg); diff != "" {
+				t.Errorf("Unexpected message (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -43,10 +43,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/externalframeworks"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podworkload "sigs.k8s.io/kueue/pkg/controller/jobs/pod"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/metrics"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
 	stringsutils "sigs.k8s.io/kueue/pkg/util/strings"
 	"sigs.k8s.io/kueue/pkg/util/tlsconfig"
 	"sigs.k8s.io/kueue/pkg/util/waitforpodsready"
@@ -82,6 +84,7 @@ var (
 	visibilityServerBindPortPath          = field.NewPath("visibilityServer", "bindPort")
 	customLabelsPath                      = field.NewPath("metrics", "customLabels")
 	resourceQuotaCheckStrategyPath        = field.NewPath("resources", "quotaCheckStrategy")
+	schedulingProfilePath                 = field.NewPath("schedulingProfile")
 	// Values in this map should never exceed metrics.MaxCustomLabelsForSourceKind.
 	maxCustomLabelsPerSourceKind = map[configapi.SourceKind]int{
 		configapi.SourceKindWorkload:     min(2, metrics.MaxCustomLabelsForSourceKind),
@@ -108,6 +111,7 @@ func Validate(c *configapi.Configuration, scheme *runtime.Scheme, integrationMan
 	allErrs = append(allErrs, validateVisibilityServer(c)...)
 	allErrs = append(allErrs, validateCustomLabels(c)...)
 	allErrs = append(allErrs, validateQuotaCheckStrategy(c)...)
+	allErrs = append(allErrs, validateSchedulingProfile(c)...)
 	return allErrs
 }
 
@@ -207,6 +211,7 @@ func validateMultiKueue(c *configapi.Configuration, integrationManager *jobframe
 				if builtInGVKs.Has(gvk) {
 					allErrs = append(allErrs, field.Invalid(fldPath, f.Name, "conflicts with a built-in MultiKueue adapter"))
 				}
+				allErrs = append(allErrs, validateMultiKueueExternalFrameworkMappings(&f, path.Index(i))...)
 			}
 		}
 
@@ -233,6 +238,50 @@ func validateMultiKueue(c *configapi.Configuration, integrationManager *jobframe
 					"must be greater than or equal to 1"))
 			}
 		}
+
+		if ptr.Deref(c.MultiKueue.DispatcherName, "") == configapi.MultiKueueDispatcherModeCapacityAware &&
+			!features.Enabled(features.MultiKueueCapacityAwareDispatcher) {
+			allErrs = append(allErrs, field.Forbidden(multiKueuePath.Child("dispatcherName"),
+				"the capacity-aware dispatcher requires the MultiKueueCapacityAwareDispatcher feature gate"))
+		}
+
+		if cadc := c.MultiKueue.CapacityAwareDispatcherConfig; cadc != nil {
+			cadcPath := multiKueuePath.Child("capacityAwareDispatcherConfig")
+			if ptr.Deref(c.MultiKueue.DispatcherName, "") != configapi.MultiKueueDispatcherModeCapacityAware {
+				allErrs = append(allErrs, field.Invalid(cadcPath, cadc,
+					"capacityAwareDispatcherConfig is only valid when dispatcherName is set to the capacity-aware dispatcher"))
+			}
+			if cadc.StepSize != nil && *cadc.StepSize < 1 {
+				allErrs = append(allErrs, field.Invalid(cadcPath.Child("stepSize"), *cadc.StepSize,
+					"must be greater than or equal to 1"))
+			}
+			if scoring := cadc.Scoring; scoring != nil {
+				scoringPath := cadcPath.Child("scoring")
+				weights := []struct {
+					name   string
+					weight *int32
+				}{
+					{name: "freeQuotaWeight", weight: scoring.FreeQuotaWeight},
+					{name: "pendingWorkloadsWeight", weight: scoring.PendingWorkloadsWeight},
+					{name: "fairSharingWeight", weight: scoring.FairSharingWeight},
+				}
+				allZero := true
+				for _, w := range weights {
+					if w.weight != nil && *w.weight < 0 {
+						allErrs = append(allErrs, field.Invalid(scoringPath.Child(w.name), *w.weight,
+							"must be greater than or equal to 0"))
+					}
+					// Unset weights are defaulted to positive values.
+					if w.weight == nil || *w.weight > 0 {
+						allZero = false
+					}
+				}
+				if allZero {
+					allErrs = append(allErrs, field.Invalid(scoringPath, scoring,
+						"at least one weight must be greater than 0"))
+				}
+			}
+		}
 	}
 	return allErrs
 }
@@ -736,6 +785,56 @@ func validateVisibilityServer(c *configapi.Configuration) field.ErrorList {
 	return allErrs
 }
 
+func validateSchedulingProfile(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	if c.SchedulingProfile == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.SchedulingFramework) {
+		return append(allErrs, field.Forbidden(schedulingProfilePath, "requires the SchedulingFramework feature gate"))
+	}
+	pluginsPath := schedulingProfilePath.Child("plugins")
+	plugins := c.SchedulingProfile.Plugins
+	if len(plugins.QueueSort) > 1 {
+		allErrs = append(allErrs, field.TooMany(pluginsPath.Child("queueSort"), len(plugins.QueueSort), 1))
+	}
+	allErrs = append(allErrs, validatePluginNames(pluginsPath.Child("queueSort"), plugins.QueueSort)...)
+	allErrs = append(allErrs, validatePluginNames(pluginsPath.Child("preFilter"), plugins.PreFilter)...)
+	allErrs = append(allErrs, validatePluginNames(pluginsPath.Child("flavorScore"), plugins.FlavorScore)...)
+	allErrs = append(allErrs, validatePluginNames(pluginsPath.Child("preemptionCandidateFilter"), plugins.PreemptionCandidateFilter)...)
+	if len(plugins.PreemptionCandidateOrder) > 1 {
+		allErrs = append(allErrs, field.TooMany(pluginsPath.Child("preemptionCandidateOrder"), len(plugins.PreemptionCandidateOrder), 1))
+	}
+	allErrs = append(allErrs, validatePluginNames(pluginsPath.Child("preemptionCandidateOrder"), plugins.PreemptionCandidateOrder)...)
+	allErrs = append(allErrs, validatePluginNames(pluginsPath.Child("postAdmit"), plugins.PostAdmit)...)
+	pluginConfigPath := schedulingProfilePath.Child("pluginConfig")
+	seen := sets.New[string]()
+	for i, pc := range c.SchedulingProfile.PluginConfig {
+		namePath := pluginConfigPath.Index(i).Child("name")
+		if pc.Name == "" {
+			allErrs = append(allErrs, field.Required(namePath, ""))
+		} else if seen.Has(pc.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, pc.Name))
+		}
+		seen.Insert(pc.Name)
+	}
+	return allErrs
+}
+
+func validatePluginNames(fldPath *field.Path, names []string) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := sets.New[string]()
+	for i, name := range names {
+		if name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), ""))
+		} else if seen.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), name))
+		}
+		seen.Insert(name)
+	}
+	return allErrs
+}
+
 var customLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
 
 func validateCustomLabels(c *configapi.Configuration) field.ErrorList {
@@ -826,6 +925,32 @@ func counterNameForMapping(mapping configapi.DeviceClassMapping) string {
 	return ""
 }
 
+func validateMultiKueueExternalFrameworkMappings(f *configapi.MultiKueueExternalFramework, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if f.ManagedByPath != nil {
+		if _, err := fieldpath.Parse(*f.ManagedByPath); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("managedByPath"), *f.ManagedByPath, err.Error()))
+		}
+	}
+	if f.SuspendPath != nil {
+		if _, err := fieldpath.Parse(*f.SuspendPath); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("suspendPath"), *f.SuspendPath, err.Error()))
+		}
+	}
+	for i, p := range f.StatusPaths {
+		if _, err := externalframeworks.ParseStatusPath(p); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("statusPaths").Index(i), p, err.Error()))
+		}
+	}
+	if f.FinishedExpression != nil {
+		if _, err := externalframeworks.CompileFinishedExpression(*f.FinishedExpression); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("finishedExpression"), *f.FinishedExpression,
+				fmt.Sprintf("CEL compilation failed: %v", err)))
+		}
+	}
+	return allErrs
+}
+
 func validateDeviceClassSource(driver string, selector *resourcev1.DeviceSelector, path *field.Path, celCache *dracel.Cache) field.ErrorList {
 	var allErrs field.ErrorList
 	if driver == "" {
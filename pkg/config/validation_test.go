@@ -475,6 +475,130 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"valid multiKueue.capacityAwareDispatcherConfig with capacity-aware dispatcher": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: new(configapi.MultiKueueDispatcherModeCapacityAware),
+					CapacityAwareDispatcherConfig: &configapi.CapacityAwareDispatcherConfig{
+						StepSize: new(int32(2)),
+						Scoring: &configapi.CapacityAwareScoring{
+							FreeQuotaWeight:   new(int32(1)),
+							FairSharingWeight: new(int32(0)),
+						},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.MultiKueueCapacityAwareDispatcher: true},
+		},
+		"capacity-aware dispatcher without the feature gate": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: new(configapi.MultiKueueDispatcherModeCapacityAware),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "multiKueue.dispatcherName",
+				},
+			},
+		},
+		"invalid multiKueue.capacityAwareDispatcherConfig": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					DispatcherName: new(configapi.MultiKueueDispatcherModeIncremental),
+					CapacityAwareDispatcherConfig: &configapi.CapacityAwareDispatcherConfig{
+						StepSize: new(int32(0)),
+						Scoring: &configapi.CapacityAwareScoring{
+							FreeQuotaWeight:        new(int32(-1)),
+							PendingWorkloadsWeight: new(int32(0)),
+							FairSharingWeight:      new(int32(0)),
+						},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.MultiKueueCapacityAwareDispatcher: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.capacityAwareDispatcherConfig",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.capacityAwareDispatcherConfig.stepSize",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.capacityAwareDispatcherConfig.scoring.freeQuotaWeight",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.capacityAwareDispatcherConfig.scoring",
+				},
+			},
+		},
+		"valid multiKueue.externalFrameworks field mappings": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					ExternalFrameworks: []configapi.MultiKueueExternalFramework{{
+						Name:               "TrainingRun.v1.example.com",
+						ManagedByPath:      new("spec.control.managedBy"),
+						SuspendPath:        new("spec.control.suspend"),
+						StatusPaths:        []string{"status.phase", "status.conditions"},
+						FinishedExpression: new(`object.status.phase in ["Succeeded", "Failed"]`),
+					}},
+				},
+			},
+		},
+		"invalid multiKueue.externalFrameworks field mappings": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					ExternalFrameworks: []configapi.MultiKueueExternalFramework{{
+						Name:               "TrainingRun.v1.example.com",
+						ManagedByPath:      new(""),
+						SuspendPath:        new("spec..suspend"),
+						StatusPaths:        []string{"status.phase", "spec.replicas"},
+						FinishedExpression: new("object.status.phase"),
+					}},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].managedByPath",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].suspendPath",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].statusPaths[1]",
+				},
+			},
+		},
+		"multiKueue.externalFrameworks finished expression not returning a bool": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				MultiKueue: &configapi.MultiKueue{
+					ExternalFrameworks: []configapi.MultiKueueExternalFramework{{
+						Name:               "TrainingRun.v1.example.com",
+						FinishedExpression: new(`size(object.status.phase) + 1`),
+					}},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "multiKueue.externalFrameworks[0].finishedExpression",
+				},
+			},
+		},
 		"empty multiKueue.clusterProfile.accessProviders.name": {
 			cfg: &configapi.Configuration{
 				Integrations: defaultIntegrations,
@@ -1221,6 +1345,71 @@ func TestValidate(t *testing.T) {
 				features.QuotaCheckStrategy: false,
 			},
 		},
+		"valid schedulingProfile": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				SchedulingProfile: &configapi.SchedulingProfile{
+					Plugins: configapi.SchedulingPlugins{
+						QueueSort: []string{"PrioritySort"},
+						PreFilter: []string{"foo", "bar"},
+					},
+					PluginConfig: []configapi.PluginConfig{{Name: "foo"}},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{
+				features.SchedulingFramework: true,
+			},
+		},
+		"schedulingProfile requires the feature gate": {
+			cfg: &configapi.Configuration{
+				Integrations:      defaultIntegrations,
+				SchedulingProfile: &configapi.SchedulingProfile{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "schedulingProfile",
+				},
+			},
+		},
+		"invalid schedulingProfile": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				SchedulingProfile: &configapi.SchedulingProfile{
+					Plugins: configapi.SchedulingPlugins{
+						QueueSort:                []string{"foo", "bar"},
+						PreemptionCandidateOrder: []string{"foo", "bar"},
+						PostAdmit:                []string{"foo", "foo", ""},
+					},
+					PluginConfig: []configapi.PluginConfig{{Name: "foo"}, {Name: "foo"}},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{
+				features.SchedulingFramework: true,
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeTooMany,
+					Field: "schedulingProfile.plugins.queueSort",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeTooMany,
+					Field: "schedulingProfile.plugins.preemptionCandidateOrder",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "schedulingProfile.plugins.postAdmit[1]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "schedulingProfile.plugins.postAdmit[2]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "schedulingProfile.pluginConfig[1].name",
+				},
+			},
+		},
 		"valid counter source on deviceClassMapping": {
 			featureGates: map[featuregate.Feature]bool{features.KueueDRAIntegrationPartitionableDevices: true},
 			cfg: &configapi.Configuration{
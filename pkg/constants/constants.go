@@ -25,6 +25,7 @@ const (
 	MultiKueueName               = "multikueue"
 	JobControllerName            = KueueName + "-job-controller"
 	WorkloadControllerName       = KueueName + "-workload-controller"
+	ClusterQueueControllerName   = KueueName + "-cluster-queue-controller"
 	PodTerminationControllerName = KueueName + "-pod-termination-controller"
 	AdmissionName                = KueueName + "-admission"
 	ReclaimablePodsMgr           = KueueName + "-reclaimable-pods"
@@ -45,6 +45,8 @@ type SetupOptions struct {
 	dispatcherName       string
 	clusterProfileConfig *configapi.ClusterProfile
 	roleTracker          *roletracker.RoleTracker
+	workerClusters       *WorkerClusters
+	loopbackClusters     map[string]LoopbackCluster
 }
 
 type SetupOption func(o *SetupOptions)
@@ -108,6 +110,22 @@ func WithRoleTracker(tracker *roletracker.RoleTracker) SetupOption {
 	}
 }
 
+// WithWorkerClusters sets the WorkerClusters which gets access to
+// the clients of the connected worker clusters.
+func WithWorkerClusters(w *WorkerClusters) SetupOption {
+	return func(o *SetupOptions) {
+		o.workerClusters = w
+	}
+}
+
+// WithLoopbackClusters sets the in-process worker clusters, by name, which the
+// MultiKueueClusters with the Loopback location type connect to.
+func WithLoopbackClusters(clusters map[string]LoopbackCluster) SetupOption {
+	return func(o *SetupOptions) {
+		o.loopbackClusters = clusters
+	}
+}
+
 func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) error {
 	options := &SetupOptions{
 		gcInterval:        defaultGCInterval,
@@ -157,10 +175,14 @@ func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) e
 		options.adapters, cpAccessProvider, options.roleTracker,
 		mgr.GetEventRecorder("multikueue-cluster"),
 	)
+	cRec.loopbackClusters = options.loopbackClusters
 	err = cRec.setupWithManager(mgr)
 	if err != nil {
 		return err
 	}
+	if options.workerClusters != nil {
+		options.workerClusters.clusters = cRec
+	}
 
 	acRec := newACReconciler(mgr.GetClient(), helper, options.roleTracker)
 	err = acRec.setupWithManager(mgr)
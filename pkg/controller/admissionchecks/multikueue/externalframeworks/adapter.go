@@ -35,12 +35,15 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/api"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
 )
 
-// Adapter implements the MultiKueueAdapter interface for external frameworks
-// with hardcoded default behavior as specified in the KEP.
+// Adapter implements the MultiKueueAdapter interface for external frameworks.
+// By default it behaves as specified in the KEP; the fields it relies on can
+// be remapped in the external framework configuration.
 type Adapter struct {
-	gvk schema.GroupVersionKind
+	gvk      schema.GroupVersionKind
+	mappings fieldMappings
 }
 
 var (
@@ -80,10 +83,28 @@ func (a *Adapter) SyncJob(ctx context.Context, localClient client.Client, remote
 		return false, a.createRemoteObject(ctx, remoteClient, localObj, workloadName, origin)
 	}
 
+	if a.deferStatusSync(ctx, localObj, remoteObj) {
+		return true, nil
+	}
+
 	// Update existing remote object status
 	return false, a.syncStatus(ctx, localClient, localObj, remoteObj)
 }
 
+// deferStatusSync returns true if the status must not be copied back yet,
+// because the local object is suspended while the remote one is running but
+// not finished, in the same way as for batch/Job.
+func (a *Adapter) deferStatusSync(ctx context.Context, localObj, remoteObj *unstructured.Unstructured) bool {
+	if !a.mappings.isSuspended(localObj) || a.mappings.isSuspended(remoteObj) {
+		return false
+	}
+	finished, err := a.mappings.isFinished(remoteObj)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(3).Info("Failed to evaluate the finished expression", "gvk", a.gvk, "name", remoteObj.GetName(), "err", err)
+	}
+	return !finished
+}
+
 func (a *Adapter) createRemoteObject(ctx context.Context, remoteClient client.Client, localObj *unstructured.Unstructured, workloadName, origin string) error {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -92,9 +113,16 @@ func (a *Adapter) createRemoteObject(ctx context.Context, remoteClient client.Cl
 		return err
 	}
 
-	// Apply default transformation: remove the managedBy field
+	// Remove the managedBy field, so that the remote controller takes over
 	a.removeManagedByField(remoteObj)
 
+	// Create the object suspended, so that it waits for the admission in the remote cluster
+	if len(a.mappings.suspendPath) > 0 {
+		if err := unstructured.SetNestedField(remoteObj.Object, true, a.mappings.suspendPath...); err != nil {
+			return fmt.Errorf("setting %s: %w", fieldpath.String(a.mappings.suspendPath), err)
+		}
+	}
+
 	// Add prebuilt workload name and multikueue origin
 	jobframework.SetMultiKueueMeta(remoteObj, workloadName, origin)
 
@@ -139,7 +167,7 @@ func (a *Adapter) syncStatus(ctx context.Context, localClient client.Client, loc
 	// Create a deep copy of the original object to calculate the patch against.
 	originalObj := localObj.DeepCopy()
 
-	// Apply default sync: copy entire status from remote to local
+	// Copy the configured status fields, by default the entire status, from remote to local
 	a.copyStatusFromRemote(localObj, remoteObj)
 
 	// If there are no changes, do nothing.
@@ -153,26 +181,29 @@ func (a *Adapter) syncStatus(ctx context.Context, localClient client.Client, loc
 	return localClient.Status().Patch(ctx, localObj, patch)
 }
 
-// removeManagedByField removes the .spec.managedBy field from the object
+// removeManagedByField removes the managedBy field, .spec.managedBy by default, from the object
 func (a *Adapter) removeManagedByField(obj *unstructured.Unstructured) {
-	spec, exists, err := unstructured.NestedMap(obj.Object, "spec")
-	if !exists || err != nil {
-		return
-	}
-
-	delete(spec, "managedBy")
-	obj.Object["spec"] = spec
+	unstructured.RemoveNestedField(obj.Object, a.mappings.managedBy()...)
 }
 
-// copyStatusFromRemote copies the entire status from remote object to local object
+// copyStatusFromRemote copies the status fields, the entire status by default, from remote
+// object to local object. Fields missing in the remote status are removed from the local one.
 func (a *Adapter) copyStatusFromRemote(localObj, remoteObj *unstructured.Unstructured) {
-	remoteStatus, exists, err := unstructured.NestedMap(remoteObj.Object, "status")
-	if !exists || err != nil {
+	if _, exists, err := unstructured.NestedMap(remoteObj.Object, "status"); !exists || err != nil {
 		return
 	}
 
-	// Set the status in the local object
-	localObj.Object["status"] = remoteStatus
+	for _, path := range a.mappings.status() {
+		value, exists, err := unstructured.NestedFieldCopy(remoteObj.Object, path...)
+		if err != nil {
+			continue
+		}
+		if !exists {
+			unstructured.RemoveNestedField(localObj.Object, path...)
+			continue
+		}
+		_ = unstructured.SetNestedField(localObj.Object, value, path...)
+	}
 }
 
 // DeleteRemoteObject deletes the remote object identified by the given key from the remote cluster.
@@ -202,14 +233,14 @@ func (a *Adapter) IsJobManagedByKueue(ctx context.Context, c client.Client, key
 		return false, "", err
 	}
 
-	// Use the default managedBy path: .spec.managedBy
-	managedByValue, _, err := unstructured.NestedString(obj.Object, "spec", "managedBy")
+	managedByPath := a.mappings.managedBy()
+	managedByValue, _, err := unstructured.NestedString(obj.Object, managedByPath...)
 	if err != nil {
-		return false, "", fmt.Errorf("failed to read .spec.managedBy: %w", err)
+		return false, "", fmt.Errorf("failed to read %s: %w", fieldpath.String(managedByPath), err)
 	}
 
 	if managedByValue != kueue.MultiKueueControllerName {
-		return false, fmt.Sprintf("Expecting .spec.managedBy to be %q not %q", kueue.MultiKueueControllerName, managedByValue), nil
+		return false, fmt.Sprintf("Expecting %s to be %q not %q", fieldpath.String(managedByPath), kueue.MultiKueueControllerName, managedByValue), nil
 	}
 
 	return true, "", nil
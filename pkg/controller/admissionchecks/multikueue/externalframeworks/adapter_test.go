@@ -28,9 +28,11 @@ import (
 	"k8s.io/component-base/featuregate"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 )
 
 func TestAdapter_IsJobManagedByKueue(t *testing.T) {
@@ -315,6 +317,120 @@ func TestAdapter_CreateRemoteObject(t *testing.T) {
 	}
 }
 
+func TestAdapter_SyncJobWithFieldMappings(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "test.example.com", Version: "v1", Kind: "TrainingRun"}
+	mappings, err := newFieldMappings(configapi.MultiKueueExternalFramework{
+		Name:               "TrainingRun.v1.test.example.com",
+		ManagedByPath:      new("spec.control.managedBy"),
+		SuspendPath:        new("spec.control.suspend"),
+		StatusPaths:        []string{"status.phase", "status.startTime"},
+		FinishedExpression: new(`object.status.phase == "Succeeded"`),
+	})
+	if err != nil {
+		t.Fatalf("newFieldMappings() error = %v", err)
+	}
+	adapter := &Adapter{gvk: gvk, mappings: mappings}
+
+	makeObj := func(suspend bool, status map[string]any) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"control": map[string]any{
+					"managedBy": kueue.MultiKueueControllerName,
+					"suspend":   suspend,
+				},
+			},
+		}}
+		if status != nil {
+			obj.Object["status"] = status
+		}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetName("test-job")
+		obj.SetNamespace("default")
+		return obj
+	}
+
+	cases := map[string]struct {
+		local        *unstructured.Unstructured
+		remote       *unstructured.Unstructured
+		wantDeferred bool
+		wantRemote   map[string]any
+		wantStatus   map[string]any
+	}{
+		"remote object is created suspended and without the managedBy field": {
+			local: makeObj(false, nil),
+			wantRemote: map[string]any{
+				"control": map[string]any{"suspend": true},
+			},
+		},
+		"status sync is deferred while the local object is suspended and the remote one runs": {
+			local:        makeObj(true, nil),
+			remote:       makeObj(false, map[string]any{"phase": "Running"}),
+			wantDeferred: true,
+		},
+		"status sync is deferred when the finished expression can't be evaluated yet": {
+			local:        makeObj(true, nil),
+			remote:       makeObj(false, map[string]any{"startTime": "now"}),
+			wantDeferred: true,
+		},
+		"status of a finished remote object is synced": {
+			local:      makeObj(true, nil),
+			remote:     makeObj(false, map[string]any{"phase": "Succeeded", "internal": "value"}),
+			wantStatus: map[string]any{"phase": "Succeeded"},
+		},
+		"status fields missing in the remote object are removed": {
+			local:      makeObj(false, map[string]any{"phase": "Running", "startTime": "now"}),
+			remote:     makeObj(false, map[string]any{"phase": "Failed"}),
+			wantStatus: map[string]any{"phase": "Failed"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			localClient := fake.NewClientBuilder().WithObjects(tc.local).WithStatusSubresource(tc.local).Build()
+			remoteBuilder := fake.NewClientBuilder()
+			if tc.remote != nil {
+				remoteBuilder = remoteBuilder.WithObjects(tc.remote)
+			}
+			remoteClient := remoteBuilder.Build()
+			key := types.NamespacedName{Name: "test-job", Namespace: "default"}
+
+			deferred, err := adapter.SyncJob(ctx, localClient, remoteClient, key, "test-workload", "origin1")
+			if err != nil {
+				t.Fatalf("SyncJob() error = %v", err)
+			}
+			if deferred != tc.wantDeferred {
+				t.Errorf("SyncJob() deferred = %v, want %v", deferred, tc.wantDeferred)
+			}
+
+			if tc.wantRemote != nil {
+				remote := &unstructured.Unstructured{}
+				remote.SetGroupVersionKind(gvk)
+				if err := remoteClient.Get(ctx, key, remote); err != nil {
+					t.Fatalf("Get remote object error = %v", err)
+				}
+				if diff := cmp.Diff(tc.wantRemote, remote.Object["spec"]); diff != "" {
+					t.Errorf("Unexpected remote spec (-want,+got):\n%s", diff)
+				}
+			}
+
+			local := &unstructured.Unstructured{}
+			local.SetGroupVersionKind(gvk)
+			if err := localClient.Get(ctx, key, local); err != nil {
+				t.Fatalf("Get local object error = %v", err)
+			}
+			wantStatus := tc.wantStatus
+			if wantStatus == nil {
+				wantStatus, _, _ = unstructured.NestedMap(tc.local.Object, "status")
+			}
+			gotStatus, _, _ := unstructured.NestedMap(local.Object, "status")
+			if diff := cmp.Diff(wantStatus, gotStatus, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected local status (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestAdapter_RemoveManagedByField(t *testing.T) {
 	adapter := &Adapter{
 		gvk: schema.GroupVersionKind{
@@ -28,7 +28,7 @@ import (
 
 // NewAdapters creates and returns adapters from the given configurations.
 func NewAdapters(configs []configapi.MultiKueueExternalFramework) ([]*Adapter, error) {
-	configsMap := make(map[schema.GroupVersionKind]configapi.MultiKueueExternalFramework)
+	mappingsMap := make(map[schema.GroupVersionKind]fieldMappings)
 	var errs []error
 
 	for _, config := range configs {
@@ -38,12 +38,17 @@ func NewAdapters(configs []configapi.MultiKueueExternalFramework) ([]*Adapter, e
 			continue
 		}
 
-		if _, exists := configsMap[*gvk]; exists {
+		if _, exists := mappingsMap[*gvk]; exists {
 			errs = append(errs, fmt.Errorf("duplicate configuration for GVK %s", gvk))
 			continue
 		}
 
-		configsMap[*gvk] = config
+		mappings, err := newFieldMappings(config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid external framework configuration for %q: %w", config.Name, err))
+			continue
+		}
+		mappingsMap[*gvk] = mappings
 	}
 
 	if len(errs) > 0 {
@@ -51,8 +56,8 @@ func NewAdapters(configs []configapi.MultiKueueExternalFramework) ([]*Adapter, e
 	}
 
 	var adapters []*Adapter
-	for gvk := range configsMap {
-		adapters = append(adapters, &Adapter{gvk: gvk})
+	for gvk, mappings := range mappingsMap {
+		adapters = append(adapters, &Adapter{gvk: gvk, mappings: mappings})
 	}
 	return adapters, nil
 }
@@ -70,6 +70,29 @@ func TestNewAdapters(t *testing.T) {
 			},
 			wantErr: errors.New("invalid external framework configuration for \"invalid-format\": invalid GVK format 'invalid-format'"),
 		},
+		{
+			name: "valid field mappings",
+			configs: []configapi.MultiKueueExternalFramework{
+				{
+					Name:               "PipelineRun.v1.tekton.dev",
+					ManagedByPath:      new("spec.managedBy"),
+					SuspendPath:        new("spec.status"),
+					StatusPaths:        []string{"status.conditions", "status.startTime"},
+					FinishedExpression: new("has(object.status.completionTime)"),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid field mappings",
+			configs: []configapi.MultiKueueExternalFramework{
+				{
+					Name:        "PipelineRun.v1.tekton.dev",
+					StatusPaths: []string{"spec.params"},
+				},
+			},
+			wantErr: errors.New("invalid external framework configuration for \"PipelineRun.v1.tekton.dev\": statusPaths: invalid path \"spec.params\": must be within the status"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,136 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalframeworks
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	utilcel "sigs.k8s.io/kueue/pkg/util/cel"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
+)
+
+var (
+	defaultManagedByPath = []string{"spec", "managedBy"}
+	defaultStatusPaths   = [][]string{{"status"}}
+)
+
+// fieldMappings describe where the adapter finds the fields of an external
+// framework resource. The zero value describes the default fields.
+type fieldMappings struct {
+	// managedByPath is empty for the default spec.managedBy.
+	managedByPath []string
+	// suspendPath is empty if the resource isn't suspended by MultiKueue.
+	suspendPath []string
+	// statusPaths is empty for the whole status.
+	statusPaths [][]string
+	// finished is nil if the resource is never considered finished.
+	finished cel.Program
+}
+
+// newFieldMappings parses the field mappings of the external framework
+// configuration.
+func newFieldMappings(config configapi.MultiKueueExternalFramework) (fieldMappings, error) {
+	var m fieldMappings
+	var errs []error
+	if config.ManagedByPath != nil {
+		path, err := fieldpath.Parse(*config.ManagedByPath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("managedByPath: %w", err))
+		}
+		m.managedByPath = path
+	}
+	if config.SuspendPath != nil {
+		path, err := fieldpath.Parse(*config.SuspendPath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("suspendPath: %w", err))
+		}
+		m.suspendPath = path
+	}
+	for _, p := range config.StatusPaths {
+		path, err := ParseStatusPath(p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("statusPaths: %w", err))
+			continue
+		}
+		m.statusPaths = append(m.statusPaths, path)
+	}
+	if config.FinishedExpression != nil {
+		program, err := CompileFinishedExpression(*config.FinishedExpression)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("finishedExpression: %w", err))
+		}
+		m.finished = program
+	}
+	return m, errors.Join(errs...)
+}
+
+func (m *fieldMappings) managedBy() []string {
+	if len(m.managedByPath) == 0 {
+		return defaultManagedByPath
+	}
+	return m.managedByPath
+}
+
+func (m *fieldMappings) status() [][]string {
+	if len(m.statusPaths) == 0 {
+		return defaultStatusPaths
+	}
+	return m.statusPaths
+}
+
+// ParseStatusPath is like fieldpath.Parse, additionally requiring the path
+// to be within the status.
+func ParseStatusPath(path string) ([]string, error) {
+	fields, err := fieldpath.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if fields[0] != "status" {
+		return nil, fmt.Errorf("invalid path %q: must be within the status", path)
+	}
+	return fields, nil
+}
+
+// CompileFinishedExpression compiles a CEL expression evaluated against the
+// remote object, which must return a boolean.
+func CompileFinishedExpression(expression string) (cel.Program, error) {
+	return utilcel.CompileBool(expression)
+}
+
+// isFinished evaluates the finished expression against the object. Failed
+// evaluations, typically due to fields not set yet, are returned as errors
+// and the object is not considered finished.
+func (m *fieldMappings) isFinished(obj *unstructured.Unstructured) (bool, error) {
+	if m.finished == nil {
+		return false, nil
+	}
+	return utilcel.EvalBool(m.finished, obj)
+}
+
+// isSuspended returns true if the suspend field of the object is set to true.
+func (m *fieldMappings) isSuspended(obj *unstructured.Unstructured) bool {
+	if len(m.suspendPath) == 0 {
+		return false
+	}
+	suspended, _, _ := unstructured.NestedBool(obj.Object, m.suspendPath...)
+	return suspended
+}
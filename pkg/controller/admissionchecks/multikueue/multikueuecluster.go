@@ -109,6 +109,20 @@ type clientWithWatchBuilder func(ctx context.Context, config *clientConfig, opti
 type clientConfig struct {
 	Kubeconfig []byte
 	RestConfig *rest.Config
+	// Client is the client of a loopback cluster, used as is.
+	Client client.WithWatch
+}
+
+// LoopbackCluster is an in-process worker cluster, which the MultiKueueClusters
+// with the Loopback location type connect to. It allows to exercise the MultiKueue
+// controllers in hermetic tests, without running other clusters.
+type LoopbackCluster struct {
+	// RestConfig connects to an in-process API server, for example one started
+	// by envtest. The validation of the kubeconfigs doesn't apply to it.
+	RestConfig *rest.Config
+	// Client is used as the client of the worker cluster when set, for example
+	// a fake client. Its reads are not cached.
+	Client client.WithWatch
 }
 
 func (c *clientConfig) toRESTConfig() (*rest.Config, error) {
@@ -226,6 +240,10 @@ func newRemoteClient(
 }
 
 func newClientWithWatch(ctx context.Context, config *clientConfig, options client.Options) (SelectivelyCachingClient, error) {
+	if config.Client != nil {
+		return NewNeverCachingClient(config.Client), nil
+	}
+
 	restConfig, err := config.toRESTConfig()
 	if err != nil {
 		return nil, err
@@ -236,7 +254,7 @@ func newClientWithWatch(ctx context.Context, config *clientConfig, options clien
 		return nil, err
 	}
 
-	if !features.Enabled(features.MultiKueueManagerQuotaAutomation) {
+	if !features.Enabled(features.MultiKueueManagerQuotaAutomation) && !features.Enabled(features.MultiKueueCapacityAwareDispatcher) {
 		return NewNeverCachingClient(directClient), nil
 	}
 
@@ -776,6 +794,9 @@ type clustersReconciler struct {
 
 	clusterProfileAccessProvider clusterProfileAccessProvider
 
+	// loopbackClusters are the in-process worker clusters, by name.
+	loopbackClusters map[string]LoopbackCluster
+
 	logName     string
 	roleTracker *roletracker.RoleTracker
 }
@@ -862,6 +883,44 @@ func (c *clustersReconciler) setRemoteClientConfig(ctx context.Context, clusterN
 	return nil, nil
 }
 
+// WorkerClusters gives read access to the connected worker clusters,
+// for the components running outside of the MultiKueue controllers.
+type WorkerClusters struct {
+	clusters *clustersReconciler
+}
+
+func NewWorkerClusters() *WorkerClusters {
+	return &WorkerClusters{}
+}
+
+// WorkerClient returns the client of the worker cluster, or false if the
+// cluster is not connected. The reads of ClusterQueues and LocalQueues are cached.
+func (w *WorkerClusters) WorkerClient(clusterName string) (client.Reader, bool) {
+	return w.workerClient(clusterName)
+}
+
+// WorkerSubResourceReader returns the reader of the subresource of the objects
+// in the worker cluster, or false if the cluster is not connected.
+func (w *WorkerClusters) WorkerSubResourceReader(clusterName, subResource string) (client.SubResourceReader, bool) {
+	c, connected := w.workerClient(clusterName)
+	if !connected {
+		return nil, false
+	}
+	return c.SubResource(subResource), true
+}
+
+func (w *WorkerClusters) workerClient(clusterName string) (SelectivelyCachingClient, bool) {
+	if w.clusters == nil {
+		return nil, false
+	}
+	rc, found := w.clusters.controllerFor(clusterName)
+	if !found || !rc.connState.isConnected() {
+		return nil, false
+	}
+	c := rc.getClient()
+	return c, c != nil
+}
+
 func (c *clustersReconciler) controllerFor(acName string) (*remoteClient, bool) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -944,6 +1003,14 @@ func (c *clustersReconciler) loadClientConfig(ctx context.Context, cluster *kueu
 		return &clientConfig{RestConfig: restConfig}, "", nil
 	}
 
+	if ref := cluster.Spec.ClusterSource.KubeConfig; ref != nil && ref.LocationType == kueue.LoopbackLocationType {
+		loopback, found := c.loopbackClusters[ref.Location]
+		if !found {
+			return nil, "LoopbackClusterNotFound", fmt.Errorf("loopback cluster %q is not registered", ref.Location)
+		}
+		return &clientConfig{RestConfig: loopback.RestConfig, Client: loopback.Client}, "", nil
+	}
+
 	kubeConfig, err := c.getKubeConfig(ctx, cluster.Spec.ClusterSource.KubeConfig)
 	if err != nil {
 		return nil, "BadKubeConfig", err
@@ -194,6 +194,7 @@ func TestUpdateConfig(t *testing.T) {
 		secrets          []corev1.Secret
 		clusterprofiles  []inventoryv1alpha1.ClusterProfile
 		cpAccessProvider clusterProfileAccessProvider
+		loopbackClusters map[string]LoopbackCluster
 
 		wantRemoteClients             map[string]*remoteClient
 		wantClusters                  []kueue.MultiKueueCluster
@@ -646,6 +647,45 @@ func TestUpdateConfig(t *testing.T) {
 				},
 			},
 		},
+		"new loopback client is added": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.LoopbackLocationType, "in-process").
+					Generation(1).
+					Obj(),
+			},
+			loopbackClusters: map[string]LoopbackCluster{
+				"in-process": {RestConfig: &rest.Config{Host: "http://127.0.0.1:8080"}},
+			},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.LoopbackLocationType, "in-process").
+					Active(metav1.ConditionTrue, "Active", "Connected", 1).
+					Generation(1).
+					Obj(),
+			},
+			wantRemoteClients: map[string]*remoteClient{
+				"worker1": newTestClient(ctx, nil, &rest.Config{Host: "http://127.0.0.1:8080"}, nil),
+			},
+		},
+		"loopback cluster is not registered": {
+			reconcileFor: "worker1",
+			clusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.LoopbackLocationType, "in-process").
+					Generation(1).
+					Obj(),
+			},
+			wantClusters: []kueue.MultiKueueCluster{
+				*utiltestingapi.MakeMultiKueueCluster("worker1").
+					KubeConfig(kueue.LoopbackLocationType, "in-process").
+					Active(metav1.ConditionFalse, "LoopbackClusterNotFound", `load client config failed: loopback cluster "in-process" is not registered`, 1).
+					Generation(1).
+					Obj(),
+			},
+			wantErr: fmt.Errorf("failed to load client config, reason: LoopbackClusterNotFound, error: %w", errors.New(`loopback cluster "in-process" is not registered`)),
+		},
 		"invalid rest config from cluster profile": {
 			reconcileFor: "invalid",
 			clusters: []kueue.MultiKueueCluster{
@@ -689,6 +729,7 @@ func TestUpdateConfig(t *testing.T) {
 			reconciler := newClustersReconciler(c, TestNamespace, 0, defaultOrigin, nil, adapters, tc.cpAccessProvider, nil, recorder)
 
 			reconciler.rootContext = ctx
+			reconciler.loopbackClusters = tc.loopbackClusters
 
 			if len(tc.remoteClients) > 0 {
 				reconciler.remoteClients = tc.remoteClients
@@ -946,6 +987,59 @@ func TestDisconnectedClientReconnectsWithSameConfig(t *testing.T) {
 	}
 }
 
+func TestLoopbackClusterWithClient(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+
+	cluster := utiltestingapi.MakeMultiKueueCluster("worker1").
+		KubeConfig(kueue.LoopbackLocationType, "in-process").
+		Generation(1).
+		Obj()
+	builder := getClientBuilder(ctx)
+	builder = builder.WithObjects(cluster)
+	builder = builder.WithStatusSubresource(&kueue.MultiKueueCluster{})
+	c := builder.Build()
+
+	remoteWl := utiltestingapi.MakeWorkload("wl", TestNamespace).Obj()
+	workerClient := getClientBuilder(ctx).WithObjects(remoteWl).Build()
+
+	adapters, _ := jobs.NewIntegrationManager().GetMultiKueueAdapters(sets.New("batch/job"))
+	recorder := &utiltesting.EventRecorder{}
+	reconciler := newClustersReconciler(c, TestNamespace, 0, defaultOrigin, nil, adapters, &testClusterProfileAccessProvider{}, nil, recorder)
+	reconciler.rootContext = ctx
+	reconciler.loopbackClusters = map[string]LoopbackCluster{
+		"in-process": {Client: workerClient},
+	}
+	t.Cleanup(func() {
+		for _, rc := range reconciler.remoteClients {
+			rc.StopWatchers()
+		}
+	})
+
+	if _, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "worker1"}}); err != nil {
+		t.Fatalf("unexpected reconcile error: %v", err)
+	}
+
+	gotCluster := &kueue.MultiKueueCluster{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(cluster), gotCluster); err != nil {
+		t.Fatalf("unexpected get cluster error: %v", err)
+	}
+	if !apimeta.IsStatusConditionTrue(gotCluster.Status.Conditions, kueue.MultiKueueClusterActive) {
+		t.Errorf("expected the cluster to be active, conditions: %v", gotCluster.Status.Conditions)
+	}
+
+	rc, found := reconciler.remoteClients["worker1"]
+	if !found {
+		t.Fatal("expected a remote client for worker1")
+	}
+	if !rc.connState.isConnected() {
+		t.Errorf("expected state to be connected")
+	}
+	gotWl := &kueue.Workload{}
+	if err := rc.getClient().Get(ctx, client.ObjectKeyFromObject(remoteWl), gotWl); err != nil {
+		t.Errorf("expected the workload of the loopback cluster to be read through the remote client: %v", err)
+	}
+}
+
 func TestConnectionStateTransitions(t *testing.T) {
 	now := time.Now()
 	fakeClock := testingclock.NewFakeClock(now)
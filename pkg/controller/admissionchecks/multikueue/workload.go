@@ -184,6 +184,16 @@ func (g *wlGroup) RemoveRemoteObjects(ctx context.Context, cluster string) error
 	return nil
 }
 
+// clusterNames returns the names of all the worker clusters of the group,
+// including the unavailable ones.
+func (g *wlGroup) clusterNames() []string {
+	names := make([]string, 0, len(g.remotes)+len(g.unavailableClusters))
+	for cluster := range g.remotes {
+		names = append(names, cluster)
+	}
+	return append(names, g.unavailableClusters...)
+}
+
 func (w *wlReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	log.V(2).Info("Reconcile Workload")
@@ -282,6 +292,52 @@ func (w *wlReconciler) updateACS(ctx context.Context, wl *kueue.Workload, acs *k
 	})
 }
 
+// migrate moves the workload away from the worker cluster where it is admitted.
+// It records the migration and resets the admission check for re-admission, so
+// the workload is evicted, its remote objects are deleted, and it is dispatched
+// again to the other worker clusters.
+func (w *wlReconciler) migrate(ctx context.Context, group *wlGroup, acs *kueue.AdmissionCheckState, reason kueue.WorkloadMigrationReason, message string) error {
+	source := workload.ClusterName(group.local)
+	migrations := workload.AddMigration(group.local, kueue.WorkloadMigration{
+		SourceCluster: source,
+		Reason:        reason,
+		Message:       api.TruncateConditionMessage(message),
+		StartTime:     metav1.NewTime(w.clock.Now()),
+	})
+	if err := workloadpatching.PatchAdmissionStatus(ctx, w.client, group.local, w.clock, func(wl *kueue.Workload) (bool, error) {
+		wl.Status.Migrations = migrations
+		acs.Message = api.TruncateConditionMessage(fmt.Sprintf("Migrating from worker cluster %q: %s, resetting for re-admission. Previously: %q", source, message, acs.State))
+		acs.State = kueue.CheckStateRetry
+		acs.LastTransitionTime = metav1.NewTime(w.clock.Now())
+		return workloadpatching.SetAdmissionCheckState(&wl.Status.AdmissionChecks, *acs, w.clock), nil
+	}); err != nil {
+		return err
+	}
+	w.recorder.Eventf(group.local, nil, corev1.EventTypeNormal, "MultiKueue", "MultiKueue", api.TruncateEventMessage(acs.Message))
+	return nil
+}
+
+// migrateRequested migrates the workload requested to be migrated with the
+// annotation, and removes the annotation. The request is dropped if the
+// workload is not admitted on a worker cluster.
+func (w *wlReconciler) migrateRequested(ctx context.Context, group *wlGroup, acs *kueue.AdmissionCheckState) error {
+	log := ctrl.LoggerFrom(ctx)
+	if acs.State == kueue.CheckStateReady && workload.ClusterName(group.local) != "" {
+		message := group.local.Annotations[kueue.MultiKueueMigrateAnnotation]
+		if message == "" {
+			message = "Migration requested"
+		}
+		if err := w.migrate(ctx, group, acs, kueue.WorkloadMigrationRequested, message); err != nil {
+			return err
+		}
+	} else {
+		log.V(2).Info("Ignoring the migration request, the workload is not admitted on a worker cluster")
+	}
+	patch := client.MergeFrom(group.local.DeepCopy())
+	delete(group.local.Annotations, kueue.MultiKueueMigrateAnnotation)
+	return w.client.Patch(ctx, group.local, patch)
+}
+
 func (w *wlReconciler) admittingWorkerLostSince(clusterName string) time.Time {
 	if rc, found := w.clusters.controllerFor(clusterName); found {
 		if since := rc.connState.lostSince(); since != nil {
@@ -488,6 +544,20 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 		}
 	}
 
+	// 4a. Migrate the workload to another worker cluster when requested.
+	if features.Enabled(features.MultiKueueWorkloadMigration) && workload.IsMigrationRequested(group.local) {
+		return reconcile.Result{}, w.migrateRequested(ctx, group, acs)
+	}
+
+	// 4b. Delete the remote objects from the worker cluster the workload is migrated away from.
+	if excluded := workload.MigrationExcludedCluster(group.local, group.clusterNames()); excluded != "" && group.remotes[excluded] != nil {
+		log.V(3).Info("Removing remote objects from the migration source cluster", "remote", excluded)
+		if err := client.IgnoreNotFound(group.RemoveRemoteObjects(ctx, excluded)); err != nil {
+			log.V(2).Error(err, "Deleting remote objects of a migrated workload", "remote", excluded)
+			return reconcile.Result{}, err
+		}
+	}
+
 	// 5. Delete workloads that are out of sync or are not in the chosen worker,
 	// except for two cases (in which we'll update the remote workload accorddingly):
 	// - elastic workloads which have been scaled down
@@ -557,6 +627,10 @@ func (w *wlReconciler) reconcileGroup(ctx context.Context, group *wlGroup) (reco
 				log.V(3).Info("Admitting remote temporarily unreachable, waiting before retry", "cluster", admitting, "retryAfter", remainingWaitTime)
 				return reconcile.Result{RequeueAfter: remainingWaitTime}, nil
 			}
+			if features.Enabled(features.MultiKueueWorkloadMigration) {
+				return reconcile.Result{}, w.migrate(ctx, group, acs, kueue.WorkloadMigrationWorkerClusterLost,
+					fmt.Sprintf("Admitting remote lost since %s", lostSince.Format(time.RFC3339)))
+			}
 			return reconcile.Result{}, w.updateACS(ctx, group.local, acs, kueue.CheckStateRetry, "Admitting remote lost")
 		}
 		if admitting == "" {
@@ -903,8 +977,11 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 	if clusterName := workload.ClusterName(group.local); group.IsElasticWorkload() && clusterName != "" {
 		nominatedWorkers = []string{clusterName}
 	} else if w.dispatcherName == config.MultiKueueDispatcherModeAllAtOnce {
+		excluded := workload.MigrationExcludedCluster(group.local, group.clusterNames())
 		for workerName := range group.remotes {
-			nominatedWorkers = append(nominatedWorkers, workerName)
+			if workerName != excluded {
+				nominatedWorkers = append(nominatedWorkers, workerName)
+			}
 		}
 
 		// group.remotes is a map, so iteration order is non-deterministic; sort only
@@ -1308,6 +1385,9 @@ func (w *wlReconciler) syncAdmittingRemoteState(ctx context.Context, group *wlGr
 					*wl.Status.ClusterName, admittingRemote)
 			}
 			wl.Status.NominatedClusterNames = nil
+			if features.Enabled(features.MultiKueueWorkloadMigration) {
+				workload.SetMigrationTargetCluster(wl, admittingRemote)
+			}
 		}
 
 		return true, nil
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
@@ -69,6 +70,7 @@ type ClusterQueueReconciler struct {
 	reportResourceMetrics bool
 	fairSharingEnabled    bool
 	clock                 clock.Clock
+	recorder              events.EventRecorder
 	roleTracker           *roletracker.RoleTracker
 	customLabels          *metrics.CustomLabels
 }
@@ -81,6 +83,7 @@ type ClusterQueueReconcilerOptions struct {
 	ReportResourceMetrics bool
 	FairSharingEnabled    bool
 	clock                 clock.Clock
+	recorder              events.EventRecorder
 	roleTracker           *roletracker.RoleTracker
 	customLabels          *metrics.CustomLabels
 }
@@ -106,6 +109,14 @@ func WithFairSharing(enabled bool) ClusterQueueReconcilerOption {
 	}
 }
 
+// WithClusterQueueEventRecorder sets the recorder of the events emitted when
+// the quota window of a ClusterQueue changes or its workloads are evicted.
+func WithClusterQueueEventRecorder(recorder events.EventRecorder) ClusterQueueReconcilerOption {
+	return func(o *ClusterQueueReconcilerOptions) {
+		o.recorder = recorder
+	}
+}
+
 func WithClusterQueueRoleTracker(tracker *roletracker.RoleTracker) ClusterQueueReconcilerOption {
 	return func(o *ClusterQueueReconcilerOptions) {
 		o.roleTracker = tracker
@@ -142,6 +153,7 @@ func NewClusterQueueReconciler(
 		reportResourceMetrics: options.ReportResourceMetrics,
 		fairSharingEnabled:    options.FairSharingEnabled,
 		clock:                 options.clock,
+		recorder:              options.recorder,
 		roleTracker:           options.roleTracker,
 		customLabels:          options.customLabels,
 	}
@@ -210,12 +222,16 @@ func (r *ClusterQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
+	if err := r.refreshQuotaWindow(log, &cqObj); err != nil {
+		log.Error(err, "Failed to refresh the quota window")
+	}
+
 	newCQObj := cqObj.DeepCopy()
 	cqCondition, reason, msg := r.cache.ClusterQueueReadiness(kueue.ClusterQueueReference(newCQObj.Name))
 	if err := r.updateCqStatusIfChanged(ctx, newCQObj, cqCondition, reason, msg); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-	return ctrl.Result{}, nil
+	return r.reconcileOverQuota(ctx, newCQObj)
 }
 
 // NotifyTopologyUpdate triggers a topology update event only on creation or deletion,
@@ -557,8 +573,31 @@ func (r *ClusterQueueReconciler) updateCqStatusIfChanged(
 	} else {
 		cq.Status.FairSharing = nil
 	}
+	cq.Status.QuotaWindow = r.quotaWindowStatus(cq)
+	cq.Status.Backfill = r.backfillStatus(cq)
 	if !equality.Semantic.DeepEqual(cq.Status, oldStatus) {
 		return r.client.Status().Update(ctx, cq)
 	}
 	return nil
 }
+
+// backfillStatus reports the workload holding the backfill reservation of a
+// ClusterQueue with the Backfill queueing strategy.
+func (r *ClusterQueueReconciler) backfillStatus(cq *kueue.ClusterQueue) *kueue.ClusterQueueBackfillStatus {
+	resv := r.qManager.BackfillReservation(kueue.ClusterQueueReference(cq.Name))
+	if resv == nil {
+		return nil
+	}
+	status := &kueue.ClusterQueueBackfillStatus{
+		Workload: kueue.BackfillWorkloadReference{
+			Name:      resv.Head.Obj.Name,
+			Namespace: resv.Head.Obj.Namespace,
+		},
+	}
+	if resv.ShadowTime != nil {
+		// Truncated to the serialized precision, so that an unchanged
+		// reservation doesn't update the status on every reconcile.
+		status.ShadowTime = new(metav1.NewTime(*resv.ShadowTime).Rfc3339Copy())
+	}
+	return status
+}
@@ -0,0 +1,143 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/features"
+	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
+)
+
+func hasQuotaSchedule(cq *kueue.ClusterQueue) bool {
+	return cq.Spec.QuotaSchedule != nil && features.Enabled(features.TimeWindowedQuotas)
+}
+
+// refreshQuotaWindow applies the quotas of the window active now, and requeues
+// the inadmissible workloads of the ClusterQueue when the window changed.
+func (r *ClusterQueueReconciler) refreshQuotaWindow(log logr.Logger, cq *kueue.ClusterQueue) error {
+	if !hasQuotaSchedule(cq) {
+		return nil
+	}
+	cqName := kueue.ClusterQueueReference(cq.Name)
+	changed, err := r.cache.RefreshQuotaWindow(log, cqName)
+	if err != nil || !changed {
+		return err
+	}
+	active := r.cache.QuotaWindow(cqName).Active
+	if r.recorder != nil {
+		if active == "" {
+			r.recorder.Eventf(cq, nil, corev1.EventTypeNormal, "QuotaWindowEnded", "QuotaWindowEnded", "The quotas of resourceGroups apply")
+		} else {
+			r.recorder.Eventf(cq, nil, corev1.EventTypeNormal, "QuotaWindowStarted", "QuotaWindowStarted", "The quotas of the window %q apply", active)
+		}
+	}
+	if r.reportResourceMetrics {
+		r.cache.RecordClusterQueueResourceMetrics(log, cqName)
+	}
+	qcache.NotifyRetryInadmissible(r.qManager, sets.New(cqName))
+	r.qManager.Broadcast()
+	return nil
+}
+
+// quotaWindowStatus returns the state of the quota schedule of the
+// ClusterQueue, keeping the last transition time while the active window
+// doesn't change. The transition is stamped with the window boundary rather
+// than the time of the reconcile.
+func (r *ClusterQueueReconciler) quotaWindowStatus(cq *kueue.ClusterQueue) *kueue.ClusterQueueQuotaWindowStatus {
+	if !hasQuotaSchedule(cq) {
+		return nil
+	}
+	state := r.cache.QuotaWindow(kueue.ClusterQueueReference(cq.Name))
+	if current := cq.Status.QuotaWindow; current != nil && current.Active == state.Active {
+		return current
+	}
+	return &kueue.ClusterQueueQuotaWindowStatus{
+		Active:             state.Active,
+		LastTransitionTime: metav1.NewTime(state.LastTransition),
+	}
+}
+
+// reconcileOverQuota applies the Evict overQuotaPolicy of the ClusterQueue,
+// evicting the workloads which reserved quota before the last window
+// transition and exceed the quota. The Drain policy is applied by the
+// scheduler, which stops the admission. It returns the result requeuing the
+// ClusterQueue at the next window transition, so that the switch happens at
+// the window boundary, or at the end of the eviction grace period.
+func (r *ClusterQueueReconciler) reconcileOverQuota(ctx context.Context, cq *kueue.ClusterQueue) (ctrl.Result, error) {
+	if !hasQuotaSchedule(cq) || !cq.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	cqName := kueue.ClusterQueueReference(cq.Name)
+	now := r.clock.Now()
+
+	var requeueAfter time.Duration
+	requeueAt := func(t time.Time) {
+		if d := t.Sub(now); d > 0 && (requeueAfter == 0 || d < requeueAfter) {
+			requeueAfter = d
+		}
+	}
+	requeueAt(r.cache.QuotaWindow(cqName).NextTransition)
+
+	status := cq.Status.QuotaWindow
+	if status == nil {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	qs := cq.Spec.QuotaSchedule
+	if qs.OverQuotaPolicy != kueue.OverQuotaPolicyEvict {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	evictAt := status.LastTransitionTime.Add(time.Duration(ptr.Deref(qs.EvictionGracePeriodSeconds, 0)) * time.Second)
+	if !r.cache.ClusterQueueOverQuota(cqName) {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	if now.Before(evictAt) {
+		requeueAt(evictAt)
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	snapshot, err := r.cache.Snapshot(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	msg := fmt.Sprintf("The workload exceeds the quota of the ClusterQueue %s after a quota window transition", cq.Name)
+	for _, wlInfo := range snapshot.OverQuotaWorkloads(log, cqName, status.LastTransitionTime.Time) {
+		wl := wlInfo.Obj.DeepCopy()
+		log.V(2).Info("Evicting the workload exceeding the quota", "workload", klog.KObj(wl))
+		exposeLqMetrics := r.cache.ShouldExposeLocalQueueMetricsForWorkload(log, wl)
+		if err := workloadevict.Evict(ctx, r.client, r.recorder, wl, kueue.WorkloadEvictedByQuotaWindow, msg, "", r.clock, exposeLqMetrics, r.roleTracker, r.customLabels); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("evicting workload %s: %w", klog.KObj(wl), err)
+			}
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
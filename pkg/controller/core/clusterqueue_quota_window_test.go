@@ -0,0 +1,190 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestClusterQueueReconcileQuotaWindow(t *testing.T) {
+	const cqName = "cq"
+	transition := time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)
+	windowEnd := transition.Add(12 * time.Hour)
+
+	cases := map[string]struct {
+		policy      kueue.OverQuotaPolicy
+		evictGrace  *int32
+		reconcileAt []time.Time
+		wantEvicted []string
+		wantRequeue time.Duration
+	}{
+		"keep": {
+			policy:      kueue.OverQuotaPolicyKeep,
+			reconcileAt: []time.Time{transition},
+			wantRequeue: 12 * time.Hour,
+		},
+		"drain": {
+			policy:      kueue.OverQuotaPolicyDrain,
+			reconcileAt: []time.Time{transition, transition.Add(10 * time.Minute)},
+			wantRequeue: windowEnd.Sub(transition.Add(10 * time.Minute)),
+		},
+		"evict": {
+			policy:      kueue.OverQuotaPolicyEvict,
+			reconcileAt: []time.Time{transition},
+			wantEvicted: []string{"low"},
+			wantRequeue: 12 * time.Hour,
+		},
+		"evict, reconciled after the window start": {
+			policy:      kueue.OverQuotaPolicyEvict,
+			reconcileAt: []time.Time{transition.Add(3 * time.Minute)},
+			wantEvicted: []string{"low"},
+			wantRequeue: 12*time.Hour - 3*time.Minute,
+		},
+		"evict during the grace period": {
+			policy:      kueue.OverQuotaPolicyEvict,
+			evictGrace:  new(int32(600)),
+			reconcileAt: []time.Time{transition, transition.Add(5 * time.Minute)},
+			wantRequeue: 5 * time.Minute,
+		},
+		"evict after the grace period": {
+			policy:      kueue.OverQuotaPolicyEvict,
+			evictGrace:  new(int32(600)),
+			reconcileAt: []time.Time{transition, transition.Add(10 * time.Minute)},
+			wantEvicted: []string{"low"},
+			wantRequeue: windowEnd.Sub(transition.Add(10 * time.Minute)),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TimeWindowedQuotas, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			fakeClock := testingclock.NewFakeClock(transition.Add(-time.Minute))
+
+			cq := utiltestingapi.MakeClusterQueue(cqName).
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+				QuotaSchedule(kueue.QuotaSchedule{
+					OverQuotaPolicy:            tc.policy,
+					EvictionGracePeriodSeconds: tc.evictGrace,
+					Windows: []kueue.QuotaWindow{{
+						Name:            "night",
+						Schedule:        "0 20 * * *",
+						DurationMinutes: 12 * 60,
+						Flavors:         []kueue.FlavorQuotas{*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()},
+					}},
+				}).
+				Obj()
+			cq.Finalizers = []string{kueue.ResourceInUseFinalizerName}
+			rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+			reserved := transition.Add(-time.Hour)
+			wls := []*kueue.Workload{
+				utiltestingapi.MakeWorkload("high", metav1.NamespaceDefault).Priority(10).
+					Request(corev1.ResourceCPU, "2").SimpleReserveQuota(cqName, "default", reserved).Obj(),
+				utiltestingapi.MakeWorkload("low", metav1.NamespaceDefault).
+					Request(corev1.ResourceCPU, "2").SimpleReserveQuota(cqName, "default", reserved).Obj(),
+			}
+
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(cq, rf, wls[0], wls[1]).
+				WithStatusSubresource(cq, &kueue.Workload{}).
+				Build()
+			cqCache := schdcache.New(cl, schdcache.WithClock(fakeClock))
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+			cqCache.AddOrUpdateResourceFlavor(log, rf)
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in cache: %v", err)
+			}
+			if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in manager: %v", err)
+			}
+			for _, wl := range wls {
+				cqCache.AddOrUpdateWorkload(log, wl)
+			}
+			recorder := &utiltesting.EventRecorder{}
+			r := &ClusterQueueReconciler{
+				client:   cl,
+				logName:  "cluster-queue-reconciler",
+				cache:    cqCache,
+				qManager: qManager,
+				clock:    fakeClock,
+				recorder: recorder,
+			}
+
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: cqName}}
+			result, err := r.Reconcile(ctx, req)
+			if err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+			if result.RequeueAfter != time.Minute {
+				t.Errorf("Reconcile before the window requeued after %v, want %v", result.RequeueAfter, time.Minute)
+			}
+			for _, at := range tc.reconcileAt {
+				fakeClock.SetTime(at)
+				if result, err = r.Reconcile(ctx, req); err != nil {
+					t.Fatalf("Reconcile at %v failed: %v", at, err)
+				}
+			}
+			if result.RequeueAfter != tc.wantRequeue {
+				t.Errorf("Reconcile requeued after %v, want %v", result.RequeueAfter, tc.wantRequeue)
+			}
+
+			var gotCQ kueue.ClusterQueue
+			if err := cl.Get(ctx, req.NamespacedName, &gotCQ); err != nil {
+				t.Fatalf("Getting the ClusterQueue: %v", err)
+			}
+			wantStatus := &kueue.ClusterQueueQuotaWindowStatus{
+				Active:             "night",
+				LastTransitionTime: metav1.NewTime(transition),
+			}
+			if diff := cmp.Diff(wantStatus, gotCQ.Status.QuotaWindow); diff != "" {
+				t.Errorf("Unexpected quota window status (-want,+got):\n%s", diff)
+			}
+
+			var gotEvicted []string
+			for _, wl := range wls {
+				var got kueue.Workload
+				if err := cl.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: wl.Name}, &got); err != nil {
+					t.Fatalf("Getting the workload %s: %v", wl.Name, err)
+				}
+				if cond := apimeta.FindStatusCondition(got.Status.Conditions, kueue.WorkloadEvicted); cond != nil && cond.Status == metav1.ConditionTrue {
+					if cond.Reason != kueue.WorkloadEvictedByQuotaWindow {
+						t.Errorf("Workload %s evicted with reason %q, want %q", wl.Name, cond.Reason, kueue.WorkloadEvictedByQuotaWindow)
+					}
+					gotEvicted = append(gotEvicted, wl.Name)
+				}
+			}
+			if diff := cmp.Diff(tc.wantEvicted, gotEvicted); diff != "" {
+				t.Errorf("Unexpected evicted workloads (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
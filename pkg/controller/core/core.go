@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	"sigs.k8s.io/kueue/pkg/accounting"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/constants"
@@ -51,6 +52,7 @@ type SetupControllersOpts struct {
 	DRABackedResources        *dra.ExtendedResourceCache
 	ResourceFormatter         *resources.ResourceFormatter
 	ResourceSliceAPIAvailable bool
+	UsageLedger               *accounting.Ledger
 }
 
 // SetupControllers sets up the core controllers. It returns the name of the
@@ -69,11 +71,18 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 	if err := wpcRec.SetupWithManager(mgr, cfg); err != nil {
 		return "WorkloadPriorityClass", err
 	}
+	if features.Enabled(features.CapacityReservations) {
+		resvRec := NewReservationReconciler(mgr.GetClient(), qManager, cc, opts.RoleTracker)
+		if err := resvRec.SetupWithManager(mgr, cfg); err != nil {
+			return "Reservation", err
+		}
+	}
 	qRec := NewLocalQueueReconciler(mgr.GetClient(), qManager, cc,
 		WithAdmissionFairSharingConfig(cfg.AdmissionFairSharing),
 		WithRoleTracker(opts.RoleTracker),
 		WithCustomLabels(opts.CustomLabels),
-		WithLocalQueueMetrics(lqMetrics))
+		WithLocalQueueMetrics(lqMetrics),
+		WithUsageLedger(opts.UsageLedger))
 	if err := qRec.SetupWithManager(mgr, cfg); err != nil {
 		return "LocalQueue", err
 	}
@@ -98,6 +107,7 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 		WithWatchers(watchers...),
 		WithClusterQueueRoleTracker(opts.RoleTracker),
 		WithClusterQueueCustomLabels(opts.CustomLabels),
+		WithClusterQueueEventRecorder(mgr.GetEventRecorder(constants.ClusterQueueControllerName)),
 	)
 	rfRec.AddUpdateWatcher(cqRec)
 	acRec.AddUpdateWatchers(cqRec)
@@ -105,9 +115,13 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 		return "ClusterQueue", err
 	}
 
+	wlWatchers := []WorkloadUpdateWatcher{qRec, cqRec}
+	if opts.UsageLedger != nil {
+		wlWatchers = append(wlWatchers, opts.UsageLedger)
+	}
 	workloadRec := NewWorkloadReconciler(mgr.GetClient(), qManager, cc,
 		mgr.GetEventRecorder(constants.WorkloadControllerName),
-		WithWorkloadUpdateWatchers(qRec, cqRec),
+		WithWorkloadUpdateWatchers(wlWatchers...),
 		WithWaitForPodsReady(waitForPodsReady(cfg.WaitForPodsReady)),
 		WithWorkloadRetention(workloadRetention(cfg.ObjectRetentionPolicies)),
 		WithWorkloadRoleTracker(opts.RoleTracker),
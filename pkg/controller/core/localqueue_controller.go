@@ -43,6 +43,7 @@ import (
 
 	config "sigs.k8s.io/kueue/apis/config/v1beta2"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/accounting"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	queueafs "sigs.k8s.io/kueue/pkg/cache/queue/afs"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
@@ -67,12 +68,17 @@ const (
 	clusterQueueIsInactiveReason = "ClusterQueueIsInactive"
 )
 
+// usageAccountingInterval is the minimum interval between the updates of the
+// usage accounting in the LocalQueue status.
+const usageAccountingInterval = time.Minute
+
 type LocalQueueReconcilerOptions struct {
 	admissionFSConfig *config.AdmissionFairSharing
 	clock             clock.Clock
 	roleTracker       *roletracker.RoleTracker
 	customLabels      *metrics.CustomLabels
 	lqMetrics         *metrics.LocalQueueMetricsConfig
+	usageLedger       *accounting.Ledger
 }
 
 // LocalQueueReconcilerOption configures the reconciler.
@@ -108,6 +114,14 @@ func WithLocalQueueMetrics(value *metrics.LocalQueueMetricsConfig) LocalQueueRec
 	}
 }
 
+// WithUsageLedger sets the ledger whose usage accounting is persisted in the
+// LocalQueue status.
+func WithUsageLedger(ledger *accounting.Ledger) LocalQueueReconcilerOption {
+	return func(o *LocalQueueReconcilerOptions) {
+		o.usageLedger = ledger
+	}
+}
+
 var defaultLQOptions = LocalQueueReconcilerOptions{
 	clock: realClock,
 }
@@ -124,6 +138,7 @@ type LocalQueueReconciler struct {
 	roleTracker       *roletracker.RoleTracker
 	customLabels      *metrics.CustomLabels
 	lqMetrics         *metrics.LocalQueueMetricsConfig
+	usageLedger       *accounting.Ledger
 }
 
 var _ reconcile.Reconciler = (*LocalQueueReconciler)(nil)
@@ -150,6 +165,7 @@ func NewLocalQueueReconciler(
 		roleTracker:       options.roleTracker,
 		customLabels:      options.customLabels,
 		lqMetrics:         options.lqMetrics,
+		usageLedger:       options.usageLedger,
 	}
 }
 
@@ -193,7 +209,7 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	if ptr.Deref(queueObj.Spec.StopPolicy, kueue.None) != kueue.None {
 		err := r.UpdateStatusIfChanged(ctx, &queueObj, metav1.ConditionFalse, StoppedReason, localQueueIsInactiveMsg)
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		return r.withUsageAccountingRequeue(ctrl.Result{}, &queueObj), client.IgnoreNotFound(err)
 	}
 
 	var cq kueue.ClusterQueue
@@ -209,7 +225,7 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	} else {
 		err := r.UpdateStatusIfChanged(ctx, &queueObj, metav1.ConditionFalse, clusterQueueIsInactiveReason, clusterQueueIsInactiveMsg)
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		return r.withUsageAccountingRequeue(ctrl.Result{}, &queueObj), client.IgnoreNotFound(err)
 	}
 
 	if afs.Enabled(r.admissionFSConfig) {
@@ -221,7 +237,7 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		// updates cause sub-millisecond reconciles where the decay math
 		// truncates CPU consumed resources to zero.
 		if interval := r.admissionFSConfig.UsageSamplingInterval.Duration; hadCache && sinceLastUpdate < interval && !r.queues.AfsUsageLedger.HasPendingPenalty(lqKey) {
-			return ctrl.Result{RequeueAfter: interval - sinceLastUpdate}, nil
+			return r.withUsageAccountingRequeue(ctrl.Result{RequeueAfter: interval - sinceLastUpdate}, &queueObj), nil
 		}
 		if err := r.reconcileConsumedUsage(ctx, &queueObj); err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -229,9 +245,25 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if err := r.queues.RebuildClusterQueue(log, &cq, queueObj.Name); err != nil {
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{RequeueAfter: r.admissionFSConfig.UsageSamplingInterval.Duration}, nil
+		return r.withUsageAccountingRequeue(ctrl.Result{RequeueAfter: r.admissionFSConfig.UsageSamplingInterval.Duration}, &queueObj), nil
 	}
-	return ctrl.Result{}, nil
+	return r.withUsageAccountingRequeue(ctrl.Result{}, &queueObj), nil
+}
+
+// withUsageAccountingRequeue lowers the requeue of the result to the next
+// update of the usage accounting in the LocalQueue status.
+func (r *LocalQueueReconciler) withUsageAccountingRequeue(result ctrl.Result, lq *kueue.LocalQueue) ctrl.Result {
+	if r.usageLedger == nil {
+		return result
+	}
+	requeueAfter := usageAccountingInterval
+	if usageAccounting := lq.Status.UsageAccounting; usageAccounting != nil {
+		requeueAfter = max(time.Second, usageAccountingInterval-r.clock.Since(usageAccounting.LastUpdateTime.Time))
+	}
+	if result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter {
+		result.RequeueAfter = requeueAfter
+	}
+	return result
 }
 
 func (r *LocalQueueReconciler) Create(e event.TypedCreateEvent[*kueue.LocalQueue]) bool {
@@ -277,6 +309,9 @@ func (r *LocalQueueReconciler) Delete(e event.TypedDeleteEvent[*kueue.LocalQueue
 		// its cache lookup could otherwise recreate the entry we just deleted.
 		r.queues.AfsUsageLedger.Delete(utilqueue.Key(e.Object))
 	}
+	if r.usageLedger != nil {
+		r.usageLedger.DeleteLocalQueue(utilqueue.Key(e.Object))
+	}
 	return true
 }
 
@@ -682,6 +717,13 @@ func (r *LocalQueueReconciler) updateStatusIfChanged(
 	queue.Status.AdmittedWorkloads = int32(stats.AdmittedWorkloads)
 	queue.Status.FlavorsReservation = stats.ReservedResources
 	queue.Status.FlavorsUsage = stats.AdmittedResources
+	if r.usageLedger != nil {
+		// The accounting changes continuously, so it is only persisted at
+		// intervals to bound the status updates.
+		if usageAccounting := queue.Status.UsageAccounting; usageAccounting == nil || r.clock.Since(usageAccounting.LastUpdateTime.Time) >= usageAccountingInterval {
+			queue.Status.UsageAccounting = r.usageLedger.LocalQueueStatus(queue)
+		}
+	}
 	if len(conditionStatus) != 0 && len(reason) != 0 && len(msg) != 0 {
 		meta.SetStatusCondition(&queue.Status.Conditions, metav1.Condition{
 			Type:               kueue.LocalQueueActive,
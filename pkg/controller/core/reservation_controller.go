@@ -0,0 +1,190 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+// ReservationReconciler synchronizes the Reservations in the cache, and
+// requeues the inadmissible workloads of the ClusterQueues using a
+// Reservation when its window starts or ends.
+type ReservationReconciler struct {
+	logName     string
+	client      client.Client
+	cache       *schdcache.Cache
+	qManager    *qcache.Manager
+	clock       clock.PassiveClock
+	roleTracker *roletracker.RoleTracker
+}
+
+var _ reconcile.Reconciler = (*ReservationReconciler)(nil)
+var _ predicate.TypedPredicate[*kueue.Reservation] = (*ReservationReconciler)(nil)
+
+func NewReservationReconciler(
+	client client.Client,
+	qManager *qcache.Manager,
+	cache *schdcache.Cache,
+	roleTracker *roletracker.RoleTracker,
+) *ReservationReconciler {
+	return &ReservationReconciler{
+		logName:     "reservation-reconciler",
+		client:      client,
+		cache:       cache,
+		qManager:    qManager,
+		clock:       clock.RealClock{},
+		roleTracker: roleTracker,
+	}
+}
+
+func (r *ReservationReconciler) logger() logr.Logger {
+	return roletracker.WithReplicaRole(ctrl.Log.WithName(r.logName), r.roleTracker)
+}
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=reservations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=reservations/status,verbs=get;update;patch
+
+func (r *ReservationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Reservation")
+
+	var resv kueue.Reservation
+	if err := r.client.Get(ctx, req.NamespacedName, &resv); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			log.V(2).Info("Reservation is being deleted")
+			r.notifyClusterQueues(r.cache.DeleteReservation(kueue.ReservationReference(req.Name)))
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	r.notifyClusterQueues(r.cache.AddOrUpdateReservation(&resv))
+
+	now := r.clock.Now()
+	oldStatus := resv.Status.DeepCopy()
+	apimeta.SetStatusCondition(&resv.Status.Conditions, reservationActiveCondition(&resv, now))
+	if !equality.Semantic.DeepEqual(&resv.Status, oldStatus) {
+		if err := r.client.Status().Update(ctx, &resv); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
+	// Requeue at the next boundary of the window, to retry the workloads
+	// that became admissible.
+	switch {
+	case now.Before(resv.Spec.StartTime.Time):
+		return ctrl.Result{RequeueAfter: resv.Spec.StartTime.Sub(now)}, nil
+	case now.Before(resv.Spec.EndTime.Time):
+		return ctrl.Result{RequeueAfter: resv.Spec.EndTime.Sub(now)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ReservationReconciler) notifyClusterQueues(cqs sets.Set[kueue.ClusterQueueReference]) {
+	if cqs.Len() == 0 {
+		return
+	}
+	qcache.NotifyRetryInadmissible(r.qManager, cqs)
+	r.qManager.Broadcast()
+}
+
+func reservationActiveCondition(resv *kueue.Reservation, now time.Time) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               kueue.ReservationActive,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: resv.Generation,
+	}
+	switch {
+	case now.Before(resv.Spec.StartTime.Time):
+		cond.Reason = kueue.ReservationPending
+		cond.Message = "The reservation window has not started"
+	case now.Before(resv.Spec.EndTime.Time):
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = kueue.ReservationStarted
+		cond.Message = "The reservation window is open"
+	default:
+		cond.Reason = kueue.ReservationExpired
+		cond.Message = "The reservation window has ended"
+	}
+	return cond
+}
+
+func (r *ReservationReconciler) Create(e event.TypedCreateEvent[*kueue.Reservation]) bool {
+	log := r.logger().WithValues("reservation", klog.KObj(e.Object))
+	log.V(2).Info("Reservation create event")
+	return true
+}
+
+func (r *ReservationReconciler) Delete(e event.TypedDeleteEvent[*kueue.Reservation]) bool {
+	log := r.logger().WithValues("reservation", klog.KObj(e.Object))
+	log.V(2).Info("Reservation delete event")
+	return true
+}
+
+func (r *ReservationReconciler) Update(e event.TypedUpdateEvent[*kueue.Reservation]) bool {
+	if equality.Semantic.DeepEqual(e.ObjectOld.Spec, e.ObjectNew.Spec) {
+		return false
+	}
+	log := r.logger().WithValues("reservation", klog.KObj(e.ObjectNew))
+	log.V(2).Info("Reservation update event")
+	return true
+}
+
+func (r *ReservationReconciler) Generic(event.TypedGenericEvent[*kueue.Reservation]) bool {
+	return false
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReservationReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.TypedControllerManagedBy[reconcile.Request](mgr).
+		Named("reservation_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.Reservation{},
+			&handler.TypedEnqueueRequestForObject[*kueue.Reservation]{},
+			r,
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      new(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.SchemeGroupVersion.WithKind("Reservation").GroupKind().String()],
+			LogConstructor:          roletracker.NewLogConstructor(r.roleTracker, "reservation-reconciler"),
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.Reservation{}, cfg))
+}
@@ -0,0 +1,134 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestReservationReconcile(t *testing.T) {
+	start := time.Date(2026, 3, 2, 2, 0, 0, 0, time.UTC)
+	end := start.Add(6 * time.Hour)
+
+	cases := map[string]struct {
+		now           time.Time
+		wantCondition metav1.Condition
+		wantRequeue   time.Duration
+	}{
+		"before the window": {
+			now: start.Add(-time.Hour),
+			wantCondition: metav1.Condition{
+				Type:    kueue.ReservationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.ReservationPending,
+				Message: "The reservation window has not started",
+			},
+			wantRequeue: time.Hour,
+		},
+		"during the window": {
+			now: start.Add(time.Hour),
+			wantCondition: metav1.Condition{
+				Type:    kueue.ReservationActive,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.ReservationStarted,
+				Message: "The reservation window is open",
+			},
+			wantRequeue: 5 * time.Hour,
+		},
+		"after the window": {
+			now: end,
+			wantCondition: metav1.Condition{
+				Type:    kueue.ReservationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.ReservationExpired,
+				Message: "The reservation window has ended",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.CapacityReservations, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			fakeClock := testingclock.NewFakeClock(tc.now)
+
+			resv := utiltestingapi.MakeReservation("resv", start, end).
+				Resource("default", corev1.ResourceCPU, "4").
+				Obj()
+			cq := utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+				Reservations("resv").
+				Obj()
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(resv, cq).
+				WithStatusSubresource(resv).
+				Build()
+			cqCache := schdcache.New(cl, schdcache.WithClock(fakeClock))
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+			cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in cache: %v", err)
+			}
+			r := NewReservationReconciler(cl, qManager, cqCache, nil)
+			r.clock = fakeClock
+
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: resv.Name}}
+			result, err := r.Reconcile(ctx, req)
+			if err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+			if result.RequeueAfter != tc.wantRequeue {
+				t.Errorf("Reconcile requeued after %v, want %v", result.RequeueAfter, tc.wantRequeue)
+			}
+
+			var got kueue.Reservation
+			if err := cl.Get(ctx, req.NamespacedName, &got); err != nil {
+				t.Fatalf("Getting the Reservation: %v", err)
+			}
+			gotCondition := apimeta.FindStatusCondition(got.Status.Conditions, kueue.ReservationActive)
+			if diff := cmp.Diff(&tc.wantCondition, gotCondition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("Unexpected Active condition (-want,+got):\n%s", diff)
+			}
+			if got := cqCache.ClusterQueuesUsingReservation("resv"); !got.Has("cq") {
+				t.Errorf("Reservation not tracked in the cache, cluster queues using it: %v", got)
+			}
+
+			if err := cl.Delete(ctx, &got); err != nil {
+				t.Fatalf("Deleting the Reservation: %v", err)
+			}
+			if _, err := r.Reconcile(ctx, req); err != nil {
+				t.Fatalf("Reconcile after deletion failed: %v", err)
+			}
+		})
+	}
+}
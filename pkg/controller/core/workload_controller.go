@@ -1284,6 +1284,7 @@ func (r *WorkloadReconciler) Delete(e event.TypedDeleteEvent[*kueue.Workload]) b
 			log.Error(err, "Failed to delete workload from cache")
 		}
 	})
+	r.queueAfterWorkflowReservation(e.Object)
 
 	// Even if the state is unknown, the last cached state tells us whether the
 	// workload was in the queues and should be cleared from them.
@@ -1298,6 +1299,20 @@ func (r *WorkloadReconciler) Delete(e event.TypedDeleteEvent[*kueue.Workload]) b
 	return true
 }
 
+// queueAfterWorkflowReservation requeues the inadmissible workloads of the
+// ClusterQueue, or of its cohort, of a workload of a workflow which released
+// its quota, once the quota reserved for the workflow is released at the end
+// of the grace period. It must be called after the workload is removed from
+// the cache.
+func (r *WorkloadReconciler) queueAfterWorkflowReservation(wl *kueue.Workload) {
+	remaining, held := r.cache.WorkflowReservationRemaining(wl)
+	if !held {
+		return
+	}
+	cqNames := sets.New(wl.Status.Admission.ClusterQueue)
+	qcache.NotifyRetryInadmissibleAfter(r.queues, cqNames, remaining)
+}
+
 func (r *WorkloadReconciler) Update(e event.TypedUpdateEvent[*kueue.Workload]) bool {
 	defer r.notifyWatchers(e.ObjectOld, e.ObjectNew)
 
@@ -1358,6 +1373,7 @@ func (r *WorkloadReconciler) Update(e event.TypedUpdateEvent[*kueue.Workload]) b
 				log.Error(err, "Failed to delete workload from cache")
 			}
 		})
+		r.queueAfterWorkflowReservation(e.ObjectOld)
 	case prevStatus == workload.StatusPending && status == workload.StatusPending:
 		switch {
 		case onHold:
@@ -1417,6 +1433,7 @@ func (r *WorkloadReconciler) Update(e event.TypedUpdateEvent[*kueue.Workload]) b
 				r.queues.DeleteSecondPassWithoutLock(wlKey)
 			}
 		})
+		r.queueAfterWorkflowReservation(e.ObjectOld)
 	case prevStatus == workload.StatusAdmitted && status == workload.StatusAdmitted && !equality.Semantic.DeepEqual(e.ObjectOld.Status.ReclaimablePods, e.ObjectNew.Status.ReclaimablePods),
 		features.Enabled(features.ElasticJobsViaWorkloadSlices) && workloadslicing.ScaledDown(workload.ExtractPodSetCountsFromWorkload(e.ObjectOld), workload.ExtractPodSetCountsFromWorkload(e.ObjectNew)),
 		workload.PriorityChanged(log, e.ObjectOld, e.ObjectNew):
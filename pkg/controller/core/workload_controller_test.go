@@ -545,6 +545,66 @@ func TestUpdateRemovesStaleQueueEntryForOnHoldWorkload(t *testing.T) {
 	}
 }
 
+func TestDeleteRequeuesInadmissibleAfterWorkflowReservation(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.WorkflowBudgets, true)
+	now := time.Now().Truncate(time.Second)
+	fakeClock := testingclock.NewFakeClock(now)
+
+	step := utiltestingapi.MakeWorkload("step-1", "ns").
+		Queue("lq").
+		Label(kueue.WorkflowLabel, "pipeline").
+		Annotation(kueue.WorkflowReservationAnnotation, "cpu=4").
+		Request(corev1.ResourceCPU, "1").
+		SimpleReserveQuota("cq", "default", now).
+		Obj()
+	pending := utiltestingapi.MakeWorkload("other", "ns").
+		Queue("lq").
+		Request(corev1.ResourceCPU, "2").
+		Obj()
+
+	cl := utiltesting.NewClientBuilder().WithObjects(utiltesting.MakeNamespace("ns"), pending).Build()
+	cqCache := schdcache.New(cl, schdcache.WithClock(fakeClock))
+	qManager, requeuer := qcache.NewManagerForUnitTestsWithRequeuer(cl, cqCache,
+		qcache.WithClock(fakeClock),
+		qcache.WithPreemptionExpectations(preemptexpectations.New()))
+	reconciler := NewWorkloadReconciler(cl, qManager, cqCache, &utiltesting.EventRecorder{},
+		WithPreemptionExpectations(preemptexpectations.New()))
+
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	cqCache.AddOrUpdateResourceFlavor(log, utiltestingapi.MakeResourceFlavor("default").Obj())
+	setupClusterQueue(ctx, t, cl, qManager, cqCache, utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+		WorkflowReservationLimit(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).
+		Active(metav1.ConditionTrue).
+		Obj(), false)
+	setupLocalQueue(ctx, t, cl, qManager, utiltestingapi.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(), false)
+	cqCache.AddOrUpdateWorkload(log, step)
+
+	if err := qManager.AddOrUpdateWorkload(log, pending); err != nil {
+		t.Fatalf("AddOrUpdateWorkload() error = %v", err)
+	}
+	requeuer.ProcessRequeues(ctx)
+	qManager.Heads(ctx)
+	qManager.RequeueWorkload(ctx, workload.NewInfo(pending), qcache.RequeueReasonNoFit, qcache.QuotaReservedReasonWaitingForQuota)
+	if inadmissible := qManager.DumpInadmissible()["cq"]; len(inadmissible) != 1 {
+		t.Fatalf("expected one inadmissible workload, got %v", inadmissible)
+	}
+
+	reconciler.Delete(event.TypedDeleteEvent[*kueue.Workload]{Object: step})
+
+	// The quota reserved for the workflow is kept during the grace period.
+	fakeClock.Step(schdcache.WorkflowReservationGracePeriod - time.Second)
+	if moved := requeuer.ProcessRequeues(ctx); moved != 0 {
+		t.Errorf("expected no workloads requeued during the grace period, got %d", moved)
+	}
+
+	fakeClock.Step(time.Second)
+	if moved := requeuer.ProcessRequeues(ctx); moved != 1 {
+		t.Errorf("expected the inadmissible workload requeued after the grace period, got %d", moved)
+	}
+}
+
 func TestUpdateSettlesAfsEntryPenalty(t *testing.T) {
 	now := time.Now().Truncate(time.Second)
 	lqKey := utilqueue.NewLocalQueueReference("ns", "lq")
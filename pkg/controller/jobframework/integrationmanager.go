@@ -109,6 +109,7 @@ type IntegrationManager struct {
 	integrations                  map[string]IntegrationCallbacks
 	enabledIntegrations           set.Set[string]
 	externalIntegrations          map[string]runtime.Object
+	runtimeJobTypes               map[schema.GroupVersionKind]runtime.Object
 	implicitlyEnabledIntegrations sets.Set[string]
 	gvkToName                     map[schema.GroupVersionKind]string
 	mu                            sync.RWMutex
@@ -129,6 +130,32 @@ func (m *IntegrationManager) RegisterExternalJobType(kindArg string) error {
 	return m.registerExternal(kindArg)
 }
 
+// RegisterRuntimeJobType registers a job type whose controller and webhook
+// are set up while the manager is running, like the kinds described by
+// JobIntegrations, so that it's known as an owner managed by Kueue.
+func (m *IntegrationManager) RegisterRuntimeJobType(gvk schema.GroupVersionKind) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.runtimeJobTypes == nil {
+		m.runtimeJobTypes = make(map[schema.GroupVersionKind]runtime.Object)
+	}
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+	m.runtimeJobTypes[gvk] = &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiVersion,
+			Kind:       kind,
+		},
+	}
+}
+
+// UnregisterRuntimeJobType removes a job type registered with
+// RegisterRuntimeJobType.
+func (m *IntegrationManager) UnregisterRuntimeJobType(gvk schema.GroupVersionKind) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.runtimeJobTypes, gvk)
+}
+
 // ForEachIntegration calls f for each framework registered with this manager.
 func (m *IntegrationManager) ForEachIntegration(f func(name string, cb IntegrationCallbacks) error) error {
 	return m.forEach(f)
@@ -337,6 +364,9 @@ func (m *IntegrationManager) isKnownOwner(ownerRef *metav1.OwnerReference) bool
 			return true
 		}
 	}
+	if m.getRuntimeJobType(ownerRef) != nil {
+		return true
+	}
 	// ReplicaSet is an interim owner from Pod to Deployment. We call it known
 	// so that the users don't need to list	it explicitly in their configs.
 	// Note that Kueue provides RBAC permissions allowing for traversal over it.
@@ -356,6 +386,16 @@ func (m *IntegrationManager) getJobTypeForOwner(ownerRef *metav1.OwnerReference)
 		}
 	}
 
+	return m.getRuntimeJobType(ownerRef)
+}
+
+func (m *IntegrationManager) getRuntimeJobType(ownerRef *metav1.OwnerReference) runtime.Object {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	gvk := schema.FromAPIVersionAndKind(ownerRef.APIVersion, ownerRef.Kind)
+	if jt, found := m.runtimeJobTypes[gvk]; found {
+		return jt
+	}
 	return nil
 }
 
@@ -400,6 +400,9 @@ func TestGetJobTypeForOwner(t *testing.T) {
 	mgr.enableIntegration("dontManage")
 	mgr.enableIntegration("manageK1")
 	mgr.enableIntegration("manageK2")
+	mgr.RegisterRuntimeJobType(schema.GroupVersionKind{Group: "test-group", Version: "v1", Kind: "K6"})
+	mgr.RegisterRuntimeJobType(schema.GroupVersionKind{Group: "test-group", Version: "v1", Kind: "K7"})
+	mgr.UnregisterRuntimeJobType(schema.GroupVersionKind{Group: "test-group", Version: "v1", Kind: "K7"})
 
 	cases := map[string]struct {
 		owner       *metav1.OwnerReference
@@ -425,6 +428,14 @@ func TestGetJobTypeForOwner(t *testing.T) {
 			owner:       &metav1.OwnerReference{Kind: "K5", APIVersion: "test-group/v1"},
 			wantJobType: nil,
 		},
+		"runtime K6": {
+			owner:       &metav1.OwnerReference{Kind: "K6", APIVersion: "test-group/v1"},
+			wantJobType: &metav1.PartialObjectMetadata{TypeMeta: metav1.TypeMeta{Kind: "K6", APIVersion: "test-group/v1"}},
+		},
+		"unregistered runtime K7": {
+			owner:       &metav1.OwnerReference{Kind: "K7", APIVersion: "test-group/v1"},
+			wantJobType: nil,
+		},
 	}
 
 	for tcName, tc := range cases {
@@ -89,6 +89,13 @@ type JobWithReclaimablePods interface {
 	ReclaimablePods(ctx context.Context, c client.Client) ([]kueue.ReclaimablePod, error)
 }
 
+// JobWithPodSetShapeConstraints is an optional interface that should be implemented
+// by generic jobs whose PodSets need to keep proportional counts on partial admission.
+type JobWithPodSetShapeConstraints interface {
+	// PodSetShapeConstraints returns the shape constraints between the PodSets of the job.
+	PodSetShapeConstraints() []kueue.PodSetShapeConstraint
+}
+
 // JobWithCustomStop is an optional interface that should be implemented by generic jobs
 // when a custom stop procedure is needed.
 type JobWithCustomStop interface {
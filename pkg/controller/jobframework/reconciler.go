@@ -1402,6 +1402,10 @@ func expectedRunningPodSets(ctx context.Context, c client.Client, wl *kueue.Work
 	infoMap := slices.ToRefMap(info, func(psi *podset.PodSetInfo) kueue.PodSetReference { return psi.Name })
 	runningPodSets := wl.Spec.DeepCopy().PodSets
 	canBePartiallyAdmitted := workload.CanBePartiallyAdmitted(wl)
+	constrainedPodSets := sets.New[kueue.PodSetReference]()
+	for _, c := range wl.Spec.PodSetShapeConstraints {
+		constrainedPodSets.Insert(c.PodSet)
+	}
 	for i := range runningPodSets {
 		ps := &runningPodSets[i]
 		psi, found := infoMap[ps.Name]
@@ -1412,7 +1416,7 @@ func expectedRunningPodSets(ctx context.Context, c client.Client, wl *kueue.Work
 		if err != nil {
 			return nil
 		}
-		if canBePartiallyAdmitted && ps.MinCount != nil {
+		if canBePartiallyAdmitted && (ps.MinCount != nil || constrainedPodSets.Has(ps.Name)) {
 			// update the expected running count
 			ps.Count = psi.Count
 		}
@@ -1585,6 +1589,7 @@ func (r *JobReconciler) constructWorkload(ctx context.Context, job GenericJob) (
 		if err != nil {
 			return nil, err
 		}
+		setWorkflow(wl, job.Object())
 		return wl, nil
 	}
 	return ConstructWorkload(ctx, r.client, job, r.labelKeysToCopy, r.annotationsToCopy)
@@ -1630,6 +1635,18 @@ func ConstructWorkload(ctx context.Context, c client.Client, job GenericJob, lab
 			"LabelValue", jobUID,
 		)
 	}
+	if features.Enabled(features.CapacityReservations) {
+		if resv, found := object.GetLabels()[kueue.ReservationLabel]; found {
+			wl.Labels[kueue.ReservationLabel] = resv
+		}
+	}
+	setWorkflow(wl, object)
+
+	if features.Enabled(features.PodSetShapeConstraints) {
+		if jobWithConstraints, implements := job.(JobWithPodSetShapeConstraints); implements {
+			wl.Spec.PodSetShapeConstraints = jobWithConstraints.PodSetShapeConstraints()
+		}
+	}
 
 	if err := ctrl.SetControllerReference(object, wl, c.Scheme()); err != nil {
 		return nil, err
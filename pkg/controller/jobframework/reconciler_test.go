@@ -1263,3 +1263,72 @@ func TestReconcileGenericJob_EvictionClearsQuotaReservation(t *testing.T) {
 		})
 	}
 }
+
+func TestConstructWorkloadWorkflow(t *testing.T) {
+	cases := map[string]struct {
+		disableGate     bool
+		labels          map[string]string
+		wantWorkflow    string
+		wantAnnotations map[string]string
+	}{
+		"workflow label": {
+			labels:       map[string]string{kueue.WorkflowLabel: "pipeline"},
+			wantWorkflow: "pipeline",
+			wantAnnotations: map[string]string{
+				kueue.WorkflowBudgetAnnotation:      "cpu=8",
+				kueue.WorkflowReservationAnnotation: "cpu=2",
+			},
+		},
+		"argo workflow": {
+			labels:       map[string]string{"workflows.argoproj.io/workflow": "argo-pipeline"},
+			wantWorkflow: "argo-pipeline",
+			wantAnnotations: map[string]string{
+				kueue.WorkflowBudgetAnnotation:      "cpu=8",
+				kueue.WorkflowReservationAnnotation: "cpu=2",
+			},
+		},
+		"tekton pipeline run": {
+			labels:       map[string]string{"tekton.dev/pipelineRun": "tekton-pipeline"},
+			wantWorkflow: "tekton-pipeline",
+			wantAnnotations: map[string]string{
+				kueue.WorkflowBudgetAnnotation:      "cpu=8",
+				kueue.WorkflowReservationAnnotation: "cpu=2",
+			},
+		},
+		"not a workflow step": {},
+		"feature gate disabled": {
+			disableGate: true,
+			labels:      map[string]string{kueue.WorkflowLabel: "pipeline"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkflowBudgets, !tc.disableGate)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			jobWrapper := testingjob.MakeJob("step", metav1.NamespaceDefault).
+				SetAnnotation(kueue.WorkflowBudgetAnnotation, "cpu=8").
+				SetAnnotation(kueue.WorkflowReservationAnnotation, "cpu=2")
+			for k, v := range tc.labels {
+				jobWrapper.Label(k, v)
+			}
+			cl := utiltesting.NewClientBuilder(batchv1.AddToScheme, kueue.AddToScheme).Build()
+
+			wl, err := ConstructWorkload(ctx, cl, (*job.Job)(jobWrapper.Obj()), nil, nil)
+			if err != nil {
+				t.Fatalf("ConstructWorkload failed: %v", err)
+			}
+			if got := wl.Labels[kueue.WorkflowLabel]; got != tc.wantWorkflow {
+				t.Errorf("Unexpected workflow %q, want %q", got, tc.wantWorkflow)
+			}
+			gotAnnotations := make(map[string]string)
+			for _, key := range []string{kueue.WorkflowBudgetAnnotation, kueue.WorkflowReservationAnnotation} {
+				if v, found := wl.Annotations[key]; found {
+					gotAnnotations[key] = v
+				}
+			}
+			if diff := cmp.Diff(tc.wantAnnotations, gotAnnotations, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected workflow annotations (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
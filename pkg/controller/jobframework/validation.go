@@ -28,6 +28,7 @@ import (
 	kftraining "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	awv1beta2 "github.com/project-codeflare/appwrapper/api/v1beta2"
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -56,10 +57,14 @@ var (
 	prebuiltWorkloadAnnotationPath = annotationsPath.Key(constants.PrebuiltWorkloadAnnotation)
 	elasticJobAnnotationPath       = annotationsPath.Key(workloadslicing.EnabledAnnotationKey)
 	supportedElasticJobGVKs        = sets.New(
+		appsv1.SchemeGroupVersion.WithKind("Deployment").String(),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet").String(),
 		batchv1.SchemeGroupVersion.WithKind("Job").String(),
 		rayv1.GroupVersion.WithKind("RayCluster").String(),
 		rayv1.GroupVersion.WithKind("RayJob").String(),
 		rayv1.GroupVersion.WithKind("RayService").String(),
+		// The KServe types are not vendored.
+		schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"}.String(),
 	)
 	supportedPrebuiltWlJobGVKs = sets.New(
 		batchv1.SchemeGroupVersion.WithKind("Job").String(),
@@ -210,7 +215,13 @@ func validateJobUpdateForWorkloadPriorityClassName(oldJob, newJob GenericJob) fi
 // annotations[workloadslicing.EnabledAnnotationKey] and using apivalidation.FieldImmutableErrorMsg.
 // If the value did not change, // it returns nil.
 func validatedUpdateForEnabledWorkloadSlice(oldJob, newJob GenericJob) field.ErrorList {
-	if oldEnabled, newEnabled := workloadslicing.Enabled(oldJob.Object()), workloadslicing.Enabled(newJob.Object()); oldEnabled != newEnabled {
+	return ValidateElasticJobAnnotationOnUpdate(oldJob.Object(), newJob.Object())
+}
+
+// ValidateElasticJobAnnotationOnUpdate validates that the workload-slicing toggle
+// remains immutable on update, for the integrations not validated as a GenericJob.
+func ValidateElasticJobAnnotationOnUpdate(oldObj, newObj client.Object) field.ErrorList {
+	if oldEnabled, newEnabled := workloadslicing.Enabled(oldObj), workloadslicing.Enabled(newObj); oldEnabled != newEnabled {
 		return field.ErrorList{field.Invalid(elasticJobAnnotationPath, newEnabled, apivalidation.FieldImmutableErrorMsg)}
 	}
 	return nil
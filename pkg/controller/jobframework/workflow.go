@@ -0,0 +1,102 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+// The steps of a workflow are admitted as independent workloads, sharing the
+// budget of the workflow. The workflow engines only create the pods of a step
+// once the steps it depends on completed, so the dependencies of the DAG are
+// enforced before Kueue sees the step.
+
+// workflowEngineLabels are the labels set by the workflow engines on the
+// pods of the steps of a workflow, with the name of the workflow. They are
+// used when the job doesn't have the WorkflowLabel.
+var workflowEngineLabels = []string{
+	// Argo Workflows
+	"workflows.argoproj.io/workflow",
+	// Tekton Pipelines
+	"tekton.dev/pipelineRun",
+}
+
+const (
+	// argoNodeIDAnnotation is the annotation set by Argo Workflows on the pod
+	// of a step, with the ID of the node of the step attempt.
+	argoNodeIDAnnotation = "workflows.argoproj.io/node-id"
+	// tektonTaskRunLabel is the label set by Tekton Pipelines on the pods of
+	// a TaskRun, with the name of the TaskRun.
+	tektonTaskRunLabel = "tekton.dev/taskRun"
+)
+
+// WorkflowStepName returns the name of the step attempt of a workflow engine
+// the pod is created for, if any. A retried step is a new attempt: Argo
+// Workflows creates a node with a new ID, and Tekton Pipelines a new pod for
+// the TaskRun.
+func WorkflowStepName(pod client.Object) (string, bool) {
+	if id, found := pod.GetAnnotations()[argoNodeIDAnnotation]; found && id != "" {
+		return id, true
+	}
+	if _, found := pod.GetLabels()[tektonTaskRunLabel]; found && pod.GetName() != "" {
+		return pod.GetName(), true
+	}
+	return "", false
+}
+
+// WorkflowName returns the name of the workflow the job is a step of, if any.
+func WorkflowName(object client.Object) (string, bool) {
+	labels := object.GetLabels()
+	if name, found := labels[kueue.WorkflowLabel]; found {
+		return name, true
+	}
+	for _, key := range workflowEngineLabels {
+		if name, found := labels[key]; found {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// setWorkflow propagates to the workload the workflow of the job, and the
+// budget and the reservation of the workflow.
+func setWorkflow(wl *kueue.Workload, object client.Object) {
+	if !features.Enabled(features.WorkflowBudgets) {
+		return
+	}
+	name, found := WorkflowName(object)
+	if !found {
+		return
+	}
+	if wl.Labels == nil {
+		wl.Labels = make(map[string]string)
+	}
+	wl.Labels[kueue.WorkflowLabel] = name
+	for _, key := range []string{kueue.WorkflowBudgetAnnotation, kueue.WorkflowReservationAnnotation} {
+		value, found := object.GetAnnotations()[key]
+		if !found {
+			continue
+		}
+		if wl.Annotations == nil {
+			wl.Annotations = make(map[string]string)
+		}
+		wl.Annotations[key] = value
+	}
+}
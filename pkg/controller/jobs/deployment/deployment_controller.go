@@ -22,9 +22,16 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 var (
@@ -38,7 +45,8 @@ const (
 func RegisterIntegration(m *jobframework.IntegrationManager) error {
 	return m.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
 		SetupIndexes:                    SetupIndexes,
-		NewReconciler:                   jobframework.NewNoopReconcilerFactory(gvk),
+		NewJob:                          NewJob,
+		NewReconciler:                   NewReconciler,
 		GVK:                             gvk,
 		SetupWebhook:                    SetupWebhook,
 		JobType:                         &appsv1.Deployment{},
@@ -47,11 +55,24 @@ func RegisterIntegration(m *jobframework.IntegrationManager) error {
 	})
 }
 
+// Deployment is managed as a job only when it is elastic. The pods of the
+// other Deployments are managed by the pod integration, each as a workload.
 type Deployment appsv1.Deployment
 
-// +kubebuilder:rbac:groups="apps",resources=deployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups="apps",resources=deployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="apps",resources=deployments/finalizers,verbs=get;update
 // +kubebuilder:rbac:groups="apps",resources=replicasets,verbs=get;list;watch
 
+func NewJob() jobframework.GenericJob {
+	return &Deployment{}
+}
+
+var NewReconciler = jobframework.NewGenericReconcilerFactory(NewJob)
+
+var _ jobframework.GenericJob = (*Deployment)(nil)
+var _ jobframework.JobWithSkip = (*Deployment)(nil)
+var _ jobframework.JobWithCustomStop = (*Deployment)(nil)
+
 func fromObject(o runtime.Object) *Deployment {
 	return (*Deployment)(o.(*appsv1.Deployment))
 }
@@ -64,6 +85,84 @@ func (d *Deployment) GVK() schema.GroupVersionKind {
 	return gvk
 }
 
-func SetupIndexes(context.Context, client.FieldIndexer) error {
-	return nil
+// Skip skips the Deployments which are not elastic.
+func (d *Deployment) Skip(context.Context) bool {
+	return !workloadslicing.Enabled(d.Object())
+}
+
+// IsSuspended returns true until the Deployment is admitted, as the pod
+// template of an admitted elastic Deployment carries its workload slice name.
+func (d *Deployment) IsSuspended() bool {
+	_, found := d.Spec.Template.Annotations[kueue.WorkloadSliceNameAnnotation]
+	return !found
+}
+
+func (d *Deployment) Suspend() {
+	delete(d.Spec.Template.Annotations, kueue.WorkloadSliceNameAnnotation)
+}
+
+// Stop suspends the Deployment, and deletes its ungated pods, which are
+// recreated gated by the ReplicaSet.
+func (d *Deployment) Stop(ctx context.Context, c client.Client, podSetsInfo []podset.PodSetInfo, _ jobframework.StopReason, _ string) (bool, error) {
+	stoppedNow := false
+	if !d.IsSuspended() {
+		if err := clientutil.Patch(ctx, c, d.Object(), func() (bool, error) {
+			d.Suspend()
+			d.RestorePodSetsInfo(ctx, podSetsInfo)
+			return true, nil
+		}); err != nil {
+			return false, err
+		}
+		stoppedNow = true
+	}
+	return stoppedNow, workloadslicing.DeleteUngatedPods(ctx, c, d.Namespace, d.Spec.Selector)
+}
+
+func (d *Deployment) RunWithPodSetsInfo(ctx context.Context, _ client.Client, podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return podset.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	return podset.Merge(ctrl.LoggerFrom(ctx), &d.Spec.Template.ObjectMeta, &d.Spec.Template.Spec, podSetsInfo[0])
+}
+
+func (d *Deployment) RestorePodSetsInfo(_ context.Context, podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) == 0 {
+		return false
+	}
+	return podset.RestorePodSpec(&d.Spec.Template.ObjectMeta, &d.Spec.Template.Spec, podSetsInfo[0])
+}
+
+// Finished always returns false, as a Deployment serves until it is deleted.
+func (d *Deployment) Finished(context.Context) (message string, success, finished bool) {
+	return "", false, false
+}
+
+// PodSets returns a single PodSet with the desired replicas of the Deployment,
+// which are set by the HorizontalPodAutoscaler when the Deployment is autoscaled.
+func (d *Deployment) PodSets(context.Context, client.Client) ([]kueue.PodSet, error) {
+	podSet := kueue.PodSet{
+		Name:     kueue.DefaultPodSetName,
+		Template: *d.Spec.Template.DeepCopy(),
+		Count:    ptr.Deref(d.Spec.Replicas, 1),
+	}
+	if features.Enabled(features.TopologyAwareScheduling) {
+		topologyRequest, err := jobframework.NewPodSetTopologyRequest(&d.Spec.Template.ObjectMeta).Build()
+		if err != nil {
+			return nil, err
+		}
+		podSet.TopologyRequest = topologyRequest
+	}
+	return []kueue.PodSet{podSet}, nil
+}
+
+func (d *Deployment) IsActive() bool {
+	return d.Status.ReadyReplicas > 0
+}
+
+func (d *Deployment) PodsReady(context.Context, client.Client) bool {
+	return d.Status.ReadyReplicas >= ptr.Deref(d.Spec.Replicas, 1)
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
 }
@@ -0,0 +1,105 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingdeployment "sigs.k8s.io/kueue/pkg/util/testingjobs/deployment"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
+)
+
+func TestRunAndStop(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	deployment := testingdeployment.MakeDeployment("test-deployment", "ns").
+		SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+		Replicas(2).
+		PodTemplateSpecSchedulingGate(kueue.ElasticJobSchedulingGate).
+		Obj()
+	podLabel := deployment.Spec.Template.Labels["app"]
+	gatedPod := testingpod.MakePod("gated", "ns").Label("app", podLabel).Gate(kueue.ElasticJobSchedulingGate).Obj()
+	ungatedPod := testingpod.MakePod("ungated", "ns").Label("app", podLabel).Obj()
+	otherPod := testingpod.MakePod("other", "ns").Label("app", "other").Obj()
+	cl := utiltesting.NewClientBuilder().WithObjects(deployment, gatedPod, ungatedPod, otherPod).Build()
+
+	job := fromObject(deployment)
+	if !job.IsSuspended() {
+		t.Error("The Deployment isn't suspended before it is admitted")
+	}
+	podSets, err := job.PodSets(ctx, cl)
+	if err != nil {
+		t.Fatalf("PodSets returned error: %v", err)
+	}
+	if diff := cmp.Diff([]kueue.PodSet{{
+		Name:     kueue.DefaultPodSetName,
+		Template: *deployment.Spec.Template.DeepCopy(),
+		Count:    2,
+	}}, podSets); diff != "" {
+		t.Errorf("Unexpected PodSets (-want,+got):\n%s", diff)
+	}
+
+	info := podset.PodSetInfo{
+		Name:         kueue.DefaultPodSetName,
+		Count:        2,
+		Annotations:  map[string]string{kueue.WorkloadSliceNameAnnotation: "slice"},
+		NodeSelector: map[string]string{"flavor": "on-demand"},
+	}
+	if err := job.RunWithPodSetsInfo(ctx, cl, []podset.PodSetInfo{info}); err != nil {
+		t.Fatalf("RunWithPodSetsInfo returned error: %v", err)
+	}
+	if job.IsSuspended() {
+		t.Error("The Deployment is suspended after it is admitted")
+	}
+	if err := cl.Update(ctx, job.Object()); err != nil {
+		t.Fatalf("Failed to update the Deployment: %v", err)
+	}
+
+	stoppedNow, err := job.Stop(ctx, cl, []podset.PodSetInfo{{Name: kueue.DefaultPodSetName}}, jobframework.StopReasonWorkloadDeleted, "")
+	if err != nil {
+		t.Fatalf("Stop returned error: %v", err)
+	}
+	if !stoppedNow {
+		t.Error("The Deployment wasn't stopped")
+	}
+	if !job.IsSuspended() {
+		t.Error("The Deployment isn't suspended after it is stopped")
+	}
+	if len(job.Spec.Template.Spec.NodeSelector) != 0 {
+		t.Errorf("Unexpected node selector after stopping: %v", job.Spec.Template.Spec.NodeSelector)
+	}
+
+	var pods corev1.PodList
+	if err := cl.List(ctx, &pods, client.InNamespace("ns")); err != nil {
+		t.Fatalf("Failed to list pods: %v", err)
+	}
+	var gotPods []string
+	for _, p := range pods.Items {
+		gotPods = append(gotPods, p.Name)
+	}
+	if diff := cmp.Diff([]string{"gated", "other"}, gotPods); diff != "" {
+		t.Errorf("Unexpected pods after stopping (-want,+got):\n%s", diff)
+	}
+}
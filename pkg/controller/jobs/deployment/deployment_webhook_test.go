@@ -27,6 +27,7 @@ import (
 	"k8s.io/component-base/featuregate"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	kueueconstants "sigs.k8s.io/kueue/pkg/constants"
@@ -50,6 +51,7 @@ func TestDefault(t *testing.T) {
 	testCases := map[string]struct {
 		deployment     *appsv1.Deployment
 		defaultLqExist bool
+		featureGates   map[featuregate.Feature]bool
 		want           *appsv1.Deployment
 	}{
 		"deployment without queue": {
@@ -149,10 +151,23 @@ func TestDefault(t *testing.T) {
 				PodTemplateSpecLabel(constants.WorkloadPriorityClassLabel, "test").
 				Obj(),
 		},
+		"elastic deployment with queue": {
+			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
+			deployment: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+			want: testingdeployment.MakeDeployment("test-pod", "").
+				Queue("test-queue").
+				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				PodTemplateSpecSchedulingGate(kueue.ElasticJobSchedulingGate).
+				Obj(),
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGatesDuringTest(t, tc.featureGates)
 			ctx, _ := utiltesting.ContextWithLog(t)
 			integrationManager := newTestIntegrationManager(t)
 			t.Cleanup(integrationManager.EnableIntegrationsForTest(t, "pod"))
@@ -341,17 +356,11 @@ func TestValidateCreate(t *testing.T) {
 			wantErr:      nil,
 			featureGates: map[featuregate.Feature]bool{features.AdmissionGatedBy: true},
 		},
-		"elastic job annotation is rejected on create": {
+		"elastic job annotation is accepted on create": {
 			deployment: testingdeployment.MakeDeployment("test-deployment", "default").
 				Queue("queue").
 				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
 				Obj(),
-			wantErr: field.ErrorList{
-				&field.Error{
-					Type:  field.ErrorTypeForbidden,
-					Field: "metadata.annotations[" + workloadslicing.EnabledAnnotationKey + "]",
-				},
-			}.ToAggregate(),
 			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
 		},
 	}
@@ -611,7 +620,7 @@ func TestValidateUpdate(t *testing.T) {
 			wantErr:      nil,
 			featureGates: map[featuregate.Feature]bool{features.AdmissionGatedBy: true},
 		},
-		"elastic job annotation is rejected on update": {
+		"elastic job annotation can't be added on update": {
 			oldDeployment: testingdeployment.MakeDeployment("test-deployment", "default").
 				Queue("queue").
 				Obj(),
@@ -621,12 +630,27 @@ func TestValidateUpdate(t *testing.T) {
 				Obj(),
 			wantErr: field.ErrorList{
 				&field.Error{
-					Type:  field.ErrorTypeForbidden,
+					Type:  field.ErrorTypeInvalid,
 					Field: "metadata.annotations[" + workloadslicing.EnabledAnnotationKey + "]",
 				},
 			}.ToAggregate(),
 			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
 		},
+		"elastic deployment can be scaled": {
+			oldDeployment: testingdeployment.MakeDeployment("test-deployment", "default").
+				Queue("queue").
+				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Replicas(2).
+				ReadyReplicas(2).
+				Obj(),
+			newDeployment: testingdeployment.MakeDeployment("test-deployment", "default").
+				Queue("queue").
+				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Replicas(5).
+				ReadyReplicas(2).
+				Obj(),
+			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
+		},
 	}
 
 	for name, tc := range testCases {
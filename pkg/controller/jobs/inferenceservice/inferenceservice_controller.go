@@ -0,0 +1,450 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inferenceservice integrates the KServe InferenceServices with
+// Kueue. The KServe types are not vendored, the InferenceServices are handled
+// as unstructured objects.
+package inferenceservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
+)
+
+var (
+	gvk = schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"}
+
+	predictorPath = []string{"spec", "predictor"}
+)
+
+const (
+	FrameworkName = "serving.kserve.io/inferenceservice"
+
+	predictorPodSetName = "predictor"
+
+	// StopAnnotation is the annotation used by KServe to stop an
+	// InferenceService, deleting its resources.
+	StopAnnotation = "serving.kserve.io/stop"
+	// InferenceServiceLabel is the label set by KServe on the pods of an
+	// InferenceService, with the name of the InferenceService.
+	InferenceServiceLabel = "serving.kserve.io/inferenceservice"
+	// ComponentLabel is the label set by KServe on the pods of an
+	// InferenceService, with the name of the component.
+	ComponentLabel = "component"
+
+	// ServingWorkloadPriorityClassName is the name of the WorkloadPriorityClass
+	// defaulted for the InferenceServices, when it exists, so that the serving
+	// workloads have a priority distinct from the batch workloads.
+	ServingWorkloadPriorityClassName = "serving"
+
+	// modelContainerName is the name of the container KServe creates for the
+	// model of the predictor.
+	modelContainerName = "kserve-container"
+)
+
+// templateFields maps the fields of the pod template updated by Kueue, as set
+// by podset.Merge and podset.RestorePodSpec, to the fields of the predictor.
+// The other fields of the predictor are left untouched.
+var templateFields = []struct {
+	template  []string
+	predictor []string
+}{
+	{template: []string{"metadata", "annotations"}, predictor: []string{"annotations"}},
+	{template: []string{"metadata", "labels"}, predictor: []string{"labels"}},
+	{template: []string{"spec", "nodeSelector"}, predictor: []string{"nodeSelector"}},
+	{template: []string{"spec", "tolerations"}, predictor: []string{"tolerations"}},
+	{template: []string{"spec", "affinity"}, predictor: []string{"affinity"}},
+	{template: []string{"spec", "schedulingGates"}, predictor: []string{"schedulingGates"}},
+}
+
+func RegisterIntegration(m *jobframework.IntegrationManager) error {
+	return m.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewJob:        newJob,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupWebhook,
+		JobType:       newObject(),
+	})
+}
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;watch;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices/status,verbs=get
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+
+func newObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+func newJob() jobframework.GenericJob {
+	return fromObject(newObject())
+}
+
+func fromObject(obj *unstructured.Unstructured) *InferenceService {
+	return &InferenceService{obj: obj}
+}
+
+type reconciler struct {
+	jr            *jobframework.JobReconciler
+	eventRecorder events.EventRecorder
+	opts          []jobframework.Option
+}
+
+var _ jobframework.JobReconcilerInterface = (*reconciler)(nil)
+
+func NewReconciler(
+	_ context.Context,
+	client client.Client,
+	_ client.FieldIndexer,
+	eventRecorder events.EventRecorder,
+	opts ...jobframework.Option,
+) (jobframework.JobReconcilerInterface, error) {
+	return &reconciler{
+		jr:            jobframework.NewReconciler(client, eventRecorder, opts...),
+		eventRecorder: eventRecorder,
+		opts:          opts,
+	}, nil
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, newJob())
+}
+
+func (r *reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The client of the manager reads unstructured objects from the API
+	// server, the InferenceServices are read from the cache instead.
+	c, err := newJobClient(mgr)
+	if err != nil {
+		return fmt.Errorf("creating the client for %s: %w", gvk, err)
+	}
+	r.jr = jobframework.NewReconciler(c, r.eventRecorder, r.opts...)
+	controllerName := strings.ToLower(gvk.Kind)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(newObject()).
+		Owns(&kueue.Workload{}).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(podToInferenceService)).
+		Named(controllerName).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.jr.RoleTracker(), controllerName),
+		}).
+		Complete(r)
+}
+
+// podToInferenceService enqueues the InferenceService of the predictor pods,
+// for the workload to follow the scale of the predictor.
+func podToInferenceService(_ context.Context, obj client.Object) []reconcile.Request {
+	name, found := obj.GetLabels()[InferenceServiceLabel]
+	if !found || obj.GetLabels()[ComponentLabel] != predictorPodSetName {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: obj.GetNamespace(), Name: name}}}
+}
+
+// newJobClient returns a client reading the InferenceServices, which are
+// unstructured, from the cache of the manager.
+func newJobClient(mgr ctrl.Manager) (client.Client, error) {
+	return client.New(mgr.GetConfig(), client.Options{
+		HTTPClient: mgr.GetHTTPClient(),
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+		Cache: &client.CacheOptions{
+			Reader:       mgr.GetCache(),
+			Unstructured: true,
+		},
+	})
+}
+
+// InferenceService is a KServe InferenceService. The predictor is represented
+// by a single PodSet.
+type InferenceService struct {
+	obj *unstructured.Unstructured
+}
+
+var _ jobframework.GenericJob = (*InferenceService)(nil)
+var _ jobframework.JobWithPodLabelSelector = (*InferenceService)(nil)
+var _ jobframework.JobWithPriorityClass = (*InferenceService)(nil)
+
+func (j *InferenceService) Object() client.Object {
+	return j.obj
+}
+
+func (j *InferenceService) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *InferenceService) IsSuspended() bool {
+	return j.obj.GetAnnotations()[StopAnnotation] == "true"
+}
+
+func (j *InferenceService) Suspend() {
+	j.setStopped("true")
+}
+
+func (j *InferenceService) setStopped(value string) {
+	annotations := j.obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[StopAnnotation] = value
+	j.obj.SetAnnotations(annotations)
+}
+
+func (j *InferenceService) IsActive() bool {
+	return meta.IsStatusConditionTrue(j.conditions(), "PredictorReady")
+}
+
+func (j *InferenceService) PodsReady(context.Context, client.Client) bool {
+	return meta.IsStatusConditionTrue(j.conditions(), "Ready")
+}
+
+// Finished returns false, as the InferenceServices are long-running services.
+func (j *InferenceService) Finished(context.Context) (message string, success, finished bool) {
+	return "Running", false, false
+}
+
+func (j *InferenceService) PodLabelSelector() string {
+	return fmt.Sprintf("%s=%s,%s=%s", InferenceServiceLabel, j.obj.GetName(), ComponentLabel, predictorPodSetName)
+}
+
+func (j *InferenceService) PriorityClass() string {
+	priorityClass, _, _ := unstructured.NestedString(j.obj.Object, append(predictorPath, "priorityClassName")...)
+	return priorityClass
+}
+
+func (j *InferenceService) PodSets(ctx context.Context, c client.Client) ([]kueue.PodSet, error) {
+	template, err := j.podTemplate()
+	if err != nil {
+		return nil, err
+	}
+	count, err := j.podsCount(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	podSet := kueue.PodSet{
+		Name:     predictorPodSetName,
+		Template: *template,
+		Count:    count,
+	}
+	if features.Enabled(features.TopologyAwareScheduling) {
+		topologyRequest, err := jobframework.NewPodSetTopologyRequest(&template.ObjectMeta).Build()
+		if err != nil {
+			return nil, err
+		}
+		podSet.TopologyRequest = topologyRequest
+	}
+	return []kueue.PodSet{podSet}, nil
+}
+
+func (j *InferenceService) RunWithPodSetsInfo(ctx context.Context, _ client.Client, podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return podset.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	template, err := j.podTemplate()
+	if err != nil {
+		return err
+	}
+	if err := podset.Merge(ctrl.LoggerFrom(ctx), &template.ObjectMeta, &template.Spec, podSetsInfo[0]); err != nil {
+		return err
+	}
+	if err := j.setPodTemplate(template); err != nil {
+		return err
+	}
+	j.setStopped("false")
+	return nil
+}
+
+func (j *InferenceService) RestorePodSetsInfo(ctx context.Context, podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) != 1 {
+		return false
+	}
+	template, err := j.podTemplate()
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to restore the predictor")
+		return false
+	}
+	if !podset.RestorePodSpec(&template.ObjectMeta, &template.Spec, podSetsInfo[0]) {
+		return false
+	}
+	if err := j.setPodTemplate(template); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to restore the predictor")
+		return false
+	}
+	return true
+}
+
+// replicas returns the minReplicas and maxReplicas of the predictor, zero
+// standing for no maximum. KServe defaults minReplicas to 1.
+func (j *InferenceService) replicas() (int32, int32) {
+	minReplicas, found, _ := unstructured.NestedInt64(j.obj.Object, append(predictorPath, "minReplicas")...)
+	if !found {
+		minReplicas = 1
+	}
+	maxReplicas, _, _ := unstructured.NestedInt64(j.obj.Object, append(predictorPath, "maxReplicas")...)
+	return int32(minReplicas), int32(maxReplicas)
+}
+
+// podsCount returns the number of predictor pods to reserve quota for.
+//
+// Unless elastic, the quota is reserved for the maximum number of replicas.
+// When elastic, the quota follows the number of predictor pods between the
+// min and max replicas, so that scaling to zero releases the quota and scaling
+// up creates a new workload slice. Before the predictor is first ready, the
+// quota is reserved for the initial scale.
+func (j *InferenceService) podsCount(ctx context.Context, c client.Client) (int32, error) {
+	minReplicas, maxReplicas := j.replicas()
+	if !workloadslicing.Enabled(j.obj) {
+		return max(minReplicas, maxReplicas, 1), nil
+	}
+	count := max(minReplicas, 1)
+	if !j.IsSuspended() && c != nil {
+		pods, err := j.activePods(ctx, c)
+		if err != nil {
+			return 0, err
+		}
+		if pods > 0 || j.everReady() {
+			count = max(pods, minReplicas)
+		}
+	}
+	if maxReplicas > 0 {
+		count = min(count, maxReplicas)
+	}
+	return count, nil
+}
+
+// activePods returns the number of predictor pods which are not terminated
+// nor terminating.
+func (j *InferenceService) activePods(ctx context.Context, c client.Client) (int32, error) {
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.InNamespace(j.obj.GetNamespace()), client.MatchingLabels{
+		InferenceServiceLabel: j.obj.GetName(),
+		ComponentLabel:        predictorPodSetName,
+	}); err != nil {
+		return 0, fmt.Errorf("listing the predictor pods: %w", err)
+	}
+	var count int32
+	for i := range pods.Items {
+		if pods.Items[i].DeletionTimestamp.IsZero() && !utilpod.IsTerminated(&pods.Items[i]) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// everReady returns whether a revision of the predictor was ready.
+func (j *InferenceService) everReady() bool {
+	revision, _, _ := unstructured.NestedString(j.obj.Object, "status", "components", "predictor", "latestReadyRevision")
+	return revision != "" || j.IsActive()
+}
+
+func (j *InferenceService) conditions() []metav1.Condition {
+	conditionsObj, _, _ := unstructured.NestedSlice(j.obj.Object, "status", "conditions")
+	conditions := make([]metav1.Condition, 0, len(conditionsObj))
+	for _, c := range conditionsObj {
+		m, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditionType, _, _ := unstructured.NestedString(m, "type")
+		status, _, _ := unstructured.NestedString(m, "status")
+		conditions = append(conditions, metav1.Condition{Type: conditionType, Status: metav1.ConditionStatus(status)})
+	}
+	return conditions
+}
+
+// podTemplate returns the pod template of the predictor: the pod spec inlined
+// in the predictor, with the container of the model if any.
+func (j *InferenceService) podTemplate() (*corev1.PodTemplateSpec, error) {
+	predictor, found, err := unstructured.NestedMap(j.obj.Object, predictorPath...)
+	if err != nil {
+		return nil, fmt.Errorf("reading spec.predictor: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("spec.predictor not found")
+	}
+	var template corev1.PodTemplateSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(predictor, &template.Spec); err != nil {
+		return nil, fmt.Errorf("reading spec.predictor: %w", err)
+	}
+	template.Labels, _, _ = unstructured.NestedStringMap(predictor, "labels")
+	template.Annotations, _, _ = unstructured.NestedStringMap(predictor, "annotations")
+	if model, found, _ := unstructured.NestedMap(predictor, "model"); found {
+		var container corev1.Container
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(model, &container); err != nil {
+			return nil, fmt.Errorf("reading spec.predictor.model: %w", err)
+		}
+		if container.Name == "" {
+			container.Name = modelContainerName
+		}
+		template.Spec.Containers = append([]corev1.Container{container}, template.Spec.Containers...)
+	}
+	return &template, nil
+}
+
+func (j *InferenceService) setPodTemplate(template *corev1.PodTemplateSpec) error {
+	templateObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(template)
+	if err != nil {
+		return err
+	}
+	for _, f := range templateFields {
+		path := append(append([]string(nil), predictorPath...), f.predictor...)
+		value, found, err := unstructured.NestedFieldNoCopy(templateObj, f.template...)
+		if err != nil {
+			return err
+		}
+		if !found || value == nil {
+			unstructured.RemoveNestedField(j.obj.Object, path...)
+			continue
+		}
+		if err := unstructured.SetNestedField(j.obj.Object, value, path...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testinginferenceservice "sigs.k8s.io/kueue/pkg/util/testingjobs/inferenceservice"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
+)
+
+func predictorPod(name, isvc string) *testingpod.PodWrapper {
+	return testingpod.MakePod(name, "ns").
+		Label(InferenceServiceLabel, isvc).
+		Label(ComponentLabel, predictorPodSetName)
+}
+
+func TestPodSets(t *testing.T) {
+	modelTemplate := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: modelContainerName,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			}},
+		},
+	}
+	elastic := func(name string) *testinginferenceservice.InferenceServiceWrapper {
+		return testinginferenceservice.MakeInferenceService(name, "ns").
+			Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+			Request("cpu", "1")
+	}
+	cases := map[string]struct {
+		isvc      *testinginferenceservice.InferenceServiceWrapper
+		pods      []client.Object
+		wantCount int32
+	}{
+		"not elastic, the max replicas are reserved": {
+			isvc:      testinginferenceservice.MakeInferenceService("isvc", "ns").Request("cpu", "1").MinReplicas(1).MaxReplicas(3),
+			wantCount: 3,
+		},
+		"not elastic, without max replicas": {
+			isvc:      testinginferenceservice.MakeInferenceService("isvc", "ns").Request("cpu", "1"),
+			wantCount: 1,
+		},
+		"elastic, stopped": {
+			isvc:      elastic("isvc").MinReplicas(0).MaxReplicas(4).Stop(true),
+			pods:      []client.Object{predictorPod("pod1", "isvc").Obj(), predictorPod("pod2", "isvc").Obj()},
+			wantCount: 1,
+		},
+		"elastic, before the predictor is ready": {
+			isvc:      elastic("isvc").MinReplicas(0).MaxReplicas(4).Stop(false),
+			wantCount: 1,
+		},
+		"elastic, scaled to zero": {
+			isvc:      elastic("isvc").MinReplicas(0).MaxReplicas(4).Stop(false).LatestReadyRevision("isvc-predictor-00001"),
+			wantCount: 0,
+		},
+		"elastic, follows the predictor pods": {
+			isvc: elastic("isvc").MinReplicas(0).MaxReplicas(4).Stop(false).LatestReadyRevision("isvc-predictor-00001"),
+			pods: []client.Object{
+				predictorPod("pod1", "isvc").Obj(),
+				predictorPod("pod2", "isvc").Obj(),
+				predictorPod("pod3", "isvc").StatusPhase(corev1.PodSucceeded).Obj(),
+				predictorPod("pod4", "isvc").Delete().Finalizer("test").Obj(),
+				predictorPod("pod5", "other").Obj(),
+			},
+			wantCount: 2,
+		},
+		"elastic, capped by the max replicas": {
+			isvc: elastic("isvc").MinReplicas(1).MaxReplicas(2).Stop(false).Condition("PredictorReady", "True"),
+			pods: []client.Object{
+				predictorPod("pod1", "isvc").Obj(),
+				predictorPod("pod2", "isvc").Obj(),
+				predictorPod("pod3", "isvc").Obj(),
+			},
+			wantCount: 2,
+		},
+		"elastic, at least the min replicas": {
+			isvc:      elastic("isvc").MinReplicas(2).Stop(false).LatestReadyRevision("isvc-predictor-00001"),
+			pods:      []client.Object{predictorPod("pod1", "isvc").Obj()},
+			wantCount: 2,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().WithObjects(tc.pods...).Build()
+			got, err := fromObject(tc.isvc.Obj()).PodSets(ctx, cl)
+			if err != nil {
+				t.Fatalf("PodSets returned error: %v", err)
+			}
+			want := []kueue.PodSet{{
+				Name:     predictorPodSetName,
+				Template: modelTemplate,
+				Count:    tc.wantCount,
+			}}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Unexpected PodSets (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPodSetsCustomPredictor(t *testing.T) {
+	isvc := testinginferenceservice.MakeInferenceService("isvc", "ns").Obj()
+	isvc.Object["spec"] = map[string]any{
+		"predictor": map[string]any{
+			"labels":            map[string]any{"app": "model"},
+			"priorityClassName": "high",
+			"containers": []any{map[string]any{
+				"name":  "server",
+				"image": "server:latest",
+			}},
+		},
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	got, err := fromObject(isvc).PodSets(ctx, nil)
+	if err != nil {
+		t.Fatalf("PodSets returned error: %v", err)
+	}
+	want := []kueue.PodSet{{
+		Name: predictorPodSetName,
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "model"}},
+			Spec: corev1.PodSpec{
+				PriorityClassName: "high",
+				Containers:        []corev1.Container{{Name: "server", Image: "server:latest"}},
+			},
+		},
+		Count: 1,
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected PodSets (-want,+got):\n%s", diff)
+	}
+}
+
+func TestRunWithPodSetsInfo(t *testing.T) {
+	isvc := testinginferenceservice.MakeInferenceService("isvc", "ns").
+		Request("cpu", "1").
+		PredictorLabel("app", "model").
+		Stop(true)
+	job := fromObject(isvc.Clone().Obj())
+	info := podset.PodSetInfo{
+		Name:         predictorPodSetName,
+		Count:        1,
+		Labels:       map[string]string{"kueue": "admitted"},
+		Annotations:  map[string]string{"kueue": "admitted"},
+		NodeSelector: map[string]string{"flavor": "gpu"},
+		Tolerations:  []corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}},
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	if err := job.RunWithPodSetsInfo(ctx, nil, []podset.PodSetInfo{info}); err != nil {
+		t.Fatalf("RunWithPodSetsInfo returned error: %v", err)
+	}
+	want := isvc.Clone().
+		Stop(false).
+		PredictorLabel("kueue", "admitted").
+		PredictorAnnotation("kueue", "admitted").
+		Obj()
+	want.Object["spec"].(map[string]any)["predictor"].(map[string]any)["nodeSelector"] = map[string]any{"flavor": "gpu"}
+	want.Object["spec"].(map[string]any)["predictor"].(map[string]any)["tolerations"] = []any{map[string]any{"key": "gpu", "operator": "Exists"}}
+	if diff := cmp.Diff(want, job.obj); diff != "" {
+		t.Errorf("Unexpected InferenceService after running (-want,+got):\n%s", diff)
+	}
+	if job.IsSuspended() {
+		t.Error("The InferenceService is stopped after running")
+	}
+
+	job.Suspend()
+	original := podset.PodSetInfo{
+		Name:   predictorPodSetName,
+		Labels: map[string]string{"app": "model"},
+	}
+	if !job.RestorePodSetsInfo(ctx, []podset.PodSetInfo{original}) {
+		t.Error("RestorePodSetsInfo didn't change the InferenceService")
+	}
+	if diff := cmp.Diff(isvc.Obj(), job.obj); diff != "" {
+		t.Errorf("Unexpected InferenceService after restoring (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	"sigs.k8s.io/kueue/pkg/util/webhook"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
+)
+
+var (
+	predictorFieldPath = field.NewPath("spec", "predictor")
+	minReplicasPath    = predictorFieldPath.Child("minReplicas")
+	maxReplicasPath    = predictorFieldPath.Child("maxReplicas")
+)
+
+type Webhook struct {
+	integrationManager           *jobframework.IntegrationManager
+	client                       client.Client
+	queues                       *qcache.Manager
+	manageJobsWithoutQueueName   bool
+	managedJobsNamespaceSelector labels.Selector
+}
+
+// SetupWebhook configures the webhook for the KServe InferenceServices.
+func SetupWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &Webhook{
+		integrationManager:           options.IntegrationManager,
+		client:                       mgr.GetClient(),
+		queues:                       options.Queues,
+		manageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
+		managedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
+	}
+	obj := newObject()
+	if options.NoopWebhook {
+		return webhook.SetupNoopWebhook(mgr, obj)
+	}
+	return ctrl.NewWebhookManagedBy(mgr, obj).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		WithLogConstructor(jobframework.WebhookLogConstructor(gvk, options.RoleTracker)).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-serving-kserve-io-v1beta1-inferenceservice,mutating=true,failurePolicy=fail,sideEffects=None,groups=serving.kserve.io,resources=inferenceservices,verbs=create,versions=v1beta1,name=minferenceservice.kb.io,admissionReviewVersions=v1
+
+var _ admission.Defaulter[*unstructured.Unstructured] = &Webhook{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (w *Webhook) Default(ctx context.Context, obj *unstructured.Unstructured) error {
+	job := fromObject(obj)
+	log := ctrl.LoggerFrom(ctx).WithName("inferenceservice-webhook")
+	log.V(5).Info("Applying defaults")
+	if err := w.integrationManager.ApplyDefaultLocalQueue(ctx, w.client, job.Object(), w.queues.DefaultLocalQueueExist, w.managedJobsNamespaceSelector); err != nil {
+		return err
+	}
+	if err := w.applyServingWorkloadPriorityClass(ctx, job); err != nil {
+		return err
+	}
+	w.integrationManager.ApplyDefaultWorkloadPriorityClass(ctx, w.client, job.Object())
+	if err := w.integrationManager.ApplyDefaultForSuspend(ctx, job, w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector); err != nil {
+		return err
+	}
+
+	if workloadslicing.Enabled(obj) {
+		// The pods created on scale-up wait for the admission of the new
+		// workload slice.
+		template, err := job.podTemplate()
+		if err != nil {
+			return err
+		}
+		utilpod.GateTemplate(template, kueue.ElasticJobSchedulingGate)
+		return job.setPodTemplate(template)
+	}
+	return nil
+}
+
+// applyServingWorkloadPriorityClass sets the ServingWorkloadPriorityClassName
+// for the InferenceServices without priority, when it exists.
+func (w *Webhook) applyServingWorkloadPriorityClass(ctx context.Context, job *InferenceService) error {
+	if !features.Enabled(features.WorkloadPriorityClassDefaulting) {
+		return nil
+	}
+	if jobframework.WorkloadPriorityClassName(job.Object()) != "" || job.PriorityClass() != "" {
+		return nil
+	}
+	wpc := &kueue.WorkloadPriorityClass{}
+	if err := w.client.Get(ctx, types.NamespacedName{Name: ServingWorkloadPriorityClassName}, wpc); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	objLabels := job.obj.GetLabels()
+	if objLabels == nil {
+		objLabels = make(map[string]string, 1)
+	}
+	objLabels[constants.WorkloadPriorityClassLabel] = ServingWorkloadPriorityClassName
+	job.obj.SetLabels(objLabels)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-serving-kserve-io-v1beta1-inferenceservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=serving.kserve.io,resources=inferenceservices,verbs=create;update,versions=v1beta1,name=vinferenceservice.kb.io,admissionReviewVersions=v1
+
+var _ admission.Validator[*unstructured.Unstructured] = &Webhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *Webhook) ValidateCreate(ctx context.Context, obj *unstructured.Unstructured) (admission.Warnings, error) {
+	log := ctrl.LoggerFrom(ctx).WithName("inferenceservice-webhook")
+	log.V(5).Info("Validating create")
+	job := fromObject(obj)
+	allErrs := jobframework.ValidateJobOnCreate(job)
+	allErrs = append(allErrs, validatePredictor(job)...)
+	return nil, allErrs.ToAggregate()
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj *unstructured.Unstructured) (admission.Warnings, error) {
+	log := ctrl.LoggerFrom(ctx).WithName("inferenceservice-webhook")
+	log.V(5).Info("Validating update")
+	oldJob := fromObject(oldObj)
+	newJob := fromObject(newObj)
+	allErrs := jobframework.ValidateJobOnUpdate(oldJob, newJob, w.queues.DefaultLocalQueueExist)
+	allErrs = append(allErrs, validatePredictor(newJob)...)
+	return nil, allErrs.ToAggregate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *Webhook) ValidateDelete(context.Context, *unstructured.Unstructured) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validatePredictor(job *InferenceService) field.ErrorList {
+	if jobframework.QueueName(job) == "" {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if _, err := job.podTemplate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(predictorFieldPath, "", err.Error()))
+	}
+	minReplicas, maxReplicas := job.replicas()
+	if minReplicas < 0 {
+		allErrs = append(allErrs, field.Invalid(minReplicasPath, minReplicas, "must be greater than or equal to 0"))
+	}
+	if maxReplicas > 0 && maxReplicas < minReplicas {
+		allErrs = append(allErrs, field.Invalid(maxReplicasPath, maxReplicas, "must be greater than or equal to minReplicas"))
+	}
+	return allErrs
+}
@@ -0,0 +1,143 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testinginferenceservice "sigs.k8s.io/kueue/pkg/util/testingjobs/inferenceservice"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
+)
+
+func TestDefault(t *testing.T) {
+	servingPriorityClass := utiltestingapi.MakeWorkloadPriorityClass(ServingWorkloadPriorityClassName).PriorityValue(1000).Obj()
+	cases := map[string]struct {
+		isvc    *testinginferenceservice.InferenceServiceWrapper
+		objects []client.Object
+		want    *testinginferenceservice.InferenceServiceWrapper
+	}{
+		"without queue": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns"),
+			want: testinginferenceservice.MakeInferenceService("isvc", "ns"),
+		},
+		"with queue, the InferenceService is stopped": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns").Queue("queue"),
+			want: testinginferenceservice.MakeInferenceService("isvc", "ns").Queue("queue").Stop(true),
+		},
+		"elastic, the predictor pods are gated": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue),
+			want: testinginferenceservice.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Stop(true).
+				PredictorSchedulingGate(kueue.ElasticJobSchedulingGate),
+		},
+		"the serving WorkloadPriorityClass is defaulted": {
+			isvc:    testinginferenceservice.MakeInferenceService("isvc", "ns").Queue("queue"),
+			objects: []client.Object{servingPriorityClass},
+			want: testinginferenceservice.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(constants.WorkloadPriorityClassLabel, ServingWorkloadPriorityClassName).
+				Stop(true),
+		},
+		"the serving WorkloadPriorityClass is not defaulted for a predictor with priority": {
+			isvc:    testinginferenceservice.MakeInferenceService("isvc", "ns").Queue("queue").PriorityClass("high"),
+			objects: []client.Object{servingPriorityClass},
+			want:    testinginferenceservice.MakeInferenceService("isvc", "ns").Queue("queue").PriorityClass("high").Stop(true),
+		},
+		"the serving WorkloadPriorityClass is not defaulted for an InferenceService with a WorkloadPriorityClass": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(constants.WorkloadPriorityClassLabel, "low"),
+			objects: []client.Object{servingPriorityClass},
+			want: testinginferenceservice.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Label(constants.WorkloadPriorityClassLabel, "low").
+				Stop(true),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cli := utiltesting.NewClientBuilder().WithObjects(tc.objects...).Build()
+			integrationManager := jobframework.NewIntegrationManager()
+			if err := RegisterIntegration(integrationManager); err != nil {
+				t.Fatalf("RegisterIntegration() error = %v", err)
+			}
+			w := &Webhook{
+				integrationManager: integrationManager,
+				client:             cli,
+				queues:             qcache.NewManagerForUnitTests(cli, schdcache.New(cli)),
+			}
+			obj := tc.isvc.Obj()
+			if err := w.Default(ctx, obj); err != nil {
+				t.Fatalf("Default returned error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.Obj(), obj); diff != "" {
+				t.Errorf("Unexpected InferenceService (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	cases := map[string]struct {
+		isvc    *testinginferenceservice.InferenceServiceWrapper
+		wantErr error
+	}{
+		"valid": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns").Queue("queue").MinReplicas(0).MaxReplicas(3),
+		},
+		"elastic": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns").
+				Queue("queue").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue),
+		},
+		"max replicas lower than min replicas": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns").Queue("queue").MinReplicas(3).MaxReplicas(2),
+			wantErr: field.ErrorList{
+				field.Invalid(maxReplicasPath, int32(2), "must be greater than or equal to minReplicas"),
+			}.ToAggregate(),
+		},
+		"not managed": {
+			isvc: testinginferenceservice.MakeInferenceService("isvc", "ns").MinReplicas(3).MaxReplicas(2),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			_, err := (&Webhook{}).ValidateCreate(ctx, tc.isvc.Obj())
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "BadValue")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobintegration
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utilcel "sigs.k8s.io/kueue/pkg/util/cel"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
+)
+
+// podSetDefinition is the compiled form of a JobIntegrationPodSet.
+type podSetDefinition struct {
+	name         kueue.PodSetReference
+	templatePath []string
+	// countPath is empty when one pod is created from the template.
+	countPath []string
+	// reclaimableCount is nil when no pods are reclaimable.
+	reclaimableCount cel.Program
+}
+
+// Definition is the compiled form of a JobIntegrationSpec.
+type Definition struct {
+	gvk         schema.GroupVersionKind
+	podSets     []podSetDefinition
+	suspendPath []string
+	// priorityClassPath is empty when the priority class is taken from the
+	// pod templates.
+	priorityClassPath    []string
+	podLabelSelectorPath []string
+	finished             cel.Program
+	// succeeded, active and podsReady are nil when not set in the spec.
+	succeeded cel.Program
+	active    cel.Program
+	podsReady cel.Program
+}
+
+// ParseJobKind parses the jobKind of a JobIntegration, in the format
+// `Kind.version.group`.
+func ParseJobKind(jobKind string) (schema.GroupVersionKind, error) {
+	gvk, _ := schema.ParseKindArg(jobKind)
+	if gvk == nil || gvk.Group == "" {
+		return schema.GroupVersionKind{}, fmt.Errorf("invalid job kind %q, expecting Kind.version.group", jobKind)
+	}
+	return *gvk, nil
+}
+
+// NewDefinition compiles the paths and the expressions of the spec.
+func NewDefinition(spec *kueue.JobIntegrationSpec) (*Definition, error) {
+	var errs []error
+	gvk, err := ParseJobKind(spec.JobKind)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("jobKind: %w", err))
+	}
+	d := &Definition{gvk: gvk}
+
+	if len(spec.PodSets) == 0 {
+		errs = append(errs, errors.New("podSets: at least one PodSet is required"))
+	}
+	for i := range spec.PodSets {
+		ps, err := newPodSetDefinition(&spec.PodSets[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("podSets[%d]: %w", i, err))
+			continue
+		}
+		d.podSets = append(d.podSets, ps)
+	}
+
+	parsePath := func(name, path string, dst *[]string) {
+		fields, err := fieldpath.Parse(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+		*dst = fields
+	}
+	parsePath("suspendPath", spec.SuspendPath, &d.suspendPath)
+	if spec.PriorityClassPath != nil {
+		parsePath("priorityClassPath", *spec.PriorityClassPath, &d.priorityClassPath)
+	}
+	if spec.PodLabelSelectorPath != nil {
+		parsePath("podLabelSelectorPath", *spec.PodLabelSelectorPath, &d.podLabelSelectorPath)
+	}
+
+	compileBool := func(name, expression string, dst *cel.Program) {
+		program, err := utilcel.CompileBool(expression)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+		*dst = program
+	}
+	compileBool("finishedExpression", spec.FinishedExpression, &d.finished)
+	if spec.SucceededExpression != nil {
+		compileBool("succeededExpression", *spec.SucceededExpression, &d.succeeded)
+	}
+	if spec.ActiveExpression != nil {
+		compileBool("activeExpression", *spec.ActiveExpression, &d.active)
+	}
+	if spec.PodsReadyExpression != nil {
+		compileBool("podsReadyExpression", *spec.PodsReadyExpression, &d.podsReady)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func newPodSetDefinition(ps *kueue.JobIntegrationPodSet) (podSetDefinition, error) {
+	var errs []error
+	d := podSetDefinition{name: ps.Name}
+	path, err := fieldpath.Parse(ps.TemplatePath)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("templatePath: %w", err))
+	}
+	d.templatePath = path
+	if ps.CountPath != nil {
+		path, err := fieldpath.Parse(*ps.CountPath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("countPath: %w", err))
+		}
+		d.countPath = path
+	}
+	if ps.ReclaimableCountExpression != nil {
+		program, err := utilcel.CompileInt(*ps.ReclaimableCountExpression)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reclaimableCountExpression: %w", err))
+		}
+		d.reclaimableCount = program
+	}
+	return d, errors.Join(errs...)
+}
+
+// GVK returns the kind of the jobs described by the definition.
+func (d *Definition) GVK() schema.GroupVersionKind {
+	return d.gvk
+}
+
+// Registry holds the definitions of the JobIntegrations managing a kind, by
+// kind. It is shared by the JobIntegration controller, which updates it, and
+// the controllers and webhooks of the kinds, which read it.
+type Registry struct {
+	mu sync.RWMutex
+	// definitions are the active definitions, by kind.
+	definitions map[schema.GroupVersionKind]*Definition
+	// owners are the names of the JobIntegrations managing a kind.
+	owners map[schema.GroupVersionKind]string
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		definitions: make(map[schema.GroupVersionKind]*Definition),
+		owners:      make(map[schema.GroupVersionKind]string),
+	}
+}
+
+// Get returns the active definition for the kind, or nil.
+func (r *Registry) Get(gvk schema.GroupVersionKind) *Definition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.definitions[gvk]
+}
+
+// Owner returns the name of the JobIntegration managing the kind.
+func (r *Registry) Owner(gvk schema.GroupVersionKind) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name, found := r.owners[gvk]
+	return name, found
+}
+
+// Set activates the definition of the JobIntegration. It returns false if
+// the kind is managed by another JobIntegration.
+func (r *Registry) Set(name string, d *Definition) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if owner, found := r.owners[d.gvk]; found && owner != name {
+		return false
+	}
+	r.deleteLocked(name)
+	r.owners[d.gvk] = name
+	r.definitions[d.gvk] = d
+	return true
+}
+
+// Delete deactivates the definition of the JobIntegration. It returns the
+// kind it managed, if any.
+func (r *Registry) Delete(name string) (schema.GroupVersionKind, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.deleteLocked(name)
+}
+
+func (r *Registry) deleteLocked(name string) (schema.GroupVersionKind, bool) {
+	for gvk, owner := range r.owners {
+		if owner == name {
+			delete(r.owners, gvk)
+			delete(r.definitions, gvk)
+			return gvk, true
+		}
+	}
+	return schema.GroupVersionKind{}, false
+}
@@ -0,0 +1,330 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobintegration
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilcel "sigs.k8s.io/kueue/pkg/util/cel"
+	"sigs.k8s.io/kueue/pkg/util/fieldpath"
+)
+
+// templateFields are the fields of the pod templates updated by Kueue, as
+// set by podset.Merge and podset.RestorePodSpec. The other fields are left
+// untouched.
+var templateFields = [][]string{
+	{"metadata", "annotations"},
+	{"metadata", "labels"},
+	{"spec", "nodeSelector"},
+	{"spec", "tolerations"},
+	{"spec", "schedulingGates"},
+}
+
+// Job is a job of a kind described by a JobIntegration.
+type Job struct {
+	obj *unstructured.Unstructured
+	gvk schema.GroupVersionKind
+	// def is nil when the kind is no longer described by an active
+	// JobIntegration, in which case the job is skipped.
+	def *Definition
+}
+
+var _ jobframework.GenericJob = (*Job)(nil)
+var _ jobframework.JobWithSkip = (*Job)(nil)
+var _ jobframework.JobWithPodLabelSelector = (*Job)(nil)
+var _ jobframework.JobWithReclaimablePods = (*Job)(nil)
+var _ jobframework.JobWithPriorityClass = (*Job)(nil)
+
+// NewJob returns a job wrapping obj, described by the active definition of
+// the kind in the registry.
+func NewJob(registry *Registry, gvk schema.GroupVersionKind, obj *unstructured.Unstructured) *Job {
+	if obj == nil {
+		obj = &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+	}
+	return &Job{obj: obj, gvk: gvk, def: registry.Get(gvk)}
+}
+
+func (j *Job) Object() client.Object {
+	return j.obj
+}
+
+func (j *Job) GVK() schema.GroupVersionKind {
+	return j.gvk
+}
+
+// Skip returns true when the kind is no longer described by an active
+// JobIntegration.
+func (j *Job) Skip(context.Context) bool {
+	return j.def == nil
+}
+
+func (j *Job) IsSuspended() bool {
+	suspended, _, _ := unstructured.NestedBool(j.obj.Object, j.def.suspendPath...)
+	return suspended
+}
+
+func (j *Job) Suspend() {
+	_ = unstructured.SetNestedField(j.obj.Object, true, j.def.suspendPath...)
+}
+
+func (j *Job) PodSets(ctx context.Context, _ client.Client) ([]kueue.PodSet, error) {
+	podSets := make([]kueue.PodSet, len(j.def.podSets))
+	for i := range j.def.podSets {
+		ps := &j.def.podSets[i]
+		template, err := j.podTemplate(ps)
+		if err != nil {
+			return nil, err
+		}
+		count, err := j.podsCount(ps)
+		if err != nil {
+			return nil, err
+		}
+		podSets[i] = kueue.PodSet{
+			Name:     ps.name,
+			Template: *template,
+			Count:    count,
+		}
+		if features.Enabled(features.TopologyAwareScheduling) {
+			topologyRequest, err := jobframework.NewPodSetTopologyRequest(&template.ObjectMeta).Build()
+			if err != nil {
+				return nil, err
+			}
+			podSets[i].TopologyRequest = topologyRequest
+		}
+	}
+	return podSets, nil
+}
+
+func (j *Job) RunWithPodSetsInfo(ctx context.Context, _ client.Client, podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != len(j.def.podSets) {
+		return podset.BadPodSetsInfoLenError(len(j.def.podSets), len(podSetsInfo))
+	}
+	log := ctrl.LoggerFrom(ctx)
+	for i := range j.def.podSets {
+		ps := &j.def.podSets[i]
+		template, err := j.podTemplate(ps)
+		if err != nil {
+			return err
+		}
+		if err := podset.Merge(log, &template.ObjectMeta, &template.Spec, podSetsInfo[i]); err != nil {
+			return err
+		}
+		if err := j.setPodTemplate(ps, template); err != nil {
+			return err
+		}
+	}
+	return unstructured.SetNestedField(j.obj.Object, false, j.def.suspendPath...)
+}
+
+func (j *Job) RestorePodSetsInfo(ctx context.Context, podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) != len(j.def.podSets) {
+		ctrl.LoggerFrom(ctx).V(2).Info(
+			"Skipping pod set info restore because the pod set count does not match the admitted workload",
+			"expectedCount", len(j.def.podSets),
+			"gotCount", len(podSetsInfo),
+		)
+		return false
+	}
+	changed := false
+	for i := range j.def.podSets {
+		ps := &j.def.podSets[i]
+		template, err := j.podTemplate(ps)
+		if err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to restore the pod template", "podSet", ps.name)
+			continue
+		}
+		if !podset.RestorePodSpec(&template.ObjectMeta, &template.Spec, podSetsInfo[i]) {
+			continue
+		}
+		if err := j.setPodTemplate(ps, template); err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, "Failed to restore the pod template", "podSet", ps.name)
+			continue
+		}
+		changed = true
+	}
+	return changed
+}
+
+func (j *Job) Finished(ctx context.Context) (message string, success, finished bool) {
+	log := ctrl.LoggerFrom(ctx)
+	finished, err := utilcel.EvalBool(j.def.finished, j.obj)
+	if err != nil {
+		log.V(3).Info("Failed to evaluate the finished expression", "err", err)
+		return "", false, false
+	}
+	if !finished {
+		return "", false, false
+	}
+	success = true
+	if j.def.succeeded != nil {
+		if success, err = utilcel.EvalBool(j.def.succeeded, j.obj); err != nil {
+			log.V(3).Info("Failed to evaluate the succeeded expression", "err", err)
+			return "", false, false
+		}
+	}
+	if success {
+		return "Job finished successfully", true, true
+	}
+	return "Job failed", false, true
+}
+
+func (j *Job) IsActive() bool {
+	if j.def.active == nil {
+		return false
+	}
+	active, err := utilcel.EvalBool(j.def.active, j.obj)
+	return err == nil && active
+}
+
+func (j *Job) PodsReady(context.Context, client.Client) bool {
+	if j.def.podsReady == nil {
+		return true
+	}
+	ready, err := utilcel.EvalBool(j.def.podsReady, j.obj)
+	return err == nil && ready
+}
+
+func (j *Job) PodLabelSelector() string {
+	if len(j.def.podLabelSelectorPath) == 0 {
+		return ""
+	}
+	selectorObj, found, err := unstructured.NestedMap(j.obj.Object, j.def.podLabelSelectorPath...)
+	if err != nil || !found {
+		return ""
+	}
+	var selector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorObj, &selector); err != nil {
+		return ""
+	}
+	s, err := metav1.LabelSelectorAsSelector(&selector)
+	if err != nil {
+		return ""
+	}
+	return s.String()
+}
+
+func (j *Job) ReclaimablePods(ctx context.Context, _ client.Client) ([]kueue.ReclaimablePod, error) {
+	var ret []kueue.ReclaimablePod
+	for i := range j.def.podSets {
+		ps := &j.def.podSets[i]
+		if ps.reclaimableCount == nil {
+			continue
+		}
+		reclaimable, err := utilcel.EvalInt(ps.reclaimableCount, j.obj)
+		if err != nil {
+			ctrl.LoggerFrom(ctx).V(3).Info("Failed to evaluate the reclaimable count expression", "podSet", ps.name, "err", err)
+			continue
+		}
+		count, err := j.podsCount(ps)
+		if err != nil {
+			return nil, err
+		}
+		if reclaimable > 0 {
+			ret = append(ret, kueue.ReclaimablePod{
+				Name:  ps.name,
+				Count: int32(min(reclaimable, int64(count))),
+			})
+		}
+	}
+	return ret, nil
+}
+
+// PriorityClass returns the priority class at the priorityClassPath, or the
+// first priority class of the pod templates.
+func (j *Job) PriorityClass() string {
+	if len(j.def.priorityClassPath) > 0 {
+		priorityClass, _, _ := unstructured.NestedString(j.obj.Object, j.def.priorityClassPath...)
+		return priorityClass
+	}
+	for i := range j.def.podSets {
+		path := append(append([]string(nil), j.def.podSets[i].templatePath...), "spec", "priorityClassName")
+		priorityClass, _, _ := unstructured.NestedString(j.obj.Object, path...)
+		if priorityClass != "" {
+			return priorityClass
+		}
+	}
+	return ""
+}
+
+func (j *Job) podTemplate(ps *podSetDefinition) (*corev1.PodTemplateSpec, error) {
+	templateObj, found, err := unstructured.NestedMap(j.obj.Object, ps.templatePath...)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", fieldpath.String(ps.templatePath), err)
+	}
+	if !found {
+		return nil, fmt.Errorf("pod template %s not found", fieldpath.String(ps.templatePath))
+	}
+	var template corev1.PodTemplateSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateObj, &template); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", fieldpath.String(ps.templatePath), err)
+	}
+	return &template, nil
+}
+
+func (j *Job) setPodTemplate(ps *podSetDefinition, template *corev1.PodTemplateSpec) error {
+	templateObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(template)
+	if err != nil {
+		return err
+	}
+	for _, f := range templateFields {
+		path := append(append([]string(nil), ps.templatePath...), f...)
+		value, found, err := unstructured.NestedFieldNoCopy(templateObj, f...)
+		if err != nil {
+			return err
+		}
+		if !found || value == nil {
+			unstructured.RemoveNestedField(j.obj.Object, path...)
+			continue
+		}
+		if err := unstructured.SetNestedField(j.obj.Object, value, path...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *Job) podsCount(ps *podSetDefinition) (int32, error) {
+	if len(ps.countPath) == 0 {
+		return 1, nil
+	}
+	count, found, err := unstructured.NestedInt64(j.obj.Object, ps.countPath...)
+	if err != nil {
+		return 0, fmt.Errorf("reading %s: %w", fieldpath.String(ps.countPath), err)
+	}
+	if !found {
+		return 1, nil
+	}
+	if count < 0 || count > math.MaxInt32 {
+		return 0, fmt.Errorf("invalid count %d at %s, expecting a value between 0 and %d", count, fieldpath.String(ps.countPath), math.MaxInt32)
+	}
+	return int32(count), nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobintegration
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+// jobReconciler reconciles the jobs of a kind described by a JobIntegration.
+type jobReconciler struct {
+	jr       *jobframework.JobReconciler
+	registry *Registry
+	gvk      schema.GroupVersionKind
+}
+
+var _ reconcile.Reconciler = (*jobReconciler)(nil)
+
+func (r *jobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, NewJob(r.registry, r.gvk, nil))
+}
+
+// controllerName returns the name of the controller of the kind, qualified
+// with the group, so it doesn't collide with the controllers of built-in
+// kinds.
+func controllerName(gvk schema.GroupVersionKind) string {
+	return "jobintegration-" + strings.ToLower(gvk.GroupKind().String())
+}
+
+func (r *jobReconciler) setupWithManager(mgr ctrl.Manager) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(r.gvk)
+	name := controllerName(r.gvk)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(obj).
+		Owns(&kueue.Workload{}).
+		Named(name).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.jr.RoleTracker(), name),
+		}).
+		Complete(r)
+}
+
+// newJobClient returns a client reading the jobs, which are unstructured,
+// from the cache of the manager. The client of the manager reads unstructured
+// objects from the API server.
+func newJobClient(mgr ctrl.Manager) (client.Client, error) {
+	return client.New(mgr.GetConfig(), client.Options{
+		HTTPClient: mgr.GetHTTPClient(),
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+		Cache: &client.CacheOptions{
+			Reader:       mgr.GetCache(),
+			Unstructured: true,
+		},
+	})
+}
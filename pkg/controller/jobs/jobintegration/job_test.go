@@ -0,0 +1,314 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobintegration
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+var testGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "TrainingRun"}
+
+func testSpec() *kueue.JobIntegrationSpec {
+	return &kueue.JobIntegrationSpec{
+		JobKind: "TrainingRun.v1.example.com",
+		PodSets: []kueue.JobIntegrationPodSet{
+			{
+				Name:         "launcher",
+				TemplatePath: "spec.launcher.template",
+			},
+			{
+				Name:                       "workers",
+				TemplatePath:               "spec.workers.template",
+				CountPath:                  new("spec.workers.replicas"),
+				ReclaimableCountExpression: new("has(object.status.succeededWorkers) ? object.status.succeededWorkers : 0"),
+			},
+		},
+		SuspendPath:          "spec.suspend",
+		PodLabelSelectorPath: new("spec.selector"),
+		FinishedExpression:   "has(object.status.phase) && object.status.phase in ['Succeeded', 'Failed']",
+		SucceededExpression:  new("object.status.phase == 'Succeeded'"),
+		ActiveExpression:     new("has(object.status.active) && object.status.active > 0"),
+	}
+}
+
+func testObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"suspend": true,
+			"selector": map[string]any{
+				"matchLabels": map[string]any{"run": "train"},
+			},
+			"launcher": map[string]any{
+				"template": map[string]any{
+					"spec": map[string]any{
+						"priorityClassName": "high",
+						"containers": []any{
+							map[string]any{"name": "launcher", "image": "launcher:v1"},
+						},
+					},
+				},
+			},
+			"workers": map[string]any{
+				"replicas": int64(4),
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{"name": "worker", "image": "worker:v1"},
+						},
+					},
+				},
+			},
+		},
+	}}
+	obj.SetGroupVersionKind(testGVK)
+	obj.SetName("run")
+	obj.SetNamespace("default")
+	return obj
+}
+
+func TestNewDefinition(t *testing.T) {
+	cases := map[string]struct {
+		mutate  func(*kueue.JobIntegrationSpec)
+		wantErr string
+	}{
+		"valid": {},
+		"kind without group": {
+			mutate:  func(s *kueue.JobIntegrationSpec) { s.JobKind = "TrainingRun" },
+			wantErr: `jobKind: invalid job kind "TrainingRun", expecting Kind.version.group`,
+		},
+		"invalid paths and expressions": {
+			mutate: func(s *kueue.JobIntegrationSpec) {
+				s.PodSets[1].CountPath = new("spec..replicas")
+				s.FinishedExpression = "'Succeeded'"
+			},
+			wantErr: "podSets[1]: countPath: invalid path \"spec..replicas\": empty field name\n" +
+				"finishedExpression: must return bool, not string",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec := testSpec()
+			if tc.mutate != nil {
+				tc.mutate(spec)
+			}
+			d, err := NewDefinition(spec)
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Fatalf("NewDefinition succeeded, want error %q", tc.wantErr)
+				}
+				if diff := cmp.Diff(tc.wantErr, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewDefinition failed: %v", err)
+			}
+			if d.GVK() != testGVK {
+				t.Errorf("Unexpected GVK %v, want %v", d.GVK(), testGVK)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	d, err := NewDefinition(testSpec())
+	if err != nil {
+		t.Fatalf("NewDefinition failed: %v", err)
+	}
+	r := NewRegistry()
+	if !r.Set("first", d) {
+		t.Fatal("Set failed for the first JobIntegration")
+	}
+	if r.Set("second", d) {
+		t.Error("Set succeeded for a second JobIntegration of the same kind")
+	}
+	if owner, _ := r.Owner(testGVK); owner != "first" {
+		t.Errorf("Unexpected owner %q, want %q", owner, "first")
+	}
+	if gvk, found := r.Delete("first"); !found || gvk != testGVK {
+		t.Errorf("Delete returned %v, %v, want %v, true", gvk, found, testGVK)
+	}
+	if r.Get(testGVK) != nil {
+		t.Error("The definition is still active after Delete")
+	}
+	if !r.Set("second", d) {
+		t.Error("Set failed for the second JobIntegration after the first was deleted")
+	}
+}
+
+func TestJob(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	d, err := NewDefinition(testSpec())
+	if err != nil {
+		t.Fatalf("NewDefinition failed: %v", err)
+	}
+	registry := NewRegistry()
+	registry.Set("training", d)
+	job := NewJob(registry, testGVK, testObject())
+
+	if job.Skip(ctx) {
+		t.Error("The job is skipped")
+	}
+	if !job.IsSuspended() {
+		t.Error("The job is not suspended")
+	}
+	if got := job.PriorityClass(); got != "high" {
+		t.Errorf("Unexpected priority class %q, want %q", got, "high")
+	}
+	if got := job.PodLabelSelector(); got != "run=train" {
+		t.Errorf("Unexpected pod label selector %q, want %q", got, "run=train")
+	}
+	if job.IsActive() {
+		t.Error("The job is active")
+	}
+
+	podSets, err := job.PodSets(ctx, nil)
+	if err != nil {
+		t.Fatalf("PodSets failed: %v", err)
+	}
+	gotCounts := make(map[kueue.PodSetReference]int32, len(podSets))
+	for _, ps := range podSets {
+		gotCounts[ps.Name] = ps.Count
+	}
+	if diff := cmp.Diff(map[kueue.PodSetReference]int32{"launcher": 1, "workers": 4}, gotCounts); diff != "" {
+		t.Errorf("Unexpected pod set counts (-want,+got):\n%s", diff)
+	}
+
+	infos := []podset.PodSetInfo{
+		{Name: "launcher", NodeSelector: map[string]string{"flavor": "on-demand"}},
+		{Name: "workers", NodeSelector: map[string]string{"flavor": "spot"}, Tolerations: []corev1.Toleration{{Key: "spot", Operator: corev1.TolerationOpExists}}},
+	}
+	if err := job.RunWithPodSetsInfo(ctx, nil, infos); err != nil {
+		t.Fatalf("RunWithPodSetsInfo failed: %v", err)
+	}
+	if job.IsSuspended() {
+		t.Error("The job is suspended after RunWithPodSetsInfo")
+	}
+	nodeSelector, _, _ := unstructured.NestedStringMap(job.obj.Object, "spec", "workers", "template", "spec", "nodeSelector")
+	if diff := cmp.Diff(map[string]string{"flavor": "spot"}, nodeSelector); diff != "" {
+		t.Errorf("Unexpected worker node selector (-want,+got):\n%s", diff)
+	}
+	image, _, _ := unstructured.NestedSlice(job.obj.Object, "spec", "workers", "template", "spec", "containers")
+	if len(image) != 1 {
+		t.Errorf("The containers of the workers were changed: %v", image)
+	}
+
+	job.Suspend()
+	restore := []podset.PodSetInfo{{Name: "launcher"}, {Name: "workers"}}
+	if !job.RestorePodSetsInfo(ctx, restore) {
+		t.Error("RestorePodSetsInfo reported no change")
+	}
+	if _, found, _ := unstructured.NestedFieldNoCopy(job.obj.Object, "spec", "workers", "template", "spec", "tolerations"); found {
+		t.Error("The tolerations of the workers were not restored")
+	}
+
+	_ = unstructured.SetNestedField(job.obj.Object, int64(3), "status", "succeededWorkers")
+	reclaimable, err := job.ReclaimablePods(ctx, nil)
+	if err != nil {
+		t.Fatalf("ReclaimablePods failed: %v", err)
+	}
+	if diff := cmp.Diff([]kueue.ReclaimablePod{{Name: "workers", Count: 3}}, reclaimable); diff != "" {
+		t.Errorf("Unexpected reclaimable pods (-want,+got):\n%s", diff)
+	}
+
+	if _, _, finished := job.Finished(ctx); finished {
+		t.Error("The job is finished without a phase")
+	}
+	_ = unstructured.SetNestedField(job.obj.Object, "Failed", "status", "phase")
+	if _, success, finished := job.Finished(ctx); !finished || success {
+		t.Errorf("Finished returned success=%v, finished=%v, want a failed job", success, finished)
+	}
+
+	registry.Delete("training")
+	if !NewJob(registry, testGVK, testObject()).Skip(ctx) {
+		t.Error("The job is not skipped after its JobIntegration was deleted")
+	}
+}
+
+func TestJobPodSetsInvalidCount(t *testing.T) {
+	cases := map[string]struct {
+		replicas int64
+		wantErr  string
+	}{
+		"negative": {
+			replicas: -1,
+			wantErr:  "invalid count -1 at .spec.workers.replicas, expecting a value between 0 and 2147483647",
+		},
+		"overflowing": {
+			replicas: math.MaxInt32 + 1,
+			wantErr:  "invalid count 2147483648 at .spec.workers.replicas, expecting a value between 0 and 2147483647",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			d, err := NewDefinition(testSpec())
+			if err != nil {
+				t.Fatalf("NewDefinition failed: %v", err)
+			}
+			registry := NewRegistry()
+			registry.Set("training", d)
+			obj := testObject()
+			_ = unstructured.SetNestedField(obj.Object, tc.replicas, "spec", "workers", "replicas")
+			job := NewJob(registry, testGVK, obj)
+
+			_, err = job.PodSets(ctx, nil)
+			if err == nil {
+				t.Fatalf("PodSets succeeded, want error %q", tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantErr, err.Error()); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if _, err := job.ReclaimablePods(ctx, nil); err == nil {
+				t.Error("ReclaimablePods succeeded with an invalid count")
+			}
+		})
+	}
+}
+
+func TestJobFinishedWithSucceededError(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	spec := testSpec()
+	spec.SucceededExpression = new("object.status.result == 'ok'")
+	d, err := NewDefinition(spec)
+	if err != nil {
+		t.Fatalf("NewDefinition failed: %v", err)
+	}
+	registry := NewRegistry()
+	registry.Set("training", d)
+	job := NewJob(registry, testGVK, testObject())
+
+	_ = unstructured.SetNestedField(job.obj.Object, "Succeeded", "status", "phase")
+	if _, _, finished := job.Finished(ctx); finished {
+		t.Error("The job is finished while the succeeded expression fails to evaluate")
+	}
+	_ = unstructured.SetNestedField(job.obj.Object, "ok", "status", "result")
+	if _, success, finished := job.Finished(ctx); !finished || !success {
+		t.Errorf("Finished returned success=%v, finished=%v, want a succeeded job", success, finished)
+	}
+}
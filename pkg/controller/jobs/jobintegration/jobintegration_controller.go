@@ -0,0 +1,273 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobintegration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+const (
+	ControllerName = "jobintegration"
+
+	// kindNotFoundRequeueAfter is how long to wait before checking again if
+	// the kind of a JobIntegration is served by the API server.
+	kindNotFoundRequeueAfter = 30 * time.Second
+)
+
+// kindSetup records the steps of the setup of a kind which succeeded. The
+// controllers and webhooks can't be removed from a running manager, so a kind
+// is set up at most once; when its JobIntegration is deleted, its jobs are
+// skipped by the controller and let through by the webhook.
+type kindSetup struct {
+	indexed    bool
+	controller bool
+	webhook    bool
+}
+
+// Reconciler reconciles JobIntegrations, registering the controllers and the
+// webhooks for their kinds.
+//
+// It runs in all replicas, since all of them serve the webhooks, and only
+// the leading replica updates the status of the JobIntegrations.
+type Reconciler struct {
+	client      client.Client
+	registry    *Registry
+	elected     <-chan struct{}
+	options     jobframework.Options
+	opts        []jobframework.Option
+	roleTracker *roletracker.RoleTracker
+
+	// setupKind and kindServed are replaced in tests.
+	setupKind  func(ctx context.Context, name string, gvk schema.GroupVersionKind) error
+	kindServed func(gvk schema.GroupVersionKind) error
+
+	mu     sync.Mutex
+	setups map[schema.GroupVersionKind]*kindSetup
+	// jobClient reads the jobs from the cache of the manager; it's created
+	// with the first kind.
+	jobClient client.Client
+}
+
+var _ reconcile.Reconciler = (*Reconciler)(nil)
+
+// NewReconciler creates the JobIntegration reconciler. The options are the
+// ones used for the controllers and webhooks of the built-in integrations.
+func NewReconciler(mgr ctrl.Manager, registry *Registry, opts ...jobframework.Option) *Reconciler {
+	options := jobframework.ProcessOptions(opts...)
+	r := &Reconciler{
+		client:      mgr.GetClient(),
+		registry:    registry,
+		elected:     mgr.Elected(),
+		options:     options,
+		opts:        opts,
+		roleTracker: options.RoleTracker,
+		setups:      make(map[schema.GroupVersionKind]*kindSetup),
+	}
+	r.setupKind = func(ctx context.Context, name string, gvk schema.GroupVersionKind) error {
+		return r.setupKindWithManager(ctx, mgr, name, gvk)
+	}
+	r.kindServed = func(gvk schema.GroupVersionKind) error {
+		_, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+		return err
+	}
+	return r
+}
+
+// SetupWithManager registers the reconciler with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&kueue.JobIntegration{}).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			NeedLeaderElection: new(false),
+			LogConstructor:     roletracker.NewLogConstructor(r.roleTracker, ControllerName),
+		}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=jobintegrations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=jobintegrations/status,verbs=get;update;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ji := &kueue.JobIntegration{}
+	if err := r.client.Get(ctx, req.NamespacedName, ji); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		r.deactivate(ctx, req.Name)
+		return ctrl.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile JobIntegration")
+
+	if !ji.DeletionTimestamp.IsZero() {
+		r.deactivate(ctx, ji.Name)
+		return ctrl.Result{}, nil
+	}
+
+	def, err := NewDefinition(&ji.Spec)
+	if err != nil {
+		r.deactivate(ctx, ji.Name)
+		return r.updateActiveCondition(ctx, ji, metav1.ConditionFalse, kueue.JobIntegrationReasonInvalidSpec, err.Error(), 0)
+	}
+
+	gvk := def.GVK()
+	if msg := r.conflict(ji.Name, gvk); msg != "" {
+		r.deactivate(ctx, ji.Name)
+		return r.updateActiveCondition(ctx, ji, metav1.ConditionFalse, kueue.JobIntegrationReasonKindConflict, msg, 0)
+	}
+
+	if err := r.kindServed(gvk); err != nil {
+		if !meta.IsNoMatchError(err) {
+			return ctrl.Result{}, err
+		}
+		r.deactivate(ctx, ji.Name)
+		msg := fmt.Sprintf("The kind %s is not served by the API server", gvk)
+		return r.updateActiveCondition(ctx, ji, metav1.ConditionFalse, kueue.JobIntegrationReasonKindNotFound, msg, kindNotFoundRequeueAfter)
+	}
+
+	if err := r.setupKind(ctx, ji.Name, gvk); err != nil {
+		return ctrl.Result{}, err
+	}
+	if !r.registry.Set(ji.Name, def) {
+		// Another JobIntegration for the kind was activated concurrently.
+		owner, _ := r.registry.Owner(gvk)
+		msg := fmt.Sprintf("The kind %s is managed by the JobIntegration %q", gvk, owner)
+		return r.updateActiveCondition(ctx, ji, metav1.ConditionFalse, kueue.JobIntegrationReasonKindConflict, msg, 0)
+	}
+	if r.options.IntegrationManager != nil {
+		r.options.IntegrationManager.RegisterRuntimeJobType(gvk)
+	}
+	log.V(2).Info("Activated JobIntegration", "gvk", gvk)
+	msg := fmt.Sprintf("The jobs of the kind %s are managed by Kueue", gvk)
+	return r.updateActiveCondition(ctx, ji, metav1.ConditionTrue, kueue.JobIntegrationReasonRegistered, msg, 0)
+}
+
+// conflict returns why the kind can't be managed by the JobIntegration, if
+// it's managed by a built-in integration, an external framework or another
+// JobIntegration.
+func (r *Reconciler) conflict(name string, gvk schema.GroupVersionKind) string {
+	if r.options.IntegrationManager != nil {
+		if _, found := r.options.IntegrationManager.GetIntegrationByGVK(gvk); found {
+			return fmt.Sprintf("The kind %s has a built-in integration", gvk)
+		}
+	}
+	for kindArg := range r.options.EnabledExternalFrameworks {
+		if external, _ := schema.ParseKindArg(kindArg); external != nil && external.GroupKind() == gvk.GroupKind() {
+			return fmt.Sprintf("The kind %s is managed by the external framework %q", gvk, kindArg)
+		}
+	}
+	if owner, found := r.registry.Owner(gvk); found && owner != name {
+		return fmt.Sprintf("The kind %s is managed by the JobIntegration %q", gvk, owner)
+	}
+	return ""
+}
+
+func (r *Reconciler) deactivate(ctx context.Context, name string) {
+	gvk, found := r.registry.Delete(name)
+	if !found {
+		return
+	}
+	if r.options.IntegrationManager != nil {
+		r.options.IntegrationManager.UnregisterRuntimeJobType(gvk)
+	}
+	ctrl.LoggerFrom(ctx).V(2).Info("Deactivated JobIntegration", "gvk", gvk)
+}
+
+// updateActiveCondition sets the Active condition of the JobIntegration. The
+// non-leading replicas don't update the status, and requeue the request for
+// when they are elected.
+func (r *Reconciler) updateActiveCondition(ctx context.Context, ji *kueue.JobIntegration, status metav1.ConditionStatus, reason, message string, requeueAfter time.Duration) (ctrl.Result, error) {
+	changed := meta.SetStatusCondition(&ji.Status.Conditions, metav1.Condition{
+		Type:               kueue.JobIntegrationActive,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: ji.Generation,
+	})
+	if !changed {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	select {
+	case <-r.elected:
+	default:
+		return ctrl.Result{RequeueAfter: kindNotFoundRequeueAfter}, nil
+	}
+	if err := r.client.Status().Update(ctx, ji); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// setupKindWithManager sets up the index, the controller and the webhook for
+// the kind, skipping the steps which succeeded before.
+func (r *Reconciler) setupKindWithManager(ctx context.Context, mgr ctrl.Manager, name string, gvk schema.GroupVersionKind) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, found := r.setups[gvk]
+	if !found {
+		s = &kindSetup{}
+		r.setups[gvk] = s
+	}
+	if !s.indexed {
+		if err := jobframework.SetupWorkloadOwnerIndex(ctx, mgr.GetFieldIndexer(), gvk); err != nil {
+			return fmt.Errorf("setting up the workload owner index for %s: %w", gvk, err)
+		}
+		s.indexed = true
+	}
+	if !s.controller {
+		if r.jobClient == nil {
+			c, err := newJobClient(mgr)
+			if err != nil {
+				return fmt.Errorf("creating the client for %s: %w", gvk, err)
+			}
+			r.jobClient = c
+		}
+		jr := &jobReconciler{
+			jr:       jobframework.NewReconciler(r.jobClient, mgr.GetEventRecorder(fmt.Sprintf("%s-%s-controller", name, r.options.ManagerName)), r.opts...),
+			registry: r.registry,
+			gvk:      gvk,
+		}
+		if err := jr.setupWithManager(mgr); err != nil {
+			return fmt.Errorf("setting up the controller for %s: %w", gvk, err)
+		}
+		s.controller = true
+	}
+	if !s.webhook {
+		if err := SetupWebhook(mgr, r.registry, gvk, r.opts...); err != nil {
+			return fmt.Errorf("setting up the webhook for %s: %w", gvk, err)
+		}
+		s.webhook = true
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobintegration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestReconcile(t *testing.T) {
+	cases := map[string]struct {
+		mutate             func(*kueue.JobIntegrationSpec)
+		existingOwner      string
+		externalFrameworks []string
+		kindNotServed      bool
+		wantCondition      metav1.Condition
+		wantRequeue        time.Duration
+		wantSetup          bool
+		wantActive         bool
+	}{
+		"registered": {
+			wantCondition: metav1.Condition{
+				Type:    kueue.JobIntegrationActive,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.JobIntegrationReasonRegistered,
+				Message: "The jobs of the kind example.com/v1, Kind=TrainingRun are managed by Kueue",
+			},
+			wantSetup:  true,
+			wantActive: true,
+		},
+		"invalid spec": {
+			mutate: func(s *kueue.JobIntegrationSpec) { s.SuspendPath = "spec." },
+			wantCondition: metav1.Condition{
+				Type:    kueue.JobIntegrationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.JobIntegrationReasonInvalidSpec,
+				Message: `suspendPath: invalid path "spec.": empty field name`,
+			},
+		},
+		"kind managed by another JobIntegration": {
+			existingOwner: "other",
+			wantCondition: metav1.Condition{
+				Type:    kueue.JobIntegrationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.JobIntegrationReasonKindConflict,
+				Message: `The kind example.com/v1, Kind=TrainingRun is managed by the JobIntegration "other"`,
+			},
+		},
+		"kind managed by an external framework": {
+			externalFrameworks: []string{"TrainingRun.v1.example.com"},
+			wantCondition: metav1.Condition{
+				Type:    kueue.JobIntegrationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.JobIntegrationReasonKindConflict,
+				Message: `The kind example.com/v1, Kind=TrainingRun is managed by the external framework "TrainingRun.v1.example.com"`,
+			},
+		},
+		"kind not served": {
+			kindNotServed: true,
+			wantCondition: metav1.Condition{
+				Type:    kueue.JobIntegrationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  kueue.JobIntegrationReasonKindNotFound,
+				Message: "The kind example.com/v1, Kind=TrainingRun is not served by the API server",
+			},
+			wantRequeue: kindNotFoundRequeueAfter,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			ji := &kueue.JobIntegration{
+				ObjectMeta: metav1.ObjectMeta{Name: "training"},
+				Spec:       *testSpec(),
+			}
+			if tc.mutate != nil {
+				tc.mutate(&ji.Spec)
+			}
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(ji).
+				WithStatusSubresource(ji).
+				Build()
+			registry := NewRegistry()
+			if tc.existingOwner != "" {
+				d, err := NewDefinition(testSpec())
+				if err != nil {
+					t.Fatalf("NewDefinition failed: %v", err)
+				}
+				registry.Set(tc.existingOwner, d)
+			}
+			manager := jobframework.NewIntegrationManager()
+			elected := make(chan struct{})
+			close(elected)
+			setupCalls := 0
+			r := &Reconciler{
+				client:   cl,
+				registry: registry,
+				elected:  elected,
+				options: jobframework.Options{
+					IntegrationManager:        manager,
+					EnabledExternalFrameworks: sets.New(tc.externalFrameworks...),
+				},
+				setupKind: func(context.Context, string, schema.GroupVersionKind) error {
+					setupCalls++
+					return nil
+				},
+				kindServed: func(gvk schema.GroupVersionKind) error {
+					if tc.kindNotServed {
+						return &apimeta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+					}
+					return nil
+				},
+				setups: make(map[schema.GroupVersionKind]*kindSetup),
+			}
+
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: ji.Name}}
+			result, err := r.Reconcile(ctx, req)
+			if err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+			if result.RequeueAfter != tc.wantRequeue {
+				t.Errorf("Reconcile requeued after %v, want %v", result.RequeueAfter, tc.wantRequeue)
+			}
+			if gotSetup := setupCalls > 0; gotSetup != tc.wantSetup {
+				t.Errorf("Kind set up: %v, want %v", gotSetup, tc.wantSetup)
+			}
+
+			var got kueue.JobIntegration
+			if err := cl.Get(ctx, req.NamespacedName, &got); err != nil {
+				t.Fatalf("Getting the JobIntegration: %v", err)
+			}
+			gotCondition := apimeta.FindStatusCondition(got.Status.Conditions, kueue.JobIntegrationActive)
+			if diff := cmp.Diff(&tc.wantCondition, gotCondition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("Unexpected Active condition (-want,+got):\n%s", diff)
+			}
+			if owner, _ := registry.Owner(testGVK); (owner == ji.Name) != tc.wantActive {
+				t.Errorf("JobIntegration active: %v, want %v", owner == ji.Name, tc.wantActive)
+			}
+
+			if err := cl.Delete(ctx, &got); err != nil {
+				t.Fatalf("Deleting the JobIntegration: %v", err)
+			}
+			if _, err := r.Reconcile(ctx, req); err != nil {
+				t.Fatalf("Reconcile after deletion failed: %v", err)
+			}
+			if owner, _ := registry.Owner(testGVK); owner == ji.Name {
+				t.Error("The JobIntegration is still active after its deletion")
+			}
+		})
+	}
+}
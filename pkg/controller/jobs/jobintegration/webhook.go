@@ -0,0 +1,103 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobintegration
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+// Webhook defaults and validates the jobs of a kind described by a
+// JobIntegration. It lets all requests through while the kind is not
+// described by an active JobIntegration.
+type Webhook struct {
+	registry *Registry
+	gvk      schema.GroupVersionKind
+	base     *jobframework.BaseWebhook[*unstructured.Unstructured]
+}
+
+var _ admission.Defaulter[*unstructured.Unstructured] = (*Webhook)(nil)
+var _ admission.Validator[*unstructured.Unstructured] = (*Webhook)(nil)
+
+// SetupWebhook registers the webhook for the kind with the manager, serving
+// the same paths as the webhooks of built-in kinds, i.e.
+// `/mutate-<group>-<version>-<kind>` and `/validate-<group>-<version>-<kind>`
+// with the dots of the group replaced by dashes and the kind in lowercase.
+func SetupWebhook(mgr ctrl.Manager, registry *Registry, gvk schema.GroupVersionKind, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &Webhook{
+		registry: registry,
+		gvk:      gvk,
+	}
+	wh.base = &jobframework.BaseWebhook[*unstructured.Unstructured]{
+		IntegrationManager:           options.IntegrationManager,
+		Client:                       mgr.GetClient(),
+		ManageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
+		ManagedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
+		FromObject: func(obj *unstructured.Unstructured) jobframework.GenericJob {
+			return NewJob(registry, gvk, obj)
+		},
+		Queues: options.Queues,
+		Cache:  options.Cache,
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return ctrl.NewWebhookManagedBy(mgr, obj).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		WithLogConstructor(jobframework.WebhookLogConstructor(gvk, options.RoleTracker)).
+		Complete()
+}
+
+func (w *Webhook) active() bool {
+	return w.registry.Get(w.gvk) != nil
+}
+
+// Default implements admission.Defaulter so a webhook will be registered for the type
+func (w *Webhook) Default(ctx context.Context, obj *unstructured.Unstructured) error {
+	if !w.active() {
+		return nil
+	}
+	return w.base.Default(ctx, obj)
+}
+
+// ValidateCreate implements admission.Validator so a webhook will be registered for the type
+func (w *Webhook) ValidateCreate(ctx context.Context, obj *unstructured.Unstructured) (admission.Warnings, error) {
+	if !w.active() {
+		return nil, nil
+	}
+	return w.base.ValidateCreate(ctx, obj)
+}
+
+// ValidateUpdate implements admission.Validator so a webhook will be registered for the type
+func (w *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj *unstructured.Unstructured) (admission.Warnings, error) {
+	if !w.active() {
+		return nil, nil
+	}
+	return w.base.ValidateUpdate(ctx, oldObj, newObj)
+}
+
+// ValidateDelete implements admission.Validator so a webhook will be registered for the type
+func (w *Webhook) ValidateDelete(context.Context, *unstructured.Unstructured) (admission.Warnings, error) {
+	return nil, nil
+}
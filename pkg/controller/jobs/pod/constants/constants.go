@@ -27,6 +27,7 @@ const (
 	GroupNameLabel                    = "kueue.x-k8s.io/pod-group-name"
 	GroupNameAnnotation               = "kueue.x-k8s.io/pod-group-name"
 	GroupTotalCountAnnotation         = "kueue.x-k8s.io/pod-group-total-count"
+	GroupMinCountAnnotation           = "kueue.x-k8s.io/pod-group-min-count"
 	GroupFastAdmissionAnnotationKey   = "kueue.x-k8s.io/pod-group-fast-admission"
 	GroupFastAdmissionAnnotationValue = "true"
 	GroupServingAnnotationKey         = "kueue.x-k8s.io/pod-group-serving"
@@ -169,6 +169,9 @@ type Pod struct {
 	satisfiedExcessPods   bool
 	clock                 clock.Clock
 	roleTracker           *roletracker.RoleTracker
+	// admittedCount is the number of pods admitted for a partially
+	// admitted group, zero otherwise.
+	admittedCount int
 }
 
 var (
@@ -395,7 +398,7 @@ func (p *Pod) Finished(ctx context.Context) (message string, success, finished b
 	isActive := false
 	succeededCount := 0
 
-	groupTotalCount, err := p.groupTotalCount()
+	groupTotalCount, err := p.expectedCount()
 	if err != nil {
 		log.V(2).Error(err, "failed to check if pod group is finished")
 		message = "failed to check if pod group is finished"
@@ -492,7 +495,7 @@ func (p *Pod) PodsReady(ctx context.Context, _ client.Client) bool {
 		return hasPodReadyTrue(p.pod.Status.Conditions)
 	}
 
-	tc, err := p.groupTotalCount()
+	tc, err := p.expectedCount()
 	if err != nil {
 		ctrl.LoggerFrom(ctx).V(2).Error(err, "Failed to get group total count for PodsReady check")
 		return false
@@ -676,6 +679,44 @@ func (p *Pod) groupTotalCount() (int, error) {
 	return gtc, nil
 }
 
+// groupMinCount returns the value of GroupMinCountAnnotation for the pod being reconciled at the moment.
+func (p *Pod) groupMinCount() (int, bool, error) {
+	mcAnnotation, ok := p.Object().GetAnnotations()[podconstants.GroupMinCountAnnotation]
+	if !ok {
+		return 0, false, nil
+	}
+
+	mc, err := strconv.Atoi(mcAnnotation)
+	if err != nil {
+		return 0, false, err
+	}
+
+	gtc, err := p.groupTotalCount()
+	if err != nil {
+		return 0, false, err
+	}
+
+	if mc < 1 || mc > gtc {
+		return 0, false, fmt.Errorf("incorrect annotation value '%s=%s': group min count should be between 1 and the group total count",
+			podconstants.GroupMinCountAnnotation, mcAnnotation)
+	}
+
+	return mc, true, nil
+}
+
+// expectedCount returns the number of pods expected to run in the group, which
+// is lower than the group total count when the group is partially admitted.
+func (p *Pod) expectedCount() (int, error) {
+	gtc, err := p.groupTotalCount()
+	if err != nil {
+		return 0, err
+	}
+	if p.admittedCount > 0 {
+		return min(gtc, p.admittedCount), nil
+	}
+	return gtc, nil
+}
+
 // getRoleHash will filter all the fields of the pod that are relevant to admission (pod role) and return a sha256
 // checksum of those fields. This is used to group the pods of the same roles when interacting with the workload.
 func getRoleHash(p corev1.Pod) (string, error) {
@@ -744,9 +785,34 @@ func (p *Pod) constructGroupPodSets() ([]kueue.PodSet, error) {
 		if err != nil {
 			return nil, err
 		}
-		return constructGroupPodSetsFast(p.list.Items, tc)
+		podSets, err := constructGroupPodSetsFast(p.list.Items, tc)
+		if err != nil {
+			return nil, err
+		}
+		return podSets, p.setGroupMinCount(podSets)
+	}
+	podSets, err := constructGroupPodSets(p.list.Items)
+	if err != nil {
+		return nil, err
 	}
-	return constructGroupPodSets(p.list.Items)
+	return podSets, p.setGroupMinCount(podSets)
+}
+
+// setGroupMinCount sets the min count of the pod set from the GroupMinCountAnnotation,
+// when the PodGroupCompatibility feature is enabled. The min count only applies to groups
+// with a single role, the other groups are admitted with all their pods.
+func (p *Pod) setGroupMinCount(podSets []kueue.PodSet) error {
+	if !features.Enabled(features.PodGroupCompatibility) || len(podSets) != 1 {
+		return nil
+	}
+	mc, found, err := p.groupMinCount()
+	if err != nil {
+		return err
+	}
+	if found && int32(mc) < podSets[0].Count {
+		podSets[0].MinCount = ptr.To(int32(mc))
+	}
+	return nil
 }
 
 func constructPodSets(p *corev1.Pod) ([]kueue.PodSet, error) {
@@ -1298,11 +1364,21 @@ func (p *Pod) FindMatchingWorkloads(ctx context.Context, c client.Client, r even
 	activePods, inactivePods := p.partitionPods()
 
 	var absentPods int
+	var admittedCount int
+	var partiallyAdmitted bool
 	var keptPods []corev1.Pod
 	var excessActivePods []corev1.Pod
 	var replacedInactivePods []corev1.Pod
 
 	for _, ps := range workload.Spec.PodSets {
+		// Only keep the admitted pods of a partially admitted group.
+		specCount := ps.Count
+		if count := admittedPodSetCount(workload, &ps); count < ps.Count {
+			ps.Count = count
+			partiallyAdmitted = true
+		}
+		admittedCount += int(ps.Count)
+
 		// Find all the active and inactive pods of the role
 		var roleHashErrors []error
 		hasRoleFunc := func(p *corev1.Pod) bool {
@@ -1323,8 +1399,16 @@ func (p *Pod) FindMatchingWorkloads(ctx context.Context, c client.Client, r even
 
 		if excessCount := len(roleActivePods) - int(ps.Count); excessCount > 0 {
 			sortActivePods(roleActivePods)
-			excessActivePods = append(excessActivePods, roleActivePods[len(roleActivePods)-excessCount:]...)
-			keptPods = append(keptPods, roleActivePods[:len(roleActivePods)-excessCount]...)
+			for i := int(ps.Count); i < len(roleActivePods); i++ {
+				// The pods of a partially admitted group which are not
+				// admitted stay gated when a controller owns them, as it
+				// would recreate the deleted ones.
+				if i < int(specCount) && isGated(&roleActivePods[i]) && metav1.GetControllerOf(&roleActivePods[i]) != nil {
+					continue
+				}
+				excessActivePods = append(excessActivePods, roleActivePods[i])
+			}
+			keptPods = append(keptPods, roleActivePods[:ps.Count]...)
 		} else {
 			keptPods = append(keptPods, roleActivePods...)
 		}
@@ -1353,6 +1437,9 @@ func (p *Pod) FindMatchingWorkloads(ctx context.Context, c client.Client, r even
 	}
 
 	p.absentPods = absentPods
+	if partiallyAdmitted {
+		p.admittedCount = admittedCount
+	}
 	p.list.Items = keptPods
 	if err := p.EnsureWorkloadOwnedByAllMembers(ctx, c, r, workload); err != nil {
 		return nil, nil, err
@@ -1368,6 +1455,21 @@ func (p *Pod) FindMatchingWorkloads(ctx context.Context, c client.Client, r even
 	return workload, []*kueue.Workload{}, nil
 }
 
+// admittedPodSetCount returns the number of pods of the role admitted by the
+// workload, lower than the count of the pod set if the workload is partially
+// admitted.
+func admittedPodSetCount(wl *kueue.Workload, ps *kueue.PodSet) int32 {
+	if ps.MinCount == nil || wl.Status.Admission == nil || !apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadAdmitted) {
+		return ps.Count
+	}
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		if psa.Name == ps.Name && psa.Count != nil {
+			return min(*psa.Count, ps.Count)
+		}
+	}
+	return ps.Count
+}
+
 func (p *Pod) countAbsentPods(ps kueue.PodSet, activePods int) int {
 	if p.fastAdmission() {
 		if ps.Count > 0 && activePods == 0 {
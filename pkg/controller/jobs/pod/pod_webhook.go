@@ -19,11 +19,13 @@ package pod
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +38,7 @@ import (
 	ctrlconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/podgroup"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	"sigs.k8s.io/kueue/pkg/util/webhook"
@@ -49,6 +52,7 @@ var (
 	groupNameLabelPath             = labelsPath.Key(podconstants.GroupNameLabel)
 	groupNameAnnotationPath        = annotationsPath.Key(podconstants.GroupNameAnnotation)
 	groupTotalCountAnnotationPath  = annotationsPath.Key(podconstants.GroupTotalCountAnnotation)
+	groupMinCountAnnotationPath    = annotationsPath.Key(podconstants.GroupMinCountAnnotation)
 	retriableInGroupAnnotationPath = annotationsPath.Key(podconstants.RetriableInGroupAnnotationKey)
 )
 
@@ -150,6 +154,16 @@ func (w *PodWebhook) Default(ctx context.Context, obj *corev1.Pod) error {
 			return err
 		}
 
+		if features.Enabled(features.PodGroupCompatibility) {
+			if err := w.applyPodGroup(ctx, pod); err != nil {
+				return err
+			}
+		}
+
+		if features.Enabled(features.WorkflowBudgets) {
+			w.applyWorkflowStep(ctx, pod)
+		}
+
 		// Local queue defaulting
 		if jobframework.QueueNameForObject(pod.Object()) == "" &&
 			w.queues.DefaultLocalQueueExist(pod.pod.GetNamespace()) {
@@ -196,6 +210,93 @@ func (w *PodWebhook) Default(ctx context.Context, obj *corev1.Pod) error {
 	return nil
 }
 
+// applyPodGroup makes the pod a member of the pod group translated from the
+// Volcano or scheduler-plugins PodGroup referenced by the pod, if the pod is
+// managed by Kueue. The total count of the pod group is taken from the
+// GroupTotalCountAnnotation of the pod, or else from the replicas of the
+// controller owning the pod, or else from the minMember of the PodGroup. The
+// pod is not translated if the total count is unknown. The minMember of the
+// PodGroup is the minimum count of the pod group.
+func (w *PodWebhook) applyPodGroup(ctx context.Context, pod *Pod) error {
+	if utilpod.GetPodGroupName(&pod.pod) != "" {
+		return nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	managed := jobframework.QueueNameForObject(pod.Object()) != "" ||
+		w.manageJobsWithoutQueueName ||
+		w.queues.DefaultLocalQueueExist(pod.pod.GetNamespace())
+	pg, err := podgroup.ForPod(ctx, w.client, &pod.pod)
+	if err != nil {
+		if !managed {
+			log.V(3).Info("Skipping the PodGroup of a pod not managed by Kueue", "err", err)
+			return nil
+		}
+		return err
+	}
+	if pg == nil || (!managed && pg.QueueName == "") {
+		return nil
+	}
+	totalCount := int(pg.TotalCount)
+	if gtc, found := pod.pod.Annotations[podconstants.GroupTotalCountAnnotation]; found {
+		totalCount, _ = strconv.Atoi(gtc)
+	} else if totalCount <= 0 {
+		totalCount = int(pg.MinMember)
+	}
+	if totalCount <= 0 {
+		log.V(3).Info("Skipping the PodGroup with an unknown total count", "podGroup", pg.Name)
+		return nil
+	}
+	log.V(5).Info("Translating the PodGroup", "podGroup", pg.Name, "minMember", pg.MinMember, "totalCount", totalCount)
+	SetPodGroupName(&pod.pod, pg.Name)
+	if pod.pod.Annotations == nil {
+		pod.pod.Annotations = make(map[string]string, 2)
+	}
+	if _, found := pod.pod.Annotations[podconstants.GroupTotalCountAnnotation]; !found {
+		pod.pod.Annotations[podconstants.GroupTotalCountAnnotation] = strconv.Itoa(totalCount)
+	}
+	if pg.MinMember > 0 && int(pg.MinMember) < totalCount {
+		pod.pod.Annotations[podconstants.GroupMinCountAnnotation] = strconv.Itoa(int(pg.MinMember))
+	}
+	if pg.QueueName != "" && jobframework.QueueNameForObject(pod.Object()) == "" {
+		if pod.pod.Labels == nil {
+			pod.pod.Labels = make(map[string]string, 1)
+		}
+		pod.pod.Labels[ctrlconstants.QueueLabel] = pg.QueueName
+	}
+	return nil
+}
+
+// applyWorkflowStep makes the pod of a step of an Argo Workflow or a Tekton
+// PipelineRun, managed by Kueue, the only member of a pod group named after
+// the step attempt, so that every step is admitted as its own Workload,
+// composed by the pod group. The group is not retriable, its Workload
+// finishes with the pod, and a retry of the step is a new group.
+func (w *PodWebhook) applyWorkflowStep(ctx context.Context, pod *Pod) {
+	if utilpod.GetPodGroupName(&pod.pod) != "" {
+		return
+	}
+	managed := jobframework.QueueNameForObject(pod.Object()) != "" ||
+		w.manageJobsWithoutQueueName ||
+		w.queues.DefaultLocalQueueExist(pod.pod.GetNamespace())
+	if !managed {
+		return
+	}
+	step, found := jobframework.WorkflowStepName(pod.Object())
+	if !found {
+		return
+	}
+	if errs := utilvalidation.IsValidLabelValue(step); len(errs) > 0 {
+		ctrl.LoggerFrom(ctx).V(3).Info("Skipping the pod group of a workflow step with an invalid name", "step", step, "errs", errs)
+		return
+	}
+	SetPodGroupName(&pod.pod, step)
+	if pod.pod.Annotations == nil {
+		pod.pod.Annotations = make(map[string]string, 2)
+	}
+	pod.pod.Annotations[podconstants.GroupTotalCountAnnotation] = "1"
+	pod.pod.Annotations[podconstants.RetriableInGroupAnnotationKey] = podconstants.RetriableInGroupAnnotationValue
+}
+
 // +kubebuilder:webhook:path=/validate--v1-pod,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=pods,verbs=create;update,versions=v1,name=vpod.kb.io,admissionReviewVersions=v1
 
 var _ admission.Validator[*corev1.Pod] = &PodWebhook{}
@@ -303,6 +404,15 @@ func validatePodGroupMetadata(p *Pod) field.ErrorList {
 		return append(allErrs, field.Invalid(groupTotalCountAnnotationPath, gtc, err.Error()))
 	}
 
+	if mc, mcExists := p.pod.GetAnnotations()[podconstants.GroupMinCountAnnotation]; mcExists && features.Enabled(features.PodGroupCompatibility) {
+		if !gtcExists {
+			return append(allErrs, field.Required(groupTotalCountAnnotationPath, fmt.Sprintf("the '%s' annotation should be set together with the '%s' annotation", podconstants.GroupTotalCountAnnotation, podconstants.GroupMinCountAnnotation)))
+		}
+		if _, _, err := p.groupMinCount(); err != nil {
+			allErrs = append(allErrs, field.Invalid(groupMinCountAnnotationPath, mc, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
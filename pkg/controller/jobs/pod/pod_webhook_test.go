@@ -18,6 +18,7 @@ package pod
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -29,6 +30,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -46,6 +48,7 @@ import (
 	kubeflowjobs "sigs.k8s.io/kueue/pkg/controller/jobs/kubeflow/jobs"
 	"sigs.k8s.io/kueue/pkg/controller/jobs/mpijob"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/podgroup"
 	"sigs.k8s.io/kueue/pkg/controller/jobs/raycluster"
 	"sigs.k8s.io/kueue/pkg/features"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
@@ -633,6 +636,241 @@ func TestDefault(t *testing.T) {
 				KueueFinalizer().
 				Obj(),
 		},
+		"pod referencing a Volcano PodGroup": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.PodGroupCompatibility:   true,
+			},
+			initObjects: []client.Object{
+				defaultNamespace,
+				makePodGroup(podgroup.VolcanoGVK, "group", defaultNamespace.Name, 3, "queue"),
+				makeVolcanoJob("vcjob", defaultNamespace.Name, 1, 3),
+			},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				OwnerReference("vcjob", volcanoJobGVK).
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				OwnerReference("vcjob", volcanoJobGVK).
+				GroupNameAnnotation("group").
+				GroupTotalCount("4").
+				GroupMinCount("3").
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"pod referencing a scheduler-plugins PodGroup with a group total count": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.PodGroupCompatibility:   true,
+			},
+			initObjects: []client.Object{
+				defaultNamespace,
+				makePodGroup(podgroup.SchedulerPluginsGVK, "group", defaultNamespace.Name, 2, "queue"),
+			},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Label(podgroup.SchedulerPluginsGroupLabel, "group").
+				GroupTotalCount("4").
+				Queue("pod-queue").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Label(podgroup.SchedulerPluginsGroupLabel, "group").
+				GroupNameAnnotation("group").
+				GroupTotalCount("4").
+				GroupMinCount("2").
+				Queue("pod-queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"bare pod referencing a PodGroup takes the group total count from minMember": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.PodGroupCompatibility:   true,
+			},
+			initObjects: []client.Object{
+				defaultNamespace,
+				makePodGroup(podgroup.VolcanoGVK, "group", defaultNamespace.Name, 3, "queue"),
+			},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				GroupNameAnnotation("group").
+				GroupTotalCount("3").
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"bare pod referencing a PodGroup without minMember is not translated": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.PodGroupCompatibility:   true,
+			},
+			initObjects: []client.Object{
+				defaultNamespace,
+				makePodGroup(podgroup.SchedulerPluginsGVK, "group", defaultNamespace.Name, 0, ""),
+			},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Label(podgroup.SchedulerPluginsGroupLabel, "group").
+				Queue("queue").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Label(podgroup.SchedulerPluginsGroupLabel, "group").
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"pod referencing a missing PodGroup is not translated": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.PodGroupCompatibility:   true,
+			},
+			initObjects: []client.Object{defaultNamespace},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				Queue("queue").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"pod not managed by Kueue referencing a PodGroup is not translated": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.PodGroupCompatibility:   true,
+			},
+			initObjects: []client.Object{
+				defaultNamespace,
+				makePodGroup(podgroup.VolcanoGVK, "group", defaultNamespace.Name, 3, ""),
+			},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				Obj(),
+		},
+		"pod referencing a PodGroup is not translated when PodGroupCompatibility is disabled": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.PodGroupCompatibility:   false,
+			},
+			initObjects: []client.Object{
+				defaultNamespace,
+				makePodGroup(podgroup.VolcanoGVK, "group", defaultNamespace.Name, 3, ""),
+			},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				Queue("queue").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation(podgroup.VolcanoGroupNameAnnotation, "group").
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"pod of an Argo Workflow step is a pod group": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.WorkflowBudgets:         true,
+			},
+			initObjects: []client.Object{defaultNamespace},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Label("workflows.argoproj.io/workflow", "wf").
+				Annotation("workflows.argoproj.io/node-id", "wf-1234").
+				Queue("queue").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Label("workflows.argoproj.io/workflow", "wf").
+				Annotation("workflows.argoproj.io/node-id", "wf-1234").
+				GroupNameAnnotation("wf-1234").
+				GroupTotalCount("1").
+				Annotation(podconstants.RetriableInGroupAnnotationKey, podconstants.RetriableInGroupAnnotationValue).
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"pod of a Tekton TaskRun is a pod group": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.WorkflowBudgets:         true,
+			},
+			initObjects: []client.Object{defaultNamespace},
+			pod: testingpod.MakePod("task-run-pod", defaultNamespace.Name).
+				Label("tekton.dev/pipelineRun", "run").
+				Label("tekton.dev/taskRun", "task-run").
+				Queue("queue").
+				Obj(),
+			want: testingpod.MakePod("task-run-pod", defaultNamespace.Name).
+				Label("tekton.dev/pipelineRun", "run").
+				Label("tekton.dev/taskRun", "task-run").
+				GroupNameAnnotation("task-run-pod").
+				GroupTotalCount("1").
+				Annotation(podconstants.RetriableInGroupAnnotationKey, podconstants.RetriableInGroupAnnotationValue).
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
+		"pod of a workflow step not managed by Kueue is not a pod group": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.WorkflowBudgets:         true,
+			},
+			initObjects: []client.Object{defaultNamespace},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation("workflows.argoproj.io/node-id", "wf-1234").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation("workflows.argoproj.io/node-id", "wf-1234").
+				Obj(),
+		},
+		"pod of a workflow step is not a pod group when WorkflowBudgets is disabled": {
+			featureGates: map[featuregate.Feature]bool{
+				features.TopologyAwareScheduling: false,
+				features.WorkflowBudgets:         false,
+			},
+			initObjects: []client.Object{defaultNamespace},
+			pod: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation("workflows.argoproj.io/node-id", "wf-1234").
+				Queue("queue").
+				Obj(),
+			want: testingpod.MakePod("test-pod", defaultNamespace.Name).
+				Annotation("workflows.argoproj.io/node-id", "wf-1234").
+				Queue("queue").
+				ManagedByKueueLabel().
+				KueueSchedulingGate().
+				RoleHash("a9f06f3a").
+				KueueFinalizer().
+				Obj(),
+		},
 		"default WorkloadPriorityClass is not applied when default WorkloadPriorityClass does not exist": {
 			featureGates: map[featuregate.Feature]bool{
 				features.TopologyAwareScheduling:         false,
@@ -700,6 +938,39 @@ func TestDefault(t *testing.T) {
 	}
 }
 
+var volcanoJobGVK = schema.GroupVersionKind{Group: "batch.volcano.sh", Version: "v1alpha1", Kind: "Job"}
+
+func makeVolcanoJob(name, namespace string, replicas ...int64) *unstructured.Unstructured {
+	tasks := make([]any, 0, len(replicas))
+	for i, r := range replicas {
+		tasks = append(tasks, map[string]any{"name": fmt.Sprintf("task-%d", i), "replicas": r})
+	}
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"tasks": tasks,
+		},
+	}}
+	obj.SetGroupVersionKind(volcanoJobGVK)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	return obj
+}
+
+func makePodGroup(gvk schema.GroupVersionKind, name, namespace string, minMember int64, queue string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"minMember": minMember,
+		},
+	}}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	if queue != "" {
+		obj.SetLabels(map[string]string{constants.QueueLabel: queue})
+	}
+	return obj
+}
+
 func TestGetRoleHash(t *testing.T) {
 	testCases := map[string]struct {
 		pods []*Pod
@@ -862,6 +1133,52 @@ func TestValidateCreate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"pod with group min count": {
+			featureGates: map[featuregate.Feature]bool{
+				features.WorkloadIdentifierAnnotations: false,
+				features.PodGroupCompatibility:         true,
+			},
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				GroupNameLabel("test-group").
+				GroupTotalCount("4").
+				GroupMinCount("2").
+				Obj(),
+		},
+		"pod with group min count above the group total count": {
+			featureGates: map[featuregate.Feature]bool{
+				features.WorkloadIdentifierAnnotations: false,
+				features.PodGroupCompatibility:         true,
+			},
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				GroupNameLabel("test-group").
+				GroupTotalCount("2").
+				GroupMinCount("3").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/pod-group-min-count]",
+				},
+			}.ToAggregate(),
+		},
+		"pod with group min count and no group total count": {
+			featureGates: map[featuregate.Feature]bool{
+				features.WorkloadIdentifierAnnotations: false,
+				features.PodGroupCompatibility:         true,
+			},
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				GroupMinCount("3").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "metadata.annotations[kueue.x-k8s.io/pod-group-total-count]",
+				},
+			}.ToAggregate(),
+		},
 		"pod with incorrect group name": {
 			featureGates: map[featuregate.Feature]bool{features.WorkloadIdentifierAnnotations: false},
 			pod: testingpod.MakePod("test-pod", "test-ns").
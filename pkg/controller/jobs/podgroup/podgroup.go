@@ -0,0 +1,177 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package podgroup finds the Volcano and scheduler-plugins PodGroups
+// referenced by pods, for the pods to be managed by Kueue as a pod group.
+package podgroup
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ctrlconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+const (
+	// VolcanoGroupNameAnnotation is the annotation of the pods referencing
+	// a Volcano PodGroup.
+	VolcanoGroupNameAnnotation = "scheduling.volcano.sh/group-name"
+	// KubeGroupNameAnnotation is the legacy annotation of the pods referencing
+	// a Volcano PodGroup.
+	KubeGroupNameAnnotation = "scheduling.k8s.io/group-name"
+	// SchedulerPluginsGroupLabel is the label of the pods referencing
+	// a scheduler-plugins PodGroup.
+	SchedulerPluginsGroupLabel = "scheduling.x-k8s.io/pod-group"
+)
+
+var (
+	VolcanoGVK          = schema.GroupVersionKind{Group: "scheduling.volcano.sh", Version: "v1beta1", Kind: "PodGroup"}
+	SchedulerPluginsGVK = schema.GroupVersionKind{Group: "scheduling.x-k8s.io", Version: "v1alpha1", Kind: "PodGroup"}
+
+	replicaSetGK  = schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}
+	statefulSetGK = schema.GroupKind{Group: "apps", Kind: "StatefulSet"}
+	jobGK         = schema.GroupKind{Group: "batch", Kind: "Job"}
+	volcanoJobGK  = schema.GroupKind{Group: "batch.volcano.sh", Kind: "Job"}
+)
+
+// +kubebuilder:rbac:groups=scheduling.volcano.sh,resources=podgroups,verbs=get
+// +kubebuilder:rbac:groups=scheduling.x-k8s.io,resources=podgroups,verbs=get
+// +kubebuilder:rbac:groups=batch.volcano.sh,resources=jobs,verbs=get
+
+// PodGroup is the part of a Volcano or scheduler-plugins PodGroup relevant
+// to Kueue.
+type PodGroup struct {
+	Name string
+	// MinMember is the minimum number of pods of the group to run together.
+	MinMember int32
+	// QueueName is the value of the queue-name label of the PodGroup.
+	QueueName string
+	// TotalCount is the number of pods of the group, as declared by the
+	// controller owning the pod, or 0 if it is unknown.
+	TotalCount int32
+}
+
+// reference returns the GVK and the name of the PodGroup referenced by the pod.
+func reference(pod *corev1.Pod) (schema.GroupVersionKind, string, bool) {
+	if name := pod.Annotations[VolcanoGroupNameAnnotation]; name != "" {
+		return VolcanoGVK, name, true
+	}
+	if name := pod.Annotations[KubeGroupNameAnnotation]; name != "" {
+		return VolcanoGVK, name, true
+	}
+	if name := pod.Labels[SchedulerPluginsGroupLabel]; name != "" {
+		return SchedulerPluginsGVK, name, true
+	}
+	return schema.GroupVersionKind{}, "", false
+}
+
+// ForPod returns the PodGroup referenced by the pod, or nil if the pod doesn't
+// reference one, the PodGroup doesn't exist or its CRD is not installed.
+func ForPod(ctx context.Context, c client.Reader, pod *corev1.Pod) (*PodGroup, error) {
+	gvk, name, found := reference(pod)
+	if !found {
+		return nil, nil
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := c.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get %s %q: %w", gvk.Kind, name, err)
+	}
+	minMember, _, err := unstructured.NestedInt64(obj.Object, "spec", "minMember")
+	if err != nil {
+		return nil, fmt.Errorf("invalid minMember of %s %q: %w", gvk.Kind, name, err)
+	}
+	totalCount, err := ownerReplicas(ctx, c, pod)
+	if err != nil {
+		return nil, err
+	}
+	return &PodGroup{
+		Name:       name,
+		MinMember:  int32(minMember),
+		QueueName:  obj.GetLabels()[ctrlconstants.QueueLabel],
+		TotalCount: totalCount,
+	}, nil
+}
+
+// ownerReplicas returns the number of pods declared by the controller owning
+// the pod, or 0 if the pod has no controller or its kind is not supported.
+func ownerReplicas(ctx context.Context, c client.Reader, pod *corev1.Pod) (int32, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return 0, nil
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return 0, nil
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: owner.Kind}
+	switch gk {
+	case replicaSetGK, statefulSetGK, jobGK, volcanoJobGK:
+	default:
+		return 0, nil
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gv.WithKind(owner.Kind))
+	if err := c.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: owner.Name}, obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get %s %q owning the pod: %w", owner.Kind, owner.Name, err)
+	}
+	switch gk {
+	case jobGK:
+		return nestedCount(obj, "spec", "parallelism")
+	case volcanoJobGK:
+		tasks, _, err := unstructured.NestedSlice(obj.Object, "spec", "tasks")
+		if err != nil {
+			return 0, fmt.Errorf("invalid tasks of %s %q: %w", owner.Kind, owner.Name, err)
+		}
+		var total int64
+		for _, task := range tasks {
+			if t, ok := task.(map[string]any); ok {
+				replicas, _, _ := unstructured.NestedInt64(t, "replicas")
+				total += replicas
+			}
+		}
+		return int32(total), nil
+	default:
+		return nestedCount(obj, "spec", "replicas")
+	}
+}
+
+// nestedCount returns the count at the path of the object, which defaults to 1.
+func nestedCount(obj *unstructured.Unstructured, fields ...string) (int32, error) {
+	count, found, err := unstructured.NestedInt64(obj.Object, fields...)
+	if err != nil {
+		return 0, fmt.Errorf("invalid count of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if !found {
+		return 1, nil
+	}
+	return int32(count), nil
+}
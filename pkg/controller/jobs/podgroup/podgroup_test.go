@@ -0,0 +1,161 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	ctrlconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+)
+
+func makePodGroup(gvk schema.GroupVersionKind, name string, minMember int64, labels map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"minMember": minMember,
+		},
+	}}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace("ns")
+	obj.SetLabels(labels)
+	return obj
+}
+
+func makeOwner(gvk schema.GroupVersionKind, name string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace("ns")
+	return obj
+}
+
+func TestForPod(t *testing.T) {
+	replicaSetGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}
+	jobGVK := schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	volcanoJobGVK := schema.GroupVersionKind{Group: "batch.volcano.sh", Version: "v1alpha1", Kind: "Job"}
+	deploymentGVK := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	cases := map[string]struct {
+		podGroups    []client.Object
+		interceptors *interceptor.Funcs
+		pod          *testingpod.PodWrapper
+		want         *PodGroup
+		wantErr      bool
+	}{
+		"pod without PodGroup": {
+			pod: testingpod.MakePod("pod", "ns"),
+		},
+		"volcano PodGroup": {
+			podGroups: []client.Object{makePodGroup(VolcanoGVK, "group", 3, map[string]string{ctrlconstants.QueueLabel: "queue"})},
+			pod:       testingpod.MakePod("pod", "ns").Annotation(VolcanoGroupNameAnnotation, "group"),
+			want:      &PodGroup{Name: "group", MinMember: 3, QueueName: "queue"},
+		},
+		"volcano PodGroup with the legacy annotation": {
+			podGroups: []client.Object{makePodGroup(VolcanoGVK, "group", 2, nil)},
+			pod:       testingpod.MakePod("pod", "ns").Annotation(KubeGroupNameAnnotation, "group"),
+			want:      &PodGroup{Name: "group", MinMember: 2},
+		},
+		"scheduler-plugins PodGroup": {
+			podGroups: []client.Object{makePodGroup(SchedulerPluginsGVK, "group", 4, nil)},
+			pod:       testingpod.MakePod("pod", "ns").Label(SchedulerPluginsGroupLabel, "group"),
+			want:      &PodGroup{Name: "group", MinMember: 4},
+		},
+		"PodGroup not found": {
+			podGroups: []client.Object{makePodGroup(SchedulerPluginsGVK, "group", 4, nil)},
+			pod:       testingpod.MakePod("pod", "ns").Annotation(VolcanoGroupNameAnnotation, "group"),
+		},
+		"PodGroup CRD not installed": {
+			interceptors: &interceptor.Funcs{
+				Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+					return &meta.NoKindMatchError{GroupKind: VolcanoGVK.GroupKind(), SearchedVersions: []string{VolcanoGVK.Version}}
+				},
+			},
+			pod: testingpod.MakePod("pod", "ns").Annotation(VolcanoGroupNameAnnotation, "group"),
+		},
+		"invalid minMember": {
+			podGroups: []client.Object{makeOwner(VolcanoGVK, "group", map[string]any{"minMember": "two"})},
+			pod:       testingpod.MakePod("pod", "ns").Annotation(VolcanoGroupNameAnnotation, "group"),
+			wantErr:   true,
+		},
+		"total count from the owning ReplicaSet": {
+			podGroups: []client.Object{
+				makePodGroup(SchedulerPluginsGVK, "group", 2, nil),
+				makeOwner(replicaSetGVK, "rs", map[string]any{"replicas": int64(5)}),
+			},
+			pod:  testingpod.MakePod("pod", "ns").Label(SchedulerPluginsGroupLabel, "group").OwnerReference("rs", replicaSetGVK),
+			want: &PodGroup{Name: "group", MinMember: 2, TotalCount: 5},
+		},
+		"total count from the owning Job without parallelism": {
+			podGroups: []client.Object{
+				makePodGroup(SchedulerPluginsGVK, "group", 1, nil),
+				makeOwner(jobGVK, "job", map[string]any{}),
+			},
+			pod:  testingpod.MakePod("pod", "ns").Label(SchedulerPluginsGroupLabel, "group").OwnerReference("job", jobGVK),
+			want: &PodGroup{Name: "group", MinMember: 1, TotalCount: 1},
+		},
+		"total count from the tasks of the owning Volcano Job": {
+			podGroups: []client.Object{
+				makePodGroup(VolcanoGVK, "group", 3, nil),
+				makeOwner(volcanoJobGVK, "vcjob", map[string]any{"tasks": []any{
+					map[string]any{"name": "master", "replicas": int64(1)},
+					map[string]any{"name": "worker", "replicas": int64(4)},
+				}}),
+			},
+			pod:  testingpod.MakePod("pod", "ns").Annotation(VolcanoGroupNameAnnotation, "group").OwnerReference("vcjob", volcanoJobGVK),
+			want: &PodGroup{Name: "group", MinMember: 3, TotalCount: 5},
+		},
+		"unknown total count when the owner is not found": {
+			podGroups: []client.Object{makePodGroup(SchedulerPluginsGVK, "group", 2, nil)},
+			pod:       testingpod.MakePod("pod", "ns").Label(SchedulerPluginsGroupLabel, "group").OwnerReference("rs", replicaSetGVK),
+			want:      &PodGroup{Name: "group", MinMember: 2},
+		},
+		"unknown total count with an unsupported owner": {
+			podGroups: []client.Object{
+				makePodGroup(SchedulerPluginsGVK, "group", 2, nil),
+				makeOwner(deploymentGVK, "deploy", map[string]any{"replicas": int64(5)}),
+			},
+			pod:  testingpod.MakePod("pod", "ns").Label(SchedulerPluginsGroupLabel, "group").OwnerReference("deploy", deploymentGVK),
+			want: &PodGroup{Name: "group", MinMember: 2},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			builder := utiltesting.NewClientBuilder().WithObjects(tc.podGroups...)
+			if tc.interceptors != nil {
+				builder = builder.WithInterceptorFuncs(*tc.interceptors)
+			}
+			cl := builder.Build()
+			got, err := ForPod(ctx, cl, tc.pod.Obj())
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ForPod returned error %v, want error: %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected PodGroup (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,11 +23,17 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 var (
@@ -41,8 +47,9 @@ const (
 func RegisterIntegration(m *jobframework.IntegrationManager) error {
 	return m.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
 		SetupIndexes:                    SetupIndexes,
+		NewJob:                          NewJob,
 		NewReconciler:                   NewReconciler,
-		NewAdditionalReconcilers:        []jobframework.ReconcilerFactory{NewPodReconciler},
+		NewAdditionalReconcilers:        []jobframework.ReconcilerFactory{NewPodReconciler, NewElasticReconciler},
 		SetupWebhook:                    SetupWebhook,
 		JobType:                         &appsv1.StatefulSet{},
 		AddToScheme:                     appsv1.AddToScheme,
@@ -52,8 +59,18 @@ func RegisterIntegration(m *jobframework.IntegrationManager) error {
 	})
 }
 
+// StatefulSet is managed as a job only when it is elastic. The pods of the
+// other StatefulSets are managed by the pod integration, as a pod group.
 type StatefulSet appsv1.StatefulSet
 
+func NewJob() jobframework.GenericJob {
+	return &StatefulSet{}
+}
+
+var _ jobframework.GenericJob = (*StatefulSet)(nil)
+var _ jobframework.JobWithSkip = (*StatefulSet)(nil)
+var _ jobframework.JobWithCustomStop = (*StatefulSet)(nil)
+
 func fromObject(o runtime.Object) *StatefulSet {
 	return (*StatefulSet)(o.(*appsv1.StatefulSet))
 }
@@ -66,8 +83,88 @@ func (d *StatefulSet) GVK() schema.GroupVersionKind {
 	return gvk
 }
 
-func SetupIndexes(context.Context, client.FieldIndexer) error {
-	return nil
+// Skip skips the StatefulSets which are not elastic.
+func (d *StatefulSet) Skip(context.Context) bool {
+	return !workloadslicing.Enabled(d.Object())
+}
+
+// IsSuspended returns true until the StatefulSet is admitted, as the pod
+// template of an admitted elastic StatefulSet carries its workload slice name.
+func (d *StatefulSet) IsSuspended() bool {
+	_, found := d.Spec.Template.Annotations[kueue.WorkloadSliceNameAnnotation]
+	return !found
+}
+
+func (d *StatefulSet) Suspend() {
+	delete(d.Spec.Template.Annotations, kueue.WorkloadSliceNameAnnotation)
+}
+
+// Stop suspends the StatefulSet, and deletes its ungated pods, which are
+// recreated gated by the StatefulSet controller.
+func (d *StatefulSet) Stop(ctx context.Context, c client.Client, podSetsInfo []podset.PodSetInfo, _ jobframework.StopReason, _ string) (bool, error) {
+	stoppedNow := false
+	if !d.IsSuspended() {
+		if err := clientutil.Patch(ctx, c, d.Object(), func() (bool, error) {
+			d.Suspend()
+			d.RestorePodSetsInfo(ctx, podSetsInfo)
+			return true, nil
+		}); err != nil {
+			return false, err
+		}
+		stoppedNow = true
+	}
+	return stoppedNow, workloadslicing.DeleteUngatedPods(ctx, c, d.Namespace, d.Spec.Selector)
+}
+
+func (d *StatefulSet) RunWithPodSetsInfo(ctx context.Context, _ client.Client, podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return podset.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	return podset.Merge(ctrl.LoggerFrom(ctx), &d.Spec.Template.ObjectMeta, &d.Spec.Template.Spec, podSetsInfo[0])
+}
+
+func (d *StatefulSet) RestorePodSetsInfo(_ context.Context, podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) == 0 {
+		return false
+	}
+	return podset.RestorePodSpec(&d.Spec.Template.ObjectMeta, &d.Spec.Template.Spec, podSetsInfo[0])
+}
+
+// Finished always returns false, as a StatefulSet serves until it is deleted.
+func (d *StatefulSet) Finished(context.Context) (message string, success, finished bool) {
+	return "", false, false
+}
+
+// PodSets returns a single PodSet with the desired replicas of the StatefulSet,
+// which are set by the HorizontalPodAutoscaler when the StatefulSet is autoscaled.
+func (d *StatefulSet) PodSets(context.Context, client.Client) ([]kueue.PodSet, error) {
+	podSet := kueue.PodSet{
+		Name:     kueue.DefaultPodSetName,
+		Template: *d.Spec.Template.DeepCopy(),
+		Count:    ptr.Deref(d.Spec.Replicas, 1),
+	}
+	if features.Enabled(features.TopologyAwareScheduling) {
+		topologyRequest, err := jobframework.NewPodSetTopologyRequest(&d.Spec.Template.ObjectMeta).
+			PodIndexLabel(new(appsv1.PodIndexLabel)).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		podSet.TopologyRequest = topologyRequest
+	}
+	return []kueue.PodSet{podSet}, nil
+}
+
+func (d *StatefulSet) IsActive() bool {
+	return d.Status.ReadyReplicas > 0
+}
+
+func (d *StatefulSet) PodsReady(context.Context, client.Client) bool {
+	return d.Status.ReadyReplicas >= ptr.Deref(d.Spec.Replicas, 1)
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
 }
 
 func GetOwnerUID(sts *appsv1.StatefulSet) types.UID {
@@ -0,0 +1,67 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statefulset
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+)
+
+// +kubebuilder:rbac:groups="apps",resources=statefulsets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="apps",resources=statefulsets/finalizers,verbs=get;update
+
+const elasticControllerName = "statefulset_elastic"
+
+// ElasticReconciler reconciles the elastic StatefulSets as jobs, with a
+// workload slice per scale-up. The other StatefulSets are skipped.
+type ElasticReconciler struct {
+	jr *jobframework.JobReconciler
+}
+
+var _ jobframework.JobReconcilerInterface = (*ElasticReconciler)(nil)
+
+func NewElasticReconciler(_ context.Context, client client.Client, _ client.FieldIndexer, eventRecorder events.EventRecorder, opts ...jobframework.Option) (jobframework.JobReconcilerInterface, error) {
+	return &ElasticReconciler{
+		jr: jobframework.NewReconciler(client, eventRecorder, opts...),
+	}, nil
+}
+
+func (r *ElasticReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, NewJob())
+}
+
+func (r *ElasticReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctrl.Log.V(3).Info("Setting up elastic StatefulSet reconciler")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&appsv1.StatefulSet{}).
+		Owns(&kueue.Workload{}).
+		Named(elasticControllerName).
+		WithOptions(controller.Options{
+			LogConstructor: roletracker.NewLogConstructor(r.jr.RoleTracker(), elasticControllerName),
+		}).
+		Complete(r)
+}
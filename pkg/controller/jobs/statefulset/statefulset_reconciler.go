@@ -55,6 +55,7 @@ import (
 	utilstatefulset "sigs.k8s.io/kueue/pkg/util/statefulset"
 	"sigs.k8s.io/kueue/pkg/workload"
 	workloadfinish "sigs.k8s.io/kueue/pkg/workload/finish"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 const (
@@ -451,6 +452,11 @@ func (r *Reconciler) handle(obj client.Object) bool {
 		return false
 	}
 
+	if workloadslicing.Enabled(sts) {
+		log.V(3).Info("Skipping reconciliation because the StatefulSet is elastic")
+		return false
+	}
+
 	// Handle only statefulset managed by kueue.
 	suspend, err := r.integrationManager.WorkloadShouldBeSuspended(ctx, sts, r.client, r.manageJobsWithoutQueueName, r.managedJobsNamespaceSelector)
 	if err != nil {
@@ -35,9 +35,11 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	"sigs.k8s.io/kueue/pkg/util/webhook"
 	"sigs.k8s.io/kueue/pkg/workload"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 type Webhook struct {
@@ -99,6 +101,12 @@ func (wh *Webhook) Default(ctx context.Context, stsObj *appsv1.StatefulSet) erro
 	if err != nil {
 		return err
 	}
+	if suspend && workloadslicing.Enabled(ss.Object()) {
+		// The pods of an elastic StatefulSet are not managed by the pod
+		// integration, they remain gated until admitted in a workload slice.
+		utilpod.GateTemplate(&ss.Spec.Template, kueue.ElasticJobSchedulingGate)
+		return nil
+	}
 	if suspend {
 		if ss.Spec.Template.Annotations == nil {
 			ss.Spec.Template.Annotations = make(map[string]string, 1)
@@ -163,6 +171,7 @@ func (wh *Webhook) ValidateUpdate(ctx context.Context, oldSTSObj, newSTSObj *app
 
 	allErrs := jobframework.ValidateQueueName(newStatefulSet.Object())
 	allErrs = append(allErrs, jobframework.ValidateElasticJobAnnotation(newStatefulSet.Object(), newStatefulSet.GVK())...)
+	allErrs = append(allErrs, jobframework.ValidateElasticJobAnnotationOnUpdate(oldStatefulSet.Object(), newStatefulSet.Object())...)
 
 	// Prevents updating the queue-name if at least one Pod is not suspended
 	// or if the queue-name has been deleted.
@@ -191,7 +200,8 @@ func (wh *Webhook) ValidateUpdate(ctx context.Context, oldSTSObj, newSTSObj *app
 	if err != nil {
 		return nil, err
 	}
-	if suspend {
+	// The replicas of an elastic StatefulSet are scaled in workload slices.
+	if suspend && !workloadslicing.Enabled(newStatefulSet.Object()) {
 		allErrs = append(allErrs, jobframework.ValidateImmutablePodGroupPodSpec(
 			&newStatefulSet.Spec.Template.Spec,
 			&oldStatefulSet.Spec.Template.Spec,
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	leaderworkersetv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	kueueconstants "sigs.k8s.io/kueue/pkg/constants"
@@ -65,6 +66,7 @@ func TestDefault(t *testing.T) {
 		manageJobsWithoutQueueName bool
 		defaultLqExist             bool
 		enableIntegrations         []string
+		featureGates               map[featuregate.Feature]bool
 		want                       *appsv1.StatefulSet
 	}{
 		"statefulset without queue with manageJobsWithoutQueueName": {
@@ -149,10 +151,26 @@ func TestDefault(t *testing.T) {
 			statefulset:    testingstatefulset.MakeStatefulSet("test-pod", "").Obj(),
 			want:           testingstatefulset.MakeStatefulSet("test-pod", "").Obj(),
 		},
+		"elastic statefulset with queue": {
+			enableIntegrations: []string{"pod"},
+			featureGates:       map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
+			statefulset: testingstatefulset.MakeStatefulSet("test-pod", "").
+				Replicas(10).
+				Queue("test-queue").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Obj(),
+			want: testingstatefulset.MakeStatefulSet("test-pod", "").
+				Replicas(10).
+				Queue("test-queue").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				PodTemplateSpecSchedulingGate(kueue.ElasticJobSchedulingGate).
+				Obj(),
+		},
 	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGatesDuringTest(t, tc.featureGates)
 			integrationManager := newTestIntegrationManager(t)
 			t.Cleanup(integrationManager.EnableIntegrationsForTest(t, tc.enableIntegrations...))
 			ctx, _ := utiltesting.ContextWithLog(t)
@@ -349,17 +367,11 @@ func TestValidateCreate(t *testing.T) {
 			wantErr:      nil,
 			featureGates: map[featuregate.Feature]bool{features.AdmissionGatedBy: true},
 		},
-		"elastic job annotation is rejected": {
+		"elastic job annotation is accepted": {
 			sts: testingstatefulset.MakeStatefulSet("test-sts", "default").
 				Queue("queue").
 				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
 				Obj(),
-			wantErr: field.ErrorList{
-				&field.Error{
-					Type:  field.ErrorTypeForbidden,
-					Field: "metadata.annotations[" + workloadslicing.EnabledAnnotationKey + "]",
-				},
-			}.ToAggregate(),
 			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
 		},
 	}
@@ -1028,7 +1040,7 @@ func TestValidateUpdate(t *testing.T) {
 			wantErr:      nil,
 			featureGates: map[featuregate.Feature]bool{features.AdmissionGatedBy: true},
 		},
-		"elastic job annotation is rejected on update": {
+		"elastic job annotation can't be added on update": {
 			oldObj: testingstatefulset.MakeStatefulSet("test-sts", "default").
 				Queue("queue").
 				Obj(),
@@ -1038,12 +1050,25 @@ func TestValidateUpdate(t *testing.T) {
 				Obj(),
 			wantErr: field.ErrorList{
 				&field.Error{
-					Type:  field.ErrorTypeForbidden,
+					Type:  field.ErrorTypeInvalid,
 					Field: "metadata.annotations[" + workloadslicing.EnabledAnnotationKey + "]",
 				},
 			}.ToAggregate(),
 			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
 		},
+		"change in replicas of an elastic statefulset (scale up)": {
+			oldObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Queue("test-queue").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Replicas(3).
+				Obj(),
+			newObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Queue("test-queue").
+				Annotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				Replicas(4).
+				Obj(),
+			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
+		},
 	}
 
 	for name, tc := range testCases {
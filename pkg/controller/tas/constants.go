@@ -19,11 +19,12 @@ package tas
 import "time"
 
 const (
-	TASTopologyController       = "tas-topology-controller"
-	TASResourceFlavorController = "tas-resource-flavor-controller"
-	TASTopologyUngater          = "tas-topology-ungater"
-	TASNodeController           = "tas-node-controller"
-	TASPodUsageController       = "tas-pod-usage-controller"
+	TASTopologyController        = "tas-topology-controller"
+	TASResourceFlavorController  = "tas-resource-flavor-controller"
+	TASTopologyUngater           = "tas-topology-ungater"
+	TASNodeController            = "tas-node-controller"
+	TASPodUsageController        = "tas-pod-usage-controller"
+	TASDefragmentationController = "tas-defragmentation-controller"
 )
 
 const (
@@ -25,6 +25,7 @@ import (
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 )
 
@@ -73,6 +74,12 @@ func SetupControllers(
 	if ctrlName, err := nodeRec.SetupWithManager(mgr, cfg); err != nil {
 		return ctrlName, err
 	}
+	if features.Enabled(features.TASDefragmentation) {
+		defragmentationRec := newDefragmentationReconciler(mgr.GetClient(), queues, cache, mgr.GetEventRecorder(TASDefragmentationController), roleTracker)
+		if ctrlName, err := defragmentationRec.setupWithManager(mgr, cfg); err != nil {
+			return ctrlName, err
+		}
+	}
 	podUsageController := newPodUsageReconciler(mgr.GetClient(), queues, cache, roleTracker, options.podUsageOpts...)
 	if ctrlName, err := podUsageController.SetupWithManager(mgr); err != nil {
 		return ctrlName, err
@@ -0,0 +1,209 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/core"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
+)
+
+const (
+	// defragmentationPeriod is the interval at which the defragmentation plan
+	// of a ClusterQueue is computed again.
+	defragmentationPeriod = time.Minute
+
+	// defragmentationExecutionBackoff is the time after the execution of a
+	// plan during which no other plan is executed for the same pending
+	// workload, giving the scheduler time to admit it.
+	defragmentationExecutionBackoff = 5 * time.Minute
+
+	defaultDefragmentationMaxEvictions = 4
+
+	defragmentationEvictionMessageFormat = "Evicted to open the topology domain %s for the workload %s"
+)
+
+// defragmentationReconciler publishes the defragmentation plans of the TAS
+// flavors of the ClusterQueues, and executes them when the ClusterQueue
+// enables it.
+type defragmentationReconciler struct {
+	client      client.Client
+	queues      *qcache.Manager
+	cache       *schdcache.Cache
+	clock       clock.Clock
+	recorder    events.EventRecorder
+	roleTracker *roletracker.RoleTracker
+}
+
+var _ reconcile.Reconciler = (*defragmentationReconciler)(nil)
+
+func newDefragmentationReconciler(c client.Client, queues *qcache.Manager, cache *schdcache.Cache, recorder events.EventRecorder, roleTracker *roletracker.RoleTracker) *defragmentationReconciler {
+	return &defragmentationReconciler{
+		client:      c,
+		queues:      queues,
+		cache:       cache,
+		clock:       clock.RealClock{},
+		recorder:    recorder,
+		roleTracker: roleTracker,
+	}
+}
+
+func (r *defragmentationReconciler) setupWithManager(mgr ctrl.Manager, cfg *configapi.Configuration) (string, error) {
+	return TASDefragmentationController, builder.ControllerManagedBy(mgr).
+		Named("tas_defragmentation_controller").
+		For(&kueue.ClusterQueue{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			NeedLeaderElection: new(false),
+			LogConstructor:     roletracker.NewLogConstructor(r.roleTracker, TASDefragmentationController),
+		}).
+		Complete(core.WithLeadingManager(mgr, r, &kueue.ClusterQueue{}, cfg))
+}
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=clusterqueues,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=clusterqueues/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+
+func (r *defragmentationReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	cq := &kueue.ClusterQueue{}
+	if err := r.client.Get(ctx, req.NamespacedName, cq); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile ClusterQueue defragmentation")
+
+	spec := cq.Spec.TopologyDefragmentation
+	if spec == nil || !features.Enabled(features.TASDefragmentation) || !cq.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.updateStatus(ctx, cq, nil)
+	}
+
+	now := r.clock.Now()
+	current := cq.Status.TopologyDefragmentation
+	if current != nil && current.State == kueue.TopologyDefragmentationExecuted && now.Before(current.LastTransitionTime.Add(defragmentationExecutionBackoff)) {
+		return ctrl.Result{RequeueAfter: defragmentationPeriod}, nil
+	}
+
+	snapshot, err := r.cache.Snapshot(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	cqName := kueue.ClusterQueueReference(cq.Name)
+	maxEvictions := int(ptr.Deref(spec.MaxEvictions, defaultDefragmentationMaxEvictions))
+	plan := snapshot.PlanTASDefragmentation(ctx, cqName, r.queues.PendingWorkloadsInfo(cqName), maxEvictions)
+	status := planStatus(plan, current, now)
+	if status != nil && status.State == kueue.TopologyDefragmentationPlanned && spec.Policy == kueue.TopologyDefragmentationPolicyExecute {
+		if err := r.execute(ctx, cq, plan); err != nil {
+			return ctrl.Result{}, err
+		}
+		status.State = kueue.TopologyDefragmentationExecuted
+		status.LastTransitionTime = metav1.NewTime(now)
+	}
+	if err := r.updateStatus(ctx, cq, status); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: defragmentationPeriod}, nil
+}
+
+// execute evicts the workloads of the plan, so that they are placed again
+// after the pending workload.
+func (r *defragmentationReconciler) execute(ctx context.Context, cq *kueue.ClusterQueue, plan *schdcache.TASDefragmentationPlan) error {
+	log := ctrl.LoggerFrom(ctx)
+	domain := strings.Join(plan.Domain, ",")
+	msg := fmt.Sprintf(defragmentationEvictionMessageFormat, domain, klog.KObj(plan.Workload.Obj))
+	for _, wlInfo := range plan.Evictions {
+		wl := wlInfo.Obj.DeepCopy()
+		if workloadevict.IsEvicted(wl) {
+			continue
+		}
+		log.V(2).Info("Evicting the workload to open a topology domain", "workload", klog.KObj(wl), "domain", domain, "pendingWorkload", klog.KObj(plan.Workload.Obj))
+		exposeLqMetrics := r.cache.ShouldExposeLocalQueueMetricsForWorkload(log, wl)
+		if err := workloadevict.Evict(ctx, r.client, r.recorder, wl, kueue.WorkloadEvictedByTopologyDefragmentation, msg, "", r.clock, exposeLqMetrics, r.roleTracker, nil); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("evicting workload %s: %w", klog.KObj(wl), err)
+			}
+		}
+	}
+	r.recorder.Eventf(cq, nil, corev1.EventTypeNormal, "TopologyDefragmentationExecuted", "TopologyDefragmentationExecuted",
+		"Evicted %d workloads to open the topology domain %s for the workload %s", len(plan.Evictions), domain, klog.KObj(plan.Workload.Obj))
+	return nil
+}
+
+func (r *defragmentationReconciler) updateStatus(ctx context.Context, cq *kueue.ClusterQueue, status *kueue.ClusterQueueTopologyDefragmentationStatus) error {
+	if equality.Semantic.DeepEqual(cq.Status.TopologyDefragmentation, status) {
+		return nil
+	}
+	cq.Status.TopologyDefragmentation = status
+	return r.client.Status().Update(ctx, cq)
+}
+
+// planStatus returns the status publishing the plan, keeping the state and the
+// last transition time of the current status while the plan doesn't change.
+func planStatus(plan *schdcache.TASDefragmentationPlan, current *kueue.ClusterQueueTopologyDefragmentationStatus, now time.Time) *kueue.ClusterQueueTopologyDefragmentationStatus {
+	if plan == nil {
+		return nil
+	}
+	status := &kueue.ClusterQueueTopologyDefragmentationStatus{
+		Workload: kueue.TopologyDefragmentationWorkloadReference{
+			Name:      plan.Workload.Obj.Name,
+			Namespace: plan.Workload.Obj.Namespace,
+		},
+		PodSet:             plan.PodSet,
+		Flavor:             plan.Flavor,
+		Levels:             plan.Levels,
+		Domain:             plan.Domain,
+		State:              kueue.TopologyDefragmentationPlanned,
+		LastTransitionTime: metav1.NewTime(now),
+	}
+	for _, wl := range plan.Evictions {
+		status.Evictions = append(status.Evictions, kueue.TopologyDefragmentationWorkloadReference{
+			Name:      wl.Obj.Name,
+			Namespace: wl.Obj.Namespace,
+		})
+	}
+	if current != nil && current.Workload == status.Workload && current.PodSet == status.PodSet &&
+		current.Flavor == status.Flavor && slices.Equal(current.Domain, status.Domain) &&
+		slices.Equal(current.Evictions, status.Evictions) {
+		status.State = current.State
+		status.LastTransitionTime = current.LastTransitionTime
+	}
+	return status
+}
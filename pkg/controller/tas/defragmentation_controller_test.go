@@ -0,0 +1,241 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestDefragmentationReconcile(t *testing.T) {
+	const (
+		cqName        = "cq"
+		tasBlockLabel = utiltesting.DefaultBlockTopologyLevel
+	)
+	now := time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)
+
+	//      b1          b2
+	//   /     \     /     \
+	//  x1     x2   x3     x4
+	//  2      2    2      2    (cpu)
+	makeNode := func(block, host string) *corev1.Node {
+		return testingnode.MakeNode(host).
+			Label(tasBlockLabel, block).
+			Label(corev1.LabelHostname, host).
+			StatusAllocatable(corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("2"),
+				corev1.ResourcePods: resource.MustParse("10"),
+			}).
+			Ready().
+			Obj()
+	}
+	nodes := []*corev1.Node{
+		makeNode("b1", "x1"),
+		makeNode("b1", "x2"),
+		makeNode("b2", "x3"),
+		makeNode("b2", "x4"),
+	}
+	admitted := func(name string, priority int32, host string) *kueue.Workload {
+		return utiltestingapi.MakeWorkload(name, metav1.NamespaceDefault).
+			Priority(priority).
+			PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 1).
+				PreferredTopologyRequest(tasBlockLabel).
+				Request(corev1.ResourceCPU, "1").
+				Obj()).
+			ReserveQuotaAt(utiltestingapi.MakeAdmission(cqName).
+				PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "tas", "1").
+					TopologyAssignment(utiltestingapi.MakeTopologyAssignment([]string{corev1.LabelHostname}).
+						Domain(utiltestingapi.MakeTopologyDomainAssignment([]string{host}, 1).Obj()).
+						Obj()).
+					Obj()).
+				Obj(), now.Add(-time.Hour)).
+			AdmittedAt(true, now.Add(-time.Hour)).
+			Obj()
+	}
+	wantPlan := &kueue.ClusterQueueTopologyDefragmentationStatus{
+		Workload:           kueue.TopologyDefragmentationWorkloadReference{Name: "pending", Namespace: metav1.NamespaceDefault},
+		PodSet:             kueue.DefaultPodSetName,
+		Flavor:             "tas",
+		Levels:             []string{tasBlockLabel},
+		Domain:             []string{"b1"},
+		Evictions:          []kueue.TopologyDefragmentationWorkloadReference{{Name: "b1-low", Namespace: metav1.NamespaceDefault}},
+		State:              kueue.TopologyDefragmentationPlanned,
+		LastTransitionTime: metav1.NewTime(now),
+	}
+	withState := func(s *kueue.ClusterQueueTopologyDefragmentationStatus, state kueue.TopologyDefragmentationState) *kueue.ClusterQueueTopologyDefragmentationStatus {
+		s = s.DeepCopy()
+		s.State = state
+		return s
+	}
+
+	cases := map[string]struct {
+		disableFeature bool
+		policy         *kueue.TopologyDefragmentationPolicy
+		status         *kueue.ClusterQueueTopologyDefragmentationStatus
+		wantStatus     *kueue.ClusterQueueTopologyDefragmentationStatus
+		wantEvicted    []string
+		wantEvents     []utiltesting.EventRecord
+	}{
+		"plan": {
+			policy:     new(kueue.TopologyDefragmentationPolicyPlan),
+			wantStatus: wantPlan,
+		},
+		"execute": {
+			policy:      new(kueue.TopologyDefragmentationPolicyExecute),
+			wantStatus:  withState(wantPlan, kueue.TopologyDefragmentationExecuted),
+			wantEvicted: []string{"b1-low"},
+			wantEvents: []utiltesting.EventRecord{{
+				Key:       types.NamespacedName{Name: cqName},
+				EventType: corev1.EventTypeNormal,
+				Reason:    "TopologyDefragmentationExecuted",
+				Message:   "Evicted 1 workloads to open the topology domain b1 for the workload default/pending",
+			}},
+		},
+		"the plan was executed recently": {
+			policy:     new(kueue.TopologyDefragmentationPolicyExecute),
+			status:     withState(wantPlan, kueue.TopologyDefragmentationExecuted),
+			wantStatus: withState(wantPlan, kueue.TopologyDefragmentationExecuted),
+		},
+		"defragmentation disabled in the ClusterQueue": {
+			status: wantPlan,
+		},
+		"feature disabled": {
+			disableFeature: true,
+			policy:         new(kueue.TopologyDefragmentationPolicyExecute),
+			status:         wantPlan,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASDefragmentation, !tc.disableFeature)
+			ctx, log := utiltesting.ContextWithLog(t)
+			fakeClock := testingclock.NewFakeClock(now)
+
+			cqWrapper := utiltestingapi.MakeClusterQueue(cqName).
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("tas").Resource(corev1.ResourceCPU, "100").Obj())
+			if tc.policy != nil {
+				cqWrapper.TopologyDefragmentation(*tc.policy, 4)
+			}
+			cq := cqWrapper.Obj()
+			cq.Status.TopologyDefragmentation = tc.status
+			lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cqName).Obj()
+			rf := utiltestingapi.MakeResourceFlavor("tas").TopologyName("topology").Obj()
+			topology := utiltestingapi.MakeTopology("topology").Levels(tasBlockLabel, corev1.LabelHostname).Obj()
+			wls := []*kueue.Workload{
+				admitted("b1-low", 0, "x1"),
+				admitted("b2-low", 0, "x3"),
+			}
+			pending := utiltestingapi.MakeWorkload("pending", metav1.NamespaceDefault).
+				Queue("lq").
+				Priority(10).
+				PodSets(*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 4).
+					RequiredTopologyRequest(tasBlockLabel).
+					Request(corev1.ResourceCPU, "1").
+					Obj()).
+				Obj()
+
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(cq, lq, rf, topology, wls[0], wls[1], pending).
+				WithStatusSubresource(cq, &kueue.Workload{}).
+				Build()
+			cqCache := schdcache.New(cl)
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache, qcache.WithPreemptionExpectations(preemptexpectations.New()))
+			cqCache.AddOrUpdateResourceFlavor(log, rf)
+			cqCache.AddOrUpdateTopology(log, topology)
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in cache: %v", err)
+			}
+			if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in manager: %v", err)
+			}
+			if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+				t.Fatalf("Inserting localQueue in manager: %v", err)
+			}
+			for _, n := range nodes {
+				cqCache.TASCache().SyncNode(n)
+			}
+			for _, wl := range wls {
+				cqCache.AddOrUpdateWorkload(log, wl)
+			}
+			if err := qManager.AddOrUpdateWorkload(log, pending); err != nil {
+				t.Fatalf("Inserting the pending workload in manager: %v", err)
+			}
+			recorder := &utiltesting.EventRecorder{}
+			r := newDefragmentationReconciler(cl, qManager, cqCache, recorder, nil)
+			r.clock = fakeClock
+
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: cqName}}
+			if _, err := r.Reconcile(ctx, req); err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+
+			var gotCQ kueue.ClusterQueue
+			if err := cl.Get(ctx, req.NamespacedName, &gotCQ); err != nil {
+				t.Fatalf("Getting the ClusterQueue: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantStatus, gotCQ.Status.TopologyDefragmentation); diff != "" {
+				t.Errorf("Unexpected defragmentation status (-want,+got):\n%s", diff)
+			}
+
+			var gotEvicted []string
+			for _, wl := range wls {
+				var got kueue.Workload
+				if err := cl.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: wl.Name}, &got); err != nil {
+					t.Fatalf("Getting the workload %s: %v", wl.Name, err)
+				}
+				if cond := apimeta.FindStatusCondition(got.Status.Conditions, kueue.WorkloadEvicted); cond != nil && cond.Status == metav1.ConditionTrue {
+					if cond.Reason != kueue.WorkloadEvictedByTopologyDefragmentation {
+						t.Errorf("Workload %s evicted with reason %q, want %q", wl.Name, cond.Reason, kueue.WorkloadEvictedByTopologyDefragmentation)
+					}
+					gotEvicted = append(gotEvicted, wl.Name)
+				}
+			}
+			if diff := cmp.Diff(tc.wantEvicted, gotEvicted); diff != "" {
+				t.Errorf("Unexpected evicted workloads (-want,+got):\n%s", diff)
+			}
+
+			var gotEvents []utiltesting.EventRecord
+			for _, e := range recorder.RecordedEvents {
+				if e.Key.Name == cqName && e.Key.Namespace == "" {
+					gotEvents = append(gotEvents, e)
+				}
+			}
+			if diff := cmp.Diff(tc.wantEvents, gotEvents); diff != "" {
+				t.Errorf("Unexpected ClusterQueue events (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
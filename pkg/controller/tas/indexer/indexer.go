@@ -83,10 +83,14 @@ func indexPodNodeName(o client.Object) []string {
 
 func indexResourceFlavorTopologyName(o client.Object) []string {
 	flavor, ok := o.(*kueue.ResourceFlavor)
-	if !ok || flavor.Spec.TopologyName == nil {
+	if !ok {
 		return nil
 	}
-	return []string{string(*flavor.Spec.TopologyName)}
+	var names []string
+	for _, name := range utiltas.TopologyNames(flavor) {
+		names = append(names, string(name))
+	}
+	return names
 }
 
 func indexAdmittedWorkloadNodes(o client.Object) []string {
@@ -135,7 +135,7 @@ func (h *nodeHandler) Generic(_ context.Context, e event.GenericEvent, q workque
 	}
 	// trigger reconcile for TAS flavors affected by the node being created or updated
 	for name, cache := range h.cache.CloneTASCache() {
-		if utiltas.NodeMatchesFlavor(node.Labels, cache.NodeLabels(), cache.TopologyLevels()) {
+		if cache.MatchesNode(node) {
 			q.AddAfter(reconcile.Request{NamespacedName: types.NamespacedName{
 				Name: string(name),
 			}}, constants.UpdatesBatchPeriod)
@@ -153,7 +153,7 @@ func (r *rfReconciler) Reconcile(ctx context.Context, req reconcile.Request) (re
 			return reconcile.Result{}, err
 		}
 	}
-	if flv.Spec.TopologyName != nil {
+	if utiltas.IsTASFlavor(flv) {
 		// requeue inadmissible workloads as a change to the resource flavor
 		// or the set of nodes can allow admitting a workload which was
 		// previously inadmissible.
@@ -165,7 +165,7 @@ func (r *rfReconciler) Reconcile(ctx context.Context, req reconcile.Request) (re
 }
 
 func (r *rfReconciler) Create(event event.TypedCreateEvent[*kueue.ResourceFlavor]) bool {
-	if event.Object.Spec.TopologyName != nil {
+	if utiltas.IsTASFlavor(event.Object) {
 		log := r.logger().WithValues("flavor", event.Object.Name)
 		log.V(2).Info("Topology TAS ResourceFlavor event")
 
@@ -176,13 +176,14 @@ func (r *rfReconciler) Create(event event.TypedCreateEvent[*kueue.ResourceFlavor
 }
 
 func (r *rfReconciler) Delete(event event.TypedDeleteEvent[*kueue.ResourceFlavor]) bool {
-	return event.Object.Spec.TopologyName != nil
+	return utiltas.IsTASFlavor(event.Object)
 }
 
 func (r *rfReconciler) Update(event event.TypedUpdateEvent[*kueue.ResourceFlavor]) bool {
 	switch {
-	case ptr.Equal(event.ObjectOld.Spec.TopologyName, event.ObjectNew.Spec.TopologyName):
-		if event.ObjectNew.Spec.TopologyName != nil &&
+	case ptr.Equal(event.ObjectOld.Spec.TopologyName, event.ObjectNew.Spec.TopologyName) &&
+		equality.Semantic.DeepEqual(event.ObjectOld.Spec.Topologies, event.ObjectNew.Spec.Topologies):
+		if utiltas.IsTASFlavor(event.ObjectNew) &&
 			(!equality.Semantic.DeepEqual(event.ObjectOld.Spec.Tolerations, event.ObjectNew.Spec.Tolerations) ||
 				!equality.Semantic.DeepEqual(event.ObjectOld.Spec.NodeTaints, event.ObjectNew.Spec.NodeTaints) ||
 				!equality.Semantic.DeepEqual(event.ObjectOld.Spec.NodeLabels, event.ObjectNew.Spec.NodeLabels)) {
@@ -192,11 +193,11 @@ func (r *rfReconciler) Update(event event.TypedUpdateEvent[*kueue.ResourceFlavor
 			return true
 		}
 		return false
-	case event.ObjectOld.Spec.TopologyName == nil:
+	case !utiltas.IsTASFlavor(event.ObjectOld):
 		return true
 	default:
-		// topologyName was set so is changed or removed
-		return event.ObjectNew.Spec.TopologyName != nil
+		// topologyName or topologies was set so is changed or removed
+		return utiltas.IsTASFlavor(event.ObjectNew)
 	}
 }
 
@@ -42,6 +42,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/controller/core"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 const (
@@ -193,12 +194,14 @@ func (h *resourceFlavorHandler) Update(context.Context, event.UpdateEvent, workq
 
 func (h *resourceFlavorHandler) Delete(_ context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	resourceFlavor, isResourceFlavor := e.Object.(*kueue.ResourceFlavor)
-	if !isResourceFlavor || resourceFlavor.Spec.TopologyName == nil {
+	if !isResourceFlavor {
 		return
 	}
-	q.AddAfter(reconcile.Request{
-		NamespacedName: types.NamespacedName{
-			Name: string(*resourceFlavor.Spec.TopologyName),
-		},
-	}, constants.UpdatesBatchPeriod)
+	for _, name := range utiltas.TopologyNames(resourceFlavor) {
+		q.AddAfter(reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name: string(name),
+			},
+		}, constants.UpdatesBatchPeriod)
+	}
 }
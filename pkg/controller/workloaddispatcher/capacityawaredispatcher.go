@@ -0,0 +1,225 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloaddispatcher
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueueconfig "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/util/roletracker"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	CapacityAwareDispatcherControllerName = "multikueue_capacity_aware_dispatcher"
+
+	maxSignalScore = 100
+	// fairSharingShareScale is the weighted share at which the fair sharing
+	// signal scores half of maxSignalScore.
+	fairSharingShareScale = 1000
+)
+
+// WorkerClusters gives read access to the connected MultiKueue worker clusters.
+type WorkerClusters interface {
+	// WorkerClient returns the client of the worker cluster, or false if the
+	// cluster is not connected.
+	WorkerClient(clusterName string) (client.Reader, bool)
+}
+
+// clusterScorer scores the worker clusters on how likely they are to admit a
+// workload soon, based on the status of the ClusterQueue which the workload
+// would be submitted to in each of them.
+type clusterScorer struct {
+	workers                WorkerClusters
+	stepSize               int
+	freeQuotaWeight        int64
+	pendingWorkloadsWeight int64
+	fairSharingWeight      int64
+}
+
+func newClusterScorer(workers WorkerClusters, cfg *kueueconfig.CapacityAwareDispatcherConfig) *clusterScorer {
+	if cfg == nil {
+		cfg = &kueueconfig.CapacityAwareDispatcherConfig{}
+	}
+	scoring := ptr.Deref(cfg.Scoring, kueueconfig.CapacityAwareScoring{})
+	return &clusterScorer{
+		workers:                workers,
+		stepSize:               int(ptr.Deref(cfg.StepSize, 1)),
+		freeQuotaWeight:        int64(ptr.Deref(scoring.FreeQuotaWeight, 2)),
+		pendingWorkloadsWeight: int64(ptr.Deref(scoring.PendingWorkloadsWeight, 1)),
+		fairSharingWeight:      int64(ptr.Deref(scoring.FairSharingWeight, 1)),
+	}
+}
+
+// NewCapacityAwareDispatcherReconciler returns a dispatcher which nominates the
+// worker clusters incrementally, the best scoring first.
+func NewCapacityAwareDispatcherReconciler(
+	c client.Client,
+	helper *admissioncheck.MultiKueueStoreHelper,
+	roleTracker *roletracker.RoleTracker,
+	workers WorkerClusters,
+	cfg *kueueconfig.CapacityAwareDispatcherConfig,
+) *IncrementalDispatcherReconciler {
+	r := NewIncrementalDispatcherReconciler(c, helper, roleTracker, nil)
+	r.controllerName = CapacityAwareDispatcherControllerName
+	r.scorer = newClusterScorer(workers, cfg)
+	return r
+}
+
+type scoredCluster struct {
+	name   string
+	score  int64
+	scored bool
+}
+
+// nextNominatedWorkers returns the best scoring worker clusters not nominated yet.
+// The clusters which can't be scored, for example because they are not connected
+// or don't have the LocalQueue of the workload, are nominated last.
+func (s *clusterScorer) nextNominatedWorkers(ctx context.Context, log logr.Logger, wl *kueue.Workload, remoteClusters []string) ([]string, error) {
+	alreadyNominated := sets.New(wl.Status.NominatedClusterNames...)
+	requests := workloadRequests(wl)
+
+	candidates := make([]scoredCluster, 0, len(remoteClusters))
+	for _, remoteWorker := range remoteClusters {
+		if alreadyNominated.Has(remoteWorker) {
+			continue
+		}
+		score, scored := s.score(ctx, log, wl, requests, remoteWorker)
+		candidates = append(candidates, scoredCluster{name: remoteWorker, score: score, scored: scored})
+	}
+	// The sort is stable, so the ties keep the order of the MultiKueueConfig.
+	slices.SortStableFunc(candidates, func(a, b scoredCluster) int {
+		if a.scored != b.scored {
+			if a.scored {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(b.score, a.score)
+	})
+
+	log.V(5).Info("proceeding worker clusters nomination by score", "alreadyNominatedClusterNames", alreadyNominated, "candidates", candidates)
+
+	if len(candidates) == 0 {
+		return nil, ErrNoMoreWorkers
+	}
+	workers := make([]string, 0, min(s.stepSize, len(candidates)))
+	for _, c := range candidates[:min(s.stepSize, len(candidates))] {
+		workers = append(workers, c.name)
+	}
+	return workers, nil
+}
+
+// score returns the weighted average of the signals of the worker cluster,
+// from 0 to maxSignalScore, and false if the cluster can't be scored.
+func (s *clusterScorer) score(ctx context.Context, log logr.Logger, wl *kueue.Workload, requests map[corev1.ResourceName]int64, clusterName string) (int64, bool) {
+	log = log.WithValues("workerCluster", clusterName)
+	remoteClient, connected := s.workers.WorkerClient(clusterName)
+	if !connected {
+		log.V(4).Info("Worker cluster is not connected, it can't be scored")
+		return 0, false
+	}
+	lq := &kueue.LocalQueue{}
+	if err := remoteClient.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: string(wl.Spec.QueueName)}, lq); err != nil {
+		log.V(4).Info("Failed to get the LocalQueue in the worker cluster, it can't be scored", "error", err)
+		return 0, false
+	}
+	cq := &kueue.ClusterQueue{}
+	if err := remoteClient.Get(ctx, types.NamespacedName{Name: string(lq.Spec.ClusterQueue)}, cq); err != nil {
+		log.V(4).Info("Failed to get the ClusterQueue in the worker cluster, it can't be scored", "error", err)
+		return 0, false
+	}
+
+	totalWeight := s.freeQuotaWeight + s.pendingWorkloadsWeight + s.fairSharingWeight
+	if totalWeight == 0 {
+		return 0, true
+	}
+	score := (s.freeQuotaWeight*freeQuotaScore(cq, requests) +
+		s.pendingWorkloadsWeight*pendingWorkloadsScore(cq) +
+		s.fairSharingWeight*fairSharingScore(cq)) / totalWeight
+	log.V(5).Info("Scored worker cluster", "clusterQueue", cq.Name, "score", score)
+	return score, true
+}
+
+// freeQuotaScore scores the fraction of the requests which fit in the unused
+// nominal quota of the ClusterQueue, for the least available resource.
+func freeQuotaScore(cq *kueue.ClusterQueue, requests map[corev1.ResourceName]int64) int64 {
+	free := make(map[corev1.ResourceName]int64)
+	for _, rg := range cq.Spec.ResourceGroups {
+		for _, flavor := range rg.Flavors {
+			for _, r := range flavor.Resources {
+				free[r.Name] += resources.ResourceValue(r.Name, r.NominalQuota)
+			}
+		}
+	}
+	for _, flavor := range cq.Status.FlavorsUsage {
+		for _, r := range flavor.Resources {
+			free[r.Name] -= resources.ResourceValue(r.Name, r.Total)
+		}
+	}
+	score := int64(maxSignalScore)
+	for name, request := range requests {
+		if request <= 0 {
+			continue
+		}
+		available := max(free[name], 0)
+		if available < request {
+			score = min(score, available*maxSignalScore/request)
+		}
+	}
+	return score
+}
+
+// pendingWorkloadsScore scores the ClusterQueue lower as more workloads are
+// pending in it.
+func pendingWorkloadsScore(cq *kueue.ClusterQueue) int64 {
+	return maxSignalScore / (1 + int64(max(cq.Status.PendingWorkloads, 0)))
+}
+
+// fairSharingScore scores the ClusterQueue lower as its weighted share grows.
+func fairSharingScore(cq *kueue.ClusterQueue) int64 {
+	if cq.Status.FairSharing == nil || cq.Status.FairSharing.WeightedShare <= 0 {
+		return maxSignalScore
+	}
+	share := min(cq.Status.FairSharing.WeightedShare, 1<<40)
+	return maxSignalScore * fairSharingShareScale / (fairSharingShareScale + share)
+}
+
+func workloadRequests(wl *kueue.Workload) map[corev1.ResourceName]int64 {
+	total := make(map[corev1.ResourceName]int64)
+	for _, ps := range workload.NewInfo(wl).TotalRequests {
+		if ps.Requests == nil {
+			continue
+		}
+		for name, value := range resources.ToMap(ps.Requests) {
+			total[name] += value
+		}
+	}
+	return total
+}
@@ -0,0 +1,210 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloaddispatcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kueueconfig "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+type fakeWorkerClusters map[string]client.Reader
+
+func (f fakeWorkerClusters) WorkerClient(clusterName string) (client.Reader, bool) {
+	c, found := f[clusterName]
+	return c, found
+}
+
+func TestCapacityAwareDispatcherNominateWorkers(t *testing.T) {
+	const testName = "test-wl"
+	now := time.Now()
+	fakeClock := testingclock.NewFakeClock(now)
+	baseWl := utiltestingapi.MakeWorkload(testName, metav1.NamespaceDefault).
+		Queue("lq").
+		Request(corev1.ResourceCPU, "4").
+		AdmissionCheck(kueue.AdmissionCheckState{
+			Name:  "ac1",
+			State: kueue.CheckStatePending,
+		})
+
+	scheme := runtime.NewScheme()
+	if err := kueue.AddToScheme(scheme); err != nil {
+		t.Fatalf("Fail to add to scheme %s", err)
+	}
+	worker := func(cq *kueue.ClusterQueue) client.Reader {
+		lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj()
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(lq, cq).Build()
+	}
+	cq := func(nominal, used string, pending int32) *kueue.ClusterQueue {
+		cq := utiltestingapi.MakeClusterQueue("cq").
+			ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, nominal).Obj()).
+			PendingWorkloads(pending).
+			Obj()
+		cq.Status.FlavorsUsage = []kueue.FlavorUsage{{
+			Name:      "default",
+			Resources: []kueue.ResourceUsage{{Name: corev1.ResourceCPU, Total: resource.MustParse(used)}},
+		}}
+		return cq
+	}
+	workers := fakeWorkerClusters{
+		// Has room for the workload.
+		"free": worker(cq("10", "2", 0)),
+		// Has room for half of the workload.
+		"half": worker(cq("10", "8", 0)),
+		// Has room for the workload, but a long queue.
+		"busy": worker(cq("10", "2", 9)),
+		// Is full.
+		"full": worker(cq("10", "10", 0)),
+		// Doesn't have the LocalQueue.
+		"no-queue": fake.NewClientBuilder().WithScheme(scheme).Build(),
+	}
+
+	testCases := map[string]struct {
+		remoteClusters        []string
+		workload              *kueue.Workload
+		cfg                   *kueueconfig.CapacityAwareDispatcherConfig
+		advanceRoundTime      bool
+		wantErr               error
+		wantNominatedClusters []string
+	}{
+		"best scoring cluster first": {
+			remoteClusters:        []string{"no-queue", "full", "half", "busy", "free"},
+			workload:              baseWl.DeepCopy(),
+			wantNominatedClusters: []string{"free"},
+		},
+		"next best scoring clusters after the round expired": {
+			remoteClusters: []string{"no-queue", "full", "half", "busy", "free"},
+			workload:       baseWl.Clone().NominatedClusterNames("free").Obj(),
+			cfg: &kueueconfig.CapacityAwareDispatcherConfig{
+				StepSize: new(int32(2)),
+			},
+			advanceRoundTime:      true,
+			wantNominatedClusters: []string{"free", "busy", "half"},
+		},
+		"not connected and unscorable clusters are nominated last": {
+			remoteClusters: []string{"disconnected", "no-queue", "full"},
+			workload:       baseWl.DeepCopy(),
+			cfg: &kueueconfig.CapacityAwareDispatcherConfig{
+				StepSize: new(int32(2)),
+			},
+			wantNominatedClusters: []string{"full", "disconnected"},
+		},
+		"only pending workloads are scored": {
+			remoteClusters: []string{"busy", "half"},
+			workload:       baseWl.DeepCopy(),
+			cfg: &kueueconfig.CapacityAwareDispatcherConfig{
+				Scoring: &kueueconfig.CapacityAwareScoring{
+					FreeQuotaWeight:        new(int32(0)),
+					PendingWorkloadsWeight: new(int32(1)),
+					FairSharingWeight:      new(int32(0)),
+				},
+			},
+			wantNominatedClusters: []string{"half"},
+		},
+		"all already nominated": {
+			remoteClusters:        []string{"free", "half"},
+			workload:              baseWl.Clone().NominatedClusterNames("free", "half").Obj(),
+			advanceRoundTime:      true,
+			wantErr:               ErrNoMoreWorkers,
+			wantNominatedClusters: []string{"free", "half"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			objs := []client.Object{tc.workload}
+			cl := fake.NewClientBuilder().WithScheme(scheme).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(ctx context.Context, client client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						tc.workload.Status.NominatedClusterNames = obj.(*kueue.Workload).Status.NominatedClusterNames
+						return utiltesting.TreatSSAAsStrategicMerge(ctx, client, subResourceName, obj, patch, opts...)
+					},
+				}).WithObjects(objs...).WithStatusSubresource(objs...).Build()
+
+			reconciler := &IncrementalDispatcherReconciler{
+				client:          cl,
+				clock:           fakeClock,
+				roundStartTimes: utilmaps.NewSyncMap[types.NamespacedName, time.Time](0),
+				scorer:          newClusterScorer(workers, tc.cfg),
+			}
+
+			key := types.NamespacedName{Namespace: tc.workload.Namespace, Name: tc.workload.Name}
+			if tc.advanceRoundTime {
+				reconciler.setRoundStartTime(key, fakeClock.Now().Add(-incrementalDispatcherRoundTimeout-time.Second))
+			}
+
+			ctx, log := utiltesting.ContextWithLog(t)
+			_, gotErr := reconciler.nominateWorkers(ctx, tc.workload, tc.remoteClusters, log)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantNominatedClusters, tc.workload.Status.NominatedClusterNames, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected nominated clusters (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterQueueSignals(t *testing.T) {
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").
+			Resource(corev1.ResourceCPU, "4").
+			Resource("nvidia.com/gpu", "8").
+			Obj()).
+		PendingWorkloads(3).
+		Obj()
+	cq.Status.FlavorsUsage = []kueue.FlavorUsage{{
+		Name: "default",
+		Resources: []kueue.ResourceUsage{
+			{Name: corev1.ResourceCPU, Total: resource.MustParse("1")},
+			{Name: "nvidia.com/gpu", Total: resource.MustParse("6")},
+		},
+	}}
+	cq.Status.FairSharing = &kueue.FairSharingStatus{WeightedShare: 1000}
+
+	requests := map[corev1.ResourceName]int64{corev1.ResourceCPU: 2000, "nvidia.com/gpu": 4}
+	if got := freeQuotaScore(cq, requests); got != 50 {
+		t.Errorf("Unexpected free quota score, want=50, got=%d", got)
+	}
+	if got := freeQuotaScore(cq, map[corev1.ResourceName]int64{"example.com/fpga": 1}); got != 0 {
+		t.Errorf("Unexpected free quota score for an uncovered resource, want=0, got=%d", got)
+	}
+	if got := pendingWorkloadsScore(cq); got != 25 {
+		t.Errorf("Unexpected pending workloads score, want=25, got=%d", got)
+	}
+	if got := fairSharingScore(cq); got != 50 {
+		t.Errorf("Unexpected fair sharing score, want=50, got=%d", got)
+	}
+}
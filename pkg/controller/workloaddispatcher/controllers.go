@@ -17,27 +17,43 @@ limitations under the License.
 package workloaddispatcher
 
 import (
+	"errors"
+
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 )
 
-func SetupControllers(mgr ctrl.Manager, cfg *configapi.Configuration, roleTracker *roletracker.RoleTracker) (string, error) {
-	if *cfg.MultiKueue.DispatcherName != configapi.MultiKueueDispatcherModeIncremental {
+func SetupControllers(mgr ctrl.Manager, cfg *configapi.Configuration, roleTracker *roletracker.RoleTracker, workers WorkerClusters) (string, error) {
+	var rec *IncrementalDispatcherReconciler
+	switch *cfg.MultiKueue.DispatcherName {
+	case configapi.MultiKueueDispatcherModeIncremental:
+		helper, err := admissioncheck.NewMultiKueueStoreHelper(mgr.GetClient())
+		if err != nil {
+			return "", err
+		}
+		rec = NewIncrementalDispatcherReconciler(mgr.GetClient(), helper, roleTracker, cfg.MultiKueue.IncrementalDispatcherConfig)
+	case configapi.MultiKueueDispatcherModeCapacityAware:
+		if !features.Enabled(features.MultiKueueCapacityAwareDispatcher) {
+			return "", nil
+		}
+		if workers == nil {
+			return CapacityAwareDispatcherControllerName, errors.New("the capacity-aware dispatcher requires access to the worker clusters")
+		}
+		helper, err := admissioncheck.NewMultiKueueStoreHelper(mgr.GetClient())
+		if err != nil {
+			return "", err
+		}
+		rec = NewCapacityAwareDispatcherReconciler(mgr.GetClient(), helper, roleTracker, workers, cfg.MultiKueue.CapacityAwareDispatcherConfig)
+	default:
 		return "", nil
 	}
 
-	helper, err := admissioncheck.NewMultiKueueStoreHelper(mgr.GetClient())
-	if err != nil {
-		return "", err
-	}
-
-	idRec := NewIncrementalDispatcherReconciler(mgr.GetClient(), helper, roleTracker, cfg.MultiKueue.IncrementalDispatcherConfig)
-	err = idRec.SetupWithManager(mgr, cfg)
-	if err != nil {
-		return "multikueue-incremental-dispatcher", err
+	if err := rec.SetupWithManager(mgr, cfg); err != nil {
+		return rec.controllerName, err
 	}
 
 	return "", nil
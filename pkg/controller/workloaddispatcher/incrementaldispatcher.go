@@ -60,6 +60,10 @@ type IncrementalDispatcherReconciler struct {
 	roundStartTimes *utilmaps.SyncMap[types.NamespacedName, time.Time]
 	roleTracker     *roletracker.RoleTracker
 	cfg             *kueueconfig.IncrementalDispatcherConfig
+	controllerName  string
+	// scorer orders the worker clusters by score, if set. Otherwise, they are
+	// nominated in the order of the MultiKueueConfig.
+	scorer *clusterScorer
 }
 
 var realClock = clock.RealClock{}
@@ -67,9 +71,9 @@ var _ reconcile.Reconciler = (*IncrementalDispatcherReconciler)(nil)
 
 func (r *IncrementalDispatcherReconciler) SetupWithManager(mgr ctrl.Manager, cfg *kueueconfig.Configuration) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		Named(IncrementalDispatcherControllerName).
+		Named(r.controllerName).
 		For(&kueue.Workload{}).
-		WithLogConstructor(roletracker.NewLogConstructor(r.roleTracker, IncrementalDispatcherControllerName)).
+		WithLogConstructor(roletracker.NewLogConstructor(r.roleTracker, r.controllerName)).
 		Complete(core.WithLeadingManager(mgr, r, &kueue.Workload{}, cfg))
 }
 
@@ -86,6 +90,7 @@ func NewIncrementalDispatcherReconciler(
 		roundStartTimes: utilmaps.NewSyncMap[types.NamespacedName, time.Time](0),
 		roleTracker:     roleTracker,
 		cfg:             cfg,
+		controllerName:  IncrementalDispatcherControllerName,
 	}
 }
 
@@ -130,6 +135,12 @@ func (r *IncrementalDispatcherReconciler) Reconcile(ctx context.Context, req ctr
 		return reconcile.Result{}, nil
 	}
 
+	if excluded := workload.MigrationExcludedCluster(wl, remoteClusters); excluded != "" {
+		log.V(3).Info("Excluding the worker cluster the workload is migrated away from", "excludedCluster", excluded)
+		// Filters a copy, as remoteClusters aliases the cached MultiKueueConfig.
+		remoteClusters = slices.DeleteFunc(slices.Clone(remoteClusters), func(c string) bool { return c == excluded })
+	}
+
 	log.V(3).Info("Nominate Worker Clusters with Incremental Dispatcher")
 	return r.nominateWorkers(ctx, wl, remoteClusters, log)
 }
@@ -145,7 +156,7 @@ func (r *IncrementalDispatcherReconciler) nominateWorkers(ctx context.Context, w
 		return reconcile.Result{RequeueAfter: remainingWaitTime}, nil
 	}
 
-	nextNominatedWorkers, err := getNextNominatedWorkers(log, wl, remoteClusters, r.stepSize())
+	nextNominatedWorkers, err := r.nextNominatedWorkers(ctx, log, wl, remoteClusters)
 	log.V(5).Info("revoke outdated nomination and nominate new worker clusters", "revokedWorkerClusters", wl.Status.NominatedClusterNames, "nominatedWorkerClusters", nextNominatedWorkers)
 	if err != nil {
 		log.Error(err, "Failed to nominate next worker clusters")
@@ -166,6 +177,13 @@ func (r *IncrementalDispatcherReconciler) nominateWorkers(ctx context.Context, w
 	return reconcile.Result{}, nil
 }
 
+func (r *IncrementalDispatcherReconciler) nextNominatedWorkers(ctx context.Context, log logr.Logger, wl *kueue.Workload, remoteClusters []string) ([]string, error) {
+	if r.scorer != nil {
+		return r.scorer.nextNominatedWorkers(ctx, log, wl, remoteClusters)
+	}
+	return getNextNominatedWorkers(log, wl, remoteClusters, r.stepSize())
+}
+
 func getNextNominatedWorkers(log logr.Logger, wl *kueue.Workload, remoteClusters []string, batchSize int) ([]string, error) {
 	alreadyNominated := sets.New(wl.Status.NominatedClusterNames...)
 
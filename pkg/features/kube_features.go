@@ -581,6 +581,130 @@ const (
 	// validate an update if manageJobsWithoutQueueName is set or the new object carries a
 	// queue-name label.
 	ValidateRayAndSparkJobUpdates featuregate.Feature = "ValidateRayAndSparkJobUpdates"
+
+	// owner: @pajakd
+	//
+	// Enables the localqueues/admissionsimulation subresource of the visibility API,
+	// which evaluates a Workload against the current scheduler state without
+	// submitting it. Requires VisibilityOnDemand.
+	AdmissionSimulation featuregate.Feature = "AdmissionSimulation"
+
+	// owner: @pajakd
+	//
+	// Enables the quotaSchedule of ClusterQueues, which overrides the quotas of
+	// the flavors during recurring time windows.
+	TimeWindowedQuotas featuregate.Feature = "TimeWindowedQuotas"
+
+	// owner: @pajakd
+	//
+	// Enables the Reservation API, which books quota of ClusterQueues and
+	// Cohorts for the workloads of a team during a future time window.
+	CapacityReservations featuregate.Feature = "CapacityReservations"
+
+	// owner: @pajakd
+	//
+	// Enables the Backfill queueing strategy of ClusterQueues, which admits
+	// workloads behind a blocked head only if they finish before its
+	// expected start time.
+	BackfillQueueing featuregate.Feature = "BackfillQueueing"
+
+	// owner: @pajakd
+	//
+	// Enables the estimation of the admission time of pending workloads in
+	// the visibility API.
+	AdmissionTimeEstimation featuregate.Feature = "AdmissionTimeEstimation"
+
+	// owner: @pajakd
+	//
+	// Enables the scheduling profile in the Kueue Configuration, which runs
+	// plugins at the extension points of the admission cycle.
+	SchedulingFramework featuregate.Feature = "SchedulingFramework"
+
+	// owner: @pajakd
+	//
+	// Enables the shape constraints between the PodSets of a Workload, which
+	// keep the counts of coupled PodSets proportional on partial admission.
+	PodSetShapeConstraints featuregate.Feature = "PodSetShapeConstraints"
+
+	// owner: @pajakd
+	//
+	// Enables the prices of ResourceFlavors, the LowestCost flavor fungibility
+	// preference and the cost of the admitted Workloads.
+	FlavorCost featuregate.Feature = "FlavorCost"
+
+	// owner: @pajakd
+	//
+	// Enables the accounting of the quota reserved and used over time by the
+	// Workloads, persisted in the status of LocalQueues and exposed through the
+	// visibility API.
+	UsageAccounting featuregate.Feature = "UsageAccounting"
+
+	// owner: @pajakd
+	//
+	// Enables the MultiKueue Capacity-Aware Dispatcher, which nominates the worker
+	// clusters in the order of their score, computed from the status of their
+	// ClusterQueues.
+	MultiKueueCapacityAwareDispatcher featuregate.Feature = "MultiKueueCapacityAwareDispatcher"
+
+	// owner: @pajakd
+	//
+	// Enables the migration of MultiKueue workloads away from the worker cluster
+	// where they are admitted, when the worker cluster is lost or when requested
+	// with an annotation, recording the migrations in the Workload status.
+	MultiKueueWorkloadMigration featuregate.Feature = "MultiKueueWorkloadMigration"
+
+	// owner: @pajakd
+	//
+	// Enables the visibility server of the MultiKueue manager cluster to list the
+	// workloads dispatched to the worker clusters, with their state in each of
+	// the worker clusters queried from the worker visibility servers.
+	MultiKueueFederatedVisibility featuregate.Feature = "MultiKueueFederatedVisibility"
+
+	// owner: @pajakd
+	//
+	// Enables the JobIntegration API, which lets Kueue manage the jobs of kinds
+	// without a built-in integration, registering their reconcilers and
+	// webhooks at runtime.
+	JobIntegrations featuregate.Feature = "JobIntegrations"
+
+	// owner: @pajakd
+	//
+	// Enables the workflow budgets, capping the total usage of the admitted
+	// workloads of a workflow, and the workflow reservations, keeping quota
+	// for the steps of a workflow ahead of their admission.
+	WorkflowBudgets featuregate.Feature = "WorkflowBudgets"
+
+	// owner: @pajakd
+	//
+	// Enables the translation of the Volcano and scheduler-plugins PodGroups
+	// referenced by pods into Kueue pod groups.
+	PodGroupCompatibility featuregate.Feature = "PodGroupCompatibility"
+
+	// owner: @pajakd
+	//
+	// Enables the network distances between the topology domains of a Topology,
+	// used by TAS to place the pods which do not fit in a single topology domain
+	// on the closest domains.
+	TASNetworkDistance featuregate.Feature = "TASNetworkDistance"
+
+	// owner: @pajakd
+	//
+	// Enables the defragmentation of the TAS flavors of the ClusterQueues,
+	// evicting the admitted workloads whose placement after a pending workload
+	// opens a topology domain required by the pending workload.
+	TASDefragmentation featuregate.Feature = "TASDefragmentation"
+
+	// owner: @pajakd
+	//
+	// Enables the structured explanation, in the Workload status, of why TAS
+	// could not place the PodSets of a pending workload.
+	TASPlacementExplanation featuregate.Feature = "TASPlacementExplanation"
+
+	// owner: @pajakd
+	//
+	// Allows a ResourceFlavor to reference several Topologies, each selecting
+	// its own nodes, with spec.topologies.
+	TASMultiTopologyFlavors featuregate.Feature = "TASMultiTopologyFlavors"
 )
 
 func init() {
@@ -601,11 +725,17 @@ var defaultFeatureGateDependencies = map[featuregate.Feature][]featuregate.Featu
 	TASHandleOverlappingFlavors:                 {TopologyAwareScheduling},
 	TASProfileMixed:                             {TopologyAwareScheduling},
 	TASRecomputeAssignmentWithinSchedulingCycle: {TopologyAwareScheduling},
+	TASNetworkDistance:                          {TopologyAwareScheduling},
+	TASDefragmentation:                          {TopologyAwareScheduling},
+	TASPlacementExplanation:                     {TopologyAwareScheduling},
+	TASMultiTopologyFlavors:                     {TopologyAwareScheduling},
 	ElasticJobsViaWorkloadSlicesWithTAS:         {ElasticJobsViaWorkloadSlices, TopologyAwareScheduling},
 	KueueDRAIntegrationExtendedResource:         {KueueDRAIntegration},
 	KueueDRAIntegrationPartitionableDevices:     {KueueDRAIntegration},
 	KueueDRAIntegrationConsumableCapacity:       {KueueDRAIntegration},
 	FlavorFungibilityPreserveScanProgress:       {FlavorFungibility},
+	AdmissionSimulation:                         {VisibilityOnDemand},
+	FlavorCost:                                  {FlavorFungibility},
 }
 
 // defaultVersionedFeatureGates consists of all known Kueue-specific feature keys.
@@ -902,6 +1032,76 @@ var defaultVersionedFeatureGates = map[featuregate.Feature]featuregate.Versioned
 	ValidateRayAndSparkJobUpdates: {
 		{Version: version.MustParse("0.18"), Default: true, PreRelease: featuregate.Beta}, // GA in 0.21
 	},
+
+	AdmissionSimulation: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TimeWindowedQuotas: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	CapacityReservations: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	BackfillQueueing: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	AdmissionTimeEstimation: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	SchedulingFramework: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	PodSetShapeConstraints: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorCost: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	UsageAccounting: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	MultiKueueCapacityAwareDispatcher: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	MultiKueueWorkloadMigration: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	MultiKueueFederatedVisibility: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	JobIntegrations: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	WorkflowBudgets: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	PodGroupCompatibility: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TASNetworkDistance: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TASDefragmentation: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TASPlacementExplanation: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
+
+	TASMultiTopologyFlavors: {
+		{Version: version.MustParse("0.20"), Default: false, PreRelease: featuregate.Alpha},
+	},
 }
 
 func SetFeatureGateDuringTest(tb testing.TB, f featuregate.Feature, value bool) {
@@ -967,12 +1167,17 @@ func Enabled(f featuregate.Feature) bool {
 	return utilfeature.DefaultFeatureGate.Enabled(f)
 }
 
-func LogFeatureGates(log logr.Logger) {
+// Enablement returns the enablement of the Kueue feature gates.
+func Enablement() map[featuregate.Feature]bool {
 	features := make(map[featuregate.Feature]bool, len(defaultVersionedFeatureGates))
 	for f := range utilfeature.DefaultMutableFeatureGate.GetAll() {
 		if _, ok := defaultVersionedFeatureGates[f]; ok {
 			features[f] = Enabled(f)
 		}
 	}
-	log.V(2).Info("Loaded feature gates", "featureGates", features)
+	return features
+}
+
+func LogFeatureGates(log logr.Logger) {
+	log.V(2).Info("Loaded feature gates", "featureGates", Enablement())
 }
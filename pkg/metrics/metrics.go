@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -134,6 +135,14 @@ var (
 	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",priority_class="the priority class name",replica_role="one of `leader`, `follower`, or `standalone`"
 	QuotaReservedWorkloadsTotal *prometheus.CounterVec
 
+	// +metricsdoc:group=clusterqueue
+	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",replica_role="one of `leader`, `follower`, or `standalone`"
+	QuotaReservedWorkloadsCostTotal *prometheus.CounterVec
+
+	// +metricsdoc:group=clusterqueue
+	// +metricsdoc:labels=cluster_queue="the name of the ClusterQueue",replica_role="one of `leader`, `follower`, or `standalone`"
+	LowestCostSearchIncompleteTotal *prometheus.CounterVec
+
 	// +metricsdoc:group=localqueue
 	// +metricsdoc:labels=name="the name of the LocalQueue",namespace="the namespace of the LocalQueue",priority_class="the priority class name",replica_role="one of `leader`, `follower`, or `standalone`"
 	LocalQueueQuotaReservedWorkloadsTotal *prometheus.CounterVec
@@ -512,6 +521,22 @@ Globally configured custom ClusterQueue labels are also appended to the base lab
 		}, append([]string{"cluster_queue", "priority_class", "replica_role"}, clusterQueueMetricsLabels...),
 	)
 
+	QuotaReservedWorkloadsCostTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "quota_reserved_workloads_cost_total",
+			Help:      "The total cost of the quota reserved workloads per 'cluster_queue', according to the prices of the assigned ResourceFlavors",
+		}, append([]string{"cluster_queue", "replica_role"}, clusterQueueMetricsLabels...),
+	)
+
+	LowestCostSearchIncompleteTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "lowest_cost_search_incomplete_total",
+			Help:      "The total number of quota reserved workloads per 'cluster_queue' whose flavors were chosen by a LowestCost search stopped at its budget, so that the flavors may not be the cheapest",
+		}, append([]string{"cluster_queue", "replica_role"}, clusterQueueMetricsLabels...),
+	)
+
 	LocalQueueQuotaReservedWorkloadsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: constants.KueueName,
@@ -575,6 +600,8 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "QuotaWindow" means that the workload was evicted because the ClusterQueue usage exceeded its quota after a quota window started or ended.
+- "TopologyDefragmentation" means that the workload was evicted to open a topology domain for a pending workload of its ClusterQueue.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -711,6 +738,8 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "QuotaWindow" means that the workload was evicted because the ClusterQueue usage exceeded its quota after a quota window started or ended.
+- "TopologyDefragmentation" means that the workload was evicted to open a topology domain for a pending workload of its ClusterQueue.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -740,6 +769,8 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "QuotaWindow" means that the workload was evicted because the ClusterQueue usage exceeded its quota after a quota window started or ended.
+- "TopologyDefragmentation" means that the workload was evicted to open a topology domain for a pending workload of its ClusterQueue.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -761,6 +792,8 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "QuotaWindow" means that the workload was evicted because the ClusterQueue usage exceeded its quota after a quota window started or ended.
+- "TopologyDefragmentation" means that the workload was evicted to open a topology domain for a pending workload of its ClusterQueue.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.
 The label 'underlying_cause' can have the following values:
 - "" means that the value in 'reason' label is the root cause for eviction.
@@ -800,6 +833,8 @@ The label 'reason' can have the following values:
 - "ClusterQueueStopped" means that the workload was evicted because the ClusterQueue is stopped.
 - "LocalQueueStopped" means that the workload was evicted because the LocalQueue is stopped.
 - "NodeFailures" means that the workload was evicted due to node failures when using TopologyAwareScheduling.
+- "QuotaWindow" means that the workload was evicted because the ClusterQueue usage exceeded its quota after a quota window started or ended.
+- "TopologyDefragmentation" means that the workload was evicted to open a topology domain for a pending workload of its ClusterQueue.
 - "Deactivated" means that the workload was evicted because spec.active is set to false.`,
 			Buckets: generateExponentialBuckets(14),
 		}, append([]string{"cluster_queue", "reason", "replica_role"}, clusterQueueMetricsLabels...),
@@ -1078,6 +1113,16 @@ func QuotaReservedWorkload(cqName kueue.ClusterQueueReference, priorityClass str
 	QuotaReservedWaitTime.WithLabelValues(labels...).Observe(waitTime.Seconds())
 }
 
+func ReportQuotaReservedWorkloadCost(cqName kueue.ClusterQueueReference, cost float64, customLabelValues []string, tracker *roletracker.RoleTracker) {
+	labels := append([]string{string(cqName), roletracker.GetRole(tracker)}, customLabelValues...)
+	QuotaReservedWorkloadsCostTotal.WithLabelValues(labels...).Add(cost)
+}
+
+func ReportLowestCostSearchIncomplete(cqName kueue.ClusterQueueReference, customLabelValues []string, tracker *roletracker.RoleTracker) {
+	labels := append([]string{string(cqName), roletracker.GetRole(tracker)}, customLabelValues...)
+	LowestCostSearchIncompleteTotal.WithLabelValues(labels...).Inc()
+}
+
 func LocalQueueQuotaReservedWorkload(lq LocalQueueReference, priorityClass string, waitTime time.Duration, customLabelValues []string, tracker *roletracker.RoleTracker) {
 	labels := append([]string{string(lq.Name), lq.Namespace, priorityClass, roletracker.GetRole(tracker)}, customLabelValues...)
 	LocalQueueQuotaReservedWorkloadsTotal.WithLabelValues(labels...).Inc()
@@ -1134,6 +1179,57 @@ func AdmittedWorkload(cqName kueue.ClusterQueueReference, priorityClass string,
 	AdmissionWaitTime.WithLabelValues(labels...).Observe(waitTime.Seconds())
 }
 
+// WaitTimeBucket is a bucket of a wait time distribution, with the number of
+// observations up to its upper bound.
+type WaitTimeBucket struct {
+	UpperBound      time.Duration
+	CumulativeCount uint64
+}
+
+// WaitTimeDistribution is the distribution of the wait times observed by a
+// histogram, with its finite buckets sorted by upper bound.
+type WaitTimeDistribution struct {
+	Count   uint64
+	Buckets []WaitTimeBucket
+}
+
+// AdmissionWaitTimes returns the distribution of the admission wait times in
+// the ClusterQueue, merged across the priority classes and the custom labels.
+func AdmissionWaitTimes(cqName kueue.ClusterQueueReference) WaitTimeDistribution {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		AdmissionWaitTime.Collect(ch)
+		close(ch)
+	}()
+	var result WaitTimeDistribution
+	for m := range ch {
+		var metric dto.Metric
+		if err := m.Write(&metric); err != nil || metric.Histogram == nil || !hasLabel(&metric, "cluster_queue", string(cqName)) {
+			continue
+		}
+		result.Count += metric.Histogram.GetSampleCount()
+		buckets := metric.Histogram.GetBucket()
+		if result.Buckets == nil {
+			result.Buckets = make([]WaitTimeBucket, len(buckets))
+		}
+		// The series of the histogram share the same buckets.
+		for i, b := range buckets {
+			result.Buckets[i].UpperBound = time.Duration(b.GetUpperBound() * float64(time.Second))
+			result.Buckets[i].CumulativeCount += b.GetCumulativeCount()
+		}
+	}
+	return result
+}
+
+func hasLabel(metric *dto.Metric, name, value string) bool {
+	for _, l := range metric.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue() == value
+		}
+	}
+	return false
+}
+
 func LocalQueueAdmittedWorkload(lq LocalQueueReference, priorityClass string, waitTime time.Duration, customLabelValues []string, tracker *roletracker.RoleTracker) {
 	labels := append([]string{string(lq.Name), lq.Namespace, priorityClass, roletracker.GetRole(tracker)}, customLabelValues...)
 	LocalQueueAdmittedWorkloadsTotal.WithLabelValues(labels...).Inc()
@@ -1248,6 +1344,8 @@ func ClearClusterQueueMetrics(cq kueue.ClusterQueueReference) {
 	// Clears all cluster_queue-scoped gauges for cqName.
 	clearScopedGaugeMetrics(gaugeCleanupScopeClusterQueue, prometheus.Labels{"cluster_queue": cqName})
 	QuotaReservedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+	QuotaReservedWorkloadsCostTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+	LowestCostSearchIncompleteTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	QuotaReservedWaitTime.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	FinishedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	ExecutionTimeSeconds.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
@@ -1577,6 +1675,8 @@ func Register() {
 		PendingSchedulingHashes,
 		FinishedWorkloads,
 		QuotaReservedWorkloadsTotal,
+		QuotaReservedWorkloadsCostTotal,
+		LowestCostSearchIncompleteTotal,
 		FinishedWorkloadsTotal,
 		QuotaReservedWaitTime,
 		PodsReadyToEvictedTimeSeconds,
@@ -129,6 +129,39 @@ func TestReportAndCleanupClusterQueueMetrics(t *testing.T) {
 	expectFilteredMetricsCount(t, ClusterQueueResourceUsage, 0, "cluster_queue", "queue")
 }
 
+func TestAdmissionWaitTimes(t *testing.T) {
+	AdmittedWorkload("wait-time-cq", "high", 2*time.Second, nil, nil)
+	AdmittedWorkload("wait-time-cq", "low", 8*time.Second, nil, nil)
+	AdmittedWorkload("wait-time-cq", "low", 24*time.Hour, nil, nil)
+	AdmittedWorkload("other-cq", "low", time.Second, nil, nil)
+	t.Cleanup(func() {
+		ClearClusterQueueMetrics("wait-time-cq")
+		ClearClusterQueueMetrics("other-cq")
+	})
+
+	got := AdmissionWaitTimes("wait-time-cq")
+	if got.Count != 3 {
+		t.Errorf("Unexpected count, want=3, got=%d", got.Count)
+	}
+	wantBuckets := map[time.Duration]uint64{
+		time.Second:             0,
+		2500 * time.Millisecond: 1,
+		5 * time.Second:         1,
+		10 * time.Second:        2,
+	}
+	for _, b := range got.Buckets {
+		if want, found := wantBuckets[b.UpperBound]; found && b.CumulativeCount != want {
+			t.Errorf("Unexpected cumulative count of the bucket up to %v, want=%d, got=%d", b.UpperBound, want, b.CumulativeCount)
+		}
+	}
+	if last := got.Buckets[len(got.Buckets)-1]; last.CumulativeCount != 2 {
+		t.Errorf("Unexpected cumulative count of the last bucket, want=2, got=%d", last.CumulativeCount)
+	}
+	if empty := AdmissionWaitTimes("missing-cq"); empty.Count != 0 || len(empty.Buckets) != 0 {
+		t.Errorf("Unexpected distribution of a ClusterQueue without admissions: %+v", empty)
+	}
+}
+
 func TestReportAndCleanupClusterQueueQuotas(t *testing.T) {
 	const cqName = "queue"
 
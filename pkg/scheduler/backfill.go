@@ -0,0 +1,124 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// blockedByBackfill reports whether the workload is queued behind the blocked
+// head of a ClusterQueue with the Backfill queueing strategy, and isn't
+// guaranteed to finish before the head is expected to start.
+func (s *Scheduler) blockedByBackfill(wl *workload.Info) (string, bool) {
+	resv := s.queues.BackfillReservationAhead(wl)
+	if resv == nil || resv.FitsBefore(wl.Obj, s.clock.Now()) {
+		return "", false
+	}
+	head := workload.Key(resv.Head.Obj)
+	if resv.ShadowTime == nil {
+		return fmt.Sprintf("Workload is queued behind %s, whose start time can't be estimated", head), true
+	}
+	return fmt.Sprintf("Workload can't be backfilled, as it may not finish before %s is expected to start at %s",
+		head, resv.ShadowTime.UTC().Format(time.RFC3339)), true
+}
+
+// reserveForBackfill records the reservation of a workload which doesn't fit
+// at the head of a ClusterQueue with the Backfill queueing strategy. It's
+// called before the snapshot is modified on behalf of the workload.
+func (s *Scheduler) reserveForBackfill(ctx context.Context, e *entry, snap *schdcache.Snapshot) {
+	if !s.queues.BackfillEnabled(e.ClusterQueue) {
+		return
+	}
+	if s.queues.BackfillReservationAhead(&e.Info) != nil {
+		// A workload ahead of this one already holds the reservation.
+		return
+	}
+	head := e.Info
+	shadowTime := s.backfillShadowTime(ctx, &head, snap)
+	s.queues.SetBackfillReservation(&head, shadowTime)
+	ctrl.LoggerFrom(ctx).V(3).Info("Reserved the head of the ClusterQueue for backfilling", "shadowTime", shadowTime)
+}
+
+// backfillShadowTime estimates when the workload will fit in the quota,
+// assuming that the admitted workloads in its Cohort tree run until their
+// maximum execution time. Returns nil if the workload doesn't fit even
+// once all of them finished.
+func (s *Scheduler) backfillShadowTime(ctx context.Context, wl *workload.Info, snap *schdcache.Snapshot) *time.Time {
+	cq := snap.ClusterQueue(wl.ClusterQueue)
+	if cq == nil {
+		return nil
+	}
+	cqs := []*schdcache.ClusterQueueSnapshot{cq}
+	if cq.HasParent() {
+		cqs = cq.Parent().Root().SubtreeClusterQueues()
+	}
+	type finishingWorkload struct {
+		info *workload.Info
+		end  time.Time
+	}
+	var finishing []finishingWorkload
+	for _, c := range cqs {
+		for _, admitted := range c.Workloads {
+			if end := workload.ExpectedEndTime(admitted.Obj); end != nil {
+				finishing = append(finishing, finishingWorkload{info: admitted, end: *end})
+			}
+		}
+	}
+	slices.SortFunc(finishing, func(a, b finishingWorkload) int {
+		return a.end.Compare(b.end)
+	})
+
+	// The flavor scan starts over, as the workload is evaluated against
+	// hypothetical states of the snapshot.
+	wlCopy := *wl
+	wlCopy.LastAssignment = nil
+	fitsAfter := func(n int) bool {
+		removed := make([]*workload.Info, 0, n)
+		for _, f := range finishing[:n] {
+			removed = append(removed, f.info)
+		}
+		defer snap.SimulateWorkloadUsageRemoval(removed)()
+		defer snap.ApplyReservations(&wlCopy)()
+		flvAssigner := flavorassigner.New(
+			&wlCopy, cq, snap.ResourceFlavors, fairsharing.Enabled(s.fairSharing),
+			preemption.NewOracle(s.preemptor, snap), nil,
+			s.quotaCheckStrategy, s.resourceFormatter, s.schedulingCycle.Load(),
+		)
+		assignment := flvAssigner.Assign(ctx, nil)
+		return assignment.RepresentativeMode() == flavorassigner.Fit
+	}
+	n := sort.Search(len(finishing)+1, fitsAfter)
+	switch {
+	case n > len(finishing):
+		return nil
+	case n == 0:
+		return new(s.clock.Now())
+	}
+	return new(finishing[n-1].end)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	"sigs.k8s.io/kueue/pkg/util/routine"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestScheduleBackfill(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	cases := map[string]struct {
+		disableGate    bool
+		wantAdmitted   sets.Set[string]
+		wantHead       string
+		wantShadowTime *time.Time
+	}{
+		"workloads finishing before the shadow time are backfilled": {
+			wantAdmitted:   sets.New("running-short", "running-long", "short"),
+			wantHead:       "big",
+			wantShadowTime: new(now.Add(10 * time.Minute)),
+		},
+		"feature gate disabled": {
+			disableGate:  true,
+			wantAdmitted: sets.New("running-short", "running-long", "long"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.BackfillQueueing, !tc.disableGate)
+			ctx, log := utiltesting.ContextWithLog(t)
+
+			ns := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()
+			rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+			cq := utiltestingapi.MakeClusterQueue("cq").
+				QueueingStrategy(kueue.Backfill).
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas(rf.Name).Resource(corev1.ResourceCPU, "5").Obj()).
+				Obj()
+			lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj()
+			admitted := []*kueue.Workload{
+				utiltestingapi.MakeWorkload("running-short", metav1.NamespaceDefault).
+					Queue(kueue.LocalQueueName(lq.Name)).
+					Request(corev1.ResourceCPU, "2").
+					MaximumExecutionTimeSeconds(600).
+					SimpleReserveQuota(kueue.ClusterQueueReference(cq.Name), rf.Name, now).
+					AdmittedAt(true, now).
+					Obj(),
+				utiltestingapi.MakeWorkload("running-long", metav1.NamespaceDefault).
+					Queue(kueue.LocalQueueName(lq.Name)).
+					Request(corev1.ResourceCPU, "2").
+					MaximumExecutionTimeSeconds(3600).
+					SimpleReserveQuota(kueue.ClusterQueueReference(cq.Name), rf.Name, now).
+					AdmittedAt(true, now).
+					Obj(),
+			}
+			pending := []*kueue.Workload{
+				utiltestingapi.MakeWorkload("big", metav1.NamespaceDefault).
+					Queue(kueue.LocalQueueName(lq.Name)).
+					Creation(now.Add(-3*time.Second)).
+					Request(corev1.ResourceCPU, "3").
+					Obj(),
+				utiltestingapi.MakeWorkload("long", metav1.NamespaceDefault).
+					Queue(kueue.LocalQueueName(lq.Name)).
+					Creation(now.Add(-2*time.Second)).
+					Request(corev1.ResourceCPU, "1").
+					MaximumExecutionTimeSeconds(7200).
+					Obj(),
+				utiltestingapi.MakeWorkload("short", metav1.NamespaceDefault).
+					Queue(kueue.LocalQueueName(lq.Name)).
+					Creation(now.Add(-time.Second)).
+					Request(corev1.ResourceCPU, "1").
+					MaximumExecutionTimeSeconds(300).
+					Obj(),
+			}
+
+			builder := utiltesting.NewClientBuilder().
+				WithObjects(ns, rf, cq, lq).
+				WithStatusSubresource(&kueue.Workload{})
+			for _, wl := range append(admitted, pending...) {
+				builder = builder.WithObjects(wl)
+			}
+			cl := builder.Build()
+			cqCache := schdcache.New(cl)
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+			cqCache.AddOrUpdateResourceFlavor(log, rf)
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+			}
+			if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+			}
+			// The pending workloads are loaded into the queue from the client.
+			if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+				t.Fatalf("Inserting queue %s/%s in manager: %v", lq.Namespace, lq.Name, err)
+			}
+			for _, wl := range admitted {
+				cqCache.AddOrUpdateWorkload(log, wl)
+			}
+
+			scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{},
+				WithClock(t, testingclock.NewFakeClock(now)), WithPreemptionExpectations(preemptexpectations.New()))
+			wg := sync.WaitGroup{}
+			scheduler.setAdmissionRoutineWrapper(routine.NewWrapper(
+				func() { wg.Add(1) },
+				func() { wg.Done() },
+			))
+			ctx, cancel := context.WithTimeout(ctx, queueingTimeout)
+			go qManager.CleanUpOnContext(ctx)
+			defer cancel()
+
+			// Each cycle pops a single workload from the ClusterQueue.
+			for range pending {
+				scheduler.schedule(ctx)
+				wg.Wait()
+			}
+
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Building snapshot: %v", err)
+			}
+			gotAdmitted := sets.New[string]()
+			for _, wl := range snapshot.ClusterQueue(kueue.ClusterQueueReference(cq.Name)).Workloads {
+				gotAdmitted.Insert(wl.Obj.Name)
+			}
+			if diff := cmp.Diff(tc.wantAdmitted, gotAdmitted); diff != "" {
+				t.Errorf("Unexpected admitted workloads (-want,+got):\n%s", diff)
+			}
+
+			resv := qManager.BackfillReservation(kueue.ClusterQueueReference(cq.Name))
+			var gotHead string
+			var gotShadowTime *time.Time
+			if resv != nil {
+				gotHead = string(workload.Key(resv.Head.Obj))
+				gotShadowTime = resv.ShadowTime
+			}
+			wantHead := ""
+			if tc.wantHead != "" {
+				wantHead = metav1.NamespaceDefault + "/" + tc.wantHead
+			}
+			if gotHead != wantHead {
+				t.Errorf("Unexpected head holding the backfill reservation, want=%q, got=%q", wantHead, gotHead)
+			}
+			if diff := cmp.Diff(tc.wantShadowTime, gotShadowTime); diff != "" {
+				t.Errorf("Unexpected shadow time (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/scheduler/trace"
+)
+
+// TraceRecorder records the inputs and outcomes of the scheduling cycles.
+type TraceRecorder interface {
+	Record(*trace.Cycle) error
+}
+
+// traceQueueSize bounds the number of cycles waiting to be recorded. When the
+// recorder falls behind, the following cycles are dropped rather than slowing
+// down the scheduling.
+const traceQueueSize = 16
+
+// pendingTrace is a scheduling cycle waiting to be recorded.
+type pendingTrace struct {
+	cycle *trace.Cycle
+	// namespaces are the namespaces of the heads, captured along with the
+	// API objects missing from the snapshot.
+	namespaces sets.Set[string]
+}
+
+// newCycleTrace captures the inputs of the scheduling cycle held by the heads
+// and the snapshot. It must be called before the nomination, as the following
+// steps mutate the heads and the snapshot.
+//
+// The workloads and the resource flavors are shared with the cache, which
+// never mutates them, so only the heads are copied. The API objects which are
+// not part of the snapshot are captured by the trace recorder, outside of the
+// scheduling loop.
+func (s *Scheduler) newCycleTrace(schedulingCycle int64, now time.Time, heads []qcache.Head, snapshot *schdcache.Snapshot) *pendingTrace {
+	c := &trace.Cycle{
+		SchedulingCycle: schedulingCycle,
+		Time:            metav1.NewTime(now),
+		FeatureGates:    make(map[string]bool),
+		Config:          s.traceConfig,
+		Heads:           make([]trace.Head, 0, len(heads)),
+	}
+	for f, enabled := range features.Enablement() {
+		c.FeatureGates[string(f)] = enabled
+	}
+	namespaces := sets.New[string]()
+	for _, h := range heads {
+		wl := h.Obj.DeepCopy()
+		wl.ManagedFields = nil
+		c.Heads = append(c.Heads, trace.Head{ClusterQueue: h.ClusterQueue, Workload: *wl})
+		namespaces.Insert(wl.Namespace)
+	}
+	slices.SortFunc(c.Heads, func(a, b trace.Head) int { return cmp.Compare(a.ClusterQueue, b.ClusterQueue) })
+
+	for _, rf := range snapshot.ResourceFlavors {
+		rf := *rf
+		rf.ManagedFields = nil
+		c.State.ResourceFlavors = append(c.State.ResourceFlavors, rf)
+	}
+	sortTraceObjects(c.State.ResourceFlavors)
+	for _, cq := range snapshot.ClusterQueues() {
+		for _, wi := range cq.Workloads {
+			wl := *wi.Obj
+			wl.ManagedFields = nil
+			c.State.Workloads = append(c.State.Workloads, wl)
+		}
+		if cq.QuotaWindow != "" {
+			c.QuotaWindows = append(c.QuotaWindows, trace.QuotaWindow{ClusterQueue: cq.Name, Active: cq.QuotaWindow})
+		}
+	}
+	sortTraceObjects(c.State.Workloads)
+	slices.SortFunc(c.QuotaWindows, func(a, b trace.QuotaWindow) int { return cmp.Compare(a.ClusterQueue, b.ClusterQueue) })
+	return &pendingTrace{cycle: c, namespaces: namespaces}
+}
+
+// runTraceRecorder records the pending cycles until the context is done.
+func (s *Scheduler) runTraceRecorder(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case p := <-s.pendingTraces:
+			s.writeCycleTrace(ctx, p)
+		}
+	}
+}
+
+// flushCycleTraces records the pending cycles and returns once there are none.
+func (s *Scheduler) flushCycleTraces(ctx context.Context) {
+	for {
+		select {
+		case p := <-s.pendingTraces:
+			s.writeCycleTrace(ctx, p)
+		default:
+			return
+		}
+	}
+}
+
+// writeCycleTrace completes the state of the cycle and hands it to the
+// recorder. The cycles whose state could not be captured are not recorded.
+func (s *Scheduler) writeCycleTrace(ctx context.Context, p *pendingTrace) {
+	log := ctrl.LoggerFrom(ctx).WithValues("schedulingCycle", p.cycle.SchedulingCycle)
+	if err := s.captureTraceState(ctx, &p.cycle.State, p.namespaces); err != nil {
+		log.Error(err, "Failed to capture the state of the scheduling cycle, the cycle is not recorded")
+		return
+	}
+	if err := s.traceRecorder.Record(p.cycle); err != nil {
+		log.Error(err, "Failed to record the scheduling cycle")
+	}
+}
+
+// captureTraceState captures the API objects the snapshot is built from, but
+// which it doesn't hold. As it runs after the cycle, it might observe changes
+// made to these objects meanwhile.
+func (s *Scheduler) captureTraceState(ctx context.Context, state *trace.State, namespaces sets.Set[string]) error {
+	for _, name := range sets.List(namespaces) {
+		var ns corev1.Namespace
+		if err := s.client.Get(ctx, client.ObjectKey{Name: name}, &ns); err != nil {
+			return fmt.Errorf("getting namespace %q: %w", name, err)
+		}
+		ns.ManagedFields = nil
+		state.Namespaces = append(state.Namespaces, ns)
+	}
+
+	var topologies kueue.TopologyList
+	if err := s.client.List(ctx, &topologies); err != nil {
+		return fmt.Errorf("listing topologies: %w", err)
+	}
+	state.Topologies = stripTraceObjects(topologies.Items)
+	var checks kueue.AdmissionCheckList
+	if err := s.client.List(ctx, &checks); err != nil {
+		return fmt.Errorf("listing admission checks: %w", err)
+	}
+	state.AdmissionChecks = stripTraceObjects(checks.Items)
+	var cohorts kueue.CohortList
+	if err := s.client.List(ctx, &cohorts); err != nil {
+		return fmt.Errorf("listing cohorts: %w", err)
+	}
+	state.Cohorts = stripTraceObjects(cohorts.Items)
+	var cqs kueue.ClusterQueueList
+	if err := s.client.List(ctx, &cqs); err != nil {
+		return fmt.Errorf("listing cluster queues: %w", err)
+	}
+	state.ClusterQueues = stripTraceObjects(cqs.Items)
+	var lqs kueue.LocalQueueList
+	if err := s.client.List(ctx, &lqs); err != nil {
+		return fmt.Errorf("listing local queues: %w", err)
+	}
+	state.LocalQueues = stripTraceObjects(lqs.Items)
+	if features.Enabled(features.CapacityReservations) {
+		var reservations kueue.ReservationList
+		if err := s.client.List(ctx, &reservations); err != nil {
+			return fmt.Errorf("listing reservations: %w", err)
+		}
+		state.Reservations = stripTraceObjects(reservations.Items)
+	}
+	return nil
+}
+
+// recordCycleTrace completes the trace with the outcomes of the entries and
+// queues it for the recorder. Recording doesn't affect the cycle.
+func (s *Scheduler) recordCycleTrace(ctx context.Context, p *pendingTrace, entries, inadmissibleEntries []entry) {
+	c := p.cycle
+	c.Outcomes = make([]trace.Outcome, 0, len(entries)+len(inadmissibleEntries))
+	for i := range entries {
+		c.Outcomes = append(c.Outcomes, newTraceOutcome(&entries[i]))
+	}
+	for i := range inadmissibleEntries {
+		c.Outcomes = append(c.Outcomes, newTraceOutcome(&inadmissibleEntries[i]))
+	}
+	trace.SortOutcomes(c.Outcomes)
+	select {
+	case s.pendingTraces <- p:
+	default:
+		ctrl.LoggerFrom(ctx).V(2).Info("The trace recorder is falling behind, the scheduling cycle is not recorded")
+	}
+}
+
+func newTraceOutcome(e *entry) trace.Outcome {
+	o := trace.Outcome{
+		Namespace:    e.Obj.Namespace,
+		Name:         e.Obj.Name,
+		ClusterQueue: e.ClusterQueue,
+		Status:       string(e.status),
+		Message:      e.inadmissibleMsg,
+	}
+	if len(e.assignment.PodSets) > 0 {
+		o.Mode = e.assignment.RepresentativeMode().String()
+		o.Borrows = e.assignment.RequiresBorrowing()
+	}
+	for _, ps := range e.assignment.PodSets {
+		psa := trace.PodSetAssignment{Name: ps.Name, Count: ps.Count}
+		if len(ps.Flavors) > 0 {
+			psa.Flavors = make(map[corev1.ResourceName]kueue.ResourceFlavorReference, len(ps.Flavors))
+			for res, flv := range ps.Flavors {
+				psa.Flavors[res] = flv.Name
+			}
+		}
+		o.PodSets = append(o.PodSets, psa)
+	}
+	for _, t := range e.preemptionTargets {
+		o.Preempts = append(o.Preempts, trace.Target{
+			Namespace: t.WorkloadInfo.Obj.Namespace,
+			Name:      t.WorkloadInfo.Obj.Name,
+			Reason:    t.Reason,
+		})
+	}
+	return o
+}
+
+// stripTraceObjects drops the managed fields of the listed objects, which are
+// not relevant for the scheduling, and sorts them by key.
+func stripTraceObjects[T any, PT interface {
+	*T
+	client.Object
+}](objs []T) []T {
+	for i := range objs {
+		PT(&objs[i]).SetManagedFields(nil)
+	}
+	sortTraceObjects[T, PT](objs)
+	return objs
+}
+
+func sortTraceObjects[T any, PT interface {
+	*T
+	client.Object
+}](objs []T) {
+	slices.SortFunc(objs, func(a, b T) int {
+		pa, pb := PT(&a), PT(&b)
+		return cmp.Or(cmp.Compare(pa.GetNamespace(), pb.GetNamespace()), cmp.Compare(pa.GetName(), pb.GetName()))
+	})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	"sigs.k8s.io/kueue/pkg/scheduler/trace"
+	"sigs.k8s.io/kueue/pkg/util/routine"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+// traceCapture keeps the last recorded cycle in memory.
+type traceCapture struct {
+	cycle *trace.Cycle
+}
+
+func (c *traceCapture) Record(cycle *trace.Cycle) error {
+	c.cycle = cycle
+	return nil
+}
+
+func TestRecordTrace(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	ns := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()
+	rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+	makeCQ := func(name, cpu string) *kueue.ClusterQueue {
+		return utiltestingapi.MakeClusterQueue(name).
+			ResourceGroup(
+				*utiltestingapi.MakeFlavorQuotas(rf.Name).
+					Resource(corev1.ResourceCPU, cpu).
+					Obj(),
+			).
+			Preemption(kueue.ClusterQueuePreemption{
+				WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+			}).
+			Obj()
+	}
+	cqs := []*kueue.ClusterQueue{makeCQ("cq-a", "4"), makeCQ("cq-b", "2"), makeCQ("cq-c", "2")}
+	var lqs []*kueue.LocalQueue
+	for _, cq := range cqs {
+		lqs = append(lqs, utiltestingapi.MakeLocalQueue("lq-"+cq.Name[len("cq-"):], metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj())
+	}
+	workloads := []*kueue.Workload{
+		utiltestingapi.MakeWorkload("low", metav1.NamespaceDefault).
+			Queue("lq-a").
+			Priority(-1).
+			Request(corev1.ResourceCPU, "3").
+			SimpleReserveQuota("cq-a", rf.Name, now).
+			Obj(),
+		utiltestingapi.MakeWorkload("high", metav1.NamespaceDefault).
+			Queue("lq-a").
+			Request(corev1.ResourceCPU, "2").
+			Obj(),
+		utiltestingapi.MakeWorkload("big", metav1.NamespaceDefault).
+			Queue("lq-b").
+			Request(corev1.ResourceCPU, "5").
+			Obj(),
+		utiltestingapi.MakeWorkload("small", metav1.NamespaceDefault).
+			Queue("lq-c").
+			Request(corev1.ResourceCPU, "1").
+			Obj(),
+	}
+
+	ctx, log := utiltesting.ContextWithLog(t)
+	clBuilder := utiltesting.NewClientBuilder().
+		WithObjects(ns, rf).
+		WithStatusSubresource(&kueue.Workload{})
+	for _, cq := range cqs {
+		clBuilder = clBuilder.WithObjects(cq)
+	}
+	for _, lq := range lqs {
+		clBuilder = clBuilder.WithObjects(lq)
+	}
+	for _, wl := range workloads {
+		clBuilder = clBuilder.WithObjects(wl)
+	}
+	cl := clBuilder.Build()
+
+	cqCache := schdcache.New(cl)
+	qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+	cqCache.AddOrUpdateResourceFlavor(log, rf)
+	for _, cq := range cqs {
+		if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+		}
+		if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+		}
+	}
+	for _, lq := range lqs {
+		if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+			t.Fatalf("Inserting queue %s/%s in manager: %v", lq.Namespace, lq.Name, err)
+		}
+	}
+
+	capture := &traceCapture{}
+	scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{},
+		WithClock(t, testingclock.NewFakeClock(now)),
+		WithPreemptionExpectations(preemptexpectations.New()),
+		WithTraceRecorder(capture, trace.Config{}),
+	)
+	var wg sync.WaitGroup
+	scheduler.setAdmissionRoutineWrapper(routine.NewWrapper(
+		func() { wg.Add(1) },
+		func() { wg.Done() },
+	))
+	scheduler.schedule(ctx)
+	wg.Wait()
+	scheduler.flushCycleTraces(ctx)
+
+	if capture.cycle == nil {
+		t.Fatal("The scheduling cycle was not recorded")
+	}
+	gotModes := make(map[string]string)
+	for _, o := range capture.cycle.Outcomes {
+		gotModes[o.Name] = o.Mode
+	}
+	wantModes := map[string]string{"high": "Preempt", "big": "NoFit", "small": "Fit"}
+	if diff := cmp.Diff(wantModes, gotModes); diff != "" {
+		t.Errorf("Unexpected recorded modes (-want,+got):\n%s", diff)
+	}
+	if got := len(capture.cycle.State.Workloads); got != 1 {
+		t.Errorf("Expected 1 admitted workload in the recorded state, got %d", got)
+	}
+}
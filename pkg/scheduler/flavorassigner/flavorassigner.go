@@ -27,6 +27,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
@@ -81,6 +82,15 @@ type Assignment struct {
 
 	// NoFitReason contains the reason why the overall assignment failed with NoFit.
 	NoFitReason string
+
+	// Cost is the cost of the assigned resources, according to the prices of
+	// the assigned flavors. It is nil if none of the flavors has prices.
+	Cost *resource.Quantity
+
+	// CostSearchIncomplete is true when the search of the flavors with the
+	// lowest cost was stopped at its budget, so that the assigned flavors may
+	// not be the cheapest.
+	CostSearchIncomplete bool
 }
 
 // UpdateForTASResult updates the Assignment with the TAS result
@@ -145,6 +155,7 @@ func (a *Assignment) ComputeTASNetUsage(log logr.Logger, cq *schdcache.ClusterQu
 				Values:            domain.Values,
 				SinglePodRequests: singlePodRequests.Clone(),
 				Count:             count,
+				Topology:          psa.TopologyAssignment.TopologyName,
 			})
 		}
 	}
@@ -190,6 +201,18 @@ func (a *Assignment) podSetAssignmentByName(psName kueue.PodSetReference) *PodSe
 	return nil
 }
 
+// TopologyPlacementExplanations returns the explanations of why TAS could not
+// place the PodSets of the assignment.
+func (a *Assignment) TopologyPlacementExplanations() []kueue.TopologyPlacementExplanation {
+	var explanations []kueue.TopologyPlacementExplanation
+	for _, ps := range a.PodSets {
+		if ps.TopologyPlacementExplanation != nil {
+			explanations = append(explanations, *ps.TopologyPlacementExplanation)
+		}
+	}
+	return explanations
+}
+
 func (a *Assignment) updateMode(psName kueue.PodSetReference, mode FlavorAssignmentMode) {
 	if psAssignment := a.podSetAssignmentByName(psName); psAssignment != nil {
 		psAssignment.updateMode(mode)
@@ -379,6 +402,11 @@ type PodSetAssignment struct {
 	TopologyAssignment     *tas.TopologyAssignment
 	DelayedTopologyRequest *kueue.DelayedTopologyRequestState
 
+	// TopologyPlacementExplanation explains why TAS could not place the
+	// PodSet. It is set only when the TASPlacementExplanation feature gate
+	// is enabled.
+	TopologyPlacementExplanation *kueue.TopologyPlacementExplanation
+
 	FlavorAssignmentAttempts []FlavorAssignmentAttempt
 }
 
@@ -575,6 +603,15 @@ func isPreferred(a, b granularMode, fungibilityConfig kueue.FlavorFungibility) b
 	return borrowingOverPreemption()
 }
 
+// equallyPreferred returns true if neither a nor b is better than the other
+// according to the selected policy, and both fit.
+func equallyPreferred(a, b granularMode, fungibilityConfig kueue.FlavorFungibility) bool {
+	if a.preemptionMode == noFit || b.preemptionMode == noFit {
+		return false
+	}
+	return !isPreferred(a, b, fungibilityConfig) && !isPreferred(b, a, fungibilityConfig)
+}
+
 func fromPreemptionPossibility(preemptionPossibility preemptioncommon.PreemptionPossibility) preemptionMode {
 	switch preemptionPossibility {
 	case preemptioncommon.NoCandidates:
@@ -641,6 +678,12 @@ type preemptionOracle interface {
 	) (preemptioncommon.PreemptionPossibility, int)
 }
 
+// FlavorScorer scores the flavors which can be assigned to the podsets of a
+// workload. Higher scores are better.
+type FlavorScorer interface {
+	ScoreFlavor(ctx context.Context, wl *workload.Info, cq *schdcache.ClusterQueueSnapshot, flavor *kueue.ResourceFlavor, requests resources.Requests) int64
+}
+
 type FlavorAssigner struct {
 	wl                *workload.Info
 	cq                *schdcache.ClusterQueueSnapshot
@@ -663,6 +706,17 @@ type FlavorAssigner struct {
 	// schedulingCycle is the cycle this assignment is being computed in. It is recorded
 	// on the assignment so that a later cycle can tell how old the assignment is.
 	schedulingCycle int64
+
+	// flavorScorer, when set, breaks the ties between the flavors which are
+	// equally preferred by the flavor fungibility policy.
+	flavorScorer FlavorScorer
+
+	// costPlan is the flavor of each resource group of each group of PodSets
+	// in the combination with the lowest cost, for the LowestCost preference.
+	costPlan map[costPlanKey]kueue.ResourceFlavorReference
+	// costSearchIncomplete is whether the search of costPlan was stopped at
+	// its budget.
+	costSearchIncomplete bool
 }
 
 func New(
@@ -689,6 +743,13 @@ func New(
 	}
 }
 
+// SetFlavorScorer sets the scorer breaking the ties between the flavors which
+// are equally preferred by the flavor fungibility policy. It only takes effect
+// when the FlavorFungibility feature is enabled.
+func (a *FlavorAssigner) SetFlavorScorer(scorer FlavorScorer) {
+	a.flavorScorer = scorer
+}
+
 // Assign assigns a flavor to each of the resources requested in each pod set.
 // The result for each pod set is accompanied with reasons why the flavor can't
 // be assigned immediately. Each assigned flavor is accompanied with a
@@ -787,6 +848,12 @@ func (a *FlavorAssigner) assignFlavors(ctx context.Context, log logr.Logger, cou
 		groupedRequests.Insert(groupKey, indexedPodSet{originalIndex: i, podSet: &podSet, podSetAssignment: &psAssignment})
 	}
 
+	a.costPlan = nil
+	a.costSearchIncomplete = false
+	if features.Enabled(features.FlavorFungibility) && a.preferLowestCost() && a.replaceWorkloadSlice == nil {
+		a.costPlan = a.planLowestCost(ctx, log, groupedRequests)
+	}
+
 	for _, podSets := range groupedRequests.InOrder {
 		requests := resources.NewRequests()
 		psIDs := make([]int, len(podSets))
@@ -869,6 +936,7 @@ func (a *FlavorAssigner) assignFlavors(ctx context.Context, log logr.Logger, cou
 				// There is at least one PodSet which does not fit
 				psAssignment := assignment.podSetAssignmentByName(failure.PodSetName)
 				psAssignment.reason(failure.Reason)
+				psAssignment.TopologyPlacementExplanation = failure.PlacementExplanation
 				// update the mode for all flavors and the representative mode
 				assignment.updateMode(failure.PodSetName, Preempt)
 			} else {
@@ -888,6 +956,7 @@ func (a *FlavorAssigner) assignFlavors(ctx context.Context, log logr.Logger, cou
 				// There is at least one PodSet which does not fit even if
 				// all workloads are preempted.
 				psAssignment := assignment.podSetAssignmentByName(failure.PodSetName)
+				psAssignment.TopologyPlacementExplanation = failure.PlacementExplanation
 				if features.Enabled(features.UnadmittedWorkloadsObservability) {
 					psAssignment.markFlavorAttempt(failure.Flavor, NoFit, kueue.WorkloadQuotaReservedReasonTopologyPlacementFailed)
 				}
@@ -901,6 +970,10 @@ func (a *FlavorAssigner) assignFlavors(ctx context.Context, log logr.Logger, cou
 			}
 		}
 	}
+	if features.Enabled(features.FlavorCost) {
+		assignment.Cost = a.cost(&assignment)
+	}
+	assignment.CostSearchIncomplete = a.costSearchIncomplete
 	if features.Enabled(features.UnadmittedWorkloadsObservability) {
 		assignment.resolveNoFitReason(a.cq)
 	}
@@ -1086,10 +1159,22 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 	var bestAssignment ResourceAssignment
 	bestAssignmentMode := worstGranularMode()
 	consideredFlavors := newFlavorAssignmentAttempts(len(resourceGroup.Flavors))
+	// scored are the flavors as preferred as the best assignment, in the
+	// order they were tried. They are only tracked with a flavor scorer.
+	var scored []scoredFlavor
+	stopped := false
+	lowestCost := a.preferLowestCost()
+	var bestCost resource.Quantity
+	planned, hasPlan := a.costPlan[costPlanKey{psID: psIDs[0], rg: resourceGroup}]
+	bestPlanned := false
 
 	// We will only check against the flavors' labels for the resource.
 	attemptedFlavorIdx := -1
 	idx := a.wl.LastAssignment.NextFlavorToTryForPodSetResource(psIDs[0], resName)
+	if lowestCost {
+		// The cheapest flavor can only be found by comparing all of them.
+		idx = 0
+	}
 	for ; idx < len(resourceGroup.Flavors); idx++ {
 		attemptedFlavorIdx = idx
 		fName := resourceGroup.Flavors[idx]
@@ -1174,14 +1259,62 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 		consideredFlavors.AddRepresentativeModeFlavorAttempt(fName, representativeMode.preemptionMode, maxBorrow, flavorQuotaReasons, flavorNoFitReason)
 
 		if features.Enabled(features.FlavorFungibility) {
+			if lowestCost {
+				if representativeMode.preemptionMode == noFit {
+					continue
+				}
+				cost := workload.Cost(a.resourceFlavors[fName].Spec.Prices, requests.ToResourceList(a.resourceFormatter))
+				// The flavor of the cheapest combination for the workload
+				// wins over the cheapest flavor for these PodSets.
+				isPlanned := hasPlan && fName == planned
+				var c int
+				switch {
+				case isPlanned:
+					c = 1
+				case bestPlanned:
+					c = -1
+				default:
+					c = compareCost(representativeMode, cost, bestAssignmentMode, bestCost, a.cq.FlavorFungibility)
+				}
+				if c > 0 {
+					bestAssignment = assignments
+					bestAssignmentMode = representativeMode
+					bestCost = cost
+					bestPlanned = isPlanned
+					if a.flavorScorer != nil {
+						scored = append(scored[:0], scoredFlavor{fName, assignments})
+					}
+				} else if c == 0 && a.flavorScorer != nil {
+					scored = append(scored, scoredFlavor{fName, assignments})
+				}
+				continue
+			}
+			if stopped {
+				// The search is over, later flavors can only win a tie.
+				if equallyPreferred(representativeMode, bestAssignmentMode, a.cq.FlavorFungibility) {
+					scored = append(scored, scoredFlavor{fName, assignments})
+				}
+				continue
+			}
 			if !shouldTryNextFlavor(representativeMode, a.cq.FlavorFungibility) {
 				bestAssignment = assignments
 				bestAssignmentMode = representativeMode
-				break
+				if a.flavorScorer == nil {
+					break
+				}
+				// Keep scanning the flavors to find the ones equally preferred.
+				stopped = true
+				scored = append(scored[:0], scoredFlavor{fName, assignments})
+				continue
 			}
 			if isPreferred(representativeMode, bestAssignmentMode, a.cq.FlavorFungibility) {
 				bestAssignment = assignments
 				bestAssignmentMode = representativeMode
+				if a.flavorScorer != nil {
+					scored = append(scored[:0], scoredFlavor{fName, assignments})
+				}
+			} else if a.flavorScorer != nil && equallyPreferred(representativeMode, bestAssignmentMode, a.cq.FlavorFungibility) {
+				scored = append(scored, scoredFlavor{fName, assignments})
 			}
 		} else if representativeMode.preemptionMode > bestAssignmentMode.preemptionMode {
 			bestAssignment = assignments
@@ -1194,6 +1327,9 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 	}
 
 	if features.Enabled(features.FlavorFungibility) {
+		if len(scored) > 1 {
+			bestAssignment = a.highestScoredAssignment(ctx, scored, requests)
+		}
 		for _, assignment := range bestAssignment {
 			if attemptedFlavorIdx == len(resourceGroup.Flavors)-1 {
 				// we have reach the last flavor, try from the first flavor next time
@@ -1209,6 +1345,92 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 	return bestAssignment, status, consideredFlavors
 }
 
+// preferLowestCost returns true if the flavors are chosen by their cost. The
+// flavors of the combination with the lowest cost for the workload, found by
+// planLowestCost, are preferred. When there is no such combination, the
+// cheapest flavor is picked for each resource group of each PodSet.
+func (a *FlavorAssigner) preferLowestCost() bool {
+	preference := a.cq.FlavorFungibility.Preference
+	return features.Enabled(features.FlavorCost) && preference != nil && *preference == kueue.LowestCost
+}
+
+// costTier ranks the preemption modes for the LowestCost preference. The cost
+// of the flavors is only compared within the same tier.
+func costTier(mode preemptionMode) int {
+	switch mode {
+	case fit:
+		return 3
+	case preempt, reclaim:
+		return 2
+	case noPreemptionCandidates:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareCost returns a positive number if the flavor with mode a and cost
+// aCost is better than the one with mode b and cost bCost according to the
+// LowestCost preference, a negative number if it is worse, and 0 if they are
+// equally preferred. The flavors in which the workload fits come first, then
+// the flavors requiring preemption, and the cheapest flavor wins within each
+// tier. Equal costs fall back to the BorrowingOverPreemption order.
+func compareCost(a granularMode, aCost resource.Quantity, b granularMode, bCost resource.Quantity, fungibilityConfig kueue.FlavorFungibility) int {
+	if aTier, bTier := costTier(a.preemptionMode), costTier(b.preemptionMode); aTier != bTier {
+		return aTier - bTier
+	}
+	if c := bCost.Cmp(aCost); c != 0 {
+		return c
+	}
+	switch {
+	case isPreferred(a, b, fungibilityConfig):
+		return 1
+	case isPreferred(b, a, fungibilityConfig):
+		return -1
+	default:
+		return 0
+	}
+}
+
+// cost returns the cost of the resources assigned to the PodSets, according
+// to the prices of their flavors, or nil if none of the flavors has prices.
+func (a *FlavorAssigner) cost(assignment *Assignment) *resource.Quantity {
+	var cost *resource.Quantity
+	for _, psa := range assignment.PodSets {
+		for resName, flvAssignment := range psa.Flavors {
+			flavor, found := a.resourceFlavors[flvAssignment.Name]
+			if !found || len(flavor.Spec.Prices) == 0 {
+				continue
+			}
+			if cost == nil {
+				cost = resource.NewQuantity(0, resource.DecimalSI)
+			}
+			cost.Add(workload.Cost(flavor.Spec.Prices, corev1.ResourceList{resName: psa.Requests[resName]}))
+		}
+	}
+	return cost
+}
+
+type scoredFlavor struct {
+	name        kueue.ResourceFlavorReference
+	assignments ResourceAssignment
+}
+
+// highestScoredAssignment returns the assignment of the flavor with the
+// highest score. Ties are broken in favor of the flavor tried first.
+func (a *FlavorAssigner) highestScoredAssignment(ctx context.Context, flavors []scoredFlavor, requests resources.Requests) ResourceAssignment {
+	best := flavors[0].assignments
+	var bestScore int64
+	for i, f := range flavors {
+		score := a.flavorScorer.ScoreFlavor(ctx, a.wl, a.cq, a.resourceFlavors[f.name], requests)
+		if i == 0 || score > bestScore {
+			best = f.assignments
+			bestScore = score
+		}
+	}
+	return best
+}
+
 func (a *FlavorAssigner) checkFlavorForPodSets(
 	log logr.Logger,
 	flavorName kueue.ResourceFlavorReference,
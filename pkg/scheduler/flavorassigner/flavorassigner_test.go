@@ -5305,6 +5305,328 @@ func TestAssignFlavorsWithAllowedFlavors(t *testing.T) {
 	}
 }
 
+type testFlavorScorer map[kueue.ResourceFlavorReference]int64
+
+func (s testFlavorScorer) ScoreFlavor(_ context.Context, _ *workload.Info, _ *schdcache.ClusterQueueSnapshot, flavor *kueue.ResourceFlavor, _ resources.Requests) int64 {
+	return s[kueue.ResourceFlavorReference(flavor.Name)]
+}
+
+func TestAssignFlavorsWithFlavorScorer(t *testing.T) {
+	resourceFlavors := map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
+		"small": utiltestingapi.MakeResourceFlavor("small").Obj(),
+		"f1":    utiltestingapi.MakeResourceFlavor("f1").Obj(),
+		"f2":    utiltestingapi.MakeResourceFlavor("f2").Obj(),
+	}
+	cq := *utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("small").Resource(corev1.ResourceCPU, "1").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("f1").Resource(corev1.ResourceCPU, "10").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("f2").Resource(corev1.ResourceCPU, "10").Obj(),
+		).Obj()
+
+	cases := map[string]struct {
+		scorer     FlavorScorer
+		wantFlavor kueue.ResourceFlavorReference
+	}{
+		"without scorer, the first fitting flavor is assigned": {
+			wantFlavor: "f1",
+		},
+		"the highest scored flavor is assigned": {
+			scorer:     testFlavorScorer{"f2": 10},
+			wantFlavor: "f2",
+		},
+		"equal scores keep the first fitting flavor": {
+			scorer:     testFlavorScorer{"f1": 5, "f2": 5},
+			wantFlavor: "f1",
+		},
+		"a flavor which doesn't fit is not assigned, whatever its score": {
+			scorer:     testFlavorScorer{"small": 100, "f2": 10},
+			wantFlavor: "f2",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("wl", "ns").
+				PodSets(*utiltestingapi.MakePodSet("main", 1).Request(corev1.ResourceCPU, "2").Obj()).
+				Obj())
+
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := schdcache.New(utiltesting.NewFakeClient())
+			if err := cache.AddClusterQueue(ctx, &cq); err != nil {
+				t.Fatalf("Failed to add CQ to cache: %v", err)
+			}
+			for _, rf := range resourceFlavors {
+				cache.AddOrUpdateResourceFlavor(log, rf)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			cqSnapshot := snapshot.ClusterQueue(kueue.ClusterQueueReference(cq.Name))
+
+			assigner := New(wlInfo, cqSnapshot, resourceFlavors, false, &testOracle{}, nil, configapi.QuotaCheckBlockUndeclared, resources.NewResourceFormatter(), 0)
+			if tc.scorer != nil {
+				assigner.SetFlavorScorer(tc.scorer)
+			}
+			gotAssignment := assigner.Assign(ctx, nil)
+
+			if gotAssignment.RepresentativeMode() != Fit {
+				t.Fatalf("RepresentativeMode() = %v, want %v", gotAssignment.RepresentativeMode(), Fit)
+			}
+			if gotFlavor := gotAssignment.PodSets[0].Flavors[corev1.ResourceCPU].Name; gotFlavor != tc.wantFlavor {
+				t.Errorf("Assigned flavor = %v, want %v", gotFlavor, tc.wantFlavor)
+			}
+		})
+	}
+}
+
+func TestAssignFlavorsWithLowestCost(t *testing.T) {
+	resourceFlavors := map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
+		"small":     utiltestingapi.MakeResourceFlavor("small").Price(corev1.ResourceCPU, "0.5").Obj(),
+		"expensive": utiltestingapi.MakeResourceFlavor("expensive").Price(corev1.ResourceCPU, "3").Obj(),
+		"cheap": utiltestingapi.MakeResourceFlavor("cheap").
+			Price(corev1.ResourceCPU, "1").
+			Price(corev1.ResourceMemory, "1n").
+			Obj(),
+		"spot": utiltestingapi.MakeResourceFlavor("spot").Price(corev1.ResourceCPU, "0.2").Obj(),
+	}
+	cq := *utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas("small").Resource(corev1.ResourceCPU, "1").Resource(corev1.ResourceMemory, "10Gi").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("expensive").Resource(corev1.ResourceCPU, "10").Resource(corev1.ResourceMemory, "10Gi").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("cheap").Resource(corev1.ResourceCPU, "10").Resource(corev1.ResourceMemory, "10Gi").Obj(),
+			*utiltestingapi.MakeFlavorQuotas("spot").Resource(corev1.ResourceCPU, "10").Resource(corev1.ResourceMemory, "10Gi").Obj(),
+		).
+		Preemption(kueue.ClusterQueuePreemption{WithinClusterQueue: kueue.PreemptionPolicyLowerPriority}).
+		FlavorFungibility(kueue.FlavorFungibility{
+			WhenCanBorrow:  kueue.TryNextFlavor,
+			WhenCanPreempt: kueue.TryNextFlavor,
+			Preference:     new(kueue.LowestCost),
+		}).
+		Obj()
+
+	cases := map[string]struct {
+		disableFlavorCost bool
+		usage             resources.FlavorResourceQuantities
+		wantMode          FlavorAssignmentMode
+		wantFlavor        kueue.ResourceFlavorReference
+		wantCost          string
+	}{
+		"the cheapest flavor in which the workload fits is assigned": {
+			wantMode:   Fit,
+			wantFlavor: "spot",
+			wantCost:   "0.4",
+		},
+		"a flavor in which the workload fits is preferred over a cheaper one requiring preemption": {
+			usage: resources.FlavorResourceQuantities{
+				{Flavor: "spot", Resource: corev1.ResourceCPU}: resources.NewAmount(9_000),
+			},
+			wantMode:   Fit,
+			wantFlavor: "cheap",
+			wantCost:   "3.073741824",
+		},
+		"the cheapest flavor requiring preemption is assigned when no flavor fits": {
+			usage: resources.FlavorResourceQuantities{
+				{Flavor: "expensive", Resource: corev1.ResourceCPU}: resources.NewAmount(9_000),
+				{Flavor: "cheap", Resource: corev1.ResourceCPU}:     resources.NewAmount(9_000),
+				{Flavor: "spot", Resource: corev1.ResourceCPU}:      resources.NewAmount(9_000),
+			},
+			wantMode:   Preempt,
+			wantFlavor: "spot",
+			wantCost:   "0.4",
+		},
+		"without the feature gate, the first flavor in which the workload fits is assigned": {
+			disableFlavorCost: true,
+			wantMode:          Fit,
+			wantFlavor:        "expensive",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.FlavorCost, !tc.disableFlavorCost)
+			wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("wl", "ns").
+				PodSets(*utiltestingapi.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Request(corev1.ResourceMemory, "1Gi").
+					Obj()).
+				Obj())
+
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := schdcache.New(utiltesting.NewFakeClient())
+			if err := cache.AddClusterQueue(ctx, &cq); err != nil {
+				t.Fatalf("Failed to add CQ to cache: %v", err)
+			}
+			for _, rf := range resourceFlavors {
+				cache.AddOrUpdateResourceFlavor(log, rf)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			cqSnapshot := snapshot.ClusterQueue(kueue.ClusterQueueReference(cq.Name))
+			if tc.usage != nil {
+				cqSnapshot.AddUsage(workload.Usage{Quota: workload.ResourceUsage{Assigned: tc.usage}})
+			}
+
+			assigner := New(wlInfo, cqSnapshot, resourceFlavors, false, &testOracle{}, nil, configapi.QuotaCheckBlockUndeclared, resources.NewResourceFormatter(), 0)
+			gotAssignment := assigner.Assign(ctx, nil)
+
+			if gotAssignment.RepresentativeMode() != tc.wantMode {
+				t.Fatalf("RepresentativeMode() = %v, want %v", gotAssignment.RepresentativeMode(), tc.wantMode)
+			}
+			for res, flavor := range gotAssignment.PodSets[0].Flavors {
+				if flavor.Name != tc.wantFlavor {
+					t.Errorf("Assigned flavor for %s = %v, want %v", res, flavor.Name, tc.wantFlavor)
+				}
+			}
+			var wantCost *resource.Quantity
+			if tc.wantCost != "" {
+				wantCost = new(resource.MustParse(tc.wantCost))
+			}
+			if diff := cmp.Diff(wantCost, gotAssignment.Cost); diff != "" {
+				t.Errorf("Unexpected cost (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAssignFlavorsWithLowestCostAcrossPodSets(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.FlavorCost, true)
+	resourceFlavors := map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
+		"cheap": utiltestingapi.MakeResourceFlavor("cheap").Price(corev1.ResourceCPU, "1").Obj(),
+		"mid":   utiltestingapi.MakeResourceFlavor("mid").Price(corev1.ResourceCPU, "2").Obj(),
+	}
+	fungibility := kueue.FlavorFungibility{
+		WhenCanBorrow:  kueue.TryNextFlavor,
+		WhenCanPreempt: kueue.TryNextFlavor,
+		Preference:     new(kueue.LowestCost),
+	}
+
+	cases := map[string]struct {
+		midQuota    string
+		wantFlavors []kueue.ResourceFlavorReference
+		wantCost    string
+	}{
+		"the cheapest combination is assigned when the cheapest flavor per PodSet isn't": {
+			// Picking the cheapest flavor for each PodSet in turn would cost 2*1 + 4*2 = 10.
+			midQuota:    "10",
+			wantFlavors: []kueue.ResourceFlavorReference{"mid", "cheap"},
+			wantCost:    "8",
+		},
+		"the combination in which all the PodSets fit is assigned": {
+			// Picking the cheapest flavor for the first PodSet would leave no
+			// flavor for the second one.
+			midQuota:    "2",
+			wantFlavors: []kueue.ResourceFlavorReference{"mid", "cheap"},
+			wantCost:    "8",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cq := utiltestingapi.MakeClusterQueue("cq").
+				ResourceGroup(
+					*utiltestingapi.MakeFlavorQuotas("cheap").Resource(corev1.ResourceCPU, "4").Obj(),
+					*utiltestingapi.MakeFlavorQuotas("mid").Resource(corev1.ResourceCPU, tc.midQuota).Obj(),
+				).
+				FlavorFungibility(fungibility).
+				Obj()
+			wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("wl", "ns").
+				PodSets(
+					*utiltestingapi.MakePodSet("driver", 1).Request(corev1.ResourceCPU, "2").Obj(),
+					*utiltestingapi.MakePodSet("workers", 1).Request(corev1.ResourceCPU, "4").Obj(),
+				).
+				Obj())
+
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := schdcache.New(utiltesting.NewFakeClient())
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Failed to add CQ to cache: %v", err)
+			}
+			for _, rf := range resourceFlavors {
+				cache.AddOrUpdateResourceFlavor(log, rf)
+			}
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			cqSnapshot := snapshot.ClusterQueue(kueue.ClusterQueueReference(cq.Name))
+
+			assigner := New(wlInfo, cqSnapshot, resourceFlavors, false, &testOracle{}, nil, configapi.QuotaCheckBlockUndeclared, resources.NewResourceFormatter(), 0)
+			gotAssignment := assigner.Assign(ctx, nil)
+
+			if gotAssignment.RepresentativeMode() != Fit {
+				t.Fatalf("RepresentativeMode() = %v, want %v", gotAssignment.RepresentativeMode(), Fit)
+			}
+			gotFlavors := make([]kueue.ResourceFlavorReference, 0, len(gotAssignment.PodSets))
+			for _, psa := range gotAssignment.PodSets {
+				gotFlavors = append(gotFlavors, psa.Flavors[corev1.ResourceCPU].Name)
+			}
+			if diff := cmp.Diff(tc.wantFlavors, gotFlavors); diff != "" {
+				t.Errorf("Unexpected flavors (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(new(resource.MustParse(tc.wantCost)), gotAssignment.Cost); diff != "" {
+				t.Errorf("Unexpected cost (-want,+got):\n%s", diff)
+			}
+			if gotAssignment.CostSearchIncomplete {
+				t.Error("The search of the lowest cost flavors is incomplete")
+			}
+		})
+	}
+}
+
+func TestAssignFlavorsWithLowestCostSearchBudget(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.FlavorCost, true)
+	// The flavors have the same price, so that no combination is pruned and
+	// the 4^6 combinations exceed the budget of the search.
+	flavorNames := []kueue.ResourceFlavorReference{"a", "b", "c", "d"}
+	resourceFlavors := make(map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor, len(flavorNames))
+	flavorQuotas := make([]kueue.FlavorQuotas, 0, len(flavorNames))
+	for _, name := range flavorNames {
+		resourceFlavors[name] = utiltestingapi.MakeResourceFlavor(string(name)).Price(corev1.ResourceCPU, "1").Obj()
+		flavorQuotas = append(flavorQuotas, *utiltestingapi.MakeFlavorQuotas(string(name)).Resource(corev1.ResourceCPU, "10").Obj())
+	}
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(flavorQuotas...).
+		FlavorFungibility(kueue.FlavorFungibility{
+			WhenCanBorrow:  kueue.TryNextFlavor,
+			WhenCanPreempt: kueue.TryNextFlavor,
+			Preference:     new(kueue.LowestCost),
+		}).
+		Obj()
+	podSets := make([]kueue.PodSet, 0, 6)
+	for i := range 6 {
+		podSets = append(podSets, *utiltestingapi.MakePodSet(kueue.NewPodSetReference(fmt.Sprintf("ps%d", i)), 1).Request(corev1.ResourceCPU, "1").Obj())
+	}
+	wlInfo := workload.NewInfo(utiltestingapi.MakeWorkload("wl", "ns").PodSets(podSets...).Obj())
+
+	ctx, log := utiltesting.ContextWithLog(t)
+	cache := schdcache.New(utiltesting.NewFakeClient())
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Failed to add CQ to cache: %v", err)
+	}
+	for _, rf := range resourceFlavors {
+		cache.AddOrUpdateResourceFlavor(log, rf)
+	}
+	snapshot, err := cache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error while building snapshot: %v", err)
+	}
+	cqSnapshot := snapshot.ClusterQueue(kueue.ClusterQueueReference(cq.Name))
+
+	assigner := New(wlInfo, cqSnapshot, resourceFlavors, false, &testOracle{}, nil, configapi.QuotaCheckBlockUndeclared, resources.NewResourceFormatter(), 0)
+	gotAssignment := assigner.Assign(ctx, nil)
+
+	if gotAssignment.RepresentativeMode() != Fit {
+		t.Fatalf("RepresentativeMode() = %v, want %v", gotAssignment.RepresentativeMode(), Fit)
+	}
+	if !gotAssignment.CostSearchIncomplete {
+		t.Error("The search of the lowest cost flavors is complete, want it stopped at its budget")
+	}
+	if diff := cmp.Diff(new(resource.MustParse("6")), gotAssignment.Cost); diff != "" {
+		t.Errorf("Unexpected cost (-want,+got):\n%s", diff)
+	}
+}
+
 func TestIsNoFitDueToCapacityAndLimits(t *testing.T) {
 	resourceFlavors := map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
 		"flavor-a": utiltestingapi.MakeResourceFlavor("flavor-a").NodeLabel("type", "a").Obj(),
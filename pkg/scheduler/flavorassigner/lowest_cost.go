@@ -0,0 +1,236 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavorassigner
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/orderedgroups"
+	"sigs.k8s.io/kueue/pkg/util/resourcegroups"
+	"sigs.k8s.io/kueue/pkg/workload"
+	"sigs.k8s.io/kueue/pkg/workload/concurrentadmission"
+)
+
+// maxCostSearchSteps bounds the number of partial combinations of flavors
+// explored by the LowestCost search of a workload. When the bound is reached,
+// the cheapest combination found so far is used, and the assignment is
+// flagged with CostSearchIncomplete.
+const maxCostSearchSteps = 1024
+
+// costPlanKey identifies a resource group of a group of PodSets, by the first
+// PodSet of the group.
+type costPlanKey struct {
+	psID int
+	rg   *resourcegroups.ResourceGroup
+}
+
+// costStep is a resource group of a group of PodSets, to which the LowestCost
+// search assigns a flavor.
+type costStep struct {
+	psIDs    []int
+	podSets  []*kueue.PodSet
+	rg       *resourcegroups.ResourceGroup
+	requests resources.Requests
+}
+
+// costCandidate is a flavor which can be assigned to a costStep.
+type costCandidate struct {
+	flavor kueue.ResourceFlavorReference
+	mode   granularMode
+	cost   resource.Quantity
+	score  int64
+}
+
+// costSearch holds the state of the LowestCost search.
+type costSearch struct {
+	steps []costStep
+	// usage is the usage of the flavors chosen for the previous steps.
+	usage  resources.FlavorResourceQuantities
+	chosen []kueue.ResourceFlavorReference
+	budget int
+	// incomplete is whether the search was stopped at the budget.
+	incomplete bool
+
+	best      []kueue.ResourceFlavorReference
+	bestMode  granularMode
+	bestCost  resource.Quantity
+	bestScore int64
+}
+
+// planLowestCost returns the flavor to assign to each resource group of each
+// group of PodSets, such that the total cost of the workload is the lowest.
+// The combinations are ranked by their worst mode, as for a single flavor by
+// compareCost, then by their total cost, then by the total score of their
+// flavors. The combinations in which a flavor doesn't fit are pruned, as well
+// as the partial combinations already worse than the best one found. It
+// returns nil when no combination fits, or when the flavors are pinned by the
+// nomination mapping or by a previous pass.
+func (a *FlavorAssigner) planLowestCost(ctx context.Context, log logr.Logger, groupedRequests *orderedgroups.OrderedGroups[string, indexedPodSet]) map[costPlanKey]kueue.ResourceFlavorReference {
+	if a.shouldRespectNominationMapping() {
+		return nil
+	}
+	var steps []costStep
+	for _, podSets := range groupedRequests.InOrder {
+		requests := resources.NewRequests()
+		psIDs := make([]int, len(podSets))
+		psList := make([]*kueue.PodSet, len(podSets))
+		for idx, ips := range podSets {
+			if len(ips.podSetAssignment.Flavors) > 0 {
+				return nil
+			}
+			psIDs[idx] = ips.originalIndex
+			psList[idx] = &a.wl.Obj.Spec.PodSets[ips.originalIndex]
+			requests.Add(ips.podSet.Requests)
+		}
+		planned := sets.New[*resourcegroups.ResourceGroup]()
+		for _, resName := range slices.Sorted(maps.Keys(requests.ToResourceList(a.resourceFormatter))) {
+			rg := a.cq.RGByResource(resName)
+			if rg == nil || planned.Has(rg) {
+				continue
+			}
+			planned.Insert(rg)
+			steps = append(steps, costStep{
+				psIDs:    psIDs,
+				podSets:  psList,
+				rg:       rg,
+				requests: filterRequestedResources(requests, rg.CoveredResources),
+			})
+		}
+	}
+	if len(steps) == 0 {
+		return nil
+	}
+
+	s := &costSearch{
+		steps:  steps,
+		usage:  make(resources.FlavorResourceQuantities),
+		chosen: make([]kueue.ResourceFlavorReference, len(steps)),
+		budget: maxCostSearchSteps,
+	}
+	a.searchLowestCost(ctx, log, s, 0, bestGranularMode(), resource.Quantity{}, 0)
+	if s.incomplete {
+		a.costSearchIncomplete = true
+		log.V(2).Info("The search of the lowest cost flavors was stopped at its budget, the flavors may not be the cheapest", "budget", maxCostSearchSteps, "steps", len(steps), "found", s.best != nil)
+	}
+	if s.best == nil {
+		return nil
+	}
+	log.V(3).Info("Found the lowest cost flavors", "flavors", s.best, "cost", s.bestCost.String())
+	plan := make(map[costPlanKey]kueue.ResourceFlavorReference, len(steps))
+	for i, step := range steps {
+		plan[costPlanKey{psID: step.psIDs[0], rg: step.rg}] = s.best[i]
+	}
+	return plan
+}
+
+// searchLowestCost explores the combinations of flavors for the steps from
+// the i-th, given the worst mode, the cost and the score of the flavors chosen
+// for the previous steps.
+func (a *FlavorAssigner) searchLowestCost(ctx context.Context, log logr.Logger, s *costSearch, i int, mode granularMode, cost resource.Quantity, score int64) {
+	if i == len(s.steps) {
+		c := 1
+		if s.best != nil {
+			c = compareCost(mode, cost, s.bestMode, s.bestCost, a.cq.FlavorFungibility)
+		}
+		if c > 0 || (c == 0 && score > s.bestScore) {
+			s.best = slices.Clone(s.chosen)
+			s.bestMode = mode
+			s.bestCost = cost
+			s.bestScore = score
+		}
+		return
+	}
+	step := s.steps[i]
+	for _, candidate := range a.costCandidates(ctx, log, step, s.usage) {
+		if s.budget <= 0 {
+			s.incomplete = true
+			return
+		}
+		s.budget--
+		candidateMode := mode
+		if isPreferred(mode, candidate.mode, a.cq.FlavorFungibility) {
+			candidateMode = candidate.mode
+		}
+		candidateCost := cost.DeepCopy()
+		candidateCost.Add(candidate.cost)
+		// Later steps can't improve the mode nor lower the cost.
+		if s.best != nil && compareCost(candidateMode, candidateCost, s.bestMode, s.bestCost, a.cq.FlavorFungibility) < 0 {
+			continue
+		}
+		s.chosen[i] = candidate.flavor
+		step.requests.ForEach(func(rName corev1.ResourceName, val int64) {
+			fr := resources.FlavorResource{Flavor: candidate.flavor, Resource: rName}
+			s.usage[fr] = s.usage[fr].AddInt64(val)
+		})
+		a.searchLowestCost(ctx, log, s, i+1, candidateMode, candidateCost, score+candidate.score)
+		step.requests.ForEach(func(rName corev1.ResourceName, val int64) {
+			fr := resources.FlavorResource{Flavor: candidate.flavor, Resource: rName}
+			s.usage[fr] = s.usage[fr].SubInt64(val)
+		})
+	}
+}
+
+// costCandidates returns the flavors of the step which fit, given the usage
+// of the previous steps, from the best to the worst according to compareCost.
+func (a *FlavorAssigner) costCandidates(ctx context.Context, log logr.Logger, step costStep, usage resources.FlavorResourceQuantities) []costCandidate {
+	var candidates []costCandidate
+	for _, fName := range step.rg.Flavors {
+		if features.Enabled(features.ConcurrentAdmission) && !concurrentadmission.IsFlavorAllowedForVariant(a.wl.Obj, fName) {
+			continue
+		}
+		if !a.checkFlavorForPodSets(log, fName, step.psIDs, step.podSets, step.rg).IsFit() {
+			continue
+		}
+		mode := bestGranularMode()
+		step.requests.ForEach(func(rName corev1.ResourceName, val int64) {
+			if mode.preemptionMode == noFit {
+				return
+			}
+			fr := resources.FlavorResource{Flavor: fName, Resource: rName}
+			preemptionMode, borrow, _ := a.fitsResourceQuota(ctx, log, fr, usage[fr], val, a.cq.QuotaFor(fr))
+			if m := (granularMode{preemptionMode, borrowingLevel(borrow)}); isPreferred(mode, m, a.cq.FlavorFungibility) {
+				mode = m
+			}
+		})
+		if mode.preemptionMode == noFit {
+			continue
+		}
+		candidate := costCandidate{
+			flavor: fName,
+			mode:   mode,
+			cost:   workload.Cost(a.resourceFlavors[fName].Spec.Prices, step.requests.ToResourceList(a.resourceFormatter)),
+		}
+		if a.flavorScorer != nil {
+			candidate.score = a.flavorScorer.ScoreFlavor(ctx, a.wl, a.cq, a.resourceFlavors[fName], step.requests)
+		}
+		candidates = append(candidates, candidate)
+	}
+	slices.SortStableFunc(candidates, func(x, y costCandidate) int {
+		return compareCost(y.mode, y.cost, x.mode, x.cost, a.cq.FlavorFungibility)
+	})
+	return candidates
+}
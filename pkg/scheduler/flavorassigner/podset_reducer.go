@@ -17,6 +17,8 @@ limitations under the License.
 package flavorassigner
 
 import (
+	"math"
+	"slices"
 	"sort"
 
 	"k8s.io/utils/ptr"
@@ -26,33 +28,139 @@ import (
 
 // PodSetReducer helper structure used to gradually walk down
 // from PodSets[*].Count to *PodSets[*].MinimumCount.
+//
+// The counts of the PodSets constrained by a PodSetShapeConstraint are not
+// reduced on their own, they are derived from the count of the PodSet at the
+// root of their constraints, and bounded by the maxCount of the constraints.
 type PodSetReducer[R any] struct {
 	podSets    []kueue.PodSet
 	fullCounts []int32
 	deltas     []int32
 	totalDelta int32
 	fits       func([]int32) (R, bool)
+
+	// roots holds, for each PodSet, the index of the PodSet its count is
+	// derived from, or -1 if the count is not constrained.
+	roots []int
+	// multipliers holds, for each constrained PodSet, the number of its pods
+	// per pod of its root.
+	multipliers []int64
+	// constraintMaxCounts holds, for each constrained PodSet, the maxCount of
+	// its constraint, if any.
+	constraintMaxCounts []*int32
 }
 
-func NewPodSetReducer[R any](podSets []kueue.PodSet, fits func([]int32) (R, bool)) *PodSetReducer[R] {
+func NewPodSetReducer[R any](podSets []kueue.PodSet, constraints []kueue.PodSetShapeConstraint, fits func([]int32) (R, bool)) *PodSetReducer[R] {
 	psr := &PodSetReducer[R]{
 		podSets:    podSets,
 		deltas:     make([]int32, len(podSets)),
 		fullCounts: make([]int32, len(podSets)),
 		fits:       fits,
 	}
+	psr.resolveConstraints(constraints)
 
+	maxCounts := make([]int64, len(podSets))
+	minCounts := make([]int64, len(podSets))
 	for i := range psr.podSets {
 		ps := &psr.podSets[i]
-		psr.fullCounts[i] = ps.Count
+		maxCounts[i] = int64(ps.Count)
+		minCounts[i] = int64(ptr.Deref(ps.MinCount, ps.Count))
+	}
+	// The count of a root is bounded by the counts of the PodSets derived
+	// from it. A derived PodSet without minCount is only bound by its root.
+	for i, root := range psr.roots {
+		if root < 0 {
+			continue
+		}
+		m := psr.multipliers[i]
+		ps := &psr.podSets[i]
+		maxCounts[root] = min(maxCounts[root], int64(ptr.Deref(psr.constraintMaxCounts[i], ps.Count))/m)
+		if ps.MinCount != nil {
+			minCounts[root] = max(minCounts[root], (int64(*ps.MinCount)+m-1)/m)
+		}
+	}
 
-		d := ps.Count - ptr.Deref(ps.MinCount, ps.Count)
-		psr.deltas[i] = d
-		psr.totalDelta += d
+	feasible := true
+	for i := range psr.podSets {
+		if psr.roots[i] >= 0 {
+			continue
+		}
+		psr.fullCounts[i] = int32(maxCounts[i])
+		d := maxCounts[i] - minCounts[i]
+		if d < 0 {
+			// No count of the root satisfies the constraints.
+			feasible = false
+			continue
+		}
+		psr.deltas[i] = int32(d)
+		psr.totalDelta += int32(d)
+	}
+	if !feasible {
+		psr.totalDelta = 0
 	}
 	return psr
 }
 
+// MaxCounts returns the counts of the PodSets bounded by the maxCount of the
+// shape constraints, or nil if the constraints don't bound them.
+func (psr *PodSetReducer[R]) MaxCounts() []int32 {
+	counts := slices.Clone(psr.fullCounts)
+	psr.fillConstrainedCounts(counts)
+	for i := range psr.podSets {
+		if counts[i] != psr.podSets[i].Count {
+			return counts
+		}
+	}
+	return nil
+}
+
+// resolveConstraints sets the root and the multiplier of each PodSet.
+// Constraints referring to unknown PodSets, or forming a cycle, are ignored;
+// they are rejected by the workload validation.
+func (psr *PodSetReducer[R]) resolveConstraints(constraints []kueue.PodSetShapeConstraint) {
+	psr.roots = make([]int, len(psr.podSets))
+	psr.multipliers = make([]int64, len(psr.podSets))
+	psr.constraintMaxCounts = make([]*int32, len(psr.podSets))
+	parents := make([]int, len(psr.podSets))
+	ratios := make([]int64, len(psr.podSets))
+	index := make(map[kueue.PodSetReference]int, len(psr.podSets))
+	for i := range psr.podSets {
+		index[psr.podSets[i].Name] = i
+		parents[i] = -1
+	}
+	for _, c := range constraints {
+		child, found := index[c.PodSet]
+		if !found {
+			continue
+		}
+		parent, found := index[c.RelativeTo]
+		if !found || parent == child || c.Ratio < 1 {
+			continue
+		}
+		parents[child] = parent
+		ratios[child] = int64(c.Ratio)
+		psr.constraintMaxCounts[child] = c.MaxCount
+	}
+	for i := range psr.podSets {
+		psr.roots[i] = -1
+		m := int64(1)
+		current := i
+		for range len(psr.podSets) {
+			if parents[current] < 0 {
+				break
+			}
+			// Capped to avoid overflows, a multiplier above the maximum
+			// count leaves no room for the root.
+			m = min(m*ratios[current], math.MaxInt32+1)
+			current = parents[current]
+		}
+		if current != i && parents[current] < 0 {
+			psr.roots[i] = current
+			psr.multipliers[i] = m
+		}
+	}
+}
+
 func fillPodSetSizesForSearchIndex(out, fullCounts, deltas []int32, upFactor int32, downFactor int32) {
 	// this will panic if len(out) < len(deltas)
 	for i, v := range deltas {
@@ -75,6 +183,7 @@ func (psr *PodSetReducer[R]) Search() (R, bool) {
 	current := make([]int32, len(psr.podSets))
 	idx := sort.Search(int(psr.totalDelta)+1, func(i int) bool {
 		fillPodSetSizesForSearchIndex(current, psr.fullCounts, psr.deltas, int32(i), psr.totalDelta)
+		psr.fillConstrainedCounts(current)
 		r, f := psr.fits(current)
 		if f {
 			lastGoodIdx = i
@@ -84,3 +193,13 @@ func (psr *PodSetReducer[R]) Search() (R, bool) {
 	})
 	return lastR, idx == lastGoodIdx
 }
+
+// fillConstrainedCounts derives the counts of the constrained PodSets from the
+// counts of their roots.
+func (psr *PodSetReducer[R]) fillConstrainedCounts(counts []int32) {
+	for i, root := range psr.roots {
+		if root >= 0 {
+			counts[i] = int32(psr.multipliers[i] * int64(counts[root]))
+		}
+	}
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package flavorassigner
 
 import (
+	"slices"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 )
@@ -130,7 +133,7 @@ func TestSearch(t *testing.T) {
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			red := NewPodSetReducer(tc.podSets, func(counts []int32) (int32, bool) {
+			red := NewPodSetReducer(tc.podSets, nil, func(counts []int32) (int32, bool) {
 				total := int32(0)
 				for _, v := range counts {
 					total += v
@@ -148,3 +151,157 @@ func TestSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchWithShapeConstraints(t *testing.T) {
+	cases := map[string]struct {
+		podSets     []kueue.PodSet
+		constraints []kueue.PodSetShapeConstraint
+		countLimit  int32
+		wantCounts  []int32
+		wantFound   bool
+	}{
+		"workers follow the parameter servers": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+				*utiltestingapi.MakePodSet("worker", 32).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 8},
+			},
+			countLimit: 30,
+			wantCounts: []int32{3, 24},
+			wantFound:  true,
+		},
+		"minCount of the workers bounds the parameter servers": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+				*utiltestingapi.MakePodSet("worker", 32).SetMinimumCount(24).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 8},
+			},
+			countLimit: 20,
+			wantFound:  false,
+		},
+		"chained constraints and a fixed podSet": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("driver", 1).Obj(),
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(2).Obj(),
+				*utiltestingapi.MakePodSet("worker", 16).Obj(),
+				*utiltestingapi.MakePodSet("evaluator", 32).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 4},
+				{PodSet: "evaluator", RelativeTo: "worker", Ratio: 2},
+			},
+			countLimit: 45,
+			wantCounts: []int32{1, 3, 12, 24},
+			wantFound:  true,
+		},
+		"maxCount of the workers bounds the parameter servers": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+				*utiltestingapi.MakePodSet("worker", 32).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 8, MaxCount: new(int32(16))},
+			},
+			countLimit: 30,
+			wantCounts: []int32{2, 16},
+			wantFound:  true,
+		},
+		"maxCount in chained constraints": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+				*utiltestingapi.MakePodSet("worker", 16).Obj(),
+				*utiltestingapi.MakePodSet("evaluator", 32).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 4},
+				{PodSet: "evaluator", RelativeTo: "worker", Ratio: 2, MaxCount: new(int32(24))},
+			},
+			countLimit: 30,
+			wantCounts: []int32{2, 8, 16},
+			wantFound:  true,
+		},
+		"the root cannot be reduced": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("ps", 4).Obj(),
+				*utiltestingapi.MakePodSet("worker", 32).SetMinimumCount(8).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 8},
+			},
+			countLimit: 20,
+			wantFound:  false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			red := NewPodSetReducer(tc.podSets, tc.constraints, func(counts []int32) ([]int32, bool) {
+				total := int32(0)
+				for _, v := range counts {
+					total += v
+				}
+				return slices.Clone(counts), total <= tc.countLimit
+			})
+			counts, found := red.Search()
+			if found != tc.wantFound {
+				t.Errorf("Unexpected found:%v, want: %v", found, tc.wantFound)
+			}
+			if diff := cmp.Diff(tc.wantCounts, counts); diff != "" {
+				t.Errorf("Unexpected counts (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMaxCounts(t *testing.T) {
+	cases := map[string]struct {
+		podSets     []kueue.PodSet
+		constraints []kueue.PodSetShapeConstraint
+		want        []int32
+	}{
+		"no maxCount": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+				*utiltestingapi.MakePodSet("worker", 32).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 8},
+			},
+		},
+		"maxCount at the count": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+				*utiltestingapi.MakePodSet("worker", 32).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 8, MaxCount: new(int32(32))},
+			},
+		},
+		"maxCount bounds the root and the derived podSets": {
+			podSets: []kueue.PodSet{
+				*utiltestingapi.MakePodSet("driver", 1).Obj(),
+				*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+				*utiltestingapi.MakePodSet("worker", 16).Obj(),
+				*utiltestingapi.MakePodSet("evaluator", 32).Obj(),
+			},
+			constraints: []kueue.PodSetShapeConstraint{
+				{PodSet: "worker", RelativeTo: "ps", Ratio: 4, MaxCount: new(int32(12))},
+				{PodSet: "evaluator", RelativeTo: "worker", Ratio: 2},
+			},
+			want: []int32{1, 3, 12, 24},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			red := NewPodSetReducer(tc.podSets, tc.constraints, func([]int32) (struct{}, bool) {
+				return struct{}{}, true
+			})
+			if diff := cmp.Diff(tc.want, red.MaxCounts()); diff != "" {
+				t.Errorf("Unexpected max counts (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -173,7 +173,7 @@ func checkPodSetAndFlavorMatchForTAS(cq *schdcache.ClusterQueueSnapshot, ps *kue
 			return nil
 		}
 		// PodSet explicitly requires TAS, so we need to check if the flavor supports it.
-		if flavor.Spec.TopologyName == nil {
+		if !tas.IsTASFlavor(flavor) {
 			if !hasOverlapWithPodRequestedResources(ps, rg.CoveredResources) {
 				// We only accept the flavor if it does not have any intersection with
 				// the resources which are going to be provided by the TAS flavor.
@@ -197,7 +197,7 @@ func checkPodSetAndFlavorMatchForTAS(cq *schdcache.ClusterQueueSnapshot, ps *kue
 		return nil
 	}
 	// PodSet doesn't require TAS, but the flavor supports it.
-	if flavor.Spec.TopologyName != nil {
+	if tas.IsTASFlavor(flavor) {
 		return new(fmt.Sprintf("Flavor %q supports only TopologyAwareScheduling", flavor.Name))
 	}
 	// PodSet doesn't require TAS and the flavor doesn't support it, so it's a match.
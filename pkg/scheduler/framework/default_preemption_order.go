@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	preemptioncommon "sigs.k8s.io/kueue/pkg/scheduler/preemption/common"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// DefaultPreemptionOrderName is the name of the PreemptionCandidateOrder
+// plugin ordering the candidates as done without a scheduling profile.
+const DefaultPreemptionOrderName = "DefaultPreemptionOrder"
+
+func init() {
+	utilruntime.Must(RegisterPlugin(DefaultPreemptionOrderName, newDefaultPreemptionOrder))
+}
+
+type defaultPreemptionOrder struct{}
+
+var _ PreemptionCandidateOrderPlugin = (*defaultPreemptionOrder)(nil)
+
+func newDefaultPreemptionOrder(_ runtime.RawExtension, _ Handle) (Plugin, error) {
+	return &defaultPreemptionOrder{}, nil
+}
+
+func (p *defaultPreemptionOrder) Name() string {
+	return DefaultPreemptionOrderName
+}
+
+func (p *defaultPreemptionOrder) CompareCandidates(log logr.Logger, afsEnabled bool, a, b *workload.Info, preemptorCQ kueue.ClusterQueueReference, now time.Time) int {
+	return preemptioncommon.CandidatesOrdering(log, afsEnabled, a, b, preemptorCQ, now)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// FlavorPriceName is the name of the FlavorScore plugin preferring the
+// flavors in which the requests are the cheapest, according to the prices of
+// the ResourceFlavors. The cost is computed as for the LowestCost flavor
+// fungibility preference.
+const FlavorPriceName = "FlavorPrice"
+
+func init() {
+	utilruntime.Must(RegisterPlugin(FlavorPriceName, newFlavorPrice))
+}
+
+type flavorPrice struct{}
+
+var _ FlavorScorePlugin = (*flavorPrice)(nil)
+
+func newFlavorPrice(_ runtime.RawExtension, _ Handle) (Plugin, error) {
+	return &flavorPrice{}, nil
+}
+
+func (p *flavorPrice) Name() string {
+	return FlavorPriceName
+}
+
+// ScoreFlavor returns the opposite of the cost of the requests in the flavor,
+// in thousandths of the price unit.
+func (p *flavorPrice) ScoreFlavor(_ context.Context, _ *workload.Info, _ *schdcache.ClusterQueueSnapshot, flavor *kueue.ResourceFlavor, requests resources.Requests) int64 {
+	if flavor == nil || len(flavor.Spec.Prices) == 0 {
+		return 0
+	}
+	cost := workload.Cost(flavor.Spec.Prices, requests.ToResourceList(nil))
+	return -cost.MilliValue()
+}
@@ -0,0 +1,216 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	errTooManyQueueSortPlugins                = errors.New("at most one QueueSort plugin can be enabled")
+	errTooManyPreemptionCandidateOrderPlugins = errors.New("at most one PreemptionCandidateOrder plugin can be enabled")
+	errExtensionPoint                         = errors.New("plugin does not implement the extension point")
+	errUnusedPluginConfig                     = errors.New("plugin config for a plugin which is not enabled")
+)
+
+// Framework runs the plugins enabled in the scheduling profile at the
+// extension points of the admission cycle. A nil Framework runs no plugins.
+type Framework struct {
+	queueSort                 QueueSortPlugin
+	preFilter                 []PreFilterPlugin
+	flavorScore               []FlavorScorePlugin
+	preemptionCandidateFilter []PreemptionCandidateFilterPlugin
+	preemptionCandidateOrder  PreemptionCandidateOrderPlugin
+	postAdmit                 []PostAdmitPlugin
+}
+
+// New builds the plugins enabled in the profile. A plugin enabled at several
+// extension points is built once.
+func New(profile *configapi.SchedulingProfile, handle Handle) (*Framework, error) {
+	if profile == nil {
+		return nil, nil
+	}
+	if len(profile.Plugins.QueueSort) > 1 {
+		return nil, errTooManyQueueSortPlugins
+	}
+	if len(profile.Plugins.PreemptionCandidateOrder) > 1 {
+		return nil, errTooManyPreemptionCandidateOrderPlugins
+	}
+	args := make(map[string]runtime.RawExtension, len(profile.PluginConfig))
+	for _, pc := range profile.PluginConfig {
+		args[pc.Name] = pc.Args
+	}
+	plugins := make(map[string]Plugin)
+	build := func(name string) (Plugin, error) {
+		if p, found := plugins[name]; found {
+			return p, nil
+		}
+		factory, err := pluginFactory(name)
+		if err != nil {
+			return nil, err
+		}
+		p, err := factory(args[name], handle)
+		if err != nil {
+			return nil, fmt.Errorf("building plugin %q: %w", name, err)
+		}
+		plugins[name] = p
+		return p, nil
+	}
+
+	f := &Framework{}
+	queueSort, err := pluginsAt[QueueSortPlugin](profile.Plugins.QueueSort, "QueueSort", build)
+	if err != nil {
+		return nil, err
+	}
+	if len(queueSort) > 0 {
+		f.queueSort = queueSort[0]
+	}
+	if f.preFilter, err = pluginsAt[PreFilterPlugin](profile.Plugins.PreFilter, "PreFilter", build); err != nil {
+		return nil, err
+	}
+	if f.flavorScore, err = pluginsAt[FlavorScorePlugin](profile.Plugins.FlavorScore, "FlavorScore", build); err != nil {
+		return nil, err
+	}
+	if f.preemptionCandidateFilter, err = pluginsAt[PreemptionCandidateFilterPlugin](profile.Plugins.PreemptionCandidateFilter, "PreemptionCandidateFilter", build); err != nil {
+		return nil, err
+	}
+	candidateOrder, err := pluginsAt[PreemptionCandidateOrderPlugin](profile.Plugins.PreemptionCandidateOrder, "PreemptionCandidateOrder", build)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidateOrder) > 0 {
+		f.preemptionCandidateOrder = candidateOrder[0]
+	}
+	if f.postAdmit, err = pluginsAt[PostAdmitPlugin](profile.Plugins.PostAdmit, "PostAdmit", build); err != nil {
+		return nil, err
+	}
+	for name := range args {
+		if _, found := plugins[name]; !found {
+			return nil, fmt.Errorf("%w %q", errUnusedPluginConfig, name)
+		}
+	}
+	return f, nil
+}
+
+// pluginsAt builds the named plugins, which must implement the extension
+// point.
+func pluginsAt[T Plugin](names []string, extensionPoint string, build func(string) (Plugin, error)) ([]T, error) {
+	var result []T
+	for _, name := range names {
+		p, err := build(name)
+		if err != nil {
+			return nil, err
+		}
+		typed, ok := p.(T)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q does not implement %s", errExtensionPoint, name, extensionPoint)
+		}
+		result = append(result, typed)
+	}
+	return result, nil
+}
+
+// QueueSortFunc returns the comparison of the QueueSort plugin, or nil when
+// none is enabled.
+func (f *Framework) QueueSortFunc() func(a, b *workload.Info) int {
+	if f == nil || f.queueSort == nil {
+		return nil
+	}
+	return f.queueSort.Compare
+}
+
+// RunPreFilterPlugins runs the PreFilter plugins in order, and returns the
+// status of the first plugin rejecting the workload.
+func (f *Framework) RunPreFilterPlugins(ctx context.Context, wl *workload.Info, cq *schdcache.ClusterQueueSnapshot) *Status {
+	if f == nil {
+		return nil
+	}
+	for _, p := range f.preFilter {
+		if status := p.PreFilter(ctx, wl, cq); !status.IsSuccess() {
+			return NewStatus(status.Reason(), fmt.Sprintf("rejected by plugin %s: %s", p.Name(), status.Message()))
+		}
+	}
+	return nil
+}
+
+// HasFlavorScorePlugins returns true if at least one FlavorScore plugin is
+// enabled.
+func (f *Framework) HasFlavorScorePlugins() bool {
+	return f != nil && len(f.flavorScore) > 0
+}
+
+// ScoreFlavor returns the sum of the scores of the FlavorScore plugins. It
+// implements flavorassigner.FlavorScorer.
+func (f *Framework) ScoreFlavor(ctx context.Context, wl *workload.Info, cq *schdcache.ClusterQueueSnapshot, flavor *kueue.ResourceFlavor, requests resources.Requests) int64 {
+	if f == nil {
+		return 0
+	}
+	var score int64
+	for _, p := range f.flavorScore {
+		score += p.ScoreFlavor(ctx, wl, cq, flavor, requests)
+	}
+	return score
+}
+
+// PreemptionCandidateFilter returns the filter accepting the candidates
+// accepted by all the PreemptionCandidateFilter plugins, or nil when none is
+// enabled.
+func (f *Framework) PreemptionCandidateFilter() func(preemptor *kueue.Workload, candidate *workload.Info) bool {
+	if f == nil || len(f.preemptionCandidateFilter) == 0 {
+		return nil
+	}
+	return func(preemptor *kueue.Workload, candidate *workload.Info) bool {
+		for _, p := range f.preemptionCandidateFilter {
+			if !p.FilterCandidate(preemptor, candidate) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// PreemptionCandidateOrdering returns the comparison of the
+// PreemptionCandidateOrder plugin, or nil when none is enabled.
+func (f *Framework) PreemptionCandidateOrdering() func(log logr.Logger, afsEnabled bool, a, b *workload.Info, preemptorCQ kueue.ClusterQueueReference, now time.Time) int {
+	if f == nil || f.preemptionCandidateOrder == nil {
+		return nil
+	}
+	return f.preemptionCandidateOrder.CompareCandidates
+}
+
+// RunPostAdmitPlugins notifies the PostAdmit plugins of the admission of the
+// workload.
+func (f *Framework) RunPostAdmitPlugins(ctx context.Context, wl *kueue.Workload) {
+	if f == nil {
+		return
+	}
+	for _, p := range f.postAdmit {
+		p.PostAdmit(ctx, wl)
+	}
+}
@@ -0,0 +1,317 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var errMissingNamespace = errors.New("missing namespace")
+
+const (
+	rejectNamespaceName = "RejectNamespace"
+	protectLabelName    = "ProtectLabel"
+	postAdmitName       = "PostAdmit"
+)
+
+func init() {
+	utilruntime.Must(RegisterPlugin(rejectNamespaceName, newRejectNamespace))
+	utilruntime.Must(RegisterPlugin(protectLabelName, func(runtime.RawExtension, Handle) (Plugin, error) {
+		return protectLabel{}, nil
+	}))
+	utilruntime.Must(RegisterPlugin(postAdmitName, func(runtime.RawExtension, Handle) (Plugin, error) {
+		return &postAdmit{}, nil
+	}))
+}
+
+type rejectNamespaceArgs struct {
+	Namespace string `json:"namespace"`
+}
+
+// rejectNamespace rejects the workloads of a namespace.
+type rejectNamespace struct {
+	namespace string
+}
+
+func newRejectNamespace(args runtime.RawExtension, _ Handle) (Plugin, error) {
+	var a rejectNamespaceArgs
+	if len(args.Raw) == 0 {
+		return nil, errMissingNamespace
+	}
+	if err := json.Unmarshal(args.Raw, &a); err != nil {
+		return nil, err
+	}
+	return &rejectNamespace{namespace: a.Namespace}, nil
+}
+
+func (p *rejectNamespace) Name() string { return rejectNamespaceName }
+
+func (p *rejectNamespace) PreFilter(_ context.Context, wl *workload.Info, _ *schdcache.ClusterQueueSnapshot) *Status {
+	if wl.Obj.Namespace == p.namespace {
+		return NewStatus("", "namespace is rejected")
+	}
+	return nil
+}
+
+func (p *rejectNamespace) ScoreFlavor(context.Context, *workload.Info, *schdcache.ClusterQueueSnapshot, *kueue.ResourceFlavor, resources.Requests) int64 {
+	return 1
+}
+
+// protectLabel protects the workloads labeled protected from preemption.
+type protectLabel struct{}
+
+func (protectLabel) Name() string { return protectLabelName }
+
+func (protectLabel) FilterCandidate(_ *kueue.Workload, candidate *workload.Info) bool {
+	return candidate.Obj.Labels["protected"] != "true"
+}
+
+func (protectLabel) ScoreFlavor(_ context.Context, _ *workload.Info, _ *schdcache.ClusterQueueSnapshot, flavor *kueue.ResourceFlavor, _ resources.Requests) int64 {
+	return int64(len(flavor.Name))
+}
+
+type postAdmit struct {
+	admitted []string
+}
+
+func (p *postAdmit) Name() string { return postAdmitName }
+
+func (p *postAdmit) PostAdmit(_ context.Context, wl *kueue.Workload) {
+	p.admitted = append(p.admitted, wl.Name)
+}
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		profile *configapi.SchedulingProfile
+		wantErr error
+	}{
+		"no profile": {},
+		"empty profile": {
+			profile: &configapi.SchedulingProfile{},
+		},
+		"valid profile": {
+			profile: &configapi.SchedulingProfile{
+				Plugins: configapi.SchedulingPlugins{
+					QueueSort:   []string{PrioritySortName},
+					PreFilter:   []string{rejectNamespaceName},
+					FlavorScore: []string{rejectNamespaceName, protectLabelName},
+				},
+				PluginConfig: []configapi.PluginConfig{{
+					Name: rejectNamespaceName,
+					Args: runtime.RawExtension{Raw: []byte(`{"namespace":"ns"}`)},
+				}},
+			},
+		},
+		"unknown plugin": {
+			profile: &configapi.SchedulingProfile{
+				Plugins: configapi.SchedulingPlugins{PreFilter: []string{"Unknown"}},
+			},
+			wantErr: errPluginNotFound,
+		},
+		"too many QueueSort plugins": {
+			profile: &configapi.SchedulingProfile{
+				Plugins: configapi.SchedulingPlugins{QueueSort: []string{PrioritySortName, PrioritySortName}},
+			},
+			wantErr: errTooManyQueueSortPlugins,
+		},
+		"too many PreemptionCandidateOrder plugins": {
+			profile: &configapi.SchedulingProfile{
+				Plugins: configapi.SchedulingPlugins{PreemptionCandidateOrder: []string{DefaultPreemptionOrderName, DefaultPreemptionOrderName}},
+			},
+			wantErr: errTooManyPreemptionCandidateOrderPlugins,
+		},
+		"plugin not implementing the extension point": {
+			profile: &configapi.SchedulingProfile{
+				Plugins: configapi.SchedulingPlugins{PreFilter: []string{protectLabelName}},
+			},
+			wantErr: errExtensionPoint,
+		},
+		"plugin config for a plugin which is not enabled": {
+			profile: &configapi.SchedulingProfile{
+				Plugins:      configapi.SchedulingPlugins{QueueSort: []string{PrioritySortName}},
+				PluginConfig: []configapi.PluginConfig{{Name: protectLabelName}},
+			},
+			wantErr: errUnusedPluginConfig,
+		},
+		"plugin failing to build": {
+			profile: &configapi.SchedulingProfile{
+				Plugins: configapi.SchedulingPlugins{PreFilter: []string{rejectNamespaceName}},
+			},
+			wantErr: errMissingNamespace,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tc.profile, Handle{})
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("Unexpected error, want=%v, got=%v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRegisterPluginTwice(t *testing.T) {
+	err := RegisterPlugin(PrioritySortName, newPrioritySort)
+	if !errors.Is(err, errDuplicatePluginName) {
+		t.Errorf("Unexpected error, want=%v, got=%v", errDuplicatePluginName, err)
+	}
+}
+
+func TestNilFramework(t *testing.T) {
+	var f *Framework
+	if f.QueueSortFunc() != nil {
+		t.Error("Unexpected queue sort function")
+	}
+	if status := f.RunPreFilterPlugins(t.Context(), nil, nil); !status.IsSuccess() {
+		t.Errorf("Unexpected status: %q", status.Message())
+	}
+	if f.HasFlavorScorePlugins() {
+		t.Error("Unexpected FlavorScore plugins")
+	}
+	if f.PreemptionCandidateFilter() != nil {
+		t.Error("Unexpected preemption candidate filter")
+	}
+	if f.PreemptionCandidateOrdering() != nil {
+		t.Error("Unexpected preemption candidate ordering")
+	}
+	f.RunPostAdmitPlugins(t.Context(), nil)
+}
+
+func TestExtensionPoints(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	fw, err := New(&configapi.SchedulingProfile{
+		Plugins: configapi.SchedulingPlugins{
+			QueueSort:                 []string{PrioritySortName},
+			PreFilter:                 []string{rejectNamespaceName},
+			FlavorScore:               []string{rejectNamespaceName, protectLabelName},
+			PreemptionCandidateFilter: []string{protectLabelName},
+			PreemptionCandidateOrder:  []string{DefaultPreemptionOrderName},
+			PostAdmit:                 []string{postAdmitName},
+		},
+		PluginConfig: []configapi.PluginConfig{{
+			Name: rejectNamespaceName,
+			Args: runtime.RawExtension{Raw: []byte(`{"namespace":"rejected"}`)},
+		}},
+	}, Handle{})
+	if err != nil {
+		t.Fatalf("Building the framework: %v", err)
+	}
+
+	high := workload.NewInfo(utiltestingapi.MakeWorkload("high", "ns").Priority(10).Creation(now.Add(time.Second)).Obj())
+	low := workload.NewInfo(utiltestingapi.MakeWorkload("low", "ns").Priority(1).Creation(now).Obj())
+	old := workload.NewInfo(utiltestingapi.MakeWorkload("old", "ns").Priority(1).Creation(now.Add(-time.Second)).Obj())
+	queueSort := fw.QueueSortFunc()
+	if queueSort(high, low) >= 0 {
+		t.Error("Expected the workload with the higher priority first")
+	}
+	if queueSort(old, low) >= 0 {
+		t.Error("Expected the older workload first")
+	}
+	if queueSort(low, low) != 0 {
+		t.Error("Expected no preference between equal workloads")
+	}
+
+	if status := fw.RunPreFilterPlugins(t.Context(), high, nil); !status.IsSuccess() {
+		t.Errorf("Unexpected rejection: %q", status.Message())
+	}
+	rejected := workload.NewInfo(utiltestingapi.MakeWorkload("wl", "rejected").Obj())
+	status := fw.RunPreFilterPlugins(t.Context(), rejected, nil)
+	if status.IsSuccess() {
+		t.Fatal("Expected the workload to be rejected")
+	}
+	if diff := cmp.Diff("rejected by plugin RejectNamespace: namespace is rejected", status.Message()); diff != "" {
+		t.Errorf("Unexpected message (-want,+got):\n%s", diff)
+	}
+	if status.Reason() != kueue.WorkloadQuotaReservedReasonWaitingForQuota {
+		t.Errorf("Unexpected reason: %q", status.Reason())
+	}
+
+	if !fw.HasFlavorScorePlugins() {
+		t.Error("Expected FlavorScore plugins")
+	}
+	if score := fw.ScoreFlavor(t.Context(), high, nil, utiltestingapi.MakeResourceFlavor("spot").Obj(), nil); score != 5 {
+		t.Errorf("Unexpected score, want=5, got=%d", score)
+	}
+
+	filter := fw.PreemptionCandidateFilter()
+	protected := workload.NewInfo(utiltestingapi.MakeWorkload("protected", "ns").Label("protected", "true").Obj())
+	if filter(high.Obj, protected) {
+		t.Error("Expected the protected workload to be filtered out")
+	}
+	if !filter(high.Obj, low) {
+		t.Error("Expected the workload to be a candidate")
+	}
+
+	ordering := fw.PreemptionCandidateOrdering()
+	if ordering(logr.Discard(), false, low, high, "cq", now) >= 0 {
+		t.Error("Expected the workload with the lower priority to be preempted first")
+	}
+
+	fw.RunPostAdmitPlugins(t.Context(), high.Obj)
+	if diff := cmp.Diff([]string{"high"}, fw.postAdmit[0].(*postAdmit).admitted); diff != "" {
+		t.Errorf("Unexpected admitted workloads (-want,+got):\n%s", diff)
+	}
+}
+
+func TestFlavorPrice(t *testing.T) {
+	p, err := newFlavorPrice(runtime.RawExtension{}, Handle{})
+	if err != nil {
+		t.Fatalf("Building the plugin: %v", err)
+	}
+	scorer := p.(FlavorScorePlugin)
+	requests := resources.MapRequests{corev1.ResourceCPU: 2000, corev1.ResourceMemory: 1024}
+	cases := map[string]struct {
+		flavor *kueue.ResourceFlavor
+		want   int64
+	}{
+		"no prices": {
+			flavor: utiltestingapi.MakeResourceFlavor("default").Obj(),
+		},
+		"priced flavor": {
+			flavor: utiltestingapi.MakeResourceFlavor("on-demand").Price(corev1.ResourceCPU, "1.5").Obj(),
+			want:   -3000,
+		},
+		"cheaper flavor": {
+			flavor: utiltestingapi.MakeResourceFlavor("spot").Price(corev1.ResourceCPU, "500m").Obj(),
+			want:   -1000,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := scorer.ScoreFlavor(t.Context(), nil, nil, tc.flavor, requests); got != tc.want {
+				t.Errorf("Unexpected score, want=%d, got=%d", tc.want, got)
+			}
+		})
+	}
+}
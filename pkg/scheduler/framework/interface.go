@@ -0,0 +1,149 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// Plugin is the parent type of all the scheduling plugins.
+type Plugin interface {
+	// Name returns the name the plugin is registered with.
+	Name() string
+}
+
+// QueueSortPlugin orders the pending workloads within a ClusterQueue.
+// Compare is called while the ClusterQueue is locked, so it must be fast and
+// must not call back into the queues.
+type QueueSortPlugin interface {
+	Plugin
+	// Compare returns a negative number when a should be popped before b,
+	// a positive number when b should be popped first, and zero when the
+	// plugin has no preference.
+	Compare(a, b *workload.Info) int
+}
+
+// PreFilterPlugin checks whether a workload can be considered for admission
+// in the current scheduling cycle, before flavors are assigned.
+type PreFilterPlugin interface {
+	Plugin
+	// PreFilter returns nil when the workload can be considered, or the
+	// status explaining why it cannot.
+	PreFilter(ctx context.Context, wl *workload.Info, cq *schdcache.ClusterQueueSnapshot) *Status
+}
+
+// FlavorScorePlugin scores the flavors which fit the podsets of a workload.
+// The scores break the ties between the flavors which are equally preferred
+// by the flavor fungibility policy of the ClusterQueue.
+type FlavorScorePlugin interface {
+	Plugin
+	// ScoreFlavor returns the score of the flavor for the requests of the
+	// workload. Higher scores are better.
+	ScoreFlavor(ctx context.Context, wl *workload.Info, cq *schdcache.ClusterQueueSnapshot, flavor *kueue.ResourceFlavor, requests resources.Requests) int64
+}
+
+// PreemptionCandidateFilterPlugin excludes workloads from the preemption
+// candidates of a workload, in addition to the preemption policies of the
+// ClusterQueue.
+type PreemptionCandidateFilterPlugin interface {
+	Plugin
+	// FilterCandidate returns false when the candidate must not be preempted
+	// by the preemptor.
+	FilterCandidate(preemptor *kueue.Workload, candidate *workload.Info) bool
+}
+
+// PreemptionCandidateOrderPlugin orders the preemption candidates of a
+// workload. The candidates are preempted in this order until the workload
+// fits.
+type PreemptionCandidateOrderPlugin interface {
+	Plugin
+	// CompareCandidates returns a negative number when a should be preempted
+	// before b, a positive number when b should be preempted first, and zero
+	// when the plugin has no preference. afsEnabled is whether admission fair
+	// sharing is enabled, and preemptorCQ is the ClusterQueue of the preemptor.
+	CompareCandidates(log logr.Logger, afsEnabled bool, a, b *workload.Info, preemptorCQ kueue.ClusterQueueReference, now time.Time) int
+}
+
+// PostAdmitPlugin is notified once a workload is admitted. It is called
+// asynchronously from the scheduling cycle.
+type PostAdmitPlugin interface {
+	Plugin
+	// PostAdmit is called with the workload after its quota reservation
+	// was persisted.
+	PostAdmit(ctx context.Context, wl *kueue.Workload)
+}
+
+// Handle provides the plugins with the state shared by the scheduler.
+type Handle struct {
+	// WorkloadOrdering is the ordering of the workloads configured in Kueue.
+	WorkloadOrdering workload.Ordering
+	// Clock is the clock of the scheduler.
+	Clock clock.Clock
+	// Log is the logger of the scheduler.
+	Log logr.Logger
+}
+
+// PluginFactory builds a plugin from its arguments in the scheduling profile.
+// The arguments are empty when the profile has none for the plugin.
+type PluginFactory func(args runtime.RawExtension, handle Handle) (Plugin, error)
+
+// Status is the result of a plugin rejecting a workload.
+type Status struct {
+	reason  string
+	message string
+}
+
+// NewStatus returns a status with the reason of the QuotaReserved condition
+// set on the rejected workload, and a human readable message. An empty reason
+// defaults to WaitingForQuota.
+func NewStatus(reason, message string) *Status {
+	return &Status{reason: reason, message: message}
+}
+
+// IsSuccess returns true if the status doesn't reject the workload.
+func (s *Status) IsSuccess() bool {
+	return s == nil
+}
+
+// Reason returns the reason of the QuotaReserved condition.
+func (s *Status) Reason() string {
+	if s == nil {
+		return ""
+	}
+	if s.reason == "" {
+		return kueue.WorkloadQuotaReservedReasonWaitingForQuota
+	}
+	return s.reason
+}
+
+// Message returns the message of the status.
+func (s *Status) Message() string {
+	if s == nil {
+		return ""
+	}
+	return s.message
+}
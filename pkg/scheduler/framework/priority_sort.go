@@ -0,0 +1,55 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// PrioritySortName is the name of the QueueSort plugin ordering the workloads
+// by priority and queue order timestamp, as done without a scheduling profile.
+const PrioritySortName = "PrioritySort"
+
+func init() {
+	utilruntime.Must(RegisterPlugin(PrioritySortName, newPrioritySort))
+}
+
+type prioritySort struct {
+	ordering workload.Ordering
+	log      logr.Logger
+}
+
+var _ QueueSortPlugin = (*prioritySort)(nil)
+
+func newPrioritySort(_ runtime.RawExtension, handle Handle) (Plugin, error) {
+	return &prioritySort{
+		ordering: handle.WorkloadOrdering,
+		log:      handle.Log,
+	}, nil
+}
+
+func (p *prioritySort) Name() string {
+	return PrioritySortName
+}
+
+func (p *prioritySort) Compare(a, b *workload.Info) int {
+	return p.ordering.Compare(p.log, a.Obj, b.Obj)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	errDuplicatePluginName = errors.New("duplicate plugin name")
+	errPluginNotFound      = errors.New("plugin not found")
+	errMissingFactory      = errors.New("missing plugin factory")
+)
+
+// Registry maps the names of the plugins to their factories.
+type Registry map[string]PluginFactory
+
+// Register adds the plugin factory to the registry.
+func (r Registry) Register(name string, factory PluginFactory) error {
+	if factory == nil {
+		return fmt.Errorf("%w for %q", errMissingFactory, name)
+	}
+	if _, exists := r[name]; exists {
+		return fmt.Errorf("%w %q", errDuplicatePluginName, name)
+	}
+	r[name] = factory
+	return nil
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = Registry{}
+)
+
+// RegisterPlugin registers the plugin factory so that the plugin can be
+// enabled in the scheduling profile. Out-of-tree plugins are expected to call
+// it from an init function of a package imported by the Kueue binary.
+func RegisterPlugin(name string, factory PluginFactory) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	return registry.Register(name, factory)
+}
+
+func pluginFactory(name string) (PluginFactory, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	factory, found := registry[name]
+	if !found {
+		return nil, fmt.Errorf("%w %q", errPluginNotFound, name)
+	}
+	return factory, nil
+}
@@ -66,6 +66,8 @@ type HierarchicalPreemptionCtx struct {
 	FrsNeedPreemption sets.Set[resources.FlavorResource]
 	Requests          resources.FlavorResourceQuantities
 	WorkloadOrdering  workload.Ordering
+	// CandidateFilter, when set, excludes candidates from preemption.
+	CandidateFilter preemptioncommon.CandidateFilter
 }
 
 func IsBorrowingWithinCohortForbidden(cq *schdcache.ClusterQueueSnapshot) (bool, *int32) {
@@ -94,6 +96,10 @@ func classifyPreemptionVariant(ctx *HierarchicalPreemptionCtx, wl *workload.Info
 		return Never
 	}
 
+	if ctx.CandidateFilter != nil && !ctx.CandidateFilter(ctx.Wl, wl) {
+		return Never
+	}
+
 	if wl.ClusterQueue == ctx.Cq.Name {
 		return WithinCQ
 	}
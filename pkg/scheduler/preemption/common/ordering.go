@@ -32,6 +32,10 @@ import (
 	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
 )
 
+// CandidateOrdering returns a negative number when the candidate a should be
+// preempted before b, and a positive number when b should be preempted first.
+type CandidateOrdering func(log logr.Logger, afsEnabled bool, a, b *workload.Info, cq kueue.ClusterQueueReference, now time.Time) int
+
 // CandidatesOrdering criteria:
 // 0. Workloads already marked for preemption first.
 // 1. Workloads from other ClusterQueues in the cohort before the ones in the
@@ -24,6 +24,10 @@ import (
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
+// CandidateFilter reports whether the candidate can be preempted by the
+// preemptor, in addition to the preemption policies.
+type CandidateFilter func(preemptor *kueue.Workload, candidate *workload.Info) bool
+
 func SatisfiesPreemptionPolicy(log logr.Logger, preemptor, candidate *kueue.Workload, workloadOrdering workload.Ordering, policy kueue.PreemptionPolicy) bool {
 	preemptorPriority := priority.EffectivePriority(log, preemptor)
 	candidatePriority := priority.EffectivePriority(log, candidate)
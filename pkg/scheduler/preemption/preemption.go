@@ -73,6 +73,8 @@ type Preemptor struct {
 	roleTracker            *roletracker.RoleTracker
 	customLabels           *metrics.CustomLabels
 	preemptionExpectations *expectations.Store
+	candidateFilter        preemptioncommon.CandidateFilter
+	candidateOrdering      preemptioncommon.CandidateOrdering
 }
 
 type preemptionCtx struct {
@@ -109,10 +111,25 @@ func New(
 		roleTracker:            tracker,
 		customLabels:           customLabels,
 		preemptionExpectations: preemptionExpectations,
+		candidateOrdering:      preemptioncommon.CandidatesOrdering,
 	}
 	return p
 }
 
+// SetCandidateFilter sets the filter excluding workloads from the preemption
+// candidates, in addition to the preemption policies.
+func (p *Preemptor) SetCandidateFilter(filter preemptioncommon.CandidateFilter) {
+	p.candidateFilter = filter
+}
+
+// SetCandidateOrdering sets the ordering of the preemption candidates. A nil
+// ordering keeps the default one.
+func (p *Preemptor) SetCandidateOrdering(ordering preemptioncommon.CandidateOrdering) {
+	if ordering != nil {
+		p.candidateOrdering = ordering
+	}
+}
+
 type Target struct {
 	WorkloadInfo *workload.Info
 	Reason       string
@@ -289,8 +306,9 @@ func (p *Preemptor) classicalPreemptions(preemptionCtx *preemptionCtx) []*Target
 		FrsNeedPreemption: preemptionCtx.frsNeedPreemption,
 		Requests:          preemptionCtx.workloadUsage.Quota.Assigned,
 		WorkloadOrdering:  p.workloadOrdering,
+		CandidateFilter:   p.candidateFilter,
 	}
-	candidatesGenerator := classical.NewCandidateIterator(hierarchicalReclaimCtx, p.enabledAfs, preemptionCtx.frsNeedPreemption, preemptionCtx.snapshot, p.clock, preemptioncommon.CandidatesOrdering)
+	candidatesGenerator := classical.NewCandidateIterator(hierarchicalReclaimCtx, p.enabledAfs, preemptionCtx.frsNeedPreemption, preemptionCtx.snapshot, p.clock, p.candidateOrdering)
 	var attemptPossibleOpts []preemptionAttemptOpts
 	borrowWithinCohortForbidden, _ := classical.IsBorrowingWithinCohortForbidden(preemptionCtx.preemptorCQ)
 	// We have three types of candidates:
@@ -539,7 +557,7 @@ func (p *Preemptor) fairPreemptions(preemptionCtx *preemptionCtx, strategies []f
 		return nil
 	}
 	slices.SortFunc(candidates, func(a, b *workload.Info) int {
-		return preemptioncommon.CandidatesOrdering(preemptionCtx.log, p.enabledAfs, a, b, preemptionCtx.preemptorCQ.Name, p.clock.Now())
+		return p.candidateOrdering(preemptionCtx.log, p.enabledAfs, a, b, preemptionCtx.preemptorCQ.Name, p.clock.Now())
 	})
 	if logV := preemptionCtx.log.V(5); logV.Enabled() {
 		logV.Info(
@@ -607,6 +625,7 @@ func findCandidatesForPolicy(
 	policy kueue.PreemptionPolicy,
 	frsNeedPreemption sets.Set[resources.FlavorResource],
 	workloadOrdering workload.Ordering,
+	candidateFilter preemptioncommon.CandidateFilter,
 ) []*workload.Info {
 	var candidates []*workload.Info
 	for _, candidateWl := range workloadsToFilter {
@@ -622,6 +641,9 @@ func findCandidatesForPolicy(
 		if !classical.WorkloadUsesResources(candidateWl, frsNeedPreemption) {
 			continue
 		}
+		if candidateFilter != nil && !candidateFilter(wl, candidateWl) {
+			continue
+		}
 		candidates = append(candidates, candidateWl)
 	}
 	return candidates
@@ -634,7 +656,7 @@ func (p *Preemptor) findCandidates(log logr.Logger, wl *kueue.Workload, cq *schd
 	var candidates []*workload.Info
 
 	if cq.Preemption.WithinClusterQueue != kueue.PreemptionPolicyNever {
-		newCandidates := findCandidatesForPolicy(log, wl, cq.Workloads, cq.Preemption.WithinClusterQueue, frsNeedPreemption, p.workloadOrdering)
+		newCandidates := findCandidatesForPolicy(log, wl, cq.Workloads, cq.Preemption.WithinClusterQueue, frsNeedPreemption, p.workloadOrdering, p.candidateFilter)
 		candidates = append(candidates, newCandidates...)
 	}
 
@@ -644,7 +666,7 @@ func (p *Preemptor) findCandidates(log logr.Logger, wl *kueue.Workload, cq *schd
 				// Can't reclaim quota from itself or ClusterQueues that are not borrowing.
 				continue
 			}
-			newCandidates := findCandidatesForPolicy(log, wl, cohortCQ.Workloads, cq.Preemption.ReclaimWithinCohort, frsNeedPreemption, p.workloadOrdering)
+			newCandidates := findCandidatesForPolicy(log, wl, cohortCQ.Workloads, cq.Preemption.ReclaimWithinCohort, frsNeedPreemption, p.workloadOrdering, p.candidateFilter)
 			candidates = append(candidates, newCandidates...)
 		}
 	}
@@ -0,0 +1,38 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+
+	"sigs.k8s.io/kueue/pkg/util/routine"
+)
+
+// RunCycle runs the next scheduling cycle, waits for the admission of the
+// assumed workloads to complete and records the cycle. It is meant for
+// replaying recorded cycles offline, with a scheduler which is not started.
+func (s *Scheduler) RunCycle(ctx context.Context) {
+	var wg sync.WaitGroup
+	s.setAdmissionRoutineWrapper(routine.NewWrapper(
+		func() { wg.Add(1) },
+		func() { wg.Done() },
+	))
+	s.schedule(ctx)
+	wg.Wait()
+	s.flushCycleTraces(ctx)
+}
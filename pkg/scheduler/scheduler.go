@@ -24,6 +24,7 @@ import (
 	"maps"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -49,8 +50,10 @@ import (
 	"sigs.k8s.io/kueue/pkg/metrics"
 	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/framework"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
+	"sigs.k8s.io/kueue/pkg/scheduler/trace"
 	afs "sigs.k8s.io/kueue/pkg/util/admissionfairsharing"
 	"sigs.k8s.io/kueue/pkg/util/api"
 	"sigs.k8s.io/kueue/pkg/util/expectations"
@@ -91,10 +94,15 @@ type Scheduler struct {
 	roleTracker             *roletracker.RoleTracker
 	customLabels            *metrics.CustomLabels
 	resourceFormatter       *resources.ResourceFormatter
+	traceRecorder           TraceRecorder
+	traceConfig             trace.Config
+	pendingTraces           chan *pendingTrace
+	framework               *framework.Framework
 
 	// schedulingCycle identifies the number of scheduling
-	// attempts since the last restart.
-	schedulingCycle int64
+	// attempts since the last restart. It is read outside of the
+	// scheduling loop by admission simulations.
+	schedulingCycle atomic.Int64
 }
 
 type options struct {
@@ -107,6 +115,10 @@ type options struct {
 	preemptionExpectations      *expectations.Store
 	customLabels                *metrics.CustomLabels
 	resourceFormatter           *resources.ResourceFormatter
+	traceRecorder               TraceRecorder
+	traceConfig                 trace.Config
+	framework                   *framework.Framework
+	schedulingCycle             int64
 }
 
 // Option configures the reconciler.
@@ -145,6 +157,15 @@ func WithClock(_ testing.TB, c clock.Clock) Option {
 	}
 }
 
+// WithReplay sets up the scheduler to run a recorded cycle again: the clock
+// stands at the recorded time and the cycles are numbered from the recorded one.
+func WithReplay(c clock.Clock, cycle int64) Option {
+	return func(o *options) {
+		o.clock = c
+		o.schedulingCycle = cycle
+	}
+}
+
 // WithRoleTracker sets the role tracker for HA logging.
 func WithRoleTracker(tracker *roletracker.RoleTracker) Option {
 	return func(o *options) {
@@ -179,6 +200,23 @@ func WithResourceFormatter(formatter *resources.ResourceFormatter) Option {
 	}
 }
 
+// WithTraceRecorder sets the recorder of the scheduling cycles. The
+// configuration is stored along with every cycle, so that it can be replayed.
+func WithTraceRecorder(r TraceRecorder, cfg trace.Config) Option {
+	return func(o *options) {
+		o.traceRecorder = r
+		o.traceConfig = cfg
+	}
+}
+
+// WithFramework sets the framework running the plugins of the scheduling
+// profile during the admission cycle.
+func WithFramework(fw *framework.Framework) Option {
+	return func(o *options) {
+		o.framework = fw
+	}
+}
+
 func New(queues *qcache.Manager, cache *schdcache.Cache, cl client.Client, recorder events.EventRecorder, opts ...Option) *Scheduler {
 	options := defaultOptions
 	for _, opt := range opts {
@@ -215,7 +253,18 @@ func New(queues *qcache.Manager, cache *schdcache.Cache, cl client.Client, recor
 		roleTracker:             options.roleTracker,
 		customLabels:            options.customLabels,
 		resourceFormatter:       options.resourceFormatter,
+		traceRecorder:           options.traceRecorder,
+		traceConfig:             options.traceConfig,
+		framework:               options.framework,
 	}
+	if options.schedulingCycle > 0 {
+		s.schedulingCycle.Store(options.schedulingCycle - 1)
+	}
+	if s.traceRecorder != nil {
+		s.pendingTraces = make(chan *pendingTrace, traceQueueSize)
+	}
+	s.preemptor.SetCandidateFilter(options.framework.PreemptionCandidateFilter())
+	s.preemptor.SetCandidateOrdering(options.framework.PreemptionCandidateOrdering())
 	return s
 }
 
@@ -223,6 +272,9 @@ func New(queues *qcache.Manager, cache *schdcache.Cache, cl client.Client, recor
 func (s *Scheduler) Start(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx).WithName("scheduler")
 	ctx = ctrl.LoggerInto(ctx, log)
+	if s.traceRecorder != nil {
+		go s.runTraceRecorder(ctx)
+	}
 	go wait.UntilWithBackoff(ctx, s.schedule)
 	return nil
 }
@@ -306,8 +358,8 @@ func (s *Scheduler) reportSkippedPreemptions(p map[kueue.ClusterQueueReference]i
 }
 
 func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
-	s.schedulingCycle++
-	log := roletracker.WithReplicaRole(ctrl.LoggerFrom(ctx), s.roleTracker).WithValues("schedulingCycle", s.schedulingCycle)
+	schedulingCycle := s.schedulingCycle.Add(1)
+	log := roletracker.WithReplicaRole(ctrl.LoggerFrom(ctx), s.roleTracker).WithValues("schedulingCycle", schedulingCycle)
 	ctx = ctrl.LoggerInto(ctx, log)
 	cycleStartTime := s.clock.Now()
 	log.V(2).Info("Scheduling cycle starts")
@@ -339,6 +391,11 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	logSnapshotIfVerbose(log, snapshot)
 	log.V(2).Info("Snapshot taken", "duration", s.clock.Since(phaseStartTime))
 
+	var cycleTrace *pendingTrace
+	if s.traceRecorder != nil {
+		cycleTrace = s.newCycleTrace(schedulingCycle, startTime, heads, snapshot)
+	}
+
 	// 3. Calculate requirements (resource flavors, borrowing) for admitting workloads.
 	phaseStartTime = s.clock.Now()
 	entries, inadmissibleEntries := s.nominate(ctx, heads, snapshot)
@@ -363,6 +420,9 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	result := metrics.AdmissionResultInadmissible
 	for _, e := range entries {
 		logAdmissionAttemptIfVerbose(log, &e)
+		if e.status == assumed {
+			s.queues.ClearBackfillReservation(&e.Info)
+		}
 		// When the workload is evicted by scheduler we skip requeueAndUpdate.
 		// The eviction process will be finalized by the workload controller.
 		if e.status != assumed && e.status != evicted {
@@ -377,6 +437,9 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	}
 
 	log.V(2).Info("Workload processing done", "duration", s.clock.Since(phaseStartTime))
+	if cycleTrace != nil {
+		s.recordCycleTrace(ctx, cycleTrace, entries, inadmissibleEntries)
+	}
 	s.reportSkippedPreemptions(skippedPreemptions)
 	metrics.AdmissionAttempt(result, s.clock.Since(startTime), s.roleTracker)
 	if result != metrics.AdmissionResultSuccess {
@@ -413,6 +476,10 @@ func (s *Scheduler) processEntry(
 		}
 	}
 
+	// The quota booked by Reservations the workload can't use stays
+	// unavailable while the workload is processed.
+	defer snapshot.ApplyReservations(&e.Info)()
+
 	// The assignment was computed during nomination, but it may need to be refreshed.
 	// For example, TAS nominations for workloads from different CQs are computed
 	// independently, making them likely to choose conflicting topology domains.
@@ -431,6 +498,7 @@ func (s *Scheduler) processEntry(
 		e.requeueReason = qcache.RequeueReasonNoFit
 		log.V(3).Info("Skipping workload as FlavorAssigner assigned NoFit mode")
 		e.quotaReservedReason = e.assignment.NoFitReason
+		s.reserveForBackfill(ctx, e, snapshot)
 		return
 	}
 
@@ -438,6 +506,7 @@ func (s *Scheduler) processEntry(
 		if len(e.preemptionTargets) == 0 {
 			e.requeueReason = qcache.RequeueReasonPreemptionNoCandidates
 			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
+			s.reserveForBackfill(ctx, e, snapshot)
 			s.reserveCapacityForUnreclaimablePreempt(log, e, cq)
 			return
 		}
@@ -463,6 +532,8 @@ func (s *Scheduler) processEntry(
 		// become available.
 		e.LastAssignment = nil
 		cq.AddUsage(usage)
+		snapshot.AddReservationUsage(&e.Info, usage)
+		snapshot.AddWorkflowUsage(&e.Info, usage)
 		return
 	}
 
@@ -482,8 +553,17 @@ func (s *Scheduler) processEntry(
 		}
 		return
 	}
+	// The budget of the workflow may have been used by another step admitted
+	// in this cycle.
+	if msg, exceeded := snapshot.WorkflowBudgetExceeded(&e.Info); exceeded {
+		e.markSkipped(msg)
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
+		return
+	}
 	preemptedWorkloads.Insert(e.preemptionTargets)
 	cq.AddUsage(usage)
+	snapshot.AddReservationUsage(&e.Info, usage)
+	snapshot.AddWorkflowUsage(&e.Info, usage)
 
 	// Filter out the old workload slice from the preemption targets.
 	// The old workload slice is initially included in the preemption targets because it is treated
@@ -676,22 +756,9 @@ func (s *Scheduler) nominate(ctx context.Context, heads []qcache.Head, snap *sch
 		} else if workload.HasRetryChecks(h.Obj) || workload.HasRejectedChecks(h.Obj) {
 			e.inadmissibleMsg = "The workload has failed admission checks"
 			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonPendingEvaluation
-		} else if snap.InactiveClusterQueueSets.Has(h.ClusterQueue) {
-			e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s is inactive", h.ClusterQueue)
-			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonSuspended
-		} else if e.clusterQueueSnapshot == nil {
-			e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s not found", h.ClusterQueue)
-			e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonMisconfigured
-		} else if err := workload.ValidateAdmissibility(ctx, s.client, &h.Info, e.clusterQueueSnapshot.NamespaceSelector); err != nil {
-			e.inadmissibleMsg = err.Error()
-			if errors.Is(err, workload.ErrInternal) {
+		} else if admissible, err := s.checkAdmissibility(ctx, &e, snap); !admissible {
+			if err != nil {
 				log.Error(err, "Failed to validate workload admissibility")
-				e.skipStatusUpdate = true
-			} else {
-				e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonMisconfigured
-				if errors.Is(err, workload.ErrNamespaceMismatch) {
-					e.requeueReason = qcache.RequeueReasonNamespaceMismatch
-				}
 			}
 		} else {
 			assignment, targets := s.getAssignments(ctx, &e.Info, snap)
@@ -704,6 +771,48 @@ func (s *Scheduler) nominate(ctx context.Context, heads []qcache.Head, snap *sch
 	return entries, inadmissibleEntries
 }
 
+// checkAdmissibility runs the checks the workload of the entry must pass in
+// its ClusterQueue before its flavors are assigned. When a check fails, the
+// entry records why and false is returned, along with the error of a check
+// which couldn't be evaluated.
+func (s *Scheduler) checkAdmissibility(ctx context.Context, e *entry, snap *schdcache.Snapshot) (bool, error) {
+	if snap.InactiveClusterQueueSets.Has(e.ClusterQueue) {
+		e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s is inactive", e.ClusterQueue)
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonSuspended
+	} else if e.clusterQueueSnapshot == nil {
+		e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s not found", e.ClusterQueue)
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonMisconfigured
+	} else if e.clusterQueueSnapshot.Draining {
+		e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s is draining the workloads exceeding the quota", e.ClusterQueue)
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
+	} else if err := workload.ValidateAdmissibility(ctx, s.client, &e.Info, e.clusterQueueSnapshot.NamespaceSelector); err != nil {
+		e.inadmissibleMsg = err.Error()
+		if errors.Is(err, workload.ErrInternal) {
+			e.skipStatusUpdate = true
+			return false, err
+		}
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonMisconfigured
+		if errors.Is(err, workload.ErrNamespaceMismatch) {
+			e.requeueReason = qcache.RequeueReasonNamespaceMismatch
+		}
+	} else if msg, blocked := s.blockedByBackfill(&e.Info); blocked {
+		e.inadmissibleMsg = msg
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
+	} else if msg, exceeded := snap.WorkflowBudgetExceeded(&e.Info); exceeded {
+		e.inadmissibleMsg = msg
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
+	} else if msg, rejected := e.clusterQueueSnapshot.WorkflowReservationRejected(e.Obj); rejected {
+		e.inadmissibleMsg = msg
+		e.quotaReservedReason = kueue.WorkloadQuotaReservedReasonWaitingForQuota
+	} else if status := s.framework.RunPreFilterPlugins(ctx, &e.Info, e.clusterQueueSnapshot); !status.IsSuccess() {
+		e.inadmissibleMsg = status.Message()
+		e.quotaReservedReason = status.Reason()
+	} else {
+		return true, nil
+	}
+	return false, nil
+}
+
 func (s *Scheduler) updateAssignmentIfNeeded(
 	ctx context.Context,
 	log logr.Logger,
@@ -824,12 +933,13 @@ func (s *Scheduler) getAssignments(ctx context.Context, wl *workload.Info, snap
 	// dropped once it no longer describes the current state. Deciding that here rather than
 	// inside the assigner keeps it to one place per Workload per cycle: the assigner runs
 	// again for each reduced pod count when partial admission is in play.
-	if wl.LastAssignment != nil && lastAssignmentOutdated(wl.LastAssignment, cq.AllocatableResourceGeneration, s.schedulingCycle, wl.SchedulingHash) {
+	if wl.LastAssignment != nil && lastAssignmentOutdated(wl.LastAssignment, cq.AllocatableResourceGeneration, s.schedulingCycle.Load(), wl.SchedulingHash) {
 		log.FromContext(ctx).V(6).Info("Clearing Workload's last assignment because it was outdated",
 			"cq.AllocatableResourceGeneration", cq.AllocatableResourceGeneration,
 			"wl.LastAssignment.ClusterQueueGeneration", wl.LastAssignment.ClusterQueueGeneration)
 		wl.LastAssignment = nil
 	}
+	defer snap.ApplyReservations(wl)()
 	assignment, targets := s.getInitialAssignments(ctx, wl, snap)
 	updateAssignmentForTAS(ctx, snap, cq, wl, &assignment, targets)
 	return assignment, targets
@@ -884,24 +994,19 @@ func (s *Scheduler) getInitialAssignments(ctx context.Context, wl *workload.Info
 	flvAssigner := flavorassigner.New(
 		wl, cq, snap.ResourceFlavors, fairsharing.Enabled(s.fairSharing),
 		preemption.NewOracle(s.preemptor, snap), replaceableWorkloadSlice,
-		s.quotaCheckStrategy, s.resourceFormatter, s.schedulingCycle,
+		s.quotaCheckStrategy, s.resourceFormatter, s.schedulingCycle.Load(),
 	)
-	fullAssignment := flvAssigner.Assign(ctx, nil)
-
-	arm := fullAssignment.RepresentativeMode()
-	if arm == flavorassigner.Fit {
-		return fullAssignment, preemptionTargets
-	}
-
-	if arm == flavorassigner.Preempt {
-		faPreemptionTargets := s.preemptor.GetTargets(ctx, *wl, fullAssignment, snap)
-		if len(faPreemptionTargets) > 0 {
-			return fullAssignment, append(preemptionTargets, faPreemptionTargets...)
-		}
+	if s.framework.HasFlavorScorePlugins() {
+		flvAssigner.SetFlavorScorer(s.framework)
 	}
 
+	var reducer *flavorassigner.PodSetReducer[*partialAssignment]
 	if features.Enabled(features.PartialAdmission) && wl.CanBePartiallyAdmitted() {
-		reducer := flavorassigner.NewPodSetReducer(wl.Obj.Spec.PodSets, func(nextCounts []int32) (*partialAssignment, bool) {
+		var shapeConstraints []kueue.PodSetShapeConstraint
+		if features.Enabled(features.PodSetShapeConstraints) {
+			shapeConstraints = wl.Obj.Spec.PodSetShapeConstraints
+		}
+		reducer = flavorassigner.NewPodSetReducer(wl.Obj.Spec.PodSets, shapeConstraints, func(nextCounts []int32) (*partialAssignment, bool) {
 			assignment := flvAssigner.Assign(ctx, nextCounts)
 			mode := assignment.RepresentativeMode()
 			if mode == flavorassigner.Fit {
@@ -916,6 +1021,29 @@ func (s *Scheduler) getInitialAssignments(ctx context.Context, wl *workload.Info
 			}
 			return nil, false
 		})
+	}
+
+	// The maxCount of the shape constraints bounds the counts of the full
+	// assignment.
+	var maxCounts []int32
+	if reducer != nil {
+		maxCounts = reducer.MaxCounts()
+	}
+	fullAssignment := flvAssigner.Assign(ctx, maxCounts)
+
+	arm := fullAssignment.RepresentativeMode()
+	if arm == flavorassigner.Fit {
+		return fullAssignment, preemptionTargets
+	}
+
+	if arm == flavorassigner.Preempt {
+		faPreemptionTargets := s.preemptor.GetTargets(ctx, *wl, fullAssignment, snap)
+		if len(faPreemptionTargets) > 0 {
+			return fullAssignment, append(preemptionTargets, faPreemptionTargets...)
+		}
+	}
+
+	if reducer != nil {
 		if pa, found := reducer.Search(); found {
 			return pa.assignment, append(preemptionTargets, pa.preemptionTargets...)
 		}
@@ -993,6 +1121,7 @@ func (s *Scheduler) admit(ctx context.Context, e *entry, cq *schdcache.ClusterQu
 	admission := &kueue.Admission{
 		ClusterQueue:      e.ClusterQueue,
 		PodSetAssignments: e.assignment.ToAPI(log),
+		Cost:              e.assignment.Cost,
 	}
 
 	consideredStr := flavorassigner.FormatFlavorAssignmentAttemptsForEvents(e.assignment)
@@ -1018,8 +1147,12 @@ func (s *Scheduler) admit(ctx context.Context, e *entry, cq *schdcache.ClusterQu
 
 			// Record metrics and events for quota reservation and admission
 			s.recordWorkloadAdmissionMetrics(log, newWorkload, e.Obj, admission, consideredStr)
+			if e.assignment.CostSearchIncomplete {
+				metrics.ReportLowestCostSearchIncomplete(admission.ClusterQueue, s.customLabels.CQGet(admission.ClusterQueue), s.roleTracker)
+			}
 
 			log.V(2).Info("Workload successfully admitted and assigned flavors", "assignments", admission.PodSetAssignments)
+			s.framework.RunPostAdmitPlugins(ctx, newWorkload)
 			if features.Enabled(features.ElasticJobsViaWorkloadSlices) && oldWorkloadSlice != nil {
 				s.replaceOldWorkloadSlice(ctx, log, e, oldWorkloadSlice)
 			}
@@ -1191,6 +1324,9 @@ func (s *Scheduler) requeueAndUpdate(ctx context.Context, e entry) {
 			if workload.PropagateResourceRequests(wl, &e.Info, s.resourceFormatter) {
 				updated = true
 			}
+			if features.Enabled(features.TASPlacementExplanation) && workload.SetTopologyPlacementExplanations(wl, e.assignment.TopologyPlacementExplanations()) {
+				updated = true
+			}
 			if e.status == preemptionGated {
 				updated = workload.SetBlockedOnPreemptionGatesCondition(wl, s.clock.Now(), kueue.PreemptionGated, e.inadmissibleMsg)
 			}
@@ -1225,6 +1361,9 @@ func (s *Scheduler) recordQuotaReservationMetrics(log logr.Logger, newWorkload,
 
 	priorityClassName := workloadpatching.PriorityClassName(newWorkload)
 	metrics.QuotaReservedWorkload(admission.ClusterQueue, priorityClassName, waitTime, s.customLabels.CQGet(admission.ClusterQueue), s.roleTracker)
+	if admission.Cost != nil {
+		metrics.ReportQuotaReservedWorkloadCost(admission.ClusterQueue, admission.Cost.AsApproximateFloat64(), s.customLabels.CQGet(admission.ClusterQueue), s.roleTracker)
+	}
 	lqRef := metrics.LQRefFromWorkload(newWorkload)
 	if s.cache.ShouldExposeLocalQueueMetricsForWorkload(log, newWorkload) {
 		metrics.LocalQueueQuotaReservedWorkload(lqRef, priorityClassName, waitTime, s.customLabels.LQGet(utilqueue.KeyFromWorkload(newWorkload)), s.roleTracker)
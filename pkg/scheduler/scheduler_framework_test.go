@@ -0,0 +1,179 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/framework"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	"sigs.k8s.io/kueue/pkg/util/routine"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const holdPluginName = "TestHold"
+
+// testHoldPlugin is the instance of the plugin built by the frameworks.
+var testHoldPlugin = &holdPlugin{}
+
+func init() {
+	utilruntime.Must(framework.RegisterPlugin(holdPluginName, func(runtime.RawExtension, framework.Handle) (framework.Plugin, error) {
+		return testHoldPlugin, nil
+	}))
+}
+
+// holdPlugin holds the workloads labeled hold, and records the admissions.
+type holdPlugin struct {
+	sync.Mutex
+	admitted []string
+}
+
+func (p *holdPlugin) Name() string { return holdPluginName }
+
+func (p *holdPlugin) PreFilter(_ context.Context, wl *workload.Info, _ *schdcache.ClusterQueueSnapshot) *framework.Status {
+	if wl.Obj.Labels["hold"] == "true" {
+		return framework.NewStatus("", "the workload is on hold")
+	}
+	return nil
+}
+
+func (p *holdPlugin) PostAdmit(_ context.Context, wl *kueue.Workload) {
+	p.Lock()
+	defer p.Unlock()
+	p.admitted = append(p.admitted, wl.Name)
+}
+
+func TestScheduleWithFramework(t *testing.T) {
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	ns := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()
+	rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(*utiltestingapi.MakeFlavorQuotas(rf.Name).Resource(corev1.ResourceCPU, "5").Obj()).
+		Obj()
+	lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj()
+	pending := []*kueue.Workload{
+		utiltestingapi.MakeWorkload("held", metav1.NamespaceDefault).
+			Queue(kueue.LocalQueueName(lq.Name)).
+			Label("hold", "true").
+			Request(corev1.ResourceCPU, "1").
+			Obj(),
+		utiltestingapi.MakeWorkload("free", metav1.NamespaceDefault).
+			Queue(kueue.LocalQueueName(lq.Name)).
+			Request(corev1.ResourceCPU, "1").
+			Obj(),
+	}
+
+	fw, err := framework.New(&configapi.SchedulingProfile{
+		Plugins: configapi.SchedulingPlugins{
+			QueueSort: []string{framework.PrioritySortName},
+			PreFilter: []string{holdPluginName},
+			PostAdmit: []string{holdPluginName},
+		},
+	}, framework.Handle{Log: log})
+	if err != nil {
+		t.Fatalf("Building the framework: %v", err)
+	}
+
+	builder := utiltesting.NewClientBuilder().
+		WithObjects(ns, rf, cq, lq).
+		WithStatusSubresource(&kueue.Workload{})
+	for _, wl := range pending {
+		builder = builder.WithObjects(wl)
+	}
+	cl := builder.Build()
+	cqCache := schdcache.New(cl)
+	qManager := qcache.NewManagerForUnitTests(cl, cqCache, qcache.WithQueueSort(fw.QueueSortFunc()))
+	cqCache.AddOrUpdateResourceFlavor(log, rf)
+	if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+	}
+	if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+	}
+	if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+		t.Fatalf("Inserting queue %s/%s in manager: %v", lq.Namespace, lq.Name, err)
+	}
+
+	scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{},
+		WithPreemptionExpectations(preemptexpectations.New()), WithFramework(fw))
+	wg := sync.WaitGroup{}
+	scheduler.setAdmissionRoutineWrapper(routine.NewWrapper(
+		func() { wg.Add(1) },
+		func() { wg.Done() },
+	))
+	ctx, cancel := context.WithTimeout(ctx, queueingTimeout)
+	go qManager.CleanUpOnContext(ctx)
+	defer cancel()
+
+	for range pending {
+		scheduler.schedule(ctx)
+		wg.Wait()
+	}
+
+	snapshot, err := cqCache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Building snapshot: %v", err)
+	}
+	gotAdmitted := sets.New[string]()
+	for _, wl := range snapshot.ClusterQueue(kueue.ClusterQueueReference(cq.Name)).Workloads {
+		gotAdmitted.Insert(wl.Obj.Name)
+	}
+	if diff := cmp.Diff(sets.New("free"), gotAdmitted); diff != "" {
+		t.Errorf("Unexpected admitted workloads (-want,+got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff([]string{"free"}, testHoldPlugin.admitted); diff != "" {
+		t.Errorf("Unexpected workloads notified by PostAdmit (-want,+got):\n%s", diff)
+	}
+
+	var held kueue.Workload
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(pending[0]), &held); err != nil {
+		t.Fatalf("Getting the held workload: %v", err)
+	}
+	cond := apimeta.FindStatusCondition(held.Status.Conditions, kueue.WorkloadQuotaReserved)
+	if cond == nil {
+		t.Fatal("Expected a QuotaReserved condition on the held workload")
+	}
+	wantCond := metav1.Condition{
+		Type:    kueue.WorkloadQuotaReserved,
+		Status:  metav1.ConditionFalse,
+		Reason:  kueue.WorkloadQuotaReservedReasonWaitingForQuota,
+		Message: "rejected by plugin TestHold: the workload is on hold",
+	}
+	if diff := cmp.Diff(wantCond, *cond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+		t.Errorf("Unexpected QuotaReserved condition (-want,+got):\n%s", diff)
+	}
+}
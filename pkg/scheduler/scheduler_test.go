@@ -84,6 +84,9 @@ type scheduleTestCase struct {
 	additionalClusterQueues []kueue.ClusterQueue
 	additionalLocalQueues   []kueue.LocalQueue
 
+	// additionalResourceFlavors holds the extra ResourceFlavors needed by the tc.
+	additionalResourceFlavors []*kueue.ResourceFlavor
+
 	cohorts []kueue.Cohort
 
 	// wantAssignments is a summary of all the admissions in the cache after this cycle.
@@ -221,6 +224,9 @@ func runScheduleTestCases(t *testing.T, cfg scheduleTestConfig, cases map[string
 					for i := range cfg.resourceFlavors {
 						cqCache.AddOrUpdateResourceFlavor(log, cfg.resourceFlavors[i])
 					}
+					for _, rf := range tc.additionalResourceFlavors {
+						cqCache.AddOrUpdateResourceFlavor(log, rf)
+					}
 					for _, cq := range allClusterQueues {
 						if err := cqCache.AddClusterQueue(ctx, &cq); err != nil {
 							t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
@@ -746,6 +752,185 @@ func TestSchedule(t *testing.T) {
 				"custom-cq": {"sales/new-job"},
 			},
 		},
+		"workflow budget exceeded": {
+			featureGates: map[featuregate.Feature]bool{features.WorkflowBudgets: true},
+			additionalClusterQueues: []kueue.ClusterQueue{
+				*utiltestingapi.MakeClusterQueue("workflow-cq").
+					ResourceGroup(
+						*utiltestingapi.MakeFlavorQuotas("on-demand").
+							Resource(corev1.ResourceCPU, "50").Obj(),
+					).Obj(),
+			},
+			additionalLocalQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("workflow-q", "sales").ClusterQueue("workflow-cq").Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("step-1", "sales").
+					Queue("workflow-q").
+					Label(kueue.WorkflowLabel, "pipeline").
+					Annotation(kueue.WorkflowBudgetAnnotation, "cpu=15").
+					Request(corev1.ResourceCPU, "10").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("workflow-cq").
+						PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment(corev1.ResourceCPU, "on-demand", "10").
+							Obj()).
+						Obj(), now).
+					AdmittedAt(true, now).
+					Obj(),
+				*utiltestingapi.MakeWorkload("step-2", "sales").
+					Queue("workflow-q").
+					Label(kueue.WorkflowLabel, "pipeline").
+					Annotation(kueue.WorkflowBudgetAnnotation, "cpu=15").
+					Request(corev1.ResourceCPU, "10").
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("step-1", "sales").
+					Queue("workflow-q").
+					Label(kueue.WorkflowLabel, "pipeline").
+					Annotation(kueue.WorkflowBudgetAnnotation, "cpu=15").
+					Request(corev1.ResourceCPU, "10").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("workflow-cq").
+						PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment(corev1.ResourceCPU, "on-demand", "10").
+							Obj()).
+						Obj(), now).
+					AdmittedAt(true, now).
+					Obj(),
+				*utiltestingapi.MakeWorkload("step-2", "sales").
+					Queue("workflow-q").
+					Label(kueue.WorkflowLabel, "pipeline").
+					Annotation(kueue.WorkflowBudgetAnnotation, "cpu=15").
+					Request(corev1.ResourceCPU, "10").
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadQuotaReservedReasonWaitingForQuota,
+						Message:            "The budget of the workflow pipeline is exceeded for [cpu]",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadAdmittedReasonNoReservation,
+						Message:            "The workload has no reservation",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					ResourceRequests(kueue.PodSetRequest{
+						Name: "main",
+						Resources: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("10"),
+						},
+					}).
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"sales/step-1": {
+					ClusterQueue: "workflow-cq",
+					PodSetAssignments: []kueue.PodSetAssignment{
+						utiltestingapi.MakePodSetAssignment("main").
+							Assignment(corev1.ResourceCPU, "on-demand", "10000m").
+							Count(1).
+							Obj(),
+					},
+				},
+			},
+			wantInadmissibleLeft: map[kueue.ClusterQueueReference][]workload.Reference{
+				"workflow-cq": {"sales/step-2"},
+			},
+		},
+		"draining ClusterQueue over the quota of the window": {
+			featureGates: map[featuregate.Feature]bool{features.TimeWindowedQuotas: true},
+			additionalClusterQueues: []kueue.ClusterQueue{
+				*utiltestingapi.MakeClusterQueue("window-cq").
+					ResourceGroup(
+						*utiltestingapi.MakeFlavorQuotas("on-demand").
+							Resource(corev1.ResourceCPU, "50").Obj(),
+						*utiltestingapi.MakeFlavorQuotas("spot").
+							Resource(corev1.ResourceCPU, "50").Obj(),
+					).
+					QuotaSchedule(kueue.QuotaSchedule{
+						OverQuotaPolicy: kueue.OverQuotaPolicyDrain,
+						Windows: []kueue.QuotaWindow{{
+							Name:            "always",
+							Schedule:        "* * * * *",
+							DurationMinutes: 24 * 60,
+							Flavors: []kueue.FlavorQuotas{*utiltestingapi.MakeFlavorQuotas("on-demand").
+								Resource(corev1.ResourceCPU, "5").Obj()},
+						}},
+					}).
+					Obj(),
+			},
+			additionalLocalQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("window-q", "sales").ClusterQueue("window-cq").Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("running", "sales").
+					Queue("window-q").
+					Request(corev1.ResourceCPU, "10").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("window-cq").
+						PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment(corev1.ResourceCPU, "on-demand", "10").
+							Obj()).
+						Obj(), now).
+					AdmittedAt(true, now).
+					Obj(),
+				*utiltestingapi.MakeWorkload("new", "sales").
+					Queue("window-q").
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("new", "sales").
+					Queue("window-q").
+					Request(corev1.ResourceCPU, "1").
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadQuotaReservedReasonWaitingForQuota,
+						Message:            "ClusterQueue window-cq is draining the workloads exceeding the quota",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionFalse,
+						Reason:             kueue.WorkloadAdmittedReasonNoReservation,
+						Message:            "The workload has no reservation",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					ResourceRequests(kueue.PodSetRequest{
+						Name: "main",
+						Resources: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+					}).
+					Obj(),
+				*utiltestingapi.MakeWorkload("running", "sales").
+					Queue("window-q").
+					Request(corev1.ResourceCPU, "10").
+					ReserveQuotaAt(utiltestingapi.MakeAdmission("window-cq").
+						PodSets(utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment(corev1.ResourceCPU, "on-demand", "10").
+							Obj()).
+						Obj(), now).
+					AdmittedAt(true, now).
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"sales/running": {
+					ClusterQueue: "window-cq",
+					PodSetAssignments: []kueue.PodSetAssignment{
+						utiltestingapi.MakePodSetAssignment("main").
+							Assignment(corev1.ResourceCPU, "on-demand", "10000m").
+							Count(1).
+							Obj(),
+					},
+				},
+			},
+			wantInadmissibleLeft: map[kueue.ClusterQueueReference][]workload.Reference{
+				"window-cq": {"sales/new"},
+			},
+		},
 		"flavors with mixed taint mismatch and exceeding limits": {
 			additionalClusterQueues: []kueue.ClusterQueue{
 				*utiltestingapi.MakeClusterQueue("custom-cq2").
@@ -2895,6 +3080,148 @@ func TestSchedule(t *testing.T) {
 				},
 			},
 		},
+		"partial admission keeps the shape constraints between pod sets": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("new", "sales").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("ps", 6).
+							SetMinimumCount(1).
+							Request(corev1.ResourceCPU, "1").
+							Obj(),
+						*utiltestingapi.MakePodSet("worker", 48).
+							Request(corev1.ResourceCPU, "1").
+							Obj(),
+					).
+					PodSetShapeConstraint("worker", "ps", 8).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("new", "sales").
+					Queue("main").
+					PodSets(
+						*utiltestingapi.MakePodSet("ps", 6).
+							SetMinimumCount(1).
+							Request(corev1.ResourceCPU, "1").
+							Obj(),
+						*utiltestingapi.MakePodSet("worker", 48).
+							Request(corev1.ResourceCPU, "1").
+							Obj(),
+					).
+					PodSetShapeConstraint("worker", "ps", 8).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionTrue,
+						Reason:             "QuotaReserved",
+						Message:            "Quota reserved in ClusterQueue sales",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionTrue,
+						Reason:             "Admitted",
+						Message:            "The workload is admitted",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Admission(
+						utiltestingapi.MakeAdmission("sales").
+							PodSets(
+								utiltestingapi.MakePodSetAssignment("ps").
+									Assignment(corev1.ResourceCPU, "default", "5").
+									Count(5).
+									Obj(),
+								utiltestingapi.MakePodSetAssignment("worker").
+									Assignment(corev1.ResourceCPU, "default", "40").
+									Count(40).
+									Obj(),
+							).
+							Obj(),
+					).
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"sales/new": {
+					ClusterQueue: "sales",
+					PodSetAssignments: []kueue.PodSetAssignment{
+						utiltestingapi.MakePodSetAssignment("ps").
+							Assignment(corev1.ResourceCPU, "default", "5000m").
+							Count(5).
+							Obj(),
+						utiltestingapi.MakePodSetAssignment("worker").
+							Assignment(corev1.ResourceCPU, "default", "40000m").
+							Count(40).
+							Obj(),
+					},
+				},
+			},
+		},
+		"the cheapest flavor is assigned and its cost recorded with the LowestCost preference": {
+			featureGates: map[featuregate.Feature]bool{features.FlavorCost: true},
+			additionalResourceFlavors: []*kueue.ResourceFlavor{
+				utiltestingapi.MakeResourceFlavor("priced-on-demand").Price(corev1.ResourceCPU, "3").Obj(),
+				utiltestingapi.MakeResourceFlavor("priced-spot").Price(corev1.ResourceCPU, "1").Obj(),
+			},
+			additionalClusterQueues: []kueue.ClusterQueue{
+				*utiltestingapi.MakeClusterQueue("lowest-cost").
+					FlavorFungibility(kueue.FlavorFungibility{
+						WhenCanBorrow:  kueue.TryNextFlavor,
+						WhenCanPreempt: kueue.TryNextFlavor,
+						Preference:     new(kueue.LowestCost),
+					}).
+					ResourceGroup(
+						*utiltestingapi.MakeFlavorQuotas("priced-on-demand").
+							Resource(corev1.ResourceCPU, "50").Obj(),
+						*utiltestingapi.MakeFlavorQuotas("priced-spot").
+							Resource(corev1.ResourceCPU, "50").Obj(),
+					).Obj(),
+			},
+			additionalLocalQueues: []kueue.LocalQueue{
+				*utiltestingapi.MakeLocalQueue("lowest-cost", "sales").ClusterQueue("lowest-cost").Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("new", "sales").
+					Queue("lowest-cost").
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltestingapi.MakeWorkload("new", "sales").
+					Queue("lowest-cost").
+					Request(corev1.ResourceCPU, "2").
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadQuotaReserved,
+						Status:             metav1.ConditionTrue,
+						Reason:             "QuotaReserved",
+						Message:            "Quota reserved in ClusterQueue lowest-cost",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadAdmitted,
+						Status:             metav1.ConditionTrue,
+						Reason:             "Admitted",
+						Message:            "The workload is admitted",
+						LastTransitionTime: metav1.NewTime(now),
+					}).
+					Admission(utiltestingapi.MakeAdmission("lowest-cost").
+						PodSets(
+							utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+								Assignment(corev1.ResourceCPU, "priced-spot", "2").
+								Obj()).
+						Cost("2").
+						Obj()).
+					Obj(),
+			},
+			wantAssignments: map[workload.Reference]kueue.Admission{
+				"sales/new": *utiltestingapi.MakeAdmission("lowest-cost").
+					PodSets(
+						utiltestingapi.MakePodSetAssignment(kueue.DefaultPodSetName).
+							Assignment(corev1.ResourceCPU, "priced-spot", "2000m").
+							Obj()).
+					Cost("2").
+					Obj(),
+			},
+		},
 		"partial admission disabled, multiple variable pod sets": {
 			workloads: []kueue.Workload{
 				*utiltestingapi.MakeWorkload("new", "sales").
@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	afs "sigs.k8s.io/kueue/pkg/util/admissionfairsharing"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	// ErrSimulationInvalidWorkload is returned when the simulated Workload cannot be evaluated.
+	ErrSimulationInvalidWorkload = errors.New("invalid workload for admission simulation")
+)
+
+// SimulationResult is the outcome of SimulateAdmission.
+type SimulationResult struct {
+	// ClusterQueue is the ClusterQueue the Workload was evaluated against.
+	ClusterQueue kueue.ClusterQueueReference
+	// Assignment is the flavor assignment computed for the Workload. It is
+	// empty when the Workload could not be evaluated, see Message.
+	Assignment flavorassigner.Assignment
+	// PreemptionTargets are the workloads that would be preempted.
+	PreemptionTargets []*preemption.Target
+	// Message explains why the Workload cannot be admitted, if applicable.
+	Message string
+}
+
+// Mode returns the representative mode of the flavor assignment.
+func (r *SimulationResult) Mode() flavorassigner.FlavorAssignmentMode {
+	return r.Assignment.RepresentativeMode()
+}
+
+// Admissible reports whether the Workload would be admitted, either right away
+// or after the preemption of the targets.
+func (r *SimulationResult) Admissible() bool {
+	switch r.Mode() {
+	case flavorassigner.Fit:
+		return true
+	case flavorassigner.Preempt:
+		return len(r.PreemptionTargets) > 0
+	}
+	return false
+}
+
+// SimulateAdmission evaluates how the scheduler would handle the Workload if
+// it was at the head of its ClusterQueue in the next scheduling cycle. It runs
+// the admissibility checks of nominate, and the flavor assignment and
+// preemption logic of processEntry, on a fresh Snapshot of the cache, without
+// mutating the cache, the queues or the API.
+//
+// The Workload is evaluated in isolation: the ordering of the queue and the
+// other heads competing in the same cycle are not taken into account.
+func (s *Scheduler) SimulateAdmission(ctx context.Context, wl *kueue.Workload) (*SimulationResult, error) {
+	if len(wl.Spec.PodSets) == 0 {
+		return nil, fmt.Errorf("%w: no podSets", ErrSimulationInvalidWorkload)
+	}
+	cqName, ok := s.queues.ClusterQueueForWorkload(wl)
+	if !ok {
+		return nil, qcache.ErrLocalQueueDoesNotExistOrInactive
+	}
+	log := ctrl.LoggerFrom(ctx).WithValues("workload", klog.KObj(wl), "clusterQueue", klog.KRef("", string(cqName)))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	var snapshotOpts []schdcache.SnapshotOption
+	if afs.Enabled(s.admissionFairSharing) {
+		snapshotOpts = append(snapshotOpts, schdcache.WithAfsUsageLedger(s.queues.AfsUsageLedger))
+	}
+	snapshot, err := s.cache.Snapshot(ctx, snapshotOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("building snapshot: %w", err)
+	}
+
+	wl, err = s.resolveSimulatedPriority(ctx, wl)
+	if err != nil {
+		return nil, err
+	}
+	info := s.queues.NewWorkloadInfo(wl)
+	info.ClusterQueue = cqName
+	e := &entry{Head: qcache.Head{Info: *info}, clusterQueueSnapshot: snapshot.ClusterQueue(cqName)}
+	result := &SimulationResult{ClusterQueue: cqName}
+	admissible, err := s.checkAdmissibility(ctx, e, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if !admissible {
+		result.Message = e.inadmissibleMsg
+		return result, nil
+	}
+
+	result.Assignment, result.PreemptionTargets = s.getAssignments(ctx, &e.Info, snapshot)
+	if result.Mode() == flavorassigner.Preempt && len(result.PreemptionTargets) == 0 {
+		result.Message = "Workload requires preemption, but there are no candidate workloads allowed for preemption"
+	} else {
+		result.Message = result.Assignment.Message()
+	}
+	log.V(3).Info("Simulated admission", "mode", result.Mode(), "preemptionTargets", len(result.PreemptionTargets))
+	return result, nil
+}
+
+// resolveSimulatedPriority returns a copy of the Workload with the priority
+// of its priority class, as the job reconciler would set it on creation. The
+// priority set on the Workload is kept only when it references no class.
+func (s *Scheduler) resolveSimulatedPriority(ctx context.Context, wl *kueue.Workload) (*kueue.Workload, error) {
+	var (
+		ref *kueue.PriorityClassRef
+		p   int32
+		err error
+	)
+	switch {
+	case workload.IsWorkloadPriorityClass(wl):
+		ref, p, err = priority.GetPriorityFromWorkloadPriorityClass(ctx, s.client, wl.Spec.PriorityClassRef.Name)
+	case workload.IsPodPriorityClass(wl):
+		ref, p, err = priority.GetPriorityFromPriorityClass(ctx, s.client, wl.Spec.PriorityClassRef.Name)
+	case wl.Spec.Priority != nil:
+		return wl, nil
+	default:
+		ref, p, err = priority.GetPriorityFromPriorityClass(ctx, s.client, podSetsPriorityClassName(wl.Spec.PodSets))
+	}
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %w", ErrSimulationInvalidWorkload, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolving priority: %w", err)
+	}
+	wl = wl.DeepCopy()
+	wl.Spec.PriorityClassRef = ref
+	wl.Spec.Priority = &p
+	return wl, nil
+}
+
+// podSetsPriorityClassName returns the first priority class name of the
+// pod templates of the PodSets.
+func podSetsPriorityClassName(podSets []kueue.PodSet) string {
+	for _, ps := range podSets {
+		if len(ps.Template.Spec.PriorityClassName) > 0 {
+			return ps.Template.Spec.PriorityClassName
+		}
+	}
+	return ""
+}
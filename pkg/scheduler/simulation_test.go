@@ -0,0 +1,250 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+func TestSimulateAdmission(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	ns := utiltesting.MakeNamespaceWrapper(metav1.NamespaceDefault).Obj()
+	rf := utiltestingapi.MakeResourceFlavor("default").Obj()
+	cq := utiltestingapi.MakeClusterQueue("cq").
+		ResourceGroup(
+			*utiltestingapi.MakeFlavorQuotas(rf.Name).
+				Resource(corev1.ResourceCPU, "4").
+				Obj(),
+		).
+		Preemption(kueue.ClusterQueuePreemption{
+			WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+		}).
+		Obj()
+	lq := utiltestingapi.MakeLocalQueue("lq", metav1.NamespaceDefault).ClusterQueue(cq.Name).Obj()
+	admittedWl := utiltestingapi.MakeWorkload("low", metav1.NamespaceDefault).
+		Queue(kueue.LocalQueueName(lq.Name)).
+		Priority(-1).
+		Request(corev1.ResourceCPU, "3").
+		SimpleReserveQuota(kueue.ClusterQueueReference(cq.Name), rf.Name, now).
+		Obj()
+	lowWpc := utiltestingapi.MakeWorkloadPriorityClass("low-wpc").PriorityValue(-2).Obj()
+	highWpc := utiltestingapi.MakeWorkloadPriorityClass("high-wpc").PriorityValue(10).Obj()
+	lowPc := utiltesting.MakePriorityClass("low-pc").PriorityValue(-2).Obj()
+
+	cases := map[string]struct {
+		workload       *kueue.Workload
+		wantMode       flavorassigner.FlavorAssignmentMode
+		wantAdmissible bool
+		wantTargets    []string
+		wantMessage    bool
+		wantErr        error
+	}{
+		"fits": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				Request(corev1.ResourceCPU, "1").
+				Obj(),
+			wantMode:       flavorassigner.Fit,
+			wantAdmissible: true,
+		},
+		"needs preemption": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+			wantMode:       flavorassigner.Preempt,
+			wantAdmissible: true,
+			wantTargets:    []string{"low"},
+			wantMessage:    true,
+		},
+		"needs preemption without candidates": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				Priority(-2).
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+			wantMode:    flavorassigner.Preempt,
+			wantMessage: true,
+		},
+		"needs preemption without candidates at the priority of its WorkloadPriorityClass": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				WorkloadPriorityClassRef(lowWpc.Name).
+				Priority(5).
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+			wantMode:    flavorassigner.Preempt,
+			wantMessage: true,
+		},
+		"needs preemption at the priority of its WorkloadPriorityClass": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				WorkloadPriorityClassRef(highWpc.Name).
+				Priority(-5).
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+			wantMode:       flavorassigner.Preempt,
+			wantAdmissible: true,
+			wantTargets:    []string{"low"},
+			wantMessage:    true,
+		},
+		"needs preemption without candidates at the priority of its pod template": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				PodSets(
+					*utiltestingapi.MakePodSet(kueue.DefaultPodSetName, 1).
+						PriorityClass(lowPc.Name).
+						Request(corev1.ResourceCPU, "2").
+						Obj(),
+				).
+				Obj(),
+			wantMode:    flavorassigner.Preempt,
+			wantMessage: true,
+		},
+		"unknown WorkloadPriorityClass": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				WorkloadPriorityClassRef("missing").
+				Request(corev1.ResourceCPU, "1").
+				Obj(),
+			wantErr: ErrSimulationInvalidWorkload,
+		},
+		"exceeds the budget of its workflow": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				Label(kueue.WorkflowLabel, "wf").
+				Annotation(kueue.WorkflowBudgetAnnotation, "cpu=1").
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+			wantMode:    flavorassigner.NoFit,
+			wantMessage: true,
+		},
+		"reserves quota for its workflow without a limit in the ClusterQueue": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				Label(kueue.WorkflowLabel, "wf").
+				Annotation(kueue.WorkflowReservationAnnotation, "cpu=1").
+				Request(corev1.ResourceCPU, "1").
+				Obj(),
+			wantMode:    flavorassigner.NoFit,
+			wantMessage: true,
+		},
+		"doesn't fit": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				Request(corev1.ResourceCPU, "5").
+				Obj(),
+			wantMode:    flavorassigner.NoFit,
+			wantMessage: true,
+		},
+		"unknown local queue": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue("other").
+				Request(corev1.ResourceCPU, "1").
+				Obj(),
+			wantErr: qcache.ErrLocalQueueDoesNotExistOrInactive,
+		},
+		"no podSets": {
+			workload: utiltestingapi.MakeWorkload("new", metav1.NamespaceDefault).
+				Queue(kueue.LocalQueueName(lq.Name)).
+				PodSets().
+				Obj(),
+			wantErr: ErrSimulationInvalidWorkload,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkflowBudgets, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(ns, rf, cq, lq, admittedWl, lowWpc, highWpc, lowPc).
+				WithStatusSubresource(&kueue.Workload{}).
+				Build()
+
+			cqCache := schdcache.New(cl)
+			qManager := qcache.NewManagerForUnitTests(cl, cqCache)
+			cqCache.AddOrUpdateResourceFlavor(log, rf)
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue %s in cache: %v", cq.Name, err)
+			}
+			if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue %s in manager: %v", cq.Name, err)
+			}
+			if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+				t.Fatalf("Inserting queue %s/%s in manager: %v", lq.Namespace, lq.Name, err)
+			}
+			cqCache.AddOrUpdateWorkload(log, admittedWl)
+
+			scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{}, WithClock(t, testingclock.NewFakeClock(now)), WithPreemptionExpectations(preemptexpectations.New()))
+
+			result, err := scheduler.SimulateAdmission(ctx, tc.workload)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Fatalf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if result.ClusterQueue != kueue.ClusterQueueReference(cq.Name) {
+				t.Errorf("Unexpected ClusterQueue %q", result.ClusterQueue)
+			}
+			if got := result.Mode(); got != tc.wantMode {
+				t.Errorf("Unexpected mode, want=%v, got=%v", tc.wantMode, got)
+			}
+			if got := result.Admissible(); got != tc.wantAdmissible {
+				t.Errorf("Unexpected admissible, want=%v, got=%v", tc.wantAdmissible, got)
+			}
+			var gotTargets []string
+			for _, target := range result.PreemptionTargets {
+				gotTargets = append(gotTargets, target.WorkloadInfo.Obj.Name)
+			}
+			if diff := cmp.Diff(tc.wantTargets, gotTargets); diff != "" {
+				t.Errorf("Unexpected preemption targets (-want,+got):\n%s", diff)
+			}
+			if gotMessage := result.Message != ""; gotMessage != tc.wantMessage {
+				t.Errorf("Unexpected message %q", result.Message)
+			}
+
+			if pending, err := qManager.Pending(cq); err != nil || pending != 0 {
+				t.Errorf("Expected no pending workloads after the simulation, got %d (err=%v)", pending, err)
+			}
+			snapshot, err := cqCache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("Building snapshot: %v", err)
+			}
+			if got := len(snapshot.ClusterQueue(kueue.ClusterQueueReference(cq.Name)).Workloads); got != 1 {
+				t.Errorf("Expected the cache to keep 1 workload after the simulation, got %d", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"cmp"
+	"slices"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// DiffOutcomes returns a human-readable report of the differences between the
+// recorded and the replayed outcomes of a cycle, or an empty string if they
+// match. The order of the outcomes is not relevant.
+func DiffOutcomes(recorded, replayed []Outcome) string {
+	return gocmp.Diff(recorded, replayed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(compareOutcomes),
+		cmpopts.SortSlices(func(a, b Target) int {
+			return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+		}),
+	)
+}
+
+// SortOutcomes sorts the outcomes by workload.
+func SortOutcomes(outcomes []Outcome) {
+	slices.SortFunc(outcomes, compareOutcomes)
+}
+
+func compareOutcomes(a, b Outcome) int {
+	return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+}
@@ -0,0 +1,131 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// FileRecorder writes the recorded cycles to a file.
+type FileRecorder struct {
+	mu        sync.Mutex
+	file      *os.File
+	gz        *gzip.Writer
+	enc       *json.Encoder
+	maxCycles int
+	recorded  int
+	// state is the state of the last recorded cycle, the following cycles
+	// only record the changes from it.
+	state *State
+}
+
+// NewFileRecorder creates the file at path, truncating it if it exists, and
+// returns a FileRecorder writing to it. Once maxCycles cycles are recorded,
+// the following ones are dropped. A non-positive maxCycles means no limit.
+func NewFileRecorder(path string, maxCycles int) (*FileRecorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(f)
+	return &FileRecorder{
+		file:      f,
+		gz:        gz,
+		enc:       json.NewEncoder(gz),
+		maxCycles: maxCycles,
+	}, nil
+}
+
+// Record appends the cycle to the file. The file is flushed after every
+// cycle, so that it can be read while the recorder is still running.
+func (r *FileRecorder) Record(c *Cycle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.enc == nil || (r.maxCycles > 0 && r.recorded >= r.maxCycles) {
+		return nil
+	}
+	out := *c
+	if r.state != nil {
+		out.State = State{}
+		out.Changes = diffState(r.state, &c.State)
+	}
+	if err := r.enc.Encode(&out); err != nil {
+		return fmt.Errorf("encoding cycle %d: %w", c.SchedulingCycle, err)
+	}
+	state := c.State
+	r.state = &state
+	r.recorded++
+	return r.gz.Flush()
+}
+
+// Close flushes the pending data and closes the file.
+func (r *FileRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.enc == nil {
+		return nil
+	}
+	r.enc = nil
+	return errors.Join(r.gz.Close(), r.file.Close())
+}
+
+// Read decodes the cycles recorded in r. A trace cut short, for example
+// because the process was killed while writing it, is read up to the last
+// complete cycle.
+func Read(r io.Reader) ([]Cycle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(gz)
+	var cycles []Cycle
+	for {
+		var c Cycle
+		err := dec.Decode(&c)
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return cycles, nil
+		case err != nil:
+			return cycles, fmt.Errorf("decoding cycle %d of the trace: %w", len(cycles)+1, err)
+		}
+		if c.Changes != nil {
+			if len(cycles) == 0 {
+				return nil, fmt.Errorf("cycle %d holds changes, but the trace has no previous cycle", c.SchedulingCycle)
+			}
+			c.State = applyChanges(&cycles[len(cycles)-1].State, c.Changes)
+			c.Changes = nil
+		}
+		cycles = append(cycles, c)
+	}
+}
+
+// ReadFile decodes the cycles recorded in the file at path.
+func ReadFile(path string) ([]Cycle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// testCycle returns a cycle whose state changes with n: the workload "a" is
+// admitted in the first two cycles, and the workload "b" from the second one.
+func testCycle(n int64) Cycle {
+	state := State{
+		ResourceFlavors: []kueue.ResourceFlavor{{ObjectMeta: metav1.ObjectMeta{Name: "default"}}},
+	}
+	if n <= 2 {
+		state.Workloads = append(state.Workloads, kueue.Workload{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}})
+	}
+	if n >= 2 {
+		state.Workloads = append(state.Workloads, kueue.Workload{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "default"}})
+	}
+	if n >= 3 {
+		state.Reservations = append(state.Reservations, kueue.Reservation{ObjectMeta: metav1.ObjectMeta{Name: "maintenance"}})
+	}
+	return Cycle{
+		SchedulingCycle: n,
+		State:           state,
+		Time:            metav1.NewTime(time.Date(2026, 1, 1, 0, 0, int(n), 0, time.UTC)),
+		FeatureGates:    map[string]bool{"TopologyAwareScheduling": true},
+		QuotaWindows:    []QuotaWindow{{ClusterQueue: "cq", Active: "night"}},
+		Heads: []Head{{
+			ClusterQueue: "cq",
+			Workload:     kueue.Workload{ObjectMeta: metav1.ObjectMeta{Name: "wl", Namespace: "default"}},
+		}},
+		Outcomes: []Outcome{{
+			Namespace:    "default",
+			Name:         "wl",
+			ClusterQueue: "cq",
+			Status:       "assumed",
+			Mode:         "Fit",
+			PodSets: []PodSetAssignment{{
+				Name:    "main",
+				Count:   1,
+				Flavors: map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "default"},
+			}},
+		}},
+	}
+}
+
+func TestFileRecorder(t *testing.T) {
+	cases := map[string]struct {
+		maxCycles int
+		record    int64
+		truncate  bool
+		want      []Cycle
+	}{
+		"all cycles": {
+			record: 3,
+			want:   []Cycle{testCycle(1), testCycle(2), testCycle(3)},
+		},
+		"cycles over the limit are dropped": {
+			maxCycles: 2,
+			record:    3,
+			want:      []Cycle{testCycle(1), testCycle(2)},
+		},
+		"truncated trace is read up to the last complete cycle": {
+			record:   2,
+			truncate: true,
+			want:     []Cycle{testCycle(1)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "trace.gz")
+			rec, err := NewFileRecorder(path, tc.maxCycles)
+			if err != nil {
+				t.Fatalf("Failed to create the recorder: %v", err)
+			}
+			var sizeAfterFirst int64
+			for i := range tc.record {
+				c := testCycle(i + 1)
+				if err := rec.Record(&c); err != nil {
+					t.Fatalf("Failed to record cycle %d: %v", i+1, err)
+				}
+				if i == 0 {
+					st, err := os.Stat(path)
+					if err != nil {
+						t.Fatalf("Failed to stat the trace: %v", err)
+					}
+					sizeAfterFirst = st.Size()
+				}
+			}
+			if tc.truncate {
+				// Simulate a process killed while writing the second cycle.
+				st, err := os.Stat(path)
+				if err != nil {
+					t.Fatalf("Failed to stat the trace: %v", err)
+				}
+				if err := os.Truncate(path, sizeAfterFirst+(st.Size()-sizeAfterFirst)/2); err != nil {
+					t.Fatalf("Failed to truncate the trace: %v", err)
+				}
+			} else if err := rec.Close(); err != nil {
+				t.Fatalf("Failed to close the recorder: %v", err)
+			}
+
+			got, err := ReadFile(path)
+			if err != nil {
+				t.Fatalf("Failed to read the trace: %v", err)
+			}
+			if diff := gocmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected cycles (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStateChanges(t *testing.T) {
+	first, second, third := testCycle(1).State, testCycle(2).State, testCycle(3).State
+	second.Workloads[0].Labels = map[string]string{"updated": "true"}
+
+	changes := diffState(&first, &second)
+	wantChanges := &StateChanges{
+		Updated: State{Workloads: second.Workloads},
+	}
+	if diff := gocmp.Diff(wantChanges, changes); diff != "" {
+		t.Errorf("Unexpected changes of the second cycle (-want,+got):\n%s", diff)
+	}
+	if diff := gocmp.Diff(second, applyChanges(&first, changes)); diff != "" {
+		t.Errorf("Unexpected state of the second cycle (-want,+got):\n%s", diff)
+	}
+
+	changes = diffState(&second, &third)
+	wantChanges = &StateChanges{
+		Updated: State{Reservations: third.Reservations},
+		Deleted: []ObjectKey{{Kind: kindWorkload, Namespace: "default", Name: "a"}},
+	}
+	if diff := gocmp.Diff(wantChanges, changes); diff != "" {
+		t.Errorf("Unexpected changes of the third cycle (-want,+got):\n%s", diff)
+	}
+	if diff := gocmp.Diff(third, applyChanges(&second, changes)); diff != "" {
+		t.Errorf("Unexpected state of the third cycle (-want,+got):\n%s", diff)
+	}
+}
+
+func TestDiffOutcomes(t *testing.T) {
+	a := Outcome{Namespace: "ns", Name: "a", ClusterQueue: "cq", Status: "assumed", Mode: "Fit"}
+	b := Outcome{Namespace: "ns", Name: "b", ClusterQueue: "cq", Status: "nominated", Mode: "Preempt",
+		Preempts: []Target{{Namespace: "ns", Name: "x"}, {Namespace: "ns", Name: "y"}}}
+	bReordered := b
+	bReordered.Preempts = []Target{{Namespace: "ns", Name: "y"}, {Namespace: "ns", Name: "x"}}
+	bNoFit := b
+	bNoFit.Mode = "NoFit"
+	bNoFit.Preempts = nil
+
+	cases := map[string]struct {
+		recorded []Outcome
+		replayed []Outcome
+		wantDiff bool
+	}{
+		"equal": {
+			recorded: []Outcome{a, b},
+			replayed: []Outcome{a, b},
+		},
+		"different order": {
+			recorded: []Outcome{a, b},
+			replayed: []Outcome{bReordered, a},
+		},
+		"different mode": {
+			recorded: []Outcome{a, b},
+			replayed: []Outcome{a, bNoFit},
+			wantDiff: true,
+		},
+		"missing outcome": {
+			recorded: []Outcome{a, b},
+			replayed: []Outcome{a},
+			wantDiff: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diff := DiffOutcomes(tc.recorded, tc.replayed)
+			if gotDiff := diff != ""; gotDiff != tc.wantDiff {
+				t.Errorf("DiffOutcomes() returned %q, want diff: %v", diff, tc.wantDiff)
+			}
+		})
+	}
+}
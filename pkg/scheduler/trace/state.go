@@ -0,0 +1,130 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"cmp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	kindNamespace      = "Namespace"
+	kindResourceFlavor = "ResourceFlavor"
+	kindTopology       = "Topology"
+	kindAdmissionCheck = "AdmissionCheck"
+	kindCohort         = "Cohort"
+	kindClusterQueue   = "ClusterQueue"
+	kindLocalQueue     = "LocalQueue"
+	kindWorkload       = "Workload"
+	kindReservation    = "Reservation"
+)
+
+// diffState returns the changes turning the previous state into the current one.
+func diffState(prev, cur *State) *StateChanges {
+	c := &StateChanges{}
+	c.Updated.Namespaces = diffObjects(kindNamespace, prev.Namespaces, cur.Namespaces, &c.Deleted)
+	c.Updated.ResourceFlavors = diffObjects(kindResourceFlavor, prev.ResourceFlavors, cur.ResourceFlavors, &c.Deleted)
+	c.Updated.Topologies = diffObjects(kindTopology, prev.Topologies, cur.Topologies, &c.Deleted)
+	c.Updated.AdmissionChecks = diffObjects(kindAdmissionCheck, prev.AdmissionChecks, cur.AdmissionChecks, &c.Deleted)
+	c.Updated.Cohorts = diffObjects(kindCohort, prev.Cohorts, cur.Cohorts, &c.Deleted)
+	c.Updated.ClusterQueues = diffObjects(kindClusterQueue, prev.ClusterQueues, cur.ClusterQueues, &c.Deleted)
+	c.Updated.LocalQueues = diffObjects(kindLocalQueue, prev.LocalQueues, cur.LocalQueues, &c.Deleted)
+	c.Updated.Workloads = diffObjects(kindWorkload, prev.Workloads, cur.Workloads, &c.Deleted)
+	c.Updated.Reservations = diffObjects(kindReservation, prev.Reservations, cur.Reservations, &c.Deleted)
+	return c
+}
+
+// applyChanges returns the state resulting from the changes of the previous one.
+func applyChanges(prev *State, c *StateChanges) State {
+	deleted := make(map[ObjectKey]bool, len(c.Deleted))
+	for _, key := range c.Deleted {
+		deleted[key] = true
+	}
+	return State{
+		Namespaces:      applyObjects(kindNamespace, prev.Namespaces, c.Updated.Namespaces, deleted),
+		ResourceFlavors: applyObjects(kindResourceFlavor, prev.ResourceFlavors, c.Updated.ResourceFlavors, deleted),
+		Topologies:      applyObjects(kindTopology, prev.Topologies, c.Updated.Topologies, deleted),
+		AdmissionChecks: applyObjects(kindAdmissionCheck, prev.AdmissionChecks, c.Updated.AdmissionChecks, deleted),
+		Cohorts:         applyObjects(kindCohort, prev.Cohorts, c.Updated.Cohorts, deleted),
+		ClusterQueues:   applyObjects(kindClusterQueue, prev.ClusterQueues, c.Updated.ClusterQueues, deleted),
+		LocalQueues:     applyObjects(kindLocalQueue, prev.LocalQueues, c.Updated.LocalQueues, deleted),
+		Workloads:       applyObjects(kindWorkload, prev.Workloads, c.Updated.Workloads, deleted),
+		Reservations:    applyObjects(kindReservation, prev.Reservations, c.Updated.Reservations, deleted),
+	}
+}
+
+type stateObject[T any] interface {
+	*T
+	metav1.Object
+}
+
+func objectKey[T any, PT stateObject[T]](kind string, obj *T) ObjectKey {
+	return ObjectKey{Kind: kind, Namespace: PT(obj).GetNamespace(), Name: PT(obj).GetName()}
+}
+
+// diffObjects returns the objects of cur which are not in prev or differ
+// from it, and appends the keys of the objects of prev missing in cur to
+// deleted.
+func diffObjects[T any, PT stateObject[T]](kind string, prev, cur []T, deleted *[]ObjectKey) []T {
+	prevByKey := make(map[ObjectKey]*T, len(prev))
+	for i := range prev {
+		prevByKey[objectKey[T, PT](kind, &prev[i])] = &prev[i]
+	}
+	var updated []T
+	for i := range cur {
+		key := objectKey[T, PT](kind, &cur[i])
+		if p, found := prevByKey[key]; !found || !equality.Semantic.DeepEqual(*p, cur[i]) {
+			updated = append(updated, cur[i])
+		}
+		delete(prevByKey, key)
+	}
+	for i := range prev {
+		if key := objectKey[T, PT](kind, &prev[i]); prevByKey[key] != nil {
+			*deleted = append(*deleted, key)
+		}
+	}
+	return updated
+}
+
+// applyObjects returns the objects of prev which are not deleted, replaced by
+// their updated version, and the created objects, sorted by key.
+func applyObjects[T any, PT stateObject[T]](kind string, prev, updated []T, deleted map[ObjectKey]bool) []T {
+	byKey := make(map[ObjectKey]T, len(prev)+len(updated))
+	for i := range prev {
+		if key := objectKey[T, PT](kind, &prev[i]); !deleted[key] {
+			byKey[key] = prev[i]
+		}
+	}
+	for i := range updated {
+		byKey[objectKey[T, PT](kind, &updated[i])] = updated[i]
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	result := make([]T, 0, len(byKey))
+	for _, obj := range byKey {
+		result = append(result, obj)
+	}
+	slices.SortFunc(result, func(a, b T) int {
+		ka, kb := objectKey[T, PT](kind, &a), objectKey[T, PT](kind, &b)
+		return cmp.Or(cmp.Compare(ka.Namespace, kb.Namespace), cmp.Compare(ka.Name, kb.Name))
+	})
+	return result
+}
@@ -0,0 +1,151 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package trace defines the format of the scheduling traces recorded by the
+// scheduler and the tools to read them back.
+//
+// A trace is a gzip-compressed stream of JSON documents, one per scheduling
+// cycle. Every document holds the inputs of the cycle (the heads, the state
+// the cache Snapshot is built from, the scheduler configuration and the
+// feature gates) and its outputs (the outcome for every head), so that the
+// cycle can be replayed offline. Only the first document holds the full
+// state, the following ones hold the objects changed since the previous cycle.
+package trace
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta2"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// Cycle is the record of a single scheduling cycle.
+type Cycle struct {
+	// SchedulingCycle is the number of the cycle since the scheduler started.
+	SchedulingCycle int64 `json:"schedulingCycle"`
+	// Time is the time at which the cycle started.
+	Time metav1.Time `json:"time"`
+	// FeatureGates holds the enablement of the Kueue feature gates.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Config is the configuration of the scheduler.
+	Config Config `json:"config"`
+	// State holds the objects the cache Snapshot of the cycle was built from.
+	// To keep the trace compact, the file only holds the State of its first
+	// cycle, and the Changes of the following ones. Read restores the State
+	// of every cycle.
+	State State `json:"state,omitzero"`
+	// Changes holds the changes of the State since the previous cycle of the
+	// trace. It is only set in the file.
+	Changes *StateChanges `json:"changes,omitempty"`
+	// QuotaWindows hold the quota windows applied to the ClusterQueues during
+	// the cycle. The ClusterQueues not listed apply the quotas of their
+	// resource groups. Unlike the State, they are recorded in every cycle of
+	// the file.
+	QuotaWindows []QuotaWindow `json:"quotaWindows,omitempty"`
+	// Heads are the workloads popped from the queues for the cycle.
+	Heads []Head `json:"heads"`
+	// Outcomes hold the decision taken for every head.
+	Outcomes []Outcome `json:"outcomes,omitempty"`
+}
+
+// Config is the subset of the Kueue configuration used by the scheduler and
+// its caches.
+type Config struct {
+	FairSharing                 *config.FairSharing             `json:"fairSharing,omitempty"`
+	AdmissionFairSharing        *config.AdmissionFairSharing    `json:"admissionFairSharing,omitempty"`
+	QuotaCheckStrategy          config.QuotaCheckStrategy       `json:"quotaCheckStrategy,omitempty"`
+	PodsReadyRequeuingTimestamp config.RequeuingTimestamp       `json:"podsReadyRequeuingTimestamp,omitempty"`
+	BlockForPodsReady           bool                            `json:"blockForPodsReady,omitempty"`
+	ExcludeResourcePrefixes     []string                        `json:"excludeResourcePrefixes,omitempty"`
+	ResourceTransformations     []config.ResourceTransformation `json:"resourceTransformations,omitempty"`
+	// SchedulingProfile is the profile of the scheduling framework, nil when
+	// the SchedulingFramework feature is disabled.
+	SchedulingProfile *config.SchedulingProfile `json:"schedulingProfile,omitempty"`
+}
+
+// State holds the API objects the cache Snapshot is built from.
+// Workloads only contains the workloads holding a quota reservation.
+type State struct {
+	Namespaces      []corev1.Namespace     `json:"namespaces,omitempty"`
+	ResourceFlavors []kueue.ResourceFlavor `json:"resourceFlavors,omitempty"`
+	Topologies      []kueue.Topology       `json:"topologies,omitempty"`
+	AdmissionChecks []kueue.AdmissionCheck `json:"admissionChecks,omitempty"`
+	Cohorts         []kueue.Cohort         `json:"cohorts,omitempty"`
+	ClusterQueues   []kueue.ClusterQueue   `json:"clusterQueues,omitempty"`
+	LocalQueues     []kueue.LocalQueue     `json:"localQueues,omitempty"`
+	Workloads       []kueue.Workload       `json:"workloads,omitempty"`
+	Reservations    []kueue.Reservation    `json:"reservations,omitempty"`
+}
+
+// StateChanges holds the changes of the State between two cycles.
+type StateChanges struct {
+	// Updated holds the objects created or updated since the previous cycle.
+	Updated State `json:"updated,omitzero"`
+	// Deleted holds the objects deleted since the previous cycle.
+	Deleted []ObjectKey `json:"deleted,omitempty"`
+}
+
+// ObjectKey identifies an object of the State.
+type ObjectKey struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// QuotaWindow is the quota window applied to a ClusterQueue.
+type QuotaWindow struct {
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	// Active is the name of the window.
+	Active string `json:"active"`
+}
+
+// Head is a workload popped from the queue of a ClusterQueue.
+type Head struct {
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	Workload     kueue.Workload              `json:"workload"`
+}
+
+// Outcome is the decision taken by the scheduler for a head.
+type Outcome struct {
+	Namespace    string                      `json:"namespace"`
+	Name         string                      `json:"name"`
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	// Status is the status of the head at the end of the cycle, for example
+	// assumed, skipped or nominated. It is empty when the head was not
+	// nominated.
+	Status string `json:"status,omitempty"`
+	// Mode is the representative mode of the flavor assignment.
+	Mode     string             `json:"mode,omitempty"`
+	Borrows  bool               `json:"borrows,omitempty"`
+	PodSets  []PodSetAssignment `json:"podSets,omitempty"`
+	Preempts []Target           `json:"preempts,omitempty"`
+	Message  string             `json:"message,omitempty"`
+}
+
+// PodSetAssignment holds the flavors assigned to a PodSet.
+type PodSetAssignment struct {
+	Name    kueue.PodSetReference                                 `json:"name"`
+	Count   int32                                                 `json:"count"`
+	Flavors map[corev1.ResourceName]kueue.ResourceFlavorReference `json:"flavors,omitempty"`
+}
+
+// Target is a workload selected for preemption.
+type Target struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Reason    string `json:"reason"`
+}
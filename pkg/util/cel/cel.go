@@ -0,0 +1,90 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ObjectVariable is the name of the CEL variable holding the object
+	// the expressions are evaluated against.
+	ObjectVariable = "object"
+
+	// costLimit bounds the cost of a single evaluation of an expression.
+	costLimit = 1_000_000
+)
+
+// CompileBool compiles a CEL expression evaluated against an object, which
+// must return a boolean.
+func CompileBool(expression string) (cel.Program, error) {
+	return compile(expression, cel.BoolType)
+}
+
+// CompileInt compiles a CEL expression evaluated against an object, which
+// must return an integer.
+func CompileInt(expression string) (cel.Program, error) {
+	return compile(expression, cel.IntType)
+}
+
+func compile(expression string, outputType *cel.Type) (cel.Program, error) {
+	env, err := cel.NewEnv(cel.Variable(ObjectVariable, cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if t := ast.OutputType(); !t.IsExactType(outputType) && !t.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("must return %s, not %s", outputType, t)
+	}
+	return env.Program(ast, cel.CostLimit(costLimit))
+}
+
+// EvalBool evaluates a program compiled with CompileBool against the object.
+// Failed evaluations, typically due to fields not set yet, are returned as
+// errors.
+func EvalBool(program cel.Program, obj *unstructured.Unstructured) (bool, error) {
+	out, _, err := program.Eval(map[string]any{ObjectVariable: obj.Object})
+	if err != nil {
+		return false, err
+	}
+	v, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %T, expected bool", out.Value())
+	}
+	return v, nil
+}
+
+// EvalInt evaluates a program compiled with CompileInt against the object.
+// Failed evaluations, typically due to fields not set yet, are returned as
+// errors.
+func EvalInt(program cel.Program, obj *unstructured.Unstructured) (int64, error) {
+	out, _, err := program.Eval(map[string]any{ObjectVariable: obj.Object})
+	if err != nil {
+		return 0, err
+	}
+	v, ok := out.Value().(int64)
+	if !ok {
+		return 0, fmt.Errorf("expression returned %T, expected int", out.Value())
+	}
+	return v, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCompile(t *testing.T) {
+	cases := map[string]struct {
+		expression string
+		compile    func(string) (cel.Program, error)
+		wantErr    string
+	}{
+		"bool expression": {
+			expression: "object.status.phase == 'Succeeded'",
+			compile:    CompileBool,
+		},
+		"dynamic bool expression": {
+			expression: "object.status.succeeded",
+			compile:    CompileBool,
+		},
+		"int expression": {
+			expression: "object.spec.replicas * 2",
+			compile:    CompileInt,
+		},
+		"syntax error": {
+			expression: "object.status.phase ==",
+			compile:    CompileBool,
+			wantErr:    "Syntax error",
+		},
+		"undeclared variable": {
+			expression: "job.status.phase == 'Succeeded'",
+			compile:    CompileBool,
+			wantErr:    "undeclared reference to 'job'",
+		},
+		"bool expected, string returned": {
+			expression: "'Succeeded'",
+			compile:    CompileBool,
+			wantErr:    "must return bool, not string",
+		},
+		"int expected, bool returned": {
+			expression: "object.spec.replicas > 2",
+			compile:    CompileInt,
+			wantErr:    "must return int, not bool",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.compile(tc.expression)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("Expected an error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEvalBool(t *testing.T) {
+	items := make([]any, 2000)
+	for i := range items {
+		items[i] = int64(i)
+	}
+	cases := map[string]struct {
+		expression string
+		object     map[string]any
+		want       bool
+		wantErr    string
+	}{
+		"true": {
+			expression: "object.status.phase == 'Succeeded'",
+			object:     map[string]any{"status": map[string]any{"phase": "Succeeded"}},
+			want:       true,
+		},
+		"false": {
+			expression: "object.status.phase == 'Succeeded'",
+			object:     map[string]any{"status": map[string]any{"phase": "Running"}},
+		},
+		"missing field": {
+			expression: "object.status.phase == 'Succeeded'",
+			object:     map[string]any{"spec": map[string]any{}},
+			wantErr:    "no such key: status",
+		},
+		"missing field guarded by has": {
+			expression: "has(object.status) && object.status.phase == 'Succeeded'",
+			object:     map[string]any{"spec": map[string]any{}},
+		},
+		"dynamic expression returning a string": {
+			expression: "object.status.phase",
+			object:     map[string]any{"status": map[string]any{"phase": "Succeeded"}},
+			wantErr:    "expected bool",
+		},
+		"cost limit exceeded": {
+			expression: "object.items.all(x, object.items.all(y, x >= 0 && y >= 0))",
+			object:     map[string]any{"items": items},
+			wantErr:    "cost limit exceeded",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			program, err := CompileBool(tc.expression)
+			if err != nil {
+				t.Fatalf("Unexpected compilation error: %v", err)
+			}
+			got, err := EvalBool(program, &unstructured.Unstructured{Object: tc.object})
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("Expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("Unexpected result, want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEvalInt(t *testing.T) {
+	program, err := CompileInt("object.spec.replicas * 2")
+	if err != nil {
+		t.Fatalf("Unexpected compilation error: %v", err)
+	}
+	got, err := EvalInt(program, &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"replicas": int64(3)}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != 6 {
+		t.Errorf("Unexpected result, want 6, got %d", got)
+	}
+	if _, err := EvalInt(program, &unstructured.Unstructured{Object: map[string]any{}}); err == nil {
+		t.Error("Expected an error evaluating a missing field")
+	}
+}
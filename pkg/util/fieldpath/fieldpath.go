@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Parse splits a path in dot notation, like `spec.managedBy`, into its
+// fields. Array indices are not supported, as the fields are looked up in
+// nested maps.
+func Parse(path string) ([]string, error) {
+	if path == "" {
+		return nil, errors.New("path is required")
+	}
+	fields := strings.Split(path, ".")
+	for _, f := range fields {
+		if f == "" {
+			return nil, fmt.Errorf("invalid path %q: empty field name", path)
+		}
+		if strings.ContainsAny(f, "[]") {
+			return nil, fmt.Errorf("invalid path %q: array indices are not supported", path)
+		}
+	}
+	return fields, nil
+}
+
+// String returns the path of the fields in dot notation, with a leading dot,
+// like `.spec.managedBy`.
+func String(fields []string) string {
+	return "." + strings.Join(fields, ".")
+}
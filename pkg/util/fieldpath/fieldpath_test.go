@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	cases := map[string]struct {
+		path       string
+		wantFields []string
+		wantErr    string
+	}{
+		"single field": {
+			path:       "spec",
+			wantFields: []string{"spec"},
+		},
+		"nested fields": {
+			path:       "spec.managedBy",
+			wantFields: []string{"spec", "managedBy"},
+		},
+		"field names with dashes and slashes": {
+			path:       "metadata.labels.kueue-x-k8s-io/queue-name",
+			wantFields: []string{"metadata", "labels", "kueue-x-k8s-io/queue-name"},
+		},
+		"empty path": {
+			path:    "",
+			wantErr: "path is required",
+		},
+		"leading dot": {
+			path:    ".spec.managedBy",
+			wantErr: `invalid path ".spec.managedBy": empty field name`,
+		},
+		"trailing dot": {
+			path:    "spec.",
+			wantErr: `invalid path "spec.": empty field name`,
+		},
+		"consecutive dots": {
+			path:    "spec..suspend",
+			wantErr: `invalid path "spec..suspend": empty field name`,
+		},
+		"array index": {
+			path:    "status.conditions[0].type",
+			wantErr: `invalid path "status.conditions[0].type": array indices are not supported`,
+		},
+		"array wildcard": {
+			path:    "spec.containers[*]",
+			wantErr: `invalid path "spec.containers[*]": array indices are not supported`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotFields, err := Parse(tc.path)
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantFields, gotFields); diff != "" {
+				t.Errorf("Unexpected fields (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	if got, want := String([]string{"spec", "managedBy"}), ".spec.managedBy"; got != want {
+		t.Errorf("Unexpected path, want %q, got %q", want, got)
+	}
+}
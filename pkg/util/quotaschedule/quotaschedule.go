@@ -0,0 +1,173 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotaschedule
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+var (
+	errTimeZoneInSchedule = errors.New("the time zone must be set in timeZone, not in the schedule")
+	errEverySchedule      = errors.New("@every schedules are not supported")
+)
+
+var parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseWindowSchedule parses the schedule of a quota window, a standard cron
+// expression with five fields or a predefined descriptor such as @daily.
+func ParseWindowSchedule(schedule string) (cron.Schedule, error) {
+	if strings.HasPrefix(schedule, "TZ=") || strings.HasPrefix(schedule, "CRON_TZ=") {
+		return nil, errTimeZoneInSchedule
+	}
+	if strings.HasPrefix(schedule, "@every") {
+		return nil, errEverySchedule
+	}
+	return parser.Parse(schedule)
+}
+
+// LoadLocation returns the location of the quota schedule, UTC if no time zone
+// is set.
+func LoadLocation(timeZone *string) (*time.Location, error) {
+	return time.LoadLocation(ptr.Deref(timeZone, "UTC"))
+}
+
+type window struct {
+	name     string
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// Schedule is a parsed QuotaSchedule.
+type Schedule struct {
+	location *time.Location
+	windows  []window
+}
+
+// Parse parses the time zone and the windows of the quota schedule.
+func Parse(qs *kueue.QuotaSchedule) (*Schedule, error) {
+	loc, err := LoadLocation(qs.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	s := &Schedule{
+		location: loc,
+		windows:  make([]window, 0, len(qs.Windows)),
+	}
+	for _, w := range qs.Windows {
+		sched, err := ParseWindowSchedule(w.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		s.windows = append(s.windows, window{
+			name:     w.Name,
+			schedule: sched,
+			duration: time.Duration(w.DurationMinutes) * time.Minute,
+		})
+	}
+	return s, nil
+}
+
+// lastStart returns the start of the window occurrence including now, if any.
+func (w *window) lastStart(now time.Time) (time.Time, bool) {
+	// The schedule returns the first start strictly after the given time, any
+	// occurrence including now starts in (now-duration, now].
+	start := w.schedule.Next(now.Add(-w.duration))
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// Active returns the name of the window active at the given time, the first
+// one in the list when several are active, or an empty string if none is.
+func (s *Schedule) Active(now time.Time) string {
+	now = now.In(s.location)
+	for i := range s.windows {
+		if _, ok := s.windows[i].lastStart(now); ok {
+			return s.windows[i].name
+		}
+	}
+	return ""
+}
+
+// NextTransition returns the next time after now at which a window starts or
+// ends, or the zero time if no window ever starts again.
+func (s *Schedule) NextTransition(now time.Time) time.Time {
+	now = now.In(s.location)
+	var next time.Time
+	earliest := func(t time.Time) {
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	for i := range s.windows {
+		w := &s.windows[i]
+		earliest(w.schedule.Next(now))
+		if start, ok := w.lastStart(now); ok {
+			earliest(start.Add(w.duration))
+		}
+	}
+	return next
+}
+
+// LastTransition returns the last time in (since, now] at which the active
+// window changed to the one active at now, or the zero time if it didn't
+// change in that interval.
+func (s *Schedule) LastTransition(since, now time.Time) time.Time {
+	var last time.Time
+	active := s.Active(since)
+	for t := s.NextTransition(since); !t.IsZero() && !t.After(now); t = s.NextTransition(t) {
+		if a := s.Active(t); a != active {
+			active, last = a, t
+		}
+	}
+	return last
+}
+
+// ResourceGroups returns the resource groups with the quotas of the flavors
+// overridden by the named window. The input is not modified.
+func ResourceGroups(rgs []kueue.ResourceGroup, qs *kueue.QuotaSchedule, windowName string) []kueue.ResourceGroup {
+	if qs == nil || windowName == "" {
+		return rgs
+	}
+	idx := slices.IndexFunc(qs.Windows, func(w kueue.QuotaWindow) bool { return w.Name == windowName })
+	if idx < 0 {
+		return rgs
+	}
+	overrides := qs.Windows[idx].Flavors
+	out := make([]kueue.ResourceGroup, len(rgs))
+	for i := range rgs {
+		out[i] = kueue.ResourceGroup{
+			CoveredResources: rgs[i].CoveredResources,
+			Flavors:          slices.Clone(rgs[i].Flavors),
+		}
+		for j := range out[i].Flavors {
+			if k := slices.IndexFunc(overrides, func(fq kueue.FlavorQuotas) bool { return fq.Name == out[i].Flavors[j].Name }); k >= 0 {
+				out[i].Flavors[j] = overrides[k]
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,259 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quotaschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func TestParseWindowSchedule(t *testing.T) {
+	cases := map[string]struct {
+		schedule string
+		wantErr  bool
+	}{
+		"five fields": {
+			schedule: "0 20 * * 1-5",
+		},
+		"descriptor": {
+			schedule: "@daily",
+		},
+		"seconds field": {
+			schedule: "0 0 20 * * 1-5",
+			wantErr:  true,
+		},
+		"time zone prefix": {
+			schedule: "CRON_TZ=Europe/Paris 0 20 * * *",
+			wantErr:  true,
+		},
+		"every": {
+			schedule: "@every 1h",
+			wantErr:  true,
+		},
+		"invalid": {
+			schedule: "at night",
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseWindowSchedule(tc.schedule)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("ParseWindowSchedule() returned error %v, want error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestActiveAndNextTransition(t *testing.T) {
+	qs := &kueue.QuotaSchedule{
+		TimeZone: new("Europe/Paris"),
+		Windows: []kueue.QuotaWindow{
+			{
+				Name:            "maintenance",
+				Schedule:        "0 2 * * 0",
+				DurationMinutes: 60,
+			},
+			{
+				Name:            "night",
+				Schedule:        "0 20 * * *",
+				DurationMinutes: 12 * 60,
+			},
+		},
+	}
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("Loading the location: %v", err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		// 2026-03-01 is a Sunday, before the DST change of 2026-03-29.
+		return time.Date(2026, 3, day, hour, minute, 0, 0, paris)
+	}
+	cases := map[string]struct {
+		now            time.Time
+		wantActive     string
+		wantTransition time.Time
+	}{
+		"before the night": {
+			now:            at(2, 12, 0),
+			wantTransition: at(2, 20, 0),
+		},
+		"start of the night": {
+			now:            at(2, 20, 0),
+			wantActive:     "night",
+			wantTransition: at(3, 8, 0),
+		},
+		"during the night, after midnight": {
+			now:            at(3, 5, 30),
+			wantActive:     "night",
+			wantTransition: at(3, 8, 0),
+		},
+		"end of the night": {
+			now:            at(3, 8, 0),
+			wantTransition: at(3, 20, 0),
+		},
+		"overlapping windows, the first listed applies": {
+			now:            at(8, 2, 30),
+			wantActive:     "maintenance",
+			wantTransition: at(8, 3, 0),
+		},
+		"evaluated in the time zone": {
+			now:            time.Date(2026, 3, 2, 19, 30, 0, 0, time.UTC),
+			wantActive:     "night",
+			wantTransition: at(3, 8, 0),
+		},
+	}
+	s, err := Parse(qs)
+	if err != nil {
+		t.Fatalf("Parsing the schedule: %v", err)
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := s.Active(tc.now); got != tc.wantActive {
+				t.Errorf("Active() = %q, want %q", got, tc.wantActive)
+			}
+			if got := s.NextTransition(tc.now); !got.Equal(tc.wantTransition) {
+				t.Errorf("NextTransition() = %v, want %v", got, tc.wantTransition)
+			}
+		})
+	}
+}
+
+func TestLastTransition(t *testing.T) {
+	qs := &kueue.QuotaSchedule{
+		Windows: []kueue.QuotaWindow{
+			{
+				Name:            "maintenance",
+				Schedule:        "0 22 * * *",
+				DurationMinutes: 60,
+			},
+			{
+				Name:            "night",
+				Schedule:        "0 20 * * *",
+				DurationMinutes: 12 * 60,
+			},
+		},
+	}
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+	cases := map[string]struct {
+		since time.Time
+		now   time.Time
+		want  time.Time
+	}{
+		"no transition": {
+			since: at(2, 12),
+			now:   at(2, 19),
+		},
+		"window started": {
+			since: at(2, 12),
+			now:   at(2, 21),
+			want:  at(2, 20),
+		},
+		"several transitions": {
+			since: at(2, 12),
+			now:   at(3, 2),
+			want:  at(2, 23),
+		},
+		"window ended and started again": {
+			since: at(2, 21),
+			now:   at(3, 21),
+			want:  at(3, 20),
+		},
+		"transition at now": {
+			since: at(2, 21),
+			now:   at(3, 8),
+			want:  at(3, 8),
+		},
+	}
+	s, err := Parse(qs)
+	if err != nil {
+		t.Fatalf("Parsing the schedule: %v", err)
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := s.LastTransition(tc.since, tc.now); !got.Equal(tc.want) {
+				t.Errorf("LastTransition() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResourceGroups(t *testing.T) {
+	quotas := func(name kueue.ResourceFlavorReference, gpu string) kueue.FlavorQuotas {
+		return kueue.FlavorQuotas{
+			Name: name,
+			Resources: []kueue.ResourceQuota{{
+				Name:         "example.com/gpu",
+				NominalQuota: resource.MustParse(gpu),
+			}},
+		}
+	}
+	rgs := []kueue.ResourceGroup{
+		{
+			CoveredResources: []corev1.ResourceName{"example.com/gpu"},
+			Flavors:          []kueue.FlavorQuotas{quotas("a100", "8"), quotas("h100", "4")},
+		},
+	}
+	qs := &kueue.QuotaSchedule{
+		Windows: []kueue.QuotaWindow{{
+			Name:            "night",
+			Schedule:        "0 20 * * *",
+			DurationMinutes: 60,
+			Flavors:         []kueue.FlavorQuotas{quotas("h100", "16")},
+		}},
+	}
+	cases := map[string]struct {
+		window string
+		want   []kueue.ResourceGroup
+	}{
+		"no window": {
+			want: rgs,
+		},
+		"unknown window": {
+			window: "day",
+			want:   rgs,
+		},
+		"window overriding a flavor": {
+			window: "night",
+			want: []kueue.ResourceGroup{
+				{
+					CoveredResources: []corev1.ResourceName{"example.com/gpu"},
+					Flavors:          []kueue.FlavorQuotas{quotas("a100", "8"), quotas("h100", "16")},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ResourceGroups(rgs, qs, tc.window)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected resource groups (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(quotas("h100", "4"), rgs[0].Flavors[1]); diff != "" {
+				t.Errorf("The input was modified (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
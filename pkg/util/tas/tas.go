@@ -17,11 +17,13 @@ limitations under the License.
 package tas
 
 import (
+	"maps"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 type TopologyDomainID string
@@ -105,6 +107,53 @@ func Levels(topology *kueue.Topology) []string {
 	return result
 }
 
+// FlavorTopology is a Topology referenced by a ResourceFlavor, with the node
+// labels selecting its nodes.
+type FlavorTopology struct {
+	Name       kueue.TopologyReference
+	NodeLabels map[string]string
+}
+
+// IsTASFlavor reports whether the ResourceFlavor references a Topology, with
+// spec.topologyName or spec.topologies. spec.topologies is ignored unless the
+// TASMultiTopologyFlavors feature gate is enabled.
+func IsTASFlavor(rf *kueue.ResourceFlavor) bool {
+	return rf.Spec.TopologyName != nil ||
+		(features.Enabled(features.TASMultiTopologyFlavors) && len(rf.Spec.Topologies) > 0)
+}
+
+// FlavorTopologies returns the Topologies referenced by the ResourceFlavor, in
+// the order in which they are tried. The nodes of a Topology listed in
+// spec.topologies are selected by the node labels of the ResourceFlavor merged
+// with the node labels of the Topology.
+func FlavorTopologies(rf *kueue.ResourceFlavor) []FlavorTopology {
+	if rf.Spec.TopologyName != nil {
+		return []FlavorTopology{{Name: *rf.Spec.TopologyName, NodeLabels: maps.Clone(rf.Spec.NodeLabels)}}
+	}
+	if !IsTASFlavor(rf) {
+		return nil
+	}
+	result := make([]FlavorTopology, 0, len(rf.Spec.Topologies))
+	for _, topology := range rf.Spec.Topologies {
+		nodeLabels := make(map[string]string, len(rf.Spec.NodeLabels)+len(topology.NodeLabels))
+		maps.Copy(nodeLabels, rf.Spec.NodeLabels)
+		maps.Copy(nodeLabels, topology.NodeLabels)
+		result = append(result, FlavorTopology{Name: topology.Name, NodeLabels: nodeLabels})
+	}
+	return result
+}
+
+// TopologyNames returns the names of the Topologies referenced by the
+// ResourceFlavor.
+func TopologyNames(rf *kueue.ResourceFlavor) []kueue.TopologyReference {
+	topologies := FlavorTopologies(rf)
+	result := make([]kueue.TopologyReference, 0, len(topologies))
+	for _, topology := range topologies {
+		result = append(result, topology.Name)
+	}
+	return result
+}
+
 func IsNodeStatusConditionTrue(conditions []corev1.NodeCondition, conditionType corev1.NodeConditionType) bool {
 	for _, cond := range conditions {
 		if cond.Type == conditionType {
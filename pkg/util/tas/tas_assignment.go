@@ -34,6 +34,9 @@ import (
 type TopologyAssignment struct {
 	Levels  []string
 	Domains []TopologyDomainAssignment
+	// TopologyName is the name of the Topology used for the assignment, set
+	// only for the ResourceFlavors referencing several topologies.
+	TopologyName kueue.TopologyReference
 }
 
 type TopologyDomainAssignment struct {
@@ -153,8 +156,9 @@ func InternalFrom(ta *kueue.TopologyAssignment) *TopologyAssignment {
 		return nil
 	}
 	return &TopologyAssignment{
-		Levels:  ta.Levels,
-		Domains: slices.Collect(InternalSeqFrom(ta)),
+		Levels:       ta.Levels,
+		Domains:      slices.Collect(InternalSeqFrom(ta)),
+		TopologyName: ptr.Deref(ta.TopologyName, ""),
 	}
 }
 
@@ -276,10 +280,16 @@ func V1Beta2From(ta *TopologyAssignment, options ...V1Beta2FromOption) *kueue.To
 			option(opts)
 		}
 	}
+	var out *kueue.TopologyAssignment
 	if !features.Enabled(features.TASAssignmentsEncodingByHostnamePrefix) {
-		return singleCompactTopologyAssignmentEncoding(ta)
+		out = singleCompactTopologyAssignmentEncoding(ta)
+	} else {
+		out = compactTopologyAssignmentEncoding(opts.logger, ta)
+	}
+	if ta.TopologyName != "" {
+		out.TopologyName = new(ta.TopologyName)
 	}
-	return compactTopologyAssignmentEncoding(opts.logger, ta)
+	return out
 }
 
 // compactTopologyAssignmentEncoding chooses the smaller serialized encoding
@@ -527,12 +537,13 @@ func CountPodsInAssignment(ta *TopologyAssignment) int32 {
 // TruncateAssignment reduces an assignment to fit newCount pods (removes from end).
 func TruncateAssignment(ta *TopologyAssignment, newCount int32) *TopologyAssignment {
 	if ta == nil || newCount <= 0 {
-		return &TopologyAssignment{Levels: ta.Levels, Domains: nil}
+		return &TopologyAssignment{Levels: ta.Levels, Domains: nil, TopologyName: ta.TopologyName}
 	}
 
 	result := &TopologyAssignment{
-		Levels:  ta.Levels,
-		Domains: make([]TopologyDomainAssignment, 0, len(ta.Domains)),
+		Levels:       ta.Levels,
+		Domains:      make([]TopologyDomainAssignment, 0, len(ta.Domains)),
+		TopologyName: ta.TopologyName,
 	}
 
 	remaining := newCount
@@ -19,7 +19,11 @@ package tas
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 func TestBelongsTo(t *testing.T) {
@@ -125,3 +129,53 @@ func TestNodeNameFromDomainID(t *testing.T) {
 		})
 	}
 }
+
+func TestFlavorTopologies(t *testing.T) {
+	cases := map[string]struct {
+		spec                kueue.ResourceFlavorSpec
+		enableMultiTopology bool
+		want                []FlavorTopology
+	}{
+		"not a TAS flavor": {
+			spec: kueue.ResourceFlavorSpec{NodeLabels: map[string]string{"pool": "a"}},
+		},
+		"topologyName": {
+			spec: kueue.ResourceFlavorSpec{
+				NodeLabels:   map[string]string{"pool": "a"},
+				TopologyName: new(kueue.TopologyReference("default")),
+			},
+			want: []FlavorTopology{{Name: "default", NodeLabels: map[string]string{"pool": "a"}}},
+		},
+		"topologies with the feature gate disabled": {
+			spec: kueue.ResourceFlavorSpec{
+				Topologies: []kueue.FlavorTopology{{Name: "tpu", NodeLabels: map[string]string{"accelerator": "tpu"}}},
+			},
+		},
+		"topologies merge the node labels of the flavor": {
+			spec: kueue.ResourceFlavorSpec{
+				NodeLabels: map[string]string{"pool": "a"},
+				Topologies: []kueue.FlavorTopology{
+					{Name: "tpu", NodeLabels: map[string]string{"accelerator": "tpu"}},
+					{Name: "gpu", NodeLabels: map[string]string{"accelerator": "gpu", "pool": "b"}},
+				},
+			},
+			enableMultiTopology: true,
+			want: []FlavorTopology{
+				{Name: "tpu", NodeLabels: map[string]string{"accelerator": "tpu", "pool": "a"}},
+				{Name: "gpu", NodeLabels: map[string]string{"accelerator": "gpu", "pool": "b"}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASMultiTopologyFlavors, tc.enableMultiTopology)
+			rf := &kueue.ResourceFlavor{Spec: tc.spec}
+			if got := IsTASFlavor(rf); got != (len(tc.want) > 0) {
+				t.Errorf("IsTASFlavor() = %t, want %t", got, len(tc.want) > 0)
+			}
+			if diff := cmp.Diff(tc.want, FlavorTopologies(rf)); diff != "" {
+				t.Errorf("Unexpected topologies (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -289,6 +289,11 @@ func (w *WorkloadWrapper) ResourceRequests(rr ...kueue.PodSetRequest) *WorkloadW
 	return w
 }
 
+func (w *WorkloadWrapper) TopologyPlacementExplanations(explanations ...kueue.TopologyPlacementExplanation) *WorkloadWrapper {
+	w.Status.TopologyPlacementExplanations = explanations
+	return w
+}
+
 func (w *WorkloadWrapper) ReclaimablePods(rps ...kueue.ReclaimablePod) *WorkloadWrapper {
 	w.Status.ReclaimablePods = rps
 	return w
@@ -412,6 +417,11 @@ func (w *WorkloadWrapper) ClusterName(clusterName string) *WorkloadWrapper {
 	return w
 }
 
+func (w *WorkloadWrapper) Migrations(migrations ...kueue.WorkloadMigration) *WorkloadWrapper {
+	w.Status.Migrations = migrations
+	return w
+}
+
 func (w *WorkloadWrapper) NominatedClusterNames(nominatedClusterNames ...string) *WorkloadWrapper {
 	w.Status.NominatedClusterNames = nominatedClusterNames
 	return w
@@ -422,6 +432,27 @@ func (w *WorkloadWrapper) PreemptionGates(preemptionGates ...kueue.PreemptionGat
 	return w
 }
 
+// PodSetShapeConstraint adds a constraint setting the count of podSet to ratio
+// pods per pod of relativeTo.
+func (w *WorkloadWrapper) PodSetShapeConstraint(podSet, relativeTo kueue.PodSetReference, ratio int32) *WorkloadWrapper {
+	w.Spec.PodSetShapeConstraints = append(w.Spec.PodSetShapeConstraints, kueue.PodSetShapeConstraint{
+		PodSet:     podSet,
+		RelativeTo: relativeTo,
+		Ratio:      ratio,
+	})
+	return w
+}
+
+// PodSetShapeConstraintMaxCount sets the maxCount of the constraint of podSet.
+func (w *WorkloadWrapper) PodSetShapeConstraintMaxCount(podSet kueue.PodSetReference, maxCount int32) *WorkloadWrapper {
+	for i := range w.Spec.PodSetShapeConstraints {
+		if w.Spec.PodSetShapeConstraints[i].PodSet == podSet {
+			w.Spec.PodSetShapeConstraints[i].MaxCount = &maxCount
+		}
+	}
+	return w
+}
+
 func (w *WorkloadWrapper) PreemptionGateStates(preemptionGateStates ...kueue.PreemptionGateState) *WorkloadWrapper {
 	w.Status.PreemptionGates = preemptionGateStates
 	return w
@@ -792,6 +823,11 @@ func (w *AdmissionWrapper) PodSets(podSets ...kueue.PodSetAssignment) *Admission
 	return w
 }
 
+func (w *AdmissionWrapper) Cost(cost string) *AdmissionWrapper {
+	w.Admission.Cost = new(resource.MustParse(cost))
+	return w
+}
+
 // LocalQueueWrapper wraps a Queue.
 type LocalQueueWrapper struct{ kueue.LocalQueue }
 
@@ -943,6 +979,29 @@ func (c *CohortWrapper) FairWeight(w resource.Quantity) *CohortWrapper {
 	return c
 }
 
+// Reservations sets the Reservations booking quota of the cohort.
+func (c *CohortWrapper) Reservations(names ...kueue.ReservationReference) *CohortWrapper {
+	c.Spec.Reservations = names
+	return c
+}
+
+// TopologyDefragmentation sets the defragmentation of the TAS flavors of the
+// cluster queue.
+func (c *ClusterQueueWrapper) TopologyDefragmentation(policy kueue.TopologyDefragmentationPolicy, maxEvictions int32) *ClusterQueueWrapper {
+	c.Spec.TopologyDefragmentation = &kueue.TopologyDefragmentation{
+		Policy:       policy,
+		MaxEvictions: &maxEvictions,
+	}
+	return c
+}
+
+// WorkflowReservationLimit sets the limit of the quota a workflow can reserve
+// in the cluster queue.
+func (c *ClusterQueueWrapper) WorkflowReservationLimit(limit corev1.ResourceList) *ClusterQueueWrapper {
+	c.Spec.WorkflowReservationLimit = limit
+	return c
+}
+
 func (c *CohortWrapper) Label(k, v string) *CohortWrapper {
 	if c.Labels == nil {
 		c.Labels = make(map[string]string)
@@ -1133,6 +1192,18 @@ func (c *ClusterQueueWrapper) StopPolicy(p kueue.StopPolicy) *ClusterQueueWrappe
 	return c
 }
 
+// QuotaSchedule sets the quota schedule.
+func (c *ClusterQueueWrapper) QuotaSchedule(qs kueue.QuotaSchedule) *ClusterQueueWrapper {
+	c.Spec.QuotaSchedule = &qs
+	return c
+}
+
+// Reservations sets the Reservations booking quota of the cluster queue.
+func (c *ClusterQueueWrapper) Reservations(names ...kueue.ReservationReference) *ClusterQueueWrapper {
+	c.Spec.Reservations = names
+	return c
+}
+
 // DeletionTimestamp sets a deletion timestamp for the cluster queue.
 func (c *ClusterQueueWrapper) DeletionTimestamp(t time.Time) *ClusterQueueWrapper {
 	c.ClusterQueue.DeletionTimestamp = new(metav1.NewTime(t).Rfc3339Copy())
@@ -1298,6 +1369,15 @@ func (rf *ResourceFlavorWrapper) TopologyName(name string) *ResourceFlavorWrappe
 	return rf
 }
 
+// Topology appends a topology, selecting the nodes with the given node labels.
+func (rf *ResourceFlavorWrapper) Topology(name string, nodeLabels map[string]string) *ResourceFlavorWrapper {
+	rf.Spec.Topologies = append(rf.Spec.Topologies, kueue.FlavorTopology{
+		Name:       kueue.TopologyReference(name),
+		NodeLabels: nodeLabels,
+	})
+	return rf
+}
+
 // Label sets the label on the ResourceFlavor.
 func (rf *ResourceFlavorWrapper) Label(k, v string) *ResourceFlavorWrapper {
 	if rf.Labels == nil {
@@ -1319,6 +1399,15 @@ func (rf *ResourceFlavorWrapper) Taint(t corev1.Taint) *ResourceFlavorWrapper {
 	return rf
 }
 
+// Price sets the price of one unit of the resource in the ResourceFlavor.
+func (rf *ResourceFlavorWrapper) Price(name corev1.ResourceName, price string) *ResourceFlavorWrapper {
+	if rf.Spec.Prices == nil {
+		rf.Spec.Prices = corev1.ResourceList{}
+	}
+	rf.Spec.Prices[name] = resource.MustParse(price)
+	return rf
+}
+
 // Toleration  adds a taint to the ResourceFlavor.
 func (rf *ResourceFlavorWrapper) Toleration(t corev1.Toleration) *ResourceFlavorWrapper {
 	rf.Spec.Tolerations = append(rf.Spec.Tolerations, t)
@@ -1379,6 +1468,39 @@ func (t *TopologyWrapper) Obj() *kueue.Topology {
 	return &t.Topology
 }
 
+// ReservationWrapper wraps a Reservation.
+type ReservationWrapper struct{ kueue.Reservation }
+
+// MakeReservation creates a wrapper for a Reservation with the given window.
+func MakeReservation(name string, start, end time.Time) *ReservationWrapper {
+	return &ReservationWrapper{kueue.Reservation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: kueue.ReservationSpec{
+			StartTime: metav1.NewTime(start),
+			EndTime:   metav1.NewTime(end),
+		},
+	}}
+}
+
+// Resource adds a reserved quantity of a resource in a flavor.
+func (r *ReservationWrapper) Resource(flavor kueue.ResourceFlavorReference, name corev1.ResourceName, quantity string) *ReservationWrapper {
+	res := kueue.ReservationResource{Name: name, Quantity: resource.MustParse(quantity)}
+	for i := range r.Spec.Flavors {
+		if r.Spec.Flavors[i].Name == flavor {
+			r.Spec.Flavors[i].Resources = append(r.Spec.Flavors[i].Resources, res)
+			return r
+		}
+	}
+	r.Spec.Flavors = append(r.Spec.Flavors, kueue.ReservationFlavor{Name: flavor, Resources: []kueue.ReservationResource{res}})
+	return r
+}
+
+func (r *ReservationWrapper) Obj() *kueue.Reservation {
+	return &r.Reservation
+}
+
 type TopologyDomainAssignmentWrapper struct {
 	tas.TopologyDomainAssignment
 }
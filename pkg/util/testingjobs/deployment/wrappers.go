@@ -179,6 +179,12 @@ func (d *DeploymentWrapper) PodTemplateSpecManagedByKueue() *DeploymentWrapper {
 	return d.PodTemplateSpecLabel(constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue)
 }
 
+// PodTemplateSpecSchedulingGate adds a scheduling gate to the pod template spec of the Deployment
+func (d *DeploymentWrapper) PodTemplateSpecSchedulingGate(name string) *DeploymentWrapper {
+	d.Spec.Template.Spec.SchedulingGates = append(d.Spec.Template.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: name})
+	return d
+}
+
 func (d *DeploymentWrapper) TerminationGracePeriod(seconds int64) *DeploymentWrapper {
 	d.Spec.Template.Spec.TerminationGracePeriodSeconds = &seconds
 	return d
@@ -0,0 +1,157 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inferenceservice
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+var GVK = schema.GroupVersionKind{Group: "serving.kserve.io", Version: "v1beta1", Kind: "InferenceService"}
+
+// InferenceServiceWrapper wraps a KServe InferenceService.
+type InferenceServiceWrapper struct {
+	unstructured.Unstructured
+}
+
+// MakeInferenceService creates a wrapper for an InferenceService with a
+// sklearn model predictor.
+func MakeInferenceService(name, ns string) *InferenceServiceWrapper {
+	w := &InferenceServiceWrapper{unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"predictor": map[string]any{
+				"model": map[string]any{
+					"modelFormat": map[string]any{"name": "sklearn"},
+					"storageUri":  "gs://kfserving-examples/models/sklearn/1.0/model",
+				},
+			},
+		},
+	}}}
+	w.SetGroupVersionKind(GVK)
+	w.SetName(name)
+	w.SetNamespace(ns)
+	return w
+}
+
+// Obj returns the inner InferenceService.
+func (w *InferenceServiceWrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+// Clone returns a deep copy of the wrapper.
+func (w *InferenceServiceWrapper) Clone() *InferenceServiceWrapper {
+	return &InferenceServiceWrapper{Unstructured: *w.DeepCopy()}
+}
+
+// UID updates the uid of the InferenceService.
+func (w *InferenceServiceWrapper) UID(uid string) *InferenceServiceWrapper {
+	w.SetUID(types.UID(uid))
+	return w
+}
+
+// Label sets a label of the InferenceService.
+func (w *InferenceServiceWrapper) Label(k, v string) *InferenceServiceWrapper {
+	labels := w.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[k] = v
+	w.SetLabels(labels)
+	return w
+}
+
+// Annotation sets an annotation of the InferenceService.
+func (w *InferenceServiceWrapper) Annotation(k, v string) *InferenceServiceWrapper {
+	annotations := w.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[k] = v
+	w.SetAnnotations(annotations)
+	return w
+}
+
+// Queue sets the queue name of the InferenceService.
+func (w *InferenceServiceWrapper) Queue(q string) *InferenceServiceWrapper {
+	return w.Label(constants.QueueLabel, q)
+}
+
+// Stop sets the stop annotation of the InferenceService.
+func (w *InferenceServiceWrapper) Stop(stop bool) *InferenceServiceWrapper {
+	if stop {
+		return w.Annotation("serving.kserve.io/stop", "true")
+	}
+	return w.Annotation("serving.kserve.io/stop", "false")
+}
+
+// MinReplicas sets the minReplicas of the predictor.
+func (w *InferenceServiceWrapper) MinReplicas(n int64) *InferenceServiceWrapper {
+	return w.predictorField(n, "minReplicas")
+}
+
+// MaxReplicas sets the maxReplicas of the predictor.
+func (w *InferenceServiceWrapper) MaxReplicas(n int64) *InferenceServiceWrapper {
+	return w.predictorField(n, "maxReplicas")
+}
+
+// PriorityClass sets the priorityClassName of the predictor.
+func (w *InferenceServiceWrapper) PriorityClass(name string) *InferenceServiceWrapper {
+	return w.predictorField(name, "priorityClassName")
+}
+
+// Request sets a resource request of the model.
+func (w *InferenceServiceWrapper) Request(name, quantity string) *InferenceServiceWrapper {
+	return w.predictorField(quantity, "model", "resources", "requests", name)
+}
+
+// PredictorLabel sets a label of the predictor pods.
+func (w *InferenceServiceWrapper) PredictorLabel(k, v string) *InferenceServiceWrapper {
+	return w.predictorField(v, "labels", k)
+}
+
+// PredictorAnnotation sets an annotation of the predictor pods.
+func (w *InferenceServiceWrapper) PredictorAnnotation(k, v string) *InferenceServiceWrapper {
+	return w.predictorField(v, "annotations", k)
+}
+
+// PredictorSchedulingGate adds a scheduling gate to the predictor pods.
+func (w *InferenceServiceWrapper) PredictorSchedulingGate(name string) *InferenceServiceWrapper {
+	gates, _, _ := unstructured.NestedSlice(w.Object, "spec", "predictor", "schedulingGates")
+	return w.predictorField(append(gates, map[string]any{"name": name}), "schedulingGates")
+}
+
+// Condition sets a condition in the status of the InferenceService.
+func (w *InferenceServiceWrapper) Condition(conditionType, status string) *InferenceServiceWrapper {
+	conditions, _, _ := unstructured.NestedSlice(w.Object, "status", "conditions")
+	conditions = append(conditions, map[string]any{"type": conditionType, "status": status})
+	_ = unstructured.SetNestedSlice(w.Object, conditions, "status", "conditions")
+	return w
+}
+
+// LatestReadyRevision sets the latest ready revision of the predictor.
+func (w *InferenceServiceWrapper) LatestReadyRevision(name string) *InferenceServiceWrapper {
+	_ = unstructured.SetNestedField(w.Object, name, "status", "components", "predictor", "latestReadyRevision")
+	return w
+}
+
+func (w *InferenceServiceWrapper) predictorField(value any, fields ...string) *InferenceServiceWrapper {
+	_ = unstructured.SetNestedField(w.Object, value, append([]string{"spec", "predictor"}, fields...)...)
+	return w
+}
@@ -178,6 +178,11 @@ func (p *PodWrapper) GroupTotalCount(gtc string) *PodWrapper {
 	return p.Annotation(podconstants.GroupTotalCountAnnotation, gtc)
 }
 
+// GroupMinCount updates the pod.GroupMinCountAnnotation of the Pod
+func (p *PodWrapper) GroupMinCount(mc string) *PodWrapper {
+	return p.Annotation(podconstants.GroupMinCountAnnotation, mc)
+}
+
 // GroupIndex updates the pod.GroupIndexLabel of the Pod
 func (p *PodWrapper) GroupIndex(index string) *PodWrapper {
 	return p.Label(kueue.PodGroupPodIndexLabel, index)
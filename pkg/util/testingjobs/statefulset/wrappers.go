@@ -183,6 +183,12 @@ func (ss *StatefulSetWrapper) PodTemplateManagedByKueue() *StatefulSetWrapper {
 	return ss.PodTemplateSpecLabel(constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue)
 }
 
+// PodTemplateSpecSchedulingGate adds a scheduling gate to the pod template spec of the StatefulSet
+func (ss *StatefulSetWrapper) PodTemplateSpecSchedulingGate(name string) *StatefulSetWrapper {
+	ss.Spec.Template.Spec.SchedulingGates = append(ss.Spec.Template.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: name})
+	return ss
+}
+
 func (ss *StatefulSetWrapper) Replicas(r int32) *StatefulSetWrapper {
 	ss.Spec.Replicas = &r
 	return ss
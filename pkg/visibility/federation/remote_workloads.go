@@ -0,0 +1,241 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federation
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/util/parallelize"
+	"sigs.k8s.io/kueue/pkg/visibility/storage"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// WorkerClusters gives read access to the connected MultiKueue worker clusters.
+type WorkerClusters interface {
+	// WorkerClient returns the client of the worker cluster, or false if the
+	// cluster is not connected.
+	WorkerClient(clusterName string) (client.Reader, bool)
+	// WorkerSubResourceReader returns the reader of the subresource of the
+	// objects in the worker cluster, or false if the cluster is not connected.
+	WorkerSubResourceReader(clusterName, subResource string) (client.SubResourceReader, bool)
+}
+
+// remoteRequestTimeout bounds the time spent reading the state of the
+// workloads from a worker cluster, so that an unresponsive worker cluster
+// doesn't hold the visibility requests.
+const remoteRequestTimeout = 5 * time.Second
+
+// RemoteWorkloads gives the state of the workloads dispatched to the
+// MultiKueue worker clusters, by querying the worker clusters and their
+// visibility servers.
+type RemoteWorkloads struct {
+	client  client.Reader
+	workers WorkerClusters
+	timeout time.Duration
+}
+
+var _ storage.RemoteWorkloads = (*RemoteWorkloads)(nil)
+
+func NewRemoteWorkloads(c client.Reader, workers WorkerClusters) *RemoteWorkloads {
+	return &RemoteWorkloads{
+		client:  c,
+		workers: workers,
+		timeout: remoteRequestTimeout,
+	}
+}
+
+// DispatchedWorkloads returns the workloads with quota reserved in the
+// ClusterQueue, which are dispatched to the worker clusters and not admitted
+// yet, in the order of their quota reservation.
+func (r *RemoteWorkloads) DispatchedWorkloads(ctx context.Context, cqName kueue.ClusterQueueReference) ([]kueue.Workload, error) {
+	list := &kueue.WorkloadList{}
+	if err := r.client.List(ctx, list, client.MatchingFields{indexer.WorkloadClusterQueueKey: string(cqName)}); err != nil {
+		return nil, err
+	}
+	dispatched := slices.DeleteFunc(list.Items, func(wl kueue.Workload) bool {
+		return workload.Status(&wl) != workload.StatusQuotaReserved || len(dispatchedClusters(&wl)) == 0
+	})
+	slices.SortStableFunc(dispatched, func(a, b kueue.Workload) int {
+		return cmp.Or(
+			quotaReservationTime(&a).Compare(quotaReservationTime(&b).Time),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return dispatched, nil
+}
+
+// RemoteStatuses returns the state of the workloads in each of the worker
+// clusters they are dispatched to. The worker clusters are queried in
+// parallel, each within the remote request timeout. The positions of the
+// workloads pending in a worker cluster are read from its visibility server,
+// once per LocalQueue.
+func (r *RemoteWorkloads) RemoteStatuses(ctx context.Context, wls []kueue.Workload) map[workload.Reference][]visibility.RemoteWorkloadStatus {
+	var clusterNames []string
+	clusterWls := make(map[string][]*kueue.Workload)
+	for i := range wls {
+		for _, clusterName := range dispatchedClusters(&wls[i]) {
+			if _, found := clusterWls[clusterName]; !found {
+				clusterNames = append(clusterNames, clusterName)
+			}
+			clusterWls[clusterName] = append(clusterWls[clusterName], &wls[i])
+		}
+	}
+	clusterStatuses := make(map[string]map[workload.Reference]visibility.RemoteWorkloadStatus, len(clusterNames))
+	results := make([]map[workload.Reference]visibility.RemoteWorkloadStatus, len(clusterNames))
+	_ = parallelize.Until(ctx, len(clusterNames), func(i int) error {
+		results[i] = r.clusterStatuses(ctx, clusterNames[i], clusterWls[clusterNames[i]])
+		return nil
+	})
+	for i, clusterName := range clusterNames {
+		clusterStatuses[clusterName] = results[i]
+	}
+
+	statuses := make(map[workload.Reference][]visibility.RemoteWorkloadStatus, len(wls))
+	for i := range wls {
+		key := workload.Key(&wls[i])
+		for _, clusterName := range dispatchedClusters(&wls[i]) {
+			status, found := clusterStatuses[clusterName][key]
+			if !found {
+				// The request was canceled before the worker cluster was queried.
+				status = visibility.RemoteWorkloadStatus{ClusterName: clusterName, State: visibility.RemoteWorkloadUnknown}
+			}
+			statuses[key] = append(statuses[key], status)
+		}
+	}
+	return statuses
+}
+
+// clusterStatuses returns the state of the workloads in the worker cluster.
+func (r *RemoteWorkloads) clusterStatuses(ctx context.Context, clusterName string, wls []*kueue.Workload) map[workload.Reference]visibility.RemoteWorkloadStatus {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	summaries := make(map[remoteQueueKey]*visibility.PendingWorkloadsSummary)
+	statuses := make(map[workload.Reference]visibility.RemoteWorkloadStatus, len(wls))
+	for _, wl := range wls {
+		statuses[workload.Key(wl)] = r.remoteStatus(ctx, wl, clusterName, summaries)
+	}
+	return statuses
+}
+
+type remoteQueueKey struct {
+	clusterName string
+	namespace   string
+	name        kueue.LocalQueueName
+}
+
+func (r *RemoteWorkloads) remoteStatus(ctx context.Context, wl *kueue.Workload, clusterName string, summaries map[remoteQueueKey]*visibility.PendingWorkloadsSummary) visibility.RemoteWorkloadStatus {
+	status := visibility.RemoteWorkloadStatus{
+		ClusterName: clusterName,
+		State:       visibility.RemoteWorkloadUnknown,
+	}
+	remoteClient, connected := r.workers.WorkerClient(clusterName)
+	if !connected {
+		status.Message = "The worker cluster is not connected"
+		return status
+	}
+	remoteWl := &kueue.Workload{}
+	if err := remoteClient.Get(ctx, client.ObjectKeyFromObject(wl), remoteWl); err != nil {
+		if apierrors.IsNotFound(err) {
+			status.Message = "The workload is not created in the worker cluster yet"
+		} else {
+			status.Message = fmt.Sprintf("Failed to get the workload from the worker cluster: %v", err)
+		}
+		return status
+	}
+
+	switch workload.Status(remoteWl) {
+	case workload.StatusAdmitted, workload.StatusFinished:
+		status.State = visibility.RemoteWorkloadAdmitted
+	case workload.StatusQuotaReserved:
+		status.State = visibility.RemoteWorkloadQuotaReserved
+	default:
+		status.State = visibility.RemoteWorkloadPending
+		if cond := apimeta.FindStatusCondition(remoteWl.Status.Conditions, kueue.WorkloadQuotaReserved); cond != nil {
+			status.Message = cond.Message
+		}
+		if pendingWl := r.remotePendingWorkload(ctx, clusterName, remoteWl, summaries); pendingWl != nil {
+			status.PositionInClusterQueue = new(pendingWl.PositionInClusterQueue)
+			status.PositionInLocalQueue = new(pendingWl.PositionInLocalQueue)
+		}
+	}
+	return status
+}
+
+// remotePendingWorkload returns the workload from the pending workloads of its
+// LocalQueue in the worker cluster, or nil.
+func (r *RemoteWorkloads) remotePendingWorkload(ctx context.Context, clusterName string, remoteWl *kueue.Workload, summaries map[remoteQueueKey]*visibility.PendingWorkloadsSummary) *visibility.PendingWorkload {
+	key := remoteQueueKey{clusterName: clusterName, namespace: remoteWl.Namespace, name: remoteWl.Spec.QueueName}
+	summary, found := summaries[key]
+	if !found {
+		summary = r.remotePendingWorkloads(ctx, key)
+		summaries[key] = summary
+	}
+	if summary == nil {
+		return nil
+	}
+	for i := range summary.Items {
+		if summary.Items[i].Name == remoteWl.Name {
+			return &summary.Items[i]
+		}
+	}
+	return nil
+}
+
+func (r *RemoteWorkloads) remotePendingWorkloads(ctx context.Context, key remoteQueueKey) *visibility.PendingWorkloadsSummary {
+	log := ctrl.LoggerFrom(ctx).WithValues("workerCluster", key.clusterName, "localQueue", key.name, "namespace", key.namespace)
+	reader, connected := r.workers.WorkerSubResourceReader(key.clusterName, "pendingworkloads")
+	if !connected {
+		return nil
+	}
+	lq := &visibility.LocalQueue{ObjectMeta: metav1.ObjectMeta{Namespace: key.namespace, Name: string(key.name)}}
+	summary := &visibility.PendingWorkloadsSummary{}
+	if err := reader.Get(ctx, lq, summary); err != nil {
+		log.V(3).Info("Failed to get the pending workloads from the visibility server of the worker cluster", "error", err)
+		return nil
+	}
+	return summary
+}
+
+// dispatchedClusters returns the worker clusters where the workload is
+// admitted, or nominated to.
+func dispatchedClusters(wl *kueue.Workload) []string {
+	if clusterName := workload.ClusterName(wl); clusterName != "" {
+		return []string{clusterName}
+	}
+	return wl.Status.NominatedClusterNames
+}
+
+func quotaReservationTime(wl *kueue.Workload) metav1.Time {
+	if cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved); cond != nil {
+		return cond.LastTransitionTime
+	}
+	return wl.CreationTimestamp
+}
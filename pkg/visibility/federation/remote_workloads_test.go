@@ -0,0 +1,222 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type fakeWorker struct {
+	client client.Reader
+	// pendingWorkloads holds the pending workloads of the LocalQueues,
+	// by namespace/name.
+	pendingWorkloads map[string]*visibility.PendingWorkloadsSummary
+	// pendingWorkloadsGets counts the reads of the pending workloads.
+	pendingWorkloadsGets int
+}
+
+func (f *fakeWorker) Get(_ context.Context, obj client.Object, subResource client.Object, _ ...client.SubResourceGetOption) error {
+	f.pendingWorkloadsGets++
+	summary, found := f.pendingWorkloads[obj.GetNamespace()+"/"+obj.GetName()]
+	if !found {
+		return apierrors.NewNotFound(visibility.Resource("localqueue"), obj.GetName())
+	}
+	summary.DeepCopyInto(subResource.(*visibility.PendingWorkloadsSummary))
+	return nil
+}
+
+type fakeWorkerClusters map[string]*fakeWorker
+
+func (f fakeWorkerClusters) WorkerClient(clusterName string) (client.Reader, bool) {
+	w, found := f[clusterName]
+	if !found {
+		return nil, false
+	}
+	return w.client, true
+}
+
+func (f fakeWorkerClusters) WorkerSubResourceReader(clusterName, _ string) (client.SubResourceReader, bool) {
+	w, found := f[clusterName]
+	return w, found
+}
+
+func TestDispatchedWorkloads(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admission := utiltestingapi.MakeAdmission("cq").Obj()
+	objs := []client.Object{
+		utiltestingapi.MakeWorkload("dispatched-later", "ns").ReserveQuotaAt(admission, now.Add(time.Minute)).NominatedClusterNames("worker1").Obj(),
+		utiltestingapi.MakeWorkload("dispatched", "ns").ReserveQuotaAt(admission, now).NominatedClusterNames("worker1", "worker2").Obj(),
+		utiltestingapi.MakeWorkload("assigned", "ns").ReserveQuotaAt(admission, now.Add(2*time.Minute)).ClusterName("worker2").Obj(),
+		utiltestingapi.MakeWorkload("not-dispatched", "ns").ReserveQuotaAt(admission, now).Obj(),
+		utiltestingapi.MakeWorkload("admitted", "ns").ReserveQuotaAt(admission, now).AdmittedAt(true, now).ClusterName("worker1").Obj(),
+		utiltestingapi.MakeWorkload("other-cq", "ns").ReserveQuotaAt(utiltestingapi.MakeAdmission("other").Obj(), now).NominatedClusterNames("worker1").Obj(),
+		utiltestingapi.MakeWorkload("pending", "ns").Obj(),
+	}
+	c := utiltesting.NewClientBuilder().WithObjects(objs...).Build()
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	got, err := NewRemoteWorkloads(c, fakeWorkerClusters{}).DispatchedWorkloads(ctx, "cq")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	gotNames := make([]string, 0, len(got))
+	for _, wl := range got {
+		gotNames = append(gotNames, wl.Name)
+	}
+	wantNames := []string{"dispatched", "dispatched-later", "assigned"}
+	if diff := cmp.Diff(wantNames, gotNames); diff != "" {
+		t.Errorf("Unexpected dispatched workloads (-want/+got):\n%s", diff)
+	}
+}
+
+func TestRemoteStatuses(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admission := utiltestingapi.MakeAdmission("cq").Obj()
+	pendingCondition := metav1.Condition{
+		Type:    kueue.WorkloadQuotaReserved,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Pending",
+		Message: "couldn't assign flavors to pod set main: insufficient unused quota for cpu in flavor default",
+	}
+	worker1 := &fakeWorker{
+		client: utiltesting.NewClientBuilder().WithObjects(
+			utiltestingapi.MakeWorkload("wl1", "ns").Queue("lq").Condition(pendingCondition).Obj(),
+			utiltestingapi.MakeWorkload("wl2", "ns").Queue("lq").Condition(pendingCondition).Obj(),
+		).Build(),
+		pendingWorkloads: map[string]*visibility.PendingWorkloadsSummary{
+			"ns/lq": {Items: []visibility.PendingWorkload{
+				{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"}, PositionInClusterQueue: 0, PositionInLocalQueue: 0},
+				{ObjectMeta: metav1.ObjectMeta{Name: "wl1", Namespace: "ns"}, PositionInClusterQueue: 2, PositionInLocalQueue: 1},
+				{ObjectMeta: metav1.ObjectMeta{Name: "wl2", Namespace: "ns"}, PositionInClusterQueue: 3, PositionInLocalQueue: 2},
+			}},
+		},
+	}
+	worker2 := &fakeWorker{
+		client: utiltesting.NewClientBuilder().WithObjects(
+			utiltestingapi.MakeWorkload("wl1", "ns").Queue("lq").ReserveQuotaAt(admission, now).Obj(),
+		).Build(),
+	}
+	workers := fakeWorkerClusters{"worker1": worker1, "worker2": worker2}
+
+	wls := []kueue.Workload{
+		*utiltestingapi.MakeWorkload("wl1", "ns").Queue("lq").ReserveQuotaAt(admission, now).NominatedClusterNames("worker1", "worker2", "worker3").Obj(),
+		*utiltestingapi.MakeWorkload("wl2", "ns").Queue("lq").ReserveQuotaAt(admission, now).NominatedClusterNames("worker1", "worker2").Obj(),
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	got := NewRemoteWorkloads(utiltesting.NewClientBuilder().Build(), workers).RemoteStatuses(ctx, wls)
+
+	want := map[workload.Reference][]visibility.RemoteWorkloadStatus{
+		workload.NewReference("ns", "wl1"): {
+			{
+				ClusterName:            "worker1",
+				State:                  visibility.RemoteWorkloadPending,
+				PositionInClusterQueue: new(int32(2)),
+				PositionInLocalQueue:   new(int32(1)),
+				Message:                pendingCondition.Message,
+			},
+			{
+				ClusterName: "worker2",
+				State:       visibility.RemoteWorkloadQuotaReserved,
+			},
+			{
+				ClusterName: "worker3",
+				State:       visibility.RemoteWorkloadUnknown,
+				Message:     "The worker cluster is not connected",
+			},
+		},
+		workload.NewReference("ns", "wl2"): {
+			{
+				ClusterName:            "worker1",
+				State:                  visibility.RemoteWorkloadPending,
+				PositionInClusterQueue: new(int32(3)),
+				PositionInLocalQueue:   new(int32(2)),
+				Message:                pendingCondition.Message,
+			},
+			{
+				ClusterName: "worker2",
+				State:       visibility.RemoteWorkloadUnknown,
+				Message:     "The workload is not created in the worker cluster yet",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected remote statuses (-want/+got):\n%s", diff)
+	}
+	if worker1.pendingWorkloadsGets != 1 {
+		t.Errorf("Expected the pending workloads of the LocalQueue to be read once, got %d reads", worker1.pendingWorkloadsGets)
+	}
+}
+
+// unresponsiveReader is the client of a worker cluster which doesn't respond.
+type unresponsiveReader struct {
+	client.Reader
+}
+
+func (unresponsiveReader) Get(ctx context.Context, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestRemoteStatusesUnresponsiveWorker(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admission := utiltestingapi.MakeAdmission("cq").Obj()
+	workers := fakeWorkerClusters{
+		"worker1": &fakeWorker{client: unresponsiveReader{}},
+		"worker2": &fakeWorker{
+			client: utiltesting.NewClientBuilder().WithObjects(
+				utiltestingapi.MakeWorkload("wl", "ns").Queue("lq").ReserveQuotaAt(admission, now).Obj(),
+			).Build(),
+		},
+	}
+	wls := []kueue.Workload{
+		*utiltestingapi.MakeWorkload("wl", "ns").Queue("lq").ReserveQuotaAt(admission, now).NominatedClusterNames("worker1", "worker2").Obj(),
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	r := NewRemoteWorkloads(utiltesting.NewClientBuilder().Build(), workers)
+	r.timeout = 10 * time.Millisecond
+	got := r.RemoteStatuses(ctx, wls)
+
+	want := map[workload.Reference][]visibility.RemoteWorkloadStatus{
+		workload.NewReference("ns", "wl"): {
+			{
+				ClusterName: "worker1",
+				State:       visibility.RemoteWorkloadUnknown,
+				Message:     "Failed to get the workload from the worker cluster: context deadline exceeded",
+			},
+			{
+				ClusterName: "worker2",
+				State:       visibility.RemoteWorkloadQuotaReserved,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected remote statuses (-want/+got):\n%s", diff)
+	}
+}
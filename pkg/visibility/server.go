@@ -78,8 +78,55 @@ func init() {
 // +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=flowschemas,verbs=list;watch
 // +kubebuilder:rbac:groups=flowcontrol.apiserver.k8s.io,resources=flowschemas/status,verbs=patch
 
+type options struct {
+	admissionSimulator storage.AdmissionSimulator
+	admissionHistory   storage.AdmissionHistory
+	usageReporter      storage.UsageReporter
+	remoteWorkloads    storage.RemoteWorkloads
+}
+
+// Option configures the visibility server.
+type Option func(*options)
+
+// WithAdmissionSimulator serves the admission simulation subresource of
+// LocalQueues in visibility.kueue.x-k8s.io/v1beta2 using the simulator.
+func WithAdmissionSimulator(simulator storage.AdmissionSimulator) Option {
+	return func(o *options) {
+		o.admissionSimulator = simulator
+	}
+}
+
+// WithAdmissionHistory estimates the admission time of pending workloads
+// from the admission history.
+func WithAdmissionHistory(history storage.AdmissionHistory) Option {
+	return func(o *options) {
+		o.admissionHistory = history
+	}
+}
+
+// WithUsageReporter serves the usage subresources of LocalQueues and
+// ClusterQueues in visibility.kueue.x-k8s.io/v1beta2 using the reporter.
+func WithUsageReporter(reporter storage.UsageReporter) Option {
+	return func(o *options) {
+		o.usageReporter = reporter
+	}
+}
+
+// WithRemoteWorkloads lists the workloads dispatched to the MultiKueue worker
+// clusters, with their state in the worker clusters, in the pending workloads
+// of visibility.kueue.x-k8s.io/v1beta2.
+func WithRemoteWorkloads(remote storage.RemoteWorkloads) Option {
+	return func(o *options) {
+		o.remoteWorkloads = remote
+	}
+}
+
 // CreateAndStartVisibilityServer creates a visibility server injecting KueueManager and starts it
-func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manager, cfg *configapi.Configuration, kubeConfig *rest.Config, tlsOpts *tlsconfig.TLS) error {
+func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manager, cfg *configapi.Configuration, kubeConfig *rest.Config, tlsOpts *tlsconfig.TLS, opts ...Option) error {
+	var serverOptions options
+	for _, opt := range opts {
+		opt(&serverOptions)
+	}
 	config := newVisibilityServerConfig(kubeConfig)
 	if err := applyVisibilityServerOptions(config, cfg, tlsOpts); err != nil {
 		return fmt.Errorf("unable to apply VisibilityServerOptions: %w", err)
@@ -90,7 +137,7 @@ func CreateAndStartVisibilityServer(ctx context.Context, kueueMgr *qcache.Manage
 		return fmt.Errorf("unable to create visibility server: %w", err)
 	}
 
-	if err := install(visibilityServer, kueueMgr); err != nil {
+	if err := install(visibilityServer, kueueMgr, serverOptions); err != nil {
 		return fmt.Errorf("unable to install visibility.kueue.x-k8s.io API: %w", err)
 	}
 
@@ -183,11 +230,21 @@ func newVisibilityServerConfig(kubeConfig *rest.Config) *genericapiserver.Recomm
 }
 
 // install installs API scheme and registers storages
-func install(server *genericapiserver.GenericAPIServer, kueueMgr *qcache.Manager) error {
+func install(server *genericapiserver.GenericAPIServer, kueueMgr *qcache.Manager, opts options) error {
 	apiGroupInfo := genericapiserver.NewDefaultAPIGroupInfo(visibilityv1beta2.SchemeGroupVersion.Group, scheme, parameterCodec, codecs)
-	storage := storage.NewStorage(kueueMgr)
-	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta2.SchemeGroupVersion.Version] = storage
-	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta1.SchemeGroupVersion.Version] = storage
+	pendingWorkloadsStorage := storage.NewStorage(kueueMgr, opts.admissionHistory)
+	v1beta2Storage := pendingWorkloadsStorage
+	if opts.admissionSimulator != nil {
+		v1beta2Storage = storage.WithAdmissionSimulation(pendingWorkloadsStorage, opts.admissionSimulator)
+	}
+	if opts.usageReporter != nil {
+		v1beta2Storage = storage.WithUsageReports(v1beta2Storage, opts.usageReporter)
+	}
+	if opts.remoteWorkloads != nil {
+		v1beta2Storage = storage.WithRemoteWorkloads(v1beta2Storage, opts.remoteWorkloads)
+	}
+	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta2.SchemeGroupVersion.Version] = v1beta2Storage
+	apiGroupInfo.VersionedResourcesStorageMap[visibilityv1beta1.SchemeGroupVersion.Version] = pendingWorkloadsStorage
 	apiGroupInfo.PrioritizedVersions = []schema.GroupVersion{visibilityv1beta2.SchemeGroupVersion, visibilityv1beta1.SchemeGroupVersion}
 	return server.InstallAPIGroups(&apiGroupInfo)
 }
@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/metrics"
+)
+
+// minAdmissionWaitTimes is the minimum number of admission wait times observed
+// in a ClusterQueue needed to estimate from their distribution.
+const minAdmissionWaitTimes = 10
+
+// AdmissionHistory provides the admitted workloads in the ClusterQueues, which
+// are used to estimate the admission time of pending workloads.
+type AdmissionHistory interface {
+	// ExpectedReleases returns the sorted times at which the workloads
+	// admitted in the ClusterQueue reach their maximum execution time, and
+	// the number of admitted workloads which don't declare one.
+	ExpectedReleases(cqName kueue.ClusterQueueReference) ([]time.Time, int)
+}
+
+type admissionEstimator struct {
+	history AdmissionHistory
+	// waitTimes returns the distribution of the admission wait times in the
+	// ClusterQueue.
+	waitTimes func(kueue.ClusterQueueReference) metrics.WaitTimeDistribution
+	clock     clock.Clock
+}
+
+func newAdmissionEstimator(history AdmissionHistory) *admissionEstimator {
+	if history == nil {
+		return nil
+	}
+	return &admissionEstimator{
+		history:   history,
+		waitTimes: metrics.AdmissionWaitTimes,
+		clock:     clock.RealClock{},
+	}
+}
+
+// setEstimatedAdmissionTimes estimates the admission time of the pending
+// workloads of the ClusterQueue, sorted by position. It's a no-op for a nil
+// estimator.
+func (e *admissionEstimator) setEstimatedAdmissionTimes(cqName kueue.ClusterQueueReference, wls []visibility.PendingWorkload) {
+	if e == nil || len(wls) == 0 {
+		return
+	}
+	now := e.clock.Now()
+	waitTimes := e.waitTimes(cqName)
+	releases, unbounded := e.history.ExpectedReleases(cqName)
+	var previous time.Time
+	for i := range wls {
+		estimate, confidence := estimateAdmissionTime(now, wls[i].CreationTimestamp.Time, int(wls[i].PositionInClusterQueue), waitTimes, releases, unbounded)
+		if estimate == nil {
+			continue
+		}
+		// The workloads ahead in the ClusterQueue are admitted first.
+		*estimate = latest(*estimate, previous)
+		previous = *estimate
+		wls[i].EstimatedAdmissionTime = new(metav1.NewTime(*estimate))
+		wls[i].EstimatedAdmissionTimeConfidence = confidence
+	}
+}
+
+// estimateAdmissionTime estimates when the workload queued at the time and at
+// the position in the ClusterQueue is admitted.
+//
+// Assuming that every admission needs the release of an admitted workload, the
+// workload at position N is expected to be admitted once N+1 admitted workloads
+// reach their maximum execution time. When this isn't known, the estimate is
+// the median of the admission wait times in the ClusterQueue longer than the
+// time the workload already waited.
+func estimateAdmissionTime(now, queuedAt time.Time, position int, waitTimes metrics.WaitTimeDistribution, releases []time.Time, unbounded int) (*time.Time, visibility.EstimationConfidence) {
+	var fromReleases, fromWaitTimes *time.Time
+	if position < len(releases) {
+		fromReleases = new(latest(now, releases[position]))
+	}
+	if waitTimes.Count >= minAdmissionWaitTimes {
+		if wait, found := medianWaitTimeAfter(waitTimes, max(now.Sub(queuedAt), 0)); found {
+			fromWaitTimes = new(latest(now, queuedAt.Add(wait)))
+		}
+	}
+	switch {
+	case fromReleases != nil && fromWaitTimes != nil:
+		if unbounded == 0 && agree(now, *fromReleases, *fromWaitTimes) {
+			return fromReleases, visibility.HighConfidence
+		}
+		return fromReleases, visibility.MediumConfidence
+	case fromReleases != nil:
+		return fromReleases, visibility.MediumConfidence
+	case fromWaitTimes != nil:
+		return fromWaitTimes, visibility.LowConfidence
+	}
+	return nil, ""
+}
+
+// medianWaitTimeAfter returns the median of the wait times of the distribution
+// longer than elapsed, interpolated within the buckets. It's not found when
+// elapsed exceeds all the wait times, or when the median is beyond the last
+// finite bucket.
+func medianWaitTimeAfter(waitTimes metrics.WaitTimeDistribution, elapsed time.Duration) (time.Duration, bool) {
+	total := float64(waitTimes.Count)
+	below := cumulativeCount(waitTimes, elapsed)
+	if below >= total {
+		return 0, false
+	}
+	target := below + (total-below)/2
+	lowerBound, lowerCount := time.Duration(0), 0.0
+	for _, b := range waitTimes.Buckets {
+		count := float64(b.CumulativeCount)
+		if count >= target && count > lowerCount {
+			fraction := (target - lowerCount) / (count - lowerCount)
+			return max(elapsed, lowerBound+time.Duration(fraction*float64(b.UpperBound-lowerBound))), true
+		}
+		lowerBound, lowerCount = b.UpperBound, count
+	}
+	return 0, false
+}
+
+// cumulativeCount returns the number of wait times of the distribution up to
+// the duration, interpolated within the buckets.
+func cumulativeCount(waitTimes metrics.WaitTimeDistribution, d time.Duration) float64 {
+	lowerBound, lowerCount := time.Duration(0), 0.0
+	for _, b := range waitTimes.Buckets {
+		count := float64(b.CumulativeCount)
+		if d <= b.UpperBound {
+			fraction := float64(d-lowerBound) / float64(b.UpperBound-lowerBound)
+			return lowerCount + fraction*(count-lowerCount)
+		}
+		lowerBound, lowerCount = b.UpperBound, count
+	}
+	return lowerCount
+}
+
+// agree reports whether the shorter of the waits until the estimates is at
+// least half of the longer one.
+func agree(now, a, b time.Time) bool {
+	waitA, waitB := a.Sub(now), b.Sub(now)
+	return 2*min(waitA, waitB) >= max(waitA, waitB)
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,230 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/metrics"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+)
+
+// testWaitTimes is a distribution of 10 admission wait times: 4 up to 2
+// minutes, 4 between 2 and 4 minutes, and 2 between 4 and 8 minutes.
+var testWaitTimes = metrics.WaitTimeDistribution{
+	Count: 10,
+	Buckets: []metrics.WaitTimeBucket{
+		{UpperBound: time.Minute, CumulativeCount: 0},
+		{UpperBound: 2 * time.Minute, CumulativeCount: 4},
+		{UpperBound: 4 * time.Minute, CumulativeCount: 8},
+		{UpperBound: 8 * time.Minute, CumulativeCount: 10},
+	},
+}
+
+func TestEstimateAdmissionTime(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cases := map[string]struct {
+		position       int
+		queuedAt       time.Time
+		waitTimes      metrics.WaitTimeDistribution
+		releases       []time.Time
+		unbounded      int
+		wantTime       *time.Time
+		wantConfidence visibility.EstimationConfidence
+	}{
+		"no history": {
+			queuedAt: now,
+		},
+		"not enough admission wait times": {
+			queuedAt: now,
+			waitTimes: metrics.WaitTimeDistribution{
+				Count:   9,
+				Buckets: testWaitTimes.Buckets,
+			},
+		},
+		"admission wait times only": {
+			position:       1,
+			queuedAt:       now,
+			waitTimes:      testWaitTimes,
+			wantTime:       new(now.Add(2*time.Minute + 30*time.Second)),
+			wantConfidence: visibility.LowConfidence,
+		},
+		"workload already waiting": {
+			queuedAt:       now.Add(-3 * time.Minute),
+			waitTimes:      testWaitTimes,
+			wantTime:       new(now.Add(time.Minute)),
+			wantConfidence: visibility.LowConfidence,
+		},
+		"workload waiting longer than the admission wait times": {
+			queuedAt:  now.Add(-10 * time.Minute),
+			waitTimes: testWaitTimes,
+		},
+		"median beyond the last finite bucket": {
+			queuedAt: now,
+			waitTimes: metrics.WaitTimeDistribution{
+				Count:   30,
+				Buckets: testWaitTimes.Buckets,
+			},
+		},
+		"releases only": {
+			position:       1,
+			queuedAt:       now,
+			releases:       []time.Time{now.Add(5 * time.Minute), now.Add(15 * time.Minute)},
+			wantTime:       new(now.Add(15 * time.Minute)),
+			wantConfidence: visibility.MediumConfidence,
+		},
+		"overdue release": {
+			queuedAt:       now,
+			releases:       []time.Time{now.Add(-5 * time.Minute)},
+			wantTime:       new(now),
+			wantConfidence: visibility.MediumConfidence,
+		},
+		"releases agreeing with the admission wait times": {
+			position:       1,
+			queuedAt:       now,
+			waitTimes:      testWaitTimes,
+			releases:       []time.Time{now.Add(time.Minute), now.Add(3 * time.Minute)},
+			wantTime:       new(now.Add(3 * time.Minute)),
+			wantConfidence: visibility.HighConfidence,
+		},
+		"releases disagreeing with the admission wait times": {
+			position:       1,
+			queuedAt:       now,
+			waitTimes:      testWaitTimes,
+			releases:       []time.Time{now.Add(time.Minute), now.Add(2 * time.Hour)},
+			wantTime:       new(now.Add(2 * time.Hour)),
+			wantConfidence: visibility.MediumConfidence,
+		},
+		"admitted workloads without maximum execution time": {
+			position:       1,
+			queuedAt:       now,
+			waitTimes:      testWaitTimes,
+			releases:       []time.Time{now.Add(time.Minute), now.Add(3 * time.Minute)},
+			unbounded:      1,
+			wantTime:       new(now.Add(3 * time.Minute)),
+			wantConfidence: visibility.MediumConfidence,
+		},
+		"position beyond the releases": {
+			position:       2,
+			queuedAt:       now,
+			waitTimes:      testWaitTimes,
+			releases:       []time.Time{now.Add(time.Minute), now.Add(3 * time.Minute)},
+			wantTime:       new(now.Add(2*time.Minute + 30*time.Second)),
+			wantConfidence: visibility.LowConfidence,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotTime, gotConfidence := estimateAdmissionTime(now, tc.queuedAt, tc.position, tc.waitTimes, tc.releases, tc.unbounded)
+			if diff := cmp.Diff(tc.wantTime, gotTime); diff != "" {
+				t.Errorf("Unexpected estimated admission time (-want,+got):\n%s", diff)
+			}
+			if gotConfidence != tc.wantConfidence {
+				t.Errorf("Unexpected confidence, want=%q, got=%q", tc.wantConfidence, gotConfidence)
+			}
+		})
+	}
+}
+
+type fakeAdmissionHistory struct {
+	releases  []time.Time
+	unbounded int
+}
+
+func (h *fakeAdmissionHistory) ExpectedReleases(kueue.ClusterQueueReference) ([]time.Time, int) {
+	return h.releases, h.unbounded
+}
+
+func TestPendingWorkloadsInCQWithAdmissionEstimation(t *testing.T) {
+	const (
+		nsName = "foo"
+		cqName = "cq"
+		lqName = "lq"
+	)
+	now := time.Now().Truncate(time.Second)
+	history := &fakeAdmissionHistory{
+		releases: []time.Time{now.Add(10 * time.Minute)},
+	}
+
+	manager := qcache.NewManagerForUnitTests(utiltesting.NewFakeClient(), nil, qcache.WithPreemptionExpectations(preemptexpectations.New()))
+	ctx, log := utiltesting.ContextWithLog(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go manager.CleanUpOnContext(ctx)
+	if err := manager.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue(cqName).Obj()); err != nil {
+		t.Fatalf("Adding cluster queue %s: %v", cqName, err)
+	}
+	if err := manager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue(lqName, nsName).ClusterQueue(cqName).Obj()); err != nil {
+		t.Fatalf("Adding queue %q: %v", lqName, err)
+	}
+	for i, name := range []string{"a", "b"} {
+		wl := utiltestingapi.MakeWorkload(name, nsName).Queue(lqName).Creation(now.Add(time.Duration(i) * time.Second)).Obj()
+		if err := manager.AddOrUpdateWorkload(log, wl); err != nil {
+			t.Fatalf("Failed to add or update workload %q: %v", name, err)
+		}
+	}
+
+	rest := NewPendingWorkloadsInCqREST(manager, history)
+	rest.estimator.clock = testingclock.NewFakeClock(now)
+	rest.estimator.waitTimes = func(kueue.ClusterQueueReference) metrics.WaitTimeDistribution { return testWaitTimes }
+	info, err := rest.Get(ctx, cqName, &visibility.PendingWorkloadOptions{Limit: constants.DefaultPendingWorkloadsLimit})
+	if err != nil {
+		t.Fatalf("Getting pending workloads: %v", err)
+	}
+	want := []visibility.PendingWorkload{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "a",
+				Namespace:         nsName,
+				CreationTimestamp: metav1.NewTime(now),
+			},
+			LocalQueueName:                   lqName,
+			EstimatedAdmissionTime:           new(metav1.NewTime(now.Add(10 * time.Minute))),
+			EstimatedAdmissionTimeConfidence: visibility.MediumConfidence,
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "b",
+				Namespace:         nsName,
+				CreationTimestamp: metav1.NewTime(now.Add(time.Second)),
+			},
+			LocalQueueName:         lqName,
+			PositionInClusterQueue: 1,
+			PositionInLocalQueue:   1,
+			// The admission wait times estimate an earlier admission than the
+			// one of the workload ahead.
+			EstimatedAdmissionTime:           new(metav1.NewTime(now.Add(10 * time.Minute))),
+			EstimatedAdmissionTimeConfidence: visibility.LowConfidence,
+		},
+	}
+	if diff := cmp.Diff(want, info.(*visibility.PendingWorkloadsSummary).Items, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("Pending workloads differ: (-want,+got):\n%s", diff)
+	}
+}
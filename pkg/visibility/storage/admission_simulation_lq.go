@@ -0,0 +1,163 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+)
+
+// AdmissionSimulator evaluates a Workload against the current scheduler
+// state without mutating it.
+type AdmissionSimulator interface {
+	SimulateAdmission(ctx context.Context, wl *kueue.Workload) (*scheduler.SimulationResult, error)
+}
+
+type admissionSimulationInLqREST struct {
+	simulator AdmissionSimulator
+	log       logr.Logger
+}
+
+var _ rest.Storage = &admissionSimulationInLqREST{}
+var _ rest.NamedCreater = &admissionSimulationInLqREST{}
+var _ rest.Scoper = &admissionSimulationInLqREST{}
+
+func NewAdmissionSimulationInLqREST(simulator AdmissionSimulator) *admissionSimulationInLqREST {
+	return &admissionSimulationInLqREST{
+		simulator: simulator,
+		log:       ctrl.Log.WithName("admission-simulation-in-lq"),
+	}
+}
+
+// New implements rest.Storage interface
+func (m *admissionSimulationInLqREST) New() runtime.Object {
+	return &visibility.AdmissionSimulation{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *admissionSimulationInLqREST) Destroy() {}
+
+// Create implements rest.NamedCreater interface
+// It evaluates the Workload from the request as if it was submitted to the LocalQueue
+func (m *admissionSimulationInLqREST) Create(ctx context.Context, name string, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	simulation, ok := obj.(*visibility.AdmissionSimulation)
+	if !ok {
+		return nil, fmt.Errorf("invalid object: %#v", obj)
+	}
+	wl, err := decodeSimulatedWorkload(simulation.Workload)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	wl.Namespace = genericapirequest.NamespaceValue(ctx)
+	wl.Spec.QueueName = kueue.LocalQueueName(name)
+
+	result, err := m.simulator.SimulateAdmission(ctx, wl)
+	switch {
+	case errors.Is(err, qcache.ErrLocalQueueDoesNotExistOrInactive):
+		return nil, apierrors.NewNotFound(visibility.Resource("localqueue"), name)
+	case errors.Is(err, scheduler.ErrSimulationInvalidWorkload):
+		return nil, apierrors.NewBadRequest(err.Error())
+	case err != nil:
+		m.log.Error(err, "Failed to simulate admission", "localQueue", name, "namespace", wl.Namespace)
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	out := simulation.DeepCopy()
+	out.Result = newAdmissionSimulationResult(result, wl.Namespace)
+	return out, nil
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *admissionSimulationInLqREST) NamespaceScoped() bool {
+	return true
+}
+
+// decodeSimulatedWorkload decodes the Workload manifest of the request. The
+// status of the manifest is dropped, so that the Workload is evaluated as a
+// newly submitted one.
+func decodeSimulatedWorkload(raw runtime.RawExtension) (*kueue.Workload, error) {
+	if len(raw.Raw) == 0 {
+		return nil, errors.New("workload is required")
+	}
+	wl := &kueue.Workload{}
+	if err := json.Unmarshal(raw.Raw, wl); err != nil {
+		return nil, fmt.Errorf("decoding workload: %w", err)
+	}
+	if gvk := wl.GroupVersionKind(); !gvk.Empty() && gvk != kueue.SchemeGroupVersion.WithKind("Workload") {
+		return nil, fmt.Errorf("unsupported workload kind %s, expected %s", gvk, kueue.SchemeGroupVersion.WithKind("Workload"))
+	}
+	wl.Status = kueue.WorkloadStatus{}
+	return wl, nil
+}
+
+// newAdmissionSimulationResult converts the result of the simulation. The
+// subresource is available to the batch users of the namespace, so the
+// preemption targets in other namespaces are redacted.
+func newAdmissionSimulationResult(result *scheduler.SimulationResult, namespace string) *visibility.AdmissionSimulationResult {
+	out := &visibility.AdmissionSimulationResult{
+		ClusterQueue: result.ClusterQueue,
+		Mode:         result.Mode().String(),
+		Admissible:   result.Admissible(),
+		Borrowing:    result.Assignment.RequiresBorrowing(),
+		Message:      result.Message,
+	}
+	for _, ps := range result.Assignment.PodSets {
+		psa := visibility.SimulatedPodSetAssignment{
+			Name:  ps.Name,
+			Count: ps.Count,
+		}
+		if len(ps.Flavors) > 0 {
+			psa.Flavors = make(map[corev1.ResourceName]kueue.ResourceFlavorReference, len(ps.Flavors))
+			psa.ResourceUsage = make(corev1.ResourceList, len(ps.Flavors))
+			for res, flv := range ps.Flavors {
+				psa.Flavors[res] = flv.Name
+				psa.ResourceUsage[res] = ps.Requests[res]
+			}
+		}
+		out.PodSetAssignments = append(out.PodSetAssignments, psa)
+	}
+	for _, target := range result.PreemptionTargets {
+		simulated := visibility.SimulatedPreemptionTarget{
+			ClusterQueue: target.WorkloadInfo.ClusterQueue,
+			Priority:     priority.Priority(target.WorkloadInfo.Obj),
+			Reason:       target.Reason,
+		}
+		if target.WorkloadInfo.Obj.Namespace == namespace {
+			simulated.Name = target.WorkloadInfo.Obj.Name
+			simulated.Namespace = target.WorkloadInfo.Obj.Namespace
+		}
+		out.PreemptionTargets = append(out.PreemptionTargets, simulated)
+	}
+	return out
+}
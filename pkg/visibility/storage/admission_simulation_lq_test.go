@@ -0,0 +1,281 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type fakeAdmissionSimulator struct {
+	result *scheduler.SimulationResult
+	err    error
+
+	gotWorkload *kueue.Workload
+}
+
+func (f *fakeAdmissionSimulator) SimulateAdmission(_ context.Context, wl *kueue.Workload) (*scheduler.SimulationResult, error) {
+	f.gotWorkload = wl
+	return f.result, f.err
+}
+
+func TestAdmissionSimulationInLQ(t *testing.T) {
+	const (
+		nsName = "ns"
+		lqName = "lq"
+	)
+
+	rawWorkload := func(wl any) runtime.RawExtension {
+		raw, err := json.Marshal(wl)
+		if err != nil {
+			t.Fatalf("Failed to marshal workload: %v", err)
+		}
+		return runtime.RawExtension{Raw: raw}
+	}
+
+	baseWl := utiltestingapi.MakeWorkload("wl", "other").
+		Queue("other-lq").
+		Request(corev1.ResourceCPU, "1").
+		Admission(utiltestingapi.MakeAdmission("cq").Obj()).
+		Obj()
+	baseWl.APIVersion = kueue.SchemeGroupVersion.String()
+	baseWl.Kind = "Workload"
+
+	lowPriorityWl := utiltestingapi.MakeWorkload("low", nsName).Priority(-1).Obj()
+	otherNamespaceWl := utiltestingapi.MakeWorkload("other", "other-ns").Priority(-2).Obj()
+
+	testCases := map[string]struct {
+		workload     runtime.RawExtension
+		result       *scheduler.SimulationResult
+		simulatorErr error
+		wantResult   *visibility.AdmissionSimulationResult
+		wantErr      func(error) bool
+		wantQueue    kueue.LocalQueueName
+	}{
+		"fit": {
+			workload: rawWorkload(baseWl),
+			result: &scheduler.SimulationResult{
+				ClusterQueue: "cq",
+				Assignment: flavorassigner.Assignment{
+					PodSets: []flavorassigner.PodSetAssignment{{
+						Name:  kueue.DefaultPodSetName,
+						Count: 1,
+						Flavors: flavorassigner.ResourceAssignment{
+							corev1.ResourceCPU: {Name: "default", Mode: flavorassigner.Fit},
+						},
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					}},
+				},
+			},
+			wantResult: &visibility.AdmissionSimulationResult{
+				ClusterQueue: "cq",
+				Mode:         "Fit",
+				Admissible:   true,
+				PodSetAssignments: []visibility.SimulatedPodSetAssignment{{
+					Name:          kueue.DefaultPodSetName,
+					Count:         1,
+					Flavors:       map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "default"},
+					ResourceUsage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				}},
+			},
+			wantQueue: lqName,
+		},
+		"preempt with borrowing": {
+			workload: rawWorkload(baseWl),
+			result: &scheduler.SimulationResult{
+				ClusterQueue: "cq",
+				Assignment: flavorassigner.Assignment{
+					Borrowing: 1,
+					PodSets: []flavorassigner.PodSetAssignment{{
+						Name:  kueue.DefaultPodSetName,
+						Count: 1,
+						Flavors: flavorassigner.ResourceAssignment{
+							corev1.ResourceCPU: {Name: "default", Mode: flavorassigner.Preempt},
+						},
+						Status:   *flavorassigner.NewStatus("insufficient unused quota for cpu in flavor default"),
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					}},
+				},
+				PreemptionTargets: []*preemption.Target{{
+					WorkloadInfo: &workload.Info{Obj: lowPriorityWl, ClusterQueue: "cq"},
+					Reason:       kueue.InClusterQueueReason,
+				}},
+			},
+			wantResult: &visibility.AdmissionSimulationResult{
+				ClusterQueue: "cq",
+				Mode:         "Preempt",
+				Admissible:   true,
+				Borrowing:    true,
+				PodSetAssignments: []visibility.SimulatedPodSetAssignment{{
+					Name:          kueue.DefaultPodSetName,
+					Count:         1,
+					Flavors:       map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "default"},
+					ResourceUsage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				}},
+				PreemptionTargets: []visibility.SimulatedPreemptionTarget{{
+					Name:         "low",
+					Namespace:    nsName,
+					ClusterQueue: "cq",
+					Priority:     -1,
+					Reason:       kueue.InClusterQueueReason,
+				}},
+			},
+			wantQueue: lqName,
+		},
+		"preemption target in another namespace": {
+			workload: rawWorkload(baseWl),
+			result: &scheduler.SimulationResult{
+				ClusterQueue: "cq",
+				Assignment: flavorassigner.Assignment{
+					PodSets: []flavorassigner.PodSetAssignment{{
+						Name:  kueue.DefaultPodSetName,
+						Count: 1,
+						Flavors: flavorassigner.ResourceAssignment{
+							corev1.ResourceCPU: {Name: "default", Mode: flavorassigner.Preempt},
+						},
+						Status:   *flavorassigner.NewStatus("insufficient unused quota for cpu in flavor default"),
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					}},
+				},
+				PreemptionTargets: []*preemption.Target{
+					{
+						WorkloadInfo: &workload.Info{Obj: lowPriorityWl, ClusterQueue: "cq"},
+						Reason:       kueue.InClusterQueueReason,
+					},
+					{
+						WorkloadInfo: &workload.Info{Obj: otherNamespaceWl, ClusterQueue: "other-cq"},
+						Reason:       kueue.InCohortReclamationReason,
+					},
+				},
+			},
+			wantResult: &visibility.AdmissionSimulationResult{
+				ClusterQueue: "cq",
+				Mode:         "Preempt",
+				Admissible:   true,
+				PodSetAssignments: []visibility.SimulatedPodSetAssignment{{
+					Name:          kueue.DefaultPodSetName,
+					Count:         1,
+					Flavors:       map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "default"},
+					ResourceUsage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				}},
+				PreemptionTargets: []visibility.SimulatedPreemptionTarget{
+					{
+						Name:         "low",
+						Namespace:    nsName,
+						ClusterQueue: "cq",
+						Priority:     -1,
+						Reason:       kueue.InClusterQueueReason,
+					},
+					{
+						ClusterQueue: "other-cq",
+						Priority:     -2,
+						Reason:       kueue.InCohortReclamationReason,
+					},
+				},
+			},
+			wantQueue: lqName,
+		},
+		"no fit": {
+			workload: rawWorkload(baseWl),
+			result: &scheduler.SimulationResult{
+				ClusterQueue: "cq",
+				Message:      "ClusterQueue cq is inactive",
+			},
+			wantResult: &visibility.AdmissionSimulationResult{
+				ClusterQueue: "cq",
+				Mode:         "NoFit",
+				Message:      "ClusterQueue cq is inactive",
+			},
+			wantQueue: lqName,
+		},
+		"missing workload": {
+			wantErr: errors.IsBadRequest,
+		},
+		"unsupported kind": {
+			workload: rawWorkload(&kueue.LocalQueue{
+				TypeMeta: metav1.TypeMeta{APIVersion: kueue.SchemeGroupVersion.String(), Kind: "LocalQueue"},
+			}),
+			wantErr: errors.IsBadRequest,
+		},
+		"invalid workload": {
+			workload:     rawWorkload(baseWl),
+			simulatorErr: scheduler.ErrSimulationInvalidWorkload,
+			wantErr:      errors.IsBadRequest,
+			wantQueue:    lqName,
+		},
+		"localqueue not found": {
+			workload:     rawWorkload(baseWl),
+			simulatorErr: qcache.ErrLocalQueueDoesNotExistOrInactive,
+			wantErr:      errors.IsNotFound,
+			wantQueue:    lqName,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			simulator := &fakeAdmissionSimulator{result: tc.result, err: tc.simulatorErr}
+			rest := NewAdmissionSimulationInLqREST(simulator)
+			ctx := request.WithNamespace(t.Context(), nsName)
+
+			obj, err := rest.Create(ctx, lqName, &visibility.AdmissionSimulation{Workload: tc.workload}, nil, nil)
+			if tc.wantErr != nil {
+				if !tc.wantErr(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			} else {
+				got := obj.(*visibility.AdmissionSimulation)
+				if diff := cmp.Diff(tc.wantResult, got.Result); diff != "" {
+					t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+				}
+			}
+
+			if tc.wantQueue != "" {
+				if simulator.gotWorkload == nil {
+					t.Fatal("Expected the simulator to be called")
+				}
+				if simulator.gotWorkload.Namespace != nsName {
+					t.Errorf("Unexpected namespace of the simulated workload: %q", simulator.gotWorkload.Namespace)
+				}
+				if simulator.gotWorkload.Spec.QueueName != tc.wantQueue {
+					t.Errorf("Unexpected queue of the simulated workload: %q", simulator.gotWorkload.Spec.QueueName)
+				}
+				if simulator.gotWorkload.Status.Admission != nil {
+					t.Error("Expected the status of the simulated workload to be dropped")
+				}
+			}
+		})
+	}
+}
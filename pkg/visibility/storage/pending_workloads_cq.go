@@ -31,23 +31,27 @@ import (
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	"sigs.k8s.io/kueue/pkg/constants"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
 
 	_ "k8s.io/metrics/pkg/apis/metrics/install"
 )
 
 type pendingWorkloadsInCqREST struct {
-	queueMgr *qcache.Manager
-	log      logr.Logger
+	queueMgr  *qcache.Manager
+	estimator *admissionEstimator
+	remote    RemoteWorkloads
+	log       logr.Logger
 }
 
 var _ rest.Storage = &pendingWorkloadsInCqREST{}
 var _ rest.GetterWithOptions = &pendingWorkloadsInCqREST{}
 var _ rest.Scoper = &pendingWorkloadsInCqREST{}
 
-func NewPendingWorkloadsInCqREST(kueueMgr *qcache.Manager) *pendingWorkloadsInCqREST {
+func NewPendingWorkloadsInCqREST(kueueMgr *qcache.Manager, history AdmissionHistory) *pendingWorkloadsInCqREST {
 	return &pendingWorkloadsInCqREST{
-		queueMgr: kueueMgr,
-		log:      ctrl.Log.WithName("pending-workload-in-cq"),
+		queueMgr:  kueueMgr,
+		estimator: newAdmissionEstimator(history),
+		log:       ctrl.Log.WithName("pending-workload-in-cq"),
 	}
 }
 
@@ -61,7 +65,7 @@ func (m *pendingWorkloadsInCqREST) Destroy() {}
 
 // Get implements rest.GetterWithOptions interface
 // It fetches information about pending workloads and returns according to query params
-func (m *pendingWorkloadsInCqREST) Get(_ context.Context, name string, opts runtime.Object) (runtime.Object, error) {
+func (m *pendingWorkloadsInCqREST) Get(ctx context.Context, name string, opts runtime.Object) (runtime.Object, error) {
 	pendingWorkloadOpts, ok := opts.(*visibility.PendingWorkloadOptions)
 	if !ok {
 		return nil, fmt.Errorf("invalid options object: %#v", opts)
@@ -88,9 +92,43 @@ func (m *pendingWorkloadsInCqREST) Get(_ context.Context, name string, opts runt
 			wls = append(wls, *newPendingWorkload(wlInfo, positionInLocalQueue, index))
 		}
 	}
+	setBackfillShadowTime(wls, m.queueMgr.BackfillReservation(kueue.ClusterQueueReference(name)))
+	m.estimator.setEstimatedAdmissionTimes(kueue.ClusterQueueReference(name), wls)
+
+	// The workloads dispatched to the worker clusters are listed after the
+	// pending ones, so they are only needed when all pending ones are listed.
+	if m.remote != nil && len(wls) < int(limit) {
+		dispatched, err := m.remote.DispatchedWorkloads(ctx, kueue.ClusterQueueReference(name))
+		if err != nil {
+			return nil, err
+		}
+		firstDispatched := len(wls)
+		listed := make([]kueue.Workload, 0, len(dispatched))
+		for i := range dispatched {
+			index := len(pendingWorkloadsInfo) + i
+			if index >= int(offset+limit) {
+				break
+			}
+			queueKey := utilqueue.KeyFromWorkload(&dispatched[i])
+			positionInLocalQueue := localQueuePositions[queueKey]
+			localQueuePositions[queueKey]++
+
+			if index >= int(offset) {
+				wls = append(wls, *newPendingWorkload(&workload.Info{Obj: &dispatched[i]}, positionInLocalQueue, index))
+				listed = append(listed, dispatched[i])
+			}
+		}
+		setRemoteStatuses(ctx, m.remote, wls[firstDispatched:], listed)
+	}
 	return &visibility.PendingWorkloadsSummary{Items: wls}, nil
 }
 
+func (m *pendingWorkloadsInCqREST) withRemoteWorkloads(remote RemoteWorkloads) *pendingWorkloadsInCqREST {
+	withRemote := *m
+	withRemote.remote = remote
+	return &withRemote
+}
+
 // NewGetOptions creates a new options object
 func (m *pendingWorkloadsInCqREST) NewGetOptions() (runtime.Object, bool, string) {
 	// If no query parameters were passed the generated defaults function are not executed so it's necessary to set default values here as well
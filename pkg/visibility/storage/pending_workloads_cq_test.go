@@ -430,7 +430,7 @@ func TestPendingWorkloadsInCQ(t *testing.T) {
 			ctx, cancel := context.WithCancel(ctx)
 			defer cancel()
 			go manager.CleanUpOnContext(ctx)
-			pendingWorkloadsInCqRest := NewPendingWorkloadsInCqREST(manager)
+			pendingWorkloadsInCqRest := NewPendingWorkloadsInCqREST(manager, nil)
 			for _, cq := range tc.clusterQueues {
 				if err := manager.AddClusterQueue(ctx, cq); err != nil {
 					t.Fatalf("Adding cluster queue %s: %v", cq.Name, err)
@@ -32,23 +32,27 @@ import (
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	"sigs.k8s.io/kueue/pkg/constants"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
 
 	_ "k8s.io/metrics/pkg/apis/metrics/install"
 )
 
 type pendingWorkloadsInLqREST struct {
-	queueMgr *qcache.Manager
-	log      logr.Logger
+	queueMgr  *qcache.Manager
+	estimator *admissionEstimator
+	remote    RemoteWorkloads
+	log       logr.Logger
 }
 
 var _ rest.Storage = &pendingWorkloadsInLqREST{}
 var _ rest.GetterWithOptions = &pendingWorkloadsInLqREST{}
 var _ rest.Scoper = &pendingWorkloadsInLqREST{}
 
-func NewPendingWorkloadsInLqREST(kueueMgr *qcache.Manager) *pendingWorkloadsInLqREST {
+func NewPendingWorkloadsInLqREST(kueueMgr *qcache.Manager, history AdmissionHistory) *pendingWorkloadsInLqREST {
 	return &pendingWorkloadsInLqREST{
-		queueMgr: kueueMgr,
-		log:      ctrl.Log.WithName("pending-workload-in-lq"),
+		queueMgr:  kueueMgr,
+		estimator: newAdmissionEstimator(history),
+		log:       ctrl.Log.WithName("pending-workload-in-lq"),
 	}
 }
 
@@ -93,9 +97,42 @@ func (m *pendingWorkloadsInLqREST) Get(ctx context.Context, name string, opts ru
 		}
 	}
 
+	setBackfillShadowTime(wls, m.queueMgr.BackfillReservation(cqName))
+	m.estimator.setEstimatedAdmissionTimes(cqName, wls)
+
+	// The workloads dispatched to the worker clusters are listed after the
+	// pending ones, so they are only needed when all pending ones are listed.
+	if m.remote != nil && len(wls) < int(limit) {
+		dispatched, err := m.remote.DispatchedWorkloads(ctx, cqName)
+		if err != nil {
+			return nil, err
+		}
+		firstDispatched := len(wls)
+		listed := make([]kueue.Workload, 0, len(dispatched))
+		for i := range dispatched {
+			if len(wls) >= int(limit) {
+				break
+			}
+			if dispatched[i].Namespace == namespace && dispatched[i].Spec.QueueName == lqName {
+				if skippedWls < int(offset) {
+					skippedWls++
+				} else {
+					wls = append(wls, *newPendingWorkload(&workload.Info{Obj: &dispatched[i]}, int32(len(wls)+int(offset)), len(pendingWorkloadsInfo)+i))
+					listed = append(listed, dispatched[i])
+				}
+			}
+		}
+		setRemoteStatuses(ctx, m.remote, wls[firstDispatched:], listed)
+	}
 	return &visibility.PendingWorkloadsSummary{Items: wls}, nil
 }
 
+func (m *pendingWorkloadsInLqREST) withRemoteWorkloads(remote RemoteWorkloads) *pendingWorkloadsInLqREST {
+	withRemote := *m
+	withRemote.remote = remote
+	return &withRemote
+}
+
 // NewGetOptions creates a new options object
 func (m *pendingWorkloadsInLqREST) NewGetOptions() (runtime.Object, bool, string) {
 	// If no query parameters were passed the generated defaults function are not executed so it's necessary to set default values here as well
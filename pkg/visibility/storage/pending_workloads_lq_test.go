@@ -507,7 +507,7 @@ func TestPendingWorkloadsInLQ(t *testing.T) {
 			ctx, cancel := context.WithCancel(ctx)
 			defer cancel()
 			go manager.CleanUpOnContext(ctx)
-			pendingWorkloadsInLqRest := NewPendingWorkloadsInLqREST(manager)
+			pendingWorkloadsInLqRest := NewPendingWorkloadsInLqREST(manager, nil)
 			for _, cq := range tc.clusterQueues {
 				if err := manager.AddClusterQueue(ctx, cq); err != nil {
 					t.Fatalf("Adding cluster queue %s: %v", cq.Name, err)
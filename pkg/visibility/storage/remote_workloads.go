@@ -0,0 +1,49 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// RemoteWorkloads gives the state of the workloads dispatched to the
+// MultiKueue worker clusters.
+type RemoteWorkloads interface {
+	// DispatchedWorkloads returns the workloads of the ClusterQueue which are
+	// dispatched to the worker clusters and not admitted yet, in the order
+	// they were dispatched.
+	DispatchedWorkloads(ctx context.Context, cqName kueue.ClusterQueueReference) ([]kueue.Workload, error)
+	// RemoteStatuses returns the state of the workloads in each of the worker
+	// clusters they are dispatched to.
+	RemoteStatuses(ctx context.Context, wls []kueue.Workload) map[workload.Reference][]visibility.RemoteWorkloadStatus
+}
+
+// setRemoteStatuses sets the state in the worker clusters of the listed
+// dispatched workloads.
+func setRemoteStatuses(ctx context.Context, remote RemoteWorkloads, wls []visibility.PendingWorkload, dispatched []kueue.Workload) {
+	if len(dispatched) == 0 {
+		return
+	}
+	statuses := remote.RemoteStatuses(ctx, dispatched)
+	for i := range wls {
+		wls[i].RemoteStatuses = statuses[workload.NewReference(wls[i].Namespace, wls[i].Name)]
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	preemptexpectations "sigs.k8s.io/kueue/pkg/scheduler/preemption/expectations"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type fakeRemoteWorkloads struct {
+	dispatched []kueue.Workload
+	statuses   map[workload.Reference][]visibility.RemoteWorkloadStatus
+}
+
+func (f *fakeRemoteWorkloads) DispatchedWorkloads(_ context.Context, _ kueue.ClusterQueueReference) ([]kueue.Workload, error) {
+	return f.dispatched, nil
+}
+
+func (f *fakeRemoteWorkloads) RemoteStatuses(_ context.Context, wls []kueue.Workload) map[workload.Reference][]visibility.RemoteWorkloadStatus {
+	statuses := make(map[workload.Reference][]visibility.RemoteWorkloadStatus, len(wls))
+	for i := range wls {
+		statuses[workload.Key(&wls[i])] = f.statuses[workload.Key(&wls[i])]
+	}
+	return statuses
+}
+
+func TestPendingWorkloadsWithRemoteWorkloads(t *testing.T) {
+	const (
+		nsName = "ns"
+		cqName = "cq"
+		lqA    = "lq-a"
+		lqB    = "lq-b"
+	)
+	now := time.Now().Truncate(time.Second)
+
+	remote := &fakeRemoteWorkloads{
+		dispatched: []kueue.Workload{
+			*utiltestingapi.MakeWorkload("dispatched-a", nsName).Queue(lqA).Creation(now).Obj(),
+			*utiltestingapi.MakeWorkload("dispatched-b", nsName).Queue(lqB).Creation(now).Obj(),
+		},
+		statuses: map[workload.Reference][]visibility.RemoteWorkloadStatus{
+			workload.NewReference(nsName, "dispatched-a"): {
+				{ClusterName: "worker1", State: visibility.RemoteWorkloadPending, PositionInClusterQueue: new(int32(3)), PositionInLocalQueue: new(int32(1))},
+				{ClusterName: "worker2", State: visibility.RemoteWorkloadQuotaReserved},
+			},
+			workload.NewReference(nsName, "dispatched-b"): {
+				{ClusterName: "worker1", State: visibility.RemoteWorkloadUnknown, Message: "The worker cluster is not connected"},
+			},
+		},
+	}
+	pending := func(name, lq string, positionInCq, positionInLq int32, remoteStatuses ...visibility.RemoteWorkloadStatus) visibility.PendingWorkload {
+		return visibility.PendingWorkload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         nsName,
+				CreationTimestamp: metav1.NewTime(now),
+			},
+			LocalQueueName:         kueue.LocalQueueName(lq),
+			PositionInClusterQueue: positionInCq,
+			PositionInLocalQueue:   positionInLq,
+			RemoteStatuses:         remoteStatuses,
+		}
+	}
+
+	testCases := map[string]struct {
+		localQueue string
+		opts       *visibility.PendingWorkloadOptions
+		want       []visibility.PendingWorkload
+	}{
+		"ClusterQueue lists the dispatched workloads after the pending ones": {
+			opts: &visibility.PendingWorkloadOptions{Limit: 10},
+			want: []visibility.PendingWorkload{
+				pending("pending-a", lqA, 0, 0),
+				pending("dispatched-a", lqA, 1, 1, remote.statuses[workload.NewReference(nsName, "dispatched-a")]...),
+				pending("dispatched-b", lqB, 2, 0, remote.statuses[workload.NewReference(nsName, "dispatched-b")]...),
+			},
+		},
+		"ClusterQueue with offset and limit": {
+			opts: &visibility.PendingWorkloadOptions{Offset: 1, Limit: 1},
+			want: []visibility.PendingWorkload{
+				pending("dispatched-a", lqA, 1, 1, remote.statuses[workload.NewReference(nsName, "dispatched-a")]...),
+			},
+		},
+		"ClusterQueue with limit reached by the pending workloads": {
+			opts: &visibility.PendingWorkloadOptions{Limit: 1},
+			want: []visibility.PendingWorkload{
+				pending("pending-a", lqA, 0, 0),
+			},
+		},
+		"LocalQueue lists only its dispatched workloads": {
+			localQueue: lqB,
+			opts:       &visibility.PendingWorkloadOptions{Limit: 10},
+			want: []visibility.PendingWorkload{
+				pending("dispatched-b", lqB, 2, 0, remote.statuses[workload.NewReference(nsName, "dispatched-b")]...),
+			},
+		},
+		"LocalQueue with offset": {
+			localQueue: lqA,
+			opts:       &visibility.PendingWorkloadOptions{Offset: 1, Limit: 10},
+			want: []visibility.PendingWorkload{
+				pending("dispatched-a", lqA, 1, 1, remote.statuses[workload.NewReference(nsName, "dispatched-a")]...),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			queueOptions := []qcache.Option{qcache.WithPreemptionExpectations(preemptexpectations.New())}
+			manager := qcache.NewManagerForUnitTests(utiltesting.NewFakeClient(), nil, queueOptions...)
+			ctx, log := utiltesting.ContextWithLog(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go manager.CleanUpOnContext(ctx)
+
+			if err := manager.AddClusterQueue(ctx, utiltestingapi.MakeClusterQueue(cqName).Obj()); err != nil {
+				t.Fatalf("Adding cluster queue: %v", err)
+			}
+			for _, lq := range []string{lqA, lqB} {
+				if err := manager.AddLocalQueue(ctx, utiltestingapi.MakeLocalQueue(lq, nsName).ClusterQueue(cqName).Obj()); err != nil {
+					t.Fatalf("Adding queue %q: %v", lq, err)
+				}
+			}
+			if err := manager.AddOrUpdateWorkload(log, utiltestingapi.MakeWorkload("pending-a", nsName).Queue(lqA).Creation(now).Obj()); err != nil {
+				t.Fatalf("Failed to add or update workload: %v", err)
+			}
+
+			storage := WithRemoteWorkloads(NewStorage(manager, nil), remote)
+			var got runtime.Object
+			var err error
+			if tc.localQueue != "" {
+				got, err = storage["localqueues/pendingworkloads"].(*pendingWorkloadsInLqREST).Get(request.WithNamespace(ctx, nsName), tc.localQueue, tc.opts)
+			} else {
+				got, err = storage["clusterqueues/pendingworkloads"].(*pendingWorkloadsInCqREST).Get(ctx, cqName, tc.opts)
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got.(*visibility.PendingWorkloadsSummary).Items, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Pending workloads differ: (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
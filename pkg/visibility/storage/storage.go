@@ -17,16 +17,51 @@ limitations under the License.
 package storage
 
 import (
+	"maps"
+
 	"k8s.io/apiserver/pkg/registry/rest"
 
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 )
 
-func NewStorage(mgr *qcache.Manager) map[string]rest.Storage {
+// NewStorage creates the storage of the pending workloads. If history is not
+// nil, the admission time of the pending workloads is estimated from it.
+func NewStorage(mgr *qcache.Manager, history AdmissionHistory) map[string]rest.Storage {
 	return map[string]rest.Storage{
 		"clusterqueues":                  NewCqREST(),
-		"clusterqueues/pendingworkloads": NewPendingWorkloadsInCqREST(mgr),
+		"clusterqueues/pendingworkloads": NewPendingWorkloadsInCqREST(mgr, history),
 		"localqueues":                    NewLqREST(),
-		"localqueues/pendingworkloads":   NewPendingWorkloadsInLqREST(mgr),
+		"localqueues/pendingworkloads":   NewPendingWorkloadsInLqREST(mgr, history),
+	}
+}
+
+// WithAdmissionSimulation adds the admission simulation subresource, served
+// by the simulator, to the storage.
+func WithAdmissionSimulation(storage map[string]rest.Storage, simulator AdmissionSimulator) map[string]rest.Storage {
+	withSimulation := maps.Clone(storage)
+	withSimulation["localqueues/admissionsimulation"] = NewAdmissionSimulationInLqREST(simulator)
+	return withSimulation
+}
+
+// WithUsageReports adds the usage subresources, served by the reporter, to the
+// storage.
+func WithUsageReports(storage map[string]rest.Storage, reporter UsageReporter) map[string]rest.Storage {
+	withUsage := maps.Clone(storage)
+	withUsage["clusterqueues/usage"] = NewUsageInCqREST(reporter)
+	withUsage["localqueues/usage"] = NewUsageInLqREST(reporter)
+	return withUsage
+}
+
+// WithRemoteWorkloads lists the workloads dispatched to the MultiKueue worker
+// clusters, with their state in the worker clusters, after the pending
+// workloads of the storage.
+func WithRemoteWorkloads(storage map[string]rest.Storage, remote RemoteWorkloads) map[string]rest.Storage {
+	withRemote := maps.Clone(storage)
+	if cqREST, ok := storage["clusterqueues/pendingworkloads"].(*pendingWorkloadsInCqREST); ok {
+		withRemote["clusterqueues/pendingworkloads"] = cqREST.withRemoteWorkloads(remote)
+	}
+	if lqREST, ok := storage["localqueues/pendingworkloads"].(*pendingWorkloadsInLqREST); ok {
+		withRemote["localqueues/pendingworkloads"] = lqREST.withRemoteWorkloads(remote)
 	}
+	return withRemote
 }
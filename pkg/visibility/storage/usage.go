@@ -0,0 +1,163 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/accounting"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+)
+
+// UsageReporter reports the quota reserved and used over time.
+type UsageReporter interface {
+	Report(filter accounting.Filter) accounting.Report
+}
+
+type usageInLqREST struct {
+	reporter UsageReporter
+}
+
+var _ rest.Storage = &usageInLqREST{}
+var _ rest.Getter = &usageInLqREST{}
+var _ rest.Scoper = &usageInLqREST{}
+
+func NewUsageInLqREST(reporter UsageReporter) *usageInLqREST {
+	return &usageInLqREST{reporter: reporter}
+}
+
+// New implements rest.Storage interface
+func (m *usageInLqREST) New() runtime.Object {
+	return &visibility.UsageReport{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *usageInLqREST) Destroy() {}
+
+// Get implements rest.Getter interface
+// It returns the usage accounted for the LocalQueue and its workloads
+func (m *usageInLqREST) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	lqKey := utilqueue.NewLocalQueueReference(genericapirequest.NamespaceValue(ctx), kueue.LocalQueueName(name))
+	report := m.reporter.Report(accounting.Filter{LocalQueue: lqKey})
+	if _, found := report.LocalQueues[lqKey]; !found {
+		return nil, errors.NewNotFound(visibility.Resource("localqueue"), name)
+	}
+	return newUsageReport(report), nil
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *usageInLqREST) NamespaceScoped() bool {
+	return true
+}
+
+type usageInCqREST struct {
+	reporter UsageReporter
+}
+
+var _ rest.Storage = &usageInCqREST{}
+var _ rest.Getter = &usageInCqREST{}
+var _ rest.Scoper = &usageInCqREST{}
+
+func NewUsageInCqREST(reporter UsageReporter) *usageInCqREST {
+	return &usageInCqREST{reporter: reporter}
+}
+
+// New implements rest.Storage interface
+func (m *usageInCqREST) New() runtime.Object {
+	return &visibility.UsageReport{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *usageInCqREST) Destroy() {}
+
+// Get implements rest.Getter interface
+// It returns the usage accounted in the ClusterQueue, per LocalQueue and workload
+func (m *usageInCqREST) Get(_ context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	report := m.reporter.Report(accounting.Filter{ClusterQueue: kueue.ClusterQueueReference(name)})
+	return newUsageReport(report), nil
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *usageInCqREST) NamespaceScoped() bool {
+	return false
+}
+
+func newUsageReport(report accounting.Report) *visibility.UsageReport {
+	out := &visibility.UsageReport{Time: metav1.NewTime(report.Time)}
+	for lqKey, usage := range report.LocalQueues {
+		namespace, name := utilqueue.MustParseLocalQueueReference(lqKey)
+		out.LocalQueues = append(out.LocalQueues, visibility.LocalQueueUsage{
+			Name:      name,
+			Namespace: namespace,
+			Resources: newResourceUsageAccounting(usage),
+			Periods:   newUsagePeriods(report.LocalQueuePeriods[lqKey]),
+		})
+	}
+	slices.SortFunc(out.LocalQueues, func(a, b visibility.LocalQueueUsage) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	for wlKey, usage := range report.Workloads {
+		namespace, lqName := utilqueue.MustParseLocalQueueReference(usage.LocalQueue)
+		out.Workloads = append(out.Workloads, visibility.WorkloadUsage{
+			Name:           strings.TrimPrefix(string(wlKey), namespace+"/"),
+			Namespace:      namespace,
+			LocalQueueName: lqName,
+			Resources:      newResourceUsageAccounting(usage.Resources),
+			Periods:        newUsagePeriods(usage.Periods),
+		})
+	}
+	slices.SortFunc(out.Workloads, func(a, b visibility.WorkloadUsage) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	return out
+}
+
+func newResourceUsageAccounting(usage []accounting.Usage) []visibility.ResourceUsageAccounting {
+	out := make([]visibility.ResourceUsageAccounting, 0, len(usage))
+	for _, u := range usage {
+		out = append(out, visibility.ResourceUsageAccounting{
+			ClusterQueue: u.ClusterQueue,
+			Flavor:       u.Flavor,
+			Name:         u.Resource,
+			Reserved:     u.Reserved,
+			Used:         u.Used,
+		})
+	}
+	return out
+}
+
+func newUsagePeriods(periods []accounting.PeriodUsage) []visibility.UsagePeriod {
+	var out []visibility.UsagePeriod
+	for _, p := range periods {
+		out = append(out, visibility.UsagePeriod{
+			Start:     metav1.NewTime(p.Start),
+			Resources: newResourceUsageAccounting(p.Resources),
+		})
+	}
+	return out
+}
@@ -0,0 +1,128 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	"sigs.k8s.io/kueue/pkg/accounting"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type fakeUsageReporter struct {
+	report    accounting.Report
+	gotFilter accounting.Filter
+}
+
+func (f *fakeUsageReporter) Report(filter accounting.Filter) accounting.Report {
+	f.gotFilter = filter
+	return f.report
+}
+
+func TestUsage(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cpuUsage := accounting.Usage{
+		Key:      accounting.Key{ClusterQueue: "cq", Flavor: "on-demand", Resource: corev1.ResourceCPU},
+		Reserved: resource.MustParse("120"),
+		Used:     resource.MustParse("60"),
+	}
+	wantResources := []visibility.ResourceUsageAccounting{{
+		ClusterQueue: "cq",
+		Flavor:       "on-demand",
+		Name:         corev1.ResourceCPU,
+		Reserved:     resource.MustParse("120"),
+		Used:         resource.MustParse("60"),
+	}}
+	periodStart := accounting.PeriodStart(now)
+	cpuPeriods := []accounting.PeriodUsage{{Start: periodStart, Resources: []accounting.Usage{cpuUsage}}}
+	wantPeriods := []visibility.UsagePeriod{{Start: metav1.NewTime(periodStart), Resources: wantResources}}
+	report := accounting.Report{
+		Time: now,
+		LocalQueues: map[utilqueue.LocalQueueReference][]accounting.Usage{
+			"ns/lq": {cpuUsage},
+		},
+		LocalQueuePeriods: map[utilqueue.LocalQueueReference][]accounting.PeriodUsage{
+			"ns/lq": cpuPeriods,
+		},
+		Workloads: map[workload.Reference]accounting.WorkloadUsage{
+			"ns/b": {LocalQueue: "ns/lq", Resources: []accounting.Usage{cpuUsage}, Periods: cpuPeriods},
+			"ns/a": {LocalQueue: "ns/lq", Resources: []accounting.Usage{cpuUsage}},
+		},
+	}
+	wantReport := &visibility.UsageReport{
+		Time: metav1.NewTime(now),
+		LocalQueues: []visibility.LocalQueueUsage{{
+			Name:      "lq",
+			Namespace: "ns",
+			Resources: wantResources,
+			Periods:   wantPeriods,
+		}},
+		Workloads: []visibility.WorkloadUsage{
+			{Name: "a", Namespace: "ns", LocalQueueName: "lq", Resources: wantResources},
+			{Name: "b", Namespace: "ns", LocalQueueName: "lq", Resources: wantResources, Periods: wantPeriods},
+		},
+	}
+
+	t.Run("LocalQueue", func(t *testing.T) {
+		reporter := &fakeUsageReporter{report: report}
+		ctx := request.WithNamespace(context.Background(), "ns")
+		got, err := NewUsageInLqREST(reporter).Get(ctx, "lq", &metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(accounting.Filter{LocalQueue: "ns/lq"}, reporter.gotFilter); diff != "" {
+			t.Errorf("Unexpected filter (-want,+got):\n%s", diff)
+		}
+		if diff := cmp.Diff(wantReport, got); diff != "" {
+			t.Errorf("Unexpected report (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("unknown LocalQueue", func(t *testing.T) {
+		reporter := &fakeUsageReporter{report: accounting.Report{Time: now}}
+		ctx := request.WithNamespace(context.Background(), "ns")
+		_, err := NewUsageInLqREST(reporter).Get(ctx, "missing", &metav1.GetOptions{})
+		if !errors.IsNotFound(err) {
+			t.Errorf("Expected a not found error, got: %v", err)
+		}
+	})
+
+	t.Run("ClusterQueue", func(t *testing.T) {
+		reporter := &fakeUsageReporter{report: report}
+		got, err := NewUsageInCqREST(reporter).Get(context.Background(), "cq", &metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(accounting.Filter{ClusterQueue: "cq"}, reporter.gotFilter); diff != "" {
+			t.Errorf("Unexpected filter (-want,+got):\n%s", diff)
+		}
+		if diff := cmp.Diff(wantReport, got); diff != "" {
+			t.Errorf("Unexpected report (-want,+got):\n%s", diff)
+		}
+	})
+}
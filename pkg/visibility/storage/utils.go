@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta2"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/util/priority"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -55,3 +56,17 @@ func newPendingWorkload(wlInfo *workload.Info, positionInLq int32, positionInCq
 		PositionInLocalQueue:   positionInLq,
 	}
 }
+
+// setBackfillShadowTime reports the shadow time of the workload holding the
+// backfill reservation of the ClusterQueue, if it's listed.
+func setBackfillShadowTime(wls []visibility.PendingWorkload, resv *qcache.BackfillReservation) {
+	if resv == nil || resv.ShadowTime == nil {
+		return
+	}
+	for i := range wls {
+		if wls[i].Name == resv.Head.Obj.Name && wls[i].Namespace == resv.Head.Obj.Namespace {
+			wls[i].ShadowTime = new(metav1.NewTime(*resv.ShadowTime))
+			return
+		}
+	}
+}
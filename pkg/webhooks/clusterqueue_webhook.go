@@ -33,6 +33,7 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/util/quotaschedule"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
 )
@@ -132,6 +133,8 @@ func validateClusterQueueSpec(cq *kueue.ClusterQueue) field.ErrorList {
 	allErrs = append(allErrs, validateTotalCoveredResources(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
 	allErrs = append(allErrs, validateFlavorResourceCombinations(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
 	allErrs = append(allErrs, validateConcurrentAdmissionPolicy(cq, path)...)
+	allErrs = append(allErrs, validateQuotaSchedule(cq, config, path)...)
+	allErrs = append(allErrs, validateQueueingStrategy(cq, path)...)
 	return allErrs
 }
 
@@ -275,6 +278,54 @@ func validateConcurrentAdmissionPolicy(cq *kueue.ClusterQueue, path *field.Path)
 	return allErrs
 }
 
+func validateQuotaSchedule(cq *kueue.ClusterQueue, config validationConfig, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	qs := cq.Spec.QuotaSchedule
+	if !features.Enabled(features.TimeWindowedQuotas) || qs == nil {
+		return allErrs
+	}
+	path = path.Child("quotaSchedule")
+	if _, err := quotaschedule.LoadLocation(qs.TimeZone); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("timeZone"), *qs.TimeZone, err.Error()))
+	}
+
+	coveredResources := make(map[kueue.ResourceFlavorReference][]corev1.ResourceName)
+	for _, rg := range cq.Spec.ResourceGroups {
+		for _, fq := range rg.Flavors {
+			coveredResources[fq.Name] = rg.CoveredResources
+		}
+	}
+	for i, w := range qs.Windows {
+		path := path.Child("windows").Index(i)
+		if _, err := quotaschedule.ParseWindowSchedule(w.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("schedule"), w.Schedule, err.Error()))
+		}
+		for j, fq := range w.Flavors {
+			path := path.Child("flavors").Index(j)
+			covered, found := coveredResources[fq.Name]
+			if !found {
+				allErrs = append(allErrs, field.NotFound(path.Child("name"), fq.Name))
+				continue
+			}
+			if len(fq.Resources) != len(covered) {
+				allErrs = append(allErrs, field.Invalid(path.Child("resources"), len(fq.Resources),
+					fmt.Sprintf("must have the same number of resources as the coveredResources of the flavor (%d)", len(covered))))
+			}
+			allErrs = append(allErrs, validateFlavorQuotas(fq, covered, config, path, false)...)
+		}
+	}
+	return allErrs
+}
+
+func validateQueueingStrategy(cq *kueue.ClusterQueue, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if cq.Spec.QueueingStrategy == kueue.Backfill && !features.Enabled(features.BackfillQueueing) {
+		allErrs = append(allErrs, field.Forbidden(path.Child("queueingStrategy"),
+			"Backfill queueing strategy requires the BackfillQueueing feature gate"))
+	}
+	return allErrs
+}
+
 func validatePreemption(preemption *kueue.ClusterQueuePreemption, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	if preemption.ReclaimWithinCohort == kueue.PreemptionPolicyNever &&
@@ -295,6 +346,10 @@ func validateFlavorFungibility(fungibility *kueue.FlavorFungibility, path *field
 				fmt.Sprintf("preference %q requires both whenCanBorrow and whenCanPreempt to be TryNextFlavor", *fungibility.Preference),
 			))
 		}
+		if *fungibility.Preference == kueue.LowestCost && !features.Enabled(features.FlavorCost) {
+			allErrs = append(allErrs, field.Forbidden(path.Child("preference"),
+				"LowestCost preference requires the FlavorCost feature gate"))
+		}
 	}
 	return allErrs
 }
@@ -38,11 +38,13 @@ func TestValidateClusterQueue(t *testing.T) {
 	resourceGroupsPath := specPath.Child("resourceGroups")
 
 	testcases := []struct {
-		name         string
-		clusterQueue *kueue.ClusterQueue
-		wantErr      field.ErrorList
-		wantDetail   string
-		wantBadValue string
+		name             string
+		clusterQueue     *kueue.ClusterQueue
+		backfillQueueing bool
+		flavorCost       bool
+		wantErr          field.ErrorList
+		wantDetail       string
+		wantBadValue     string
 	}{
 		{
 			name: "built-in resources with qualified names",
@@ -484,11 +486,128 @@ func TestValidateClusterQueue(t *testing.T) {
 			wantDetail:   "must be one of the flavors defined in the ClusterQueue: [flavor1]",
 			wantBadValue: "non-existent-flavor",
 		},
+		{
+			name: "valid quota schedule",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "4").Obj()).
+				QuotaSchedule(kueue.QuotaSchedule{
+					TimeZone: new("Europe/Paris"),
+					Windows: []kueue.QuotaWindow{{
+						Name:            "night",
+						Schedule:        "0 20 * * 1-5",
+						DurationMinutes: 12 * 60,
+						Flavors:         []kueue.FlavorQuotas{*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "8").Obj()},
+					}},
+				}).
+				Obj(),
+		},
+		{
+			name: "quota schedule with an unknown time zone",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "4").Obj()).
+				QuotaSchedule(kueue.QuotaSchedule{
+					TimeZone: new("Mars/Olympus_Mons"),
+					Windows: []kueue.QuotaWindow{{
+						Name:            "night",
+						Schedule:        "0 20 * * *",
+						DurationMinutes: 60,
+						Flavors:         []kueue.FlavorQuotas{*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "8").Obj()},
+					}},
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("quotaSchedule", "timeZone"), "Mars/Olympus_Mons", ""),
+			},
+		},
+		{
+			name: "quota window with an invalid schedule and flavors",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "4").Resource("memory", "4Gi").Obj()).
+				QuotaSchedule(kueue.QuotaSchedule{
+					Windows: []kueue.QuotaWindow{{
+						Name:            "night",
+						Schedule:        "CRON_TZ=Europe/Paris 0 20 * * *",
+						DurationMinutes: 60,
+						Flavors: []kueue.FlavorQuotas{
+							*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "8").Obj(),
+							*utiltestingapi.MakeFlavorQuotas("unknown").Resource("cpu", "8").Obj(),
+						},
+					}},
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("quotaSchedule", "windows").Index(0).Child("schedule"), "CRON_TZ=Europe/Paris 0 20 * * *", ""),
+				field.Invalid(specPath.Child("quotaSchedule", "windows").Index(0).Child("flavors").Index(0).Child("resources"), 1, ""),
+				field.NotFound(specPath.Child("quotaSchedule", "windows").Index(0).Child("flavors").Index(1).Child("name"), "unknown"),
+			},
+			wantDetail: "the time zone must be set in timeZone, not in the schedule",
+		},
+		{
+			name: "quota window with a lending limit greater than the nominal quota",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				Cohort("cohort").
+				ResourceGroup(*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "4").Obj()).
+				QuotaSchedule(kueue.QuotaSchedule{
+					Windows: []kueue.QuotaWindow{{
+						Name:            "night",
+						Schedule:        "@daily",
+						DurationMinutes: 60,
+						Flavors:         []kueue.FlavorQuotas{*utiltestingapi.MakeFlavorQuotas("default").Resource("cpu", "1", "", "2").Obj()},
+					}},
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("quotaSchedule", "windows").Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("lendingLimit"), "2", ""),
+			},
+		},
+		{
+			name: "backfill queueing strategy",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				QueueingStrategy(kueue.Backfill).
+				Obj(),
+			backfillQueueing: true,
+		},
+		{
+			name: "LowestCost flavor fungibility preference",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				FlavorFungibility(kueue.FlavorFungibility{
+					WhenCanBorrow:  kueue.TryNextFlavor,
+					WhenCanPreempt: kueue.TryNextFlavor,
+					Preference:     new(kueue.LowestCost),
+				}).
+				Obj(),
+			flavorCost: true,
+		},
+		{
+			name: "LowestCost flavor fungibility preference without the feature gate",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				FlavorFungibility(kueue.FlavorFungibility{
+					WhenCanBorrow:  kueue.TryNextFlavor,
+					WhenCanPreempt: kueue.TryNextFlavor,
+					Preference:     new(kueue.LowestCost),
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(specPath.Child("flavorFungibility", "preference"), ""),
+			},
+		},
+		{
+			name: "backfill queueing strategy without the feature gate",
+			clusterQueue: utiltestingapi.MakeClusterQueue("cluster-queue").
+				QueueingStrategy(kueue.Backfill).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(specPath.Child("queueingStrategy"), ""),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.ConcurrentAdmission, true)
+			features.SetFeatureGateDuringTest(t, features.TimeWindowedQuotas, true)
+			features.SetFeatureGateDuringTest(t, features.BackfillQueueing, tc.backfillQueueing)
+			features.SetFeatureGateDuringTest(t, features.FlavorCost, tc.flavorCost)
 			gotErr := ValidateClusterQueue(tc.clusterQueue)
 			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
 				t.Errorf("ValidateResources() mismatch (-want +got):\n%s", diff)
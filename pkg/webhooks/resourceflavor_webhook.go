@@ -18,6 +18,8 @@ package webhooks
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -30,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/roletracker"
 )
 
@@ -89,6 +92,30 @@ func ValidateResourceFlavor(rf *kueue.ResourceFlavor) field.ErrorList {
 
 	allErrs = append(allErrs, validateNodeTaints(rf.Spec.NodeTaints, specPath.Child("nodeTaints"))...)
 	allErrs = append(allErrs, validateTolerations(rf.Spec.Tolerations, specPath.Child("tolerations"))...)
+	allErrs = append(allErrs, validatePrices(rf.Spec.Prices, specPath.Child("prices"))...)
+	allErrs = append(allErrs, validateFlavorTopologies(rf.Spec.Topologies, specPath.Child("topologies"))...)
+	return allErrs
+}
+
+func validateFlavorTopologies(topologies []kueue.FlavorTopology, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(topologies) > 0 && !features.Enabled(features.TASMultiTopologyFlavors) {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			"topologies requires the TASMultiTopologyFlavors feature gate"))
+	}
+	for i, topology := range topologies {
+		allErrs = append(allErrs, metavalidation.ValidateLabels(topology.NodeLabels, fldPath.Index(i).Child("nodeLabels"))...)
+	}
+	return allErrs
+}
+
+func validatePrices(prices corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, name := range slices.Sorted(maps.Keys(prices)) {
+		path := fldPath.Key(string(name))
+		allErrs = append(allErrs, validateResourceName(name, path)...)
+		allErrs = append(allErrs, validateResourceQuantity(prices[name], path)...)
+	}
 	return allErrs
 }
 
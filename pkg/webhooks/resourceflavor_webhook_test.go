@@ -25,15 +25,17 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+	"sigs.k8s.io/kueue/pkg/features"
 	utiltestingapi "sigs.k8s.io/kueue/pkg/util/testing/v1beta2"
 )
 
 func TestValidateResourceFlavor(t *testing.T) {
 	testcases := []struct {
-		name    string
-		rf      *kueue.ResourceFlavor
-		labels  map[string]string
-		wantErr field.ErrorList
+		name                string
+		rf                  *kueue.ResourceFlavor
+		labels              map[string]string
+		enableMultiTopology bool
+		wantErr             field.ErrorList
 	}{
 		{
 			name: "empty",
@@ -65,10 +67,53 @@ func TestValidateResourceFlavor(t *testing.T) {
 					WithOrigin("format=k8s-label-value"),
 			},
 		},
+		{
+			name: "valid prices",
+			rf: utiltestingapi.MakeResourceFlavor("resource-flavor").
+				Price(corev1.ResourceCPU, "0.5").
+				Price(corev1.ResourceMemory, "1n").
+				Obj(),
+		},
+		{
+			name: "negative price",
+			rf:   utiltestingapi.MakeResourceFlavor("resource-flavor").Price(corev1.ResourceCPU, "-1").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "prices").Key("cpu"), "-1", ""),
+			},
+		},
+		{
+			name: "valid topologies",
+			rf: utiltestingapi.MakeResourceFlavor("resource-flavor").
+				Topology("tpu", map[string]string{"accelerator": "tpu"}).
+				Topology("gpu", map[string]string{"accelerator": "gpu"}).
+				Obj(),
+			enableMultiTopology: true,
+		},
+		{
+			name: "topologies with the TASMultiTopologyFlavors feature gate disabled",
+			rf: utiltestingapi.MakeResourceFlavor("resource-flavor").
+				Topology("tpu", map[string]string{"accelerator": "tpu"}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "topologies"), ""),
+			},
+		},
+		{
+			name: "invalid topology label value",
+			rf: utiltestingapi.MakeResourceFlavor("resource-flavor").
+				Topology("tpu", map[string]string{"accelerator": "@tpu"}).
+				Obj(),
+			enableMultiTopology: true,
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "topologies").Index(0).Child("nodeLabels"), "@tpu", "").
+					WithOrigin("format=k8s-label-value"),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASMultiTopologyFlavors, tc.enableMultiTopology)
 			gotErr := ValidateResourceFlavor(tc.rf)
 			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
 				t.Errorf("validateResourceFlavorLabels() mismatch (-want +got):\n%s", diff)
@@ -73,6 +73,10 @@ func (w *WorkloadWebhook) Default(ctx context.Context, wl *kueue.Workload) error
 		}
 	}
 
+	if !features.Enabled(features.PodSetShapeConstraints) {
+		wl.Spec.PodSetShapeConstraints = nil
+	}
+
 	return nil
 }
 
@@ -111,11 +115,21 @@ func ValidateWorkload(obj, oldObj *kueue.Workload) field.ErrorList {
 	var allErrs field.ErrorList
 	specPath := field.NewPath("spec")
 
+	// The count of a constrained podSet follows the count of the podSet it is
+	// relative to, so it doesn't vary on its own.
+	constrainedPodSets := sets.New[kueue.PodSetReference]()
+	if features.Enabled(features.PodSetShapeConstraints) {
+		allErrs = append(allErrs, validatePodSetShapeConstraints(obj, specPath.Child("podSetShapeConstraints"))...)
+		for _, c := range obj.Spec.PodSetShapeConstraints {
+			constrainedPodSets.Insert(c.PodSet)
+		}
+	}
+
 	variableCountPodSets := 0
 	for i := range obj.Spec.PodSets {
 		ps := &obj.Spec.PodSets[i]
 		allErrs = append(allErrs, validatePodSet(ps, specPath.Child("podSets").Index(i))...)
-		if ps.MinCount != nil {
+		if ps.MinCount != nil && !constrainedPodSets.Has(ps.Name) {
 			variableCountPodSets++
 		}
 	}
@@ -157,6 +171,71 @@ func ValidateWorkload(obj, oldObj *kueue.Workload) field.ErrorList {
 	return allErrs
 }
 
+func validatePodSetShapeConstraints(obj *kueue.Workload, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	counts := make(map[kueue.PodSetReference]int32, len(obj.Spec.PodSets))
+	minCounts := make(map[kueue.PodSetReference]*int32, len(obj.Spec.PodSets))
+	for i := range obj.Spec.PodSets {
+		counts[obj.Spec.PodSets[i].Name] = obj.Spec.PodSets[i].Count
+		minCounts[obj.Spec.PodSets[i].Name] = obj.Spec.PodSets[i].MinCount
+	}
+	relativeTo := make(map[kueue.PodSetReference]kueue.PodSetReference, len(obj.Spec.PodSetShapeConstraints))
+	for i, c := range obj.Spec.PodSetShapeConstraints {
+		cPath := path.Index(i)
+		count, found := counts[c.PodSet]
+		if !found {
+			allErrs = append(allErrs, field.NotFound(cPath.Child("podSet"), c.PodSet))
+		}
+		relativeToCount, relativeToFound := counts[c.RelativeTo]
+		if !relativeToFound {
+			allErrs = append(allErrs, field.NotFound(cPath.Child("relativeTo"), c.RelativeTo))
+		}
+		if c.PodSet == c.RelativeTo {
+			allErrs = append(allErrs, field.Invalid(cPath.Child("relativeTo"), c.RelativeTo, "must be different from podSet"))
+			continue
+		}
+		if found && relativeToFound && int64(count) != int64(c.Ratio)*int64(relativeToCount) {
+			allErrs = append(allErrs, field.Invalid(cPath.Child("ratio"), c.Ratio,
+				fmt.Sprintf("the count of podSet %q must be %d times the count of podSet %q", c.PodSet, c.Ratio, c.RelativeTo)))
+		}
+		if c.MaxCount != nil {
+			allErrs = append(allErrs, validateShapeConstraintMaxCount(obj, &c, minCounts[c.PodSet], count, found, cPath.Child("maxCount"))...)
+		}
+		relativeTo[c.PodSet] = c.RelativeTo
+	}
+	for start := range relativeTo {
+		current, found := relativeTo[start]
+		for steps := 0; found && steps < len(relativeTo); steps++ {
+			if current == start {
+				allErrs = append(allErrs, field.Invalid(path, obj.Spec.PodSetShapeConstraints, "must not form a cycle"))
+				return allErrs
+			}
+			current, found = relativeTo[current]
+		}
+	}
+	return allErrs
+}
+
+// validateShapeConstraintMaxCount validates the maxCount of the constraint
+// against the count and the minCount of its podSet, if found.
+func validateShapeConstraintMaxCount(obj *kueue.Workload, c *kueue.PodSetShapeConstraint, minCount *int32, count int32, found bool, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	maxCount := *c.MaxCount
+	if c.Ratio > 0 && maxCount%c.Ratio != 0 {
+		allErrs = append(allErrs, field.Invalid(path, maxCount, fmt.Sprintf("must be a multiple of the ratio %d", c.Ratio)))
+	}
+	if found && maxCount > count {
+		allErrs = append(allErrs, field.Invalid(path, maxCount, fmt.Sprintf("must not exceed the count of podSet %q", c.PodSet)))
+	}
+	if minCount != nil && maxCount < *minCount {
+		allErrs = append(allErrs, field.Invalid(path, maxCount, fmt.Sprintf("must not be less than the minCount of podSet %q", c.PodSet)))
+	}
+	if !workload.CanBePartiallyAdmitted(obj) {
+		allErrs = append(allErrs, field.Invalid(path, maxCount, "requires a podSet with a minCount below its count"))
+	}
+	return allErrs
+}
+
 func validatePodSet(ps *kueue.PodSet, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -365,6 +444,7 @@ func ValidateWorkloadUpdate(newObj, oldObj *kueue.Workload) field.ErrorList {
 
 	if workload.HasQuotaReservation(oldObj) {
 		allErrs = append(allErrs, validateImmutablePodSets(newObj.Spec.PodSets, oldObj.Spec.PodSets, specPath.Child("podSets"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newObj.Spec.PodSetShapeConstraints, oldObj.Spec.PodSetShapeConstraints, specPath.Child("podSetShapeConstraints"))...)
 	}
 	if workload.HasQuotaReservation(newObj) && workload.HasQuotaReservation(oldObj) {
 		allErrs = append(allErrs, validateReclaimablePodsUpdate(newObj, oldObj, field.NewPath("status", "reclaimablePods"))...)
@@ -516,6 +516,95 @@ func TestValidateWorkload(t *testing.T) {
 				field.Invalid(podSetsPath, nil, ""),
 			}.ToAggregate(),
 		},
+		"variable count podSets linked by a shape constraint": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+					*utiltestingapi.MakePodSet("worker", 32).SetMinimumCount(8).Obj(),
+				).
+				PodSetShapeConstraint("worker", "ps", 8).
+				Obj(),
+		},
+		"invalid shape constraints": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+					*utiltestingapi.MakePodSet("worker", 30).Obj(),
+				).
+				PodSetShapeConstraint("worker", "ps", 8).
+				PodSetShapeConstraint("ps", "ps", 1).
+				PodSetShapeConstraint("evaluator", "chief", 1).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("podSetShapeConstraints").Index(0).Child("ratio"), nil, ""),
+				field.Invalid(specPath.Child("podSetShapeConstraints").Index(1).Child("relativeTo"), nil, ""),
+				field.NotFound(specPath.Child("podSetShapeConstraints").Index(2).Child("podSet"), nil),
+				field.NotFound(specPath.Child("podSetShapeConstraints").Index(2).Child("relativeTo"), nil),
+			}.ToAggregate(),
+		},
+		"shape constraint with a maxCount": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+					*utiltestingapi.MakePodSet("worker", 32).SetMinimumCount(8).Obj(),
+				).
+				PodSetShapeConstraint("worker", "ps", 8).
+				PodSetShapeConstraintMaxCount("worker", 24).
+				Obj(),
+		},
+		"invalid maxCount of shape constraints": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps", 4).SetMinimumCount(1).Obj(),
+					*utiltestingapi.MakePodSet("worker", 32).SetMinimumCount(16).Obj(),
+					*utiltestingapi.MakePodSet("evaluator", 64).Obj(),
+					*utiltestingapi.MakePodSet("reader", 4).Obj(),
+				).
+				PodSetShapeConstraint("worker", "ps", 8).
+				PodSetShapeConstraintMaxCount("worker", 8).
+				PodSetShapeConstraint("evaluator", "worker", 2).
+				PodSetShapeConstraintMaxCount("evaluator", 63).
+				PodSetShapeConstraint("reader", "ps", 1).
+				PodSetShapeConstraintMaxCount("reader", 8).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("podSetShapeConstraints").Index(0).Child("maxCount"), nil, ""),
+				field.Invalid(specPath.Child("podSetShapeConstraints").Index(1).Child("maxCount"), nil, ""),
+				field.Invalid(specPath.Child("podSetShapeConstraints").Index(2).Child("maxCount"), nil, ""),
+			}.ToAggregate(),
+		},
+		"maxCount of a shape constraint without partial admission": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps", 4).Obj(),
+					*utiltestingapi.MakePodSet("worker", 32).Obj(),
+				).
+				PodSetShapeConstraint("worker", "ps", 8).
+				PodSetShapeConstraintMaxCount("worker", 16).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("podSetShapeConstraints").Index(0).Child("maxCount"), nil, ""),
+			}.ToAggregate(),
+		},
+		"shape constraints forming a cycle": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps", 4).Obj(),
+					*utiltestingapi.MakePodSet("worker", 4).Obj(),
+				).
+				PodSetShapeConstraint("worker", "ps", 1).
+				PodSetShapeConstraint("ps", "worker", 1).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("podSetShapeConstraints"), nil, ""),
+			}.ToAggregate(),
+		},
 		"valid priority-boost": {
 			featureGates: map[featuregate.Feature]bool{features.PriorityBoost: true},
 			workload: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
@@ -1301,6 +1390,27 @@ func TestValidateWorkloadUpdate(t *testing.T) {
 				field.Invalid(field.NewPath("spec", "podSets", "1"), nil, ""),
 			}.ToAggregate(),
 		},
+		"workload.podSetShapeConstraints is immutable while quota is reserved": {
+			featureGates: map[featuregate.Feature]bool{features.PodSetShapeConstraints: true},
+			before: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps1", 2).Obj(),
+					*utiltestingapi.MakePodSet("ps2", 4).Obj()).
+				PodSetShapeConstraint("ps2", "ps1", 2).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").PodSets(
+					kueue.PodSetAssignment{Name: "ps1"},
+					kueue.PodSetAssignment{Name: "ps2"}).Obj(), now).Obj(),
+			after: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(
+					*utiltestingapi.MakePodSet("ps1", 2).Obj(),
+					*utiltestingapi.MakePodSet("ps2", 4).Obj()).
+				ReserveQuotaAt(utiltestingapi.MakeAdmission("cluster-queue").PodSets(
+					kueue.PodSetAssignment{Name: "ps1"},
+					kueue.PodSetAssignment{Name: "ps2"}).Obj(), now).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("podSetShapeConstraints"), nil, ""),
+			}.ToAggregate(),
+		},
 		"workload.podSets[].count is mutable with ElasticJobs feature gate": {
 			featureGates: map[featuregate.Feature]bool{features.ElasticJobsViaWorkloadSlices: true},
 			before: utiltestingapi.MakeWorkload(testWorkloadName, testWorkloadNamespace).
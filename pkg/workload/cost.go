@@ -0,0 +1,34 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Cost returns the cost of the amounts of resources, given the prices of one
+// unit of each resource. Resources without a price are free.
+func Cost(prices, amounts corev1.ResourceList) resource.Quantity {
+	cost := resource.Quantity{Format: resource.DecimalSI}
+	for name, amount := range amounts {
+		if price, found := prices[name]; found {
+			cost.Add(multiplyResourceQuantities(price, amount))
+		}
+	}
+	return cost
+}
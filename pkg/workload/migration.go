@@ -0,0 +1,83 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"slices"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+// maxMigrations is the number of the most recent migrations kept in the
+// status of a workload.
+const maxMigrations = 8
+
+// IsMigrationRequested returns true if the migration of the workload to
+// another MultiKueue worker cluster is requested with an annotation.
+func IsMigrationRequested(wl *kueue.Workload) bool {
+	_, found := wl.Annotations[kueue.MultiKueueMigrateAnnotation]
+	return found
+}
+
+// AddMigration returns the migrations of the workload with the new migration
+// appended, keeping only the most recent ones.
+func AddMigration(wl *kueue.Workload, migration kueue.WorkloadMigration) []kueue.WorkloadMigration {
+	migrations := append(slices.Clone(wl.Status.Migrations), migration)
+	if len(migrations) > maxMigrations {
+		migrations = migrations[len(migrations)-maxMigrations:]
+	}
+	return migrations
+}
+
+// migrationInProgress returns the most recent migration of the workload if it
+// isn't admitted on another worker cluster yet, or nil.
+func migrationInProgress(wl *kueue.Workload) *kueue.WorkloadMigration {
+	if len(wl.Status.Migrations) == 0 {
+		return nil
+	}
+	last := &wl.Status.Migrations[len(wl.Status.Migrations)-1]
+	if last.TargetCluster != nil {
+		return nil
+	}
+	return last
+}
+
+// SetMigrationTargetCluster records the worker cluster where the workload is
+// admitted as the target of the migration in progress. Returns true if the
+// workload was updated.
+func SetMigrationTargetCluster(wl *kueue.Workload, clusterName string) bool {
+	migration := migrationInProgress(wl)
+	if migration == nil {
+		return false
+	}
+	migration.TargetCluster = &clusterName
+	return true
+}
+
+// MigrationExcludedCluster returns the worker cluster which the workload is
+// migrated away from, and which must not be nominated, or an empty string.
+// The cluster is not excluded when it's the only one of the clusters.
+func MigrationExcludedCluster(wl *kueue.Workload, clusters []string) string {
+	migration := migrationInProgress(wl)
+	if migration == nil || !slices.Contains(clusters, migration.SourceCluster) {
+		return ""
+	}
+	if !slices.ContainsFunc(clusters, func(c string) bool { return c != migration.SourceCluster }) {
+		return ""
+	}
+	return migration.SourceCluster
+}
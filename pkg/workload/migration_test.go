@@ -0,0 +1,88 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta2"
+)
+
+func TestMigrationExcludedCluster(t *testing.T) {
+	testCases := map[string]struct {
+		migrations []kueue.WorkloadMigration
+		clusters   []string
+		want       string
+	}{
+		"no migrations": {
+			clusters: []string{"worker1", "worker2"},
+		},
+		"migration in progress": {
+			migrations: []kueue.WorkloadMigration{
+				{SourceCluster: "worker1", TargetCluster: new("worker2")},
+				{SourceCluster: "worker2"},
+			},
+			clusters: []string{"worker1", "worker2"},
+			want:     "worker2",
+		},
+		"migration completed": {
+			migrations: []kueue.WorkloadMigration{{SourceCluster: "worker1", TargetCluster: new("worker2")}},
+			clusters:   []string{"worker1", "worker2"},
+		},
+		"source is the only cluster": {
+			migrations: []kueue.WorkloadMigration{{SourceCluster: "worker1"}},
+			clusters:   []string{"worker1"},
+		},
+		"source is not among the clusters": {
+			migrations: []kueue.WorkloadMigration{{SourceCluster: "worker3"}},
+			clusters:   []string{"worker1", "worker2"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			wl := &kueue.Workload{Status: kueue.WorkloadStatus{Migrations: tc.migrations}}
+			if got := MigrationExcludedCluster(wl, tc.clusters); got != tc.want {
+				t.Errorf("Unexpected excluded cluster, want=%q, got=%q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAddMigration(t *testing.T) {
+	wl := &kueue.Workload{}
+	for i := range maxMigrations + 2 {
+		wl.Status.Migrations = AddMigration(wl, kueue.WorkloadMigration{SourceCluster: fmt.Sprintf("worker%d", i)})
+	}
+	var got []string
+	for _, m := range wl.Status.Migrations {
+		got = append(got, m.SourceCluster)
+	}
+	want := []string{"worker2", "worker3", "worker4", "worker5", "worker6", "worker7", "worker8", "worker9"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected migrations (-want/+got):\n%s", diff)
+	}
+
+	if !SetMigrationTargetCluster(wl, "worker0") {
+		t.Error("Expected the target cluster of the migration in progress to be set")
+	}
+	if SetMigrationTargetCluster(wl, "worker1") {
+		t.Error("Unexpected update of the target cluster of a completed migration")
+	}
+}
@@ -135,6 +135,8 @@ func admissionStatusPatch(w *kueue.Workload, wlCopy *kueue.Workload) {
 	wlCopy.Status.NominatedClusterNames = w.Status.NominatedClusterNames
 	wlCopy.Status.UnhealthyNodes = w.Status.UnhealthyNodes
 	wlCopy.Status.PreemptionGates = w.Status.PreemptionGates
+	wlCopy.Status.Migrations = w.Status.Migrations
+	wlCopy.Status.TopologyPlacementExplanations = w.Status.TopologyPlacementExplanations
 }
 
 func admissionChecksStatusPatch(w *kueue.Workload, wlCopy *kueue.Workload, c clock.Clock) {
@@ -17,6 +17,7 @@ limitations under the License.
 package workload
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
 	"encoding/json"
@@ -308,6 +309,9 @@ type TopologyDomainRequests struct {
 	SinglePodRequests resources.Requests
 	// Count indicates how many pods are requested in this TopologyDomain.
 	Count int32
+	// Topology is the name of the Topology of the domain, set only for the
+	// ResourceFlavors referencing several topologies.
+	Topology kueue.TopologyReference
 }
 
 func (t *TopologyDomainRequests) TotalRequests() resources.Requests {
@@ -748,6 +752,7 @@ func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
 					Values:            req.Values,
 					SinglePodRequests: singlePodRequests,
 					Count:             req.Count,
+					Topology:          ptr.Deref(psa.TopologyAssignment.TopologyName, ""),
 				})
 			}
 		}
@@ -905,6 +910,20 @@ func TotalExecutionTime(wl *kueue.Workload) *time.Duration {
 	return new(accumulatedPast + finishedCond.LastTransitionTime.Sub(admittedCond.LastTransitionTime.Time))
 }
 
+// ExpectedEndTime returns the time at which an admitted workload reaches its
+// maximum execution time, or nil if not applicable.
+func ExpectedEndTime(wl *kueue.Workload) *time.Time {
+	if wl.Spec.MaximumExecutionTimeSeconds == nil {
+		return nil
+	}
+	admittedCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadAdmitted)
+	if admittedCond == nil || admittedCond.Status != metav1.ConditionTrue {
+		return nil
+	}
+	remaining := time.Duration(*wl.Spec.MaximumExecutionTimeSeconds-ptr.Deref(wl.Status.AccumulatedPastExecutionTimeSeconds, 0)) * time.Second
+	return new(admittedCond.LastTransitionTime.Add(remaining))
+}
+
 func QueuedWaitTime(wl *kueue.Workload, clock clock.Clock) time.Duration {
 	queuedTime := wl.CreationTimestamp.Time
 	if c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadRequeued); c != nil {
@@ -950,6 +969,10 @@ func SetQuotaReservation(w *kueue.Workload, admission *kueue.Admission, clock cl
 		changed = true
 	}
 
+	if SetTopologyPlacementExplanations(w, nil) {
+		changed = true
+	}
+
 	return changed
 }
 
@@ -1173,6 +1196,16 @@ func PropagateResourceRequests(w *kueue.Workload, info *Info, formatter *resourc
 	return true
 }
 
+// SetTopologyPlacementExplanations sets w.Status.TopologyPlacementExplanations
+// to explanations and returns true if w was updated.
+func SetTopologyPlacementExplanations(w *kueue.Workload, explanations []kueue.TopologyPlacementExplanation) bool {
+	if equality.Semantic.DeepEqual(w.Status.TopologyPlacementExplanations, explanations) {
+		return false
+	}
+	w.Status.TopologyPlacementExplanations = explanations
+	return true
+}
+
 type Ordering struct {
 	PodsReadyRequeuingTimestamp config.RequeuingTimestamp
 }
@@ -1200,6 +1233,24 @@ func (o Ordering) GetQueueOrderTimestamp(w *kueue.Workload) *metav1.Time {
 	return &w.CreationTimestamp
 }
 
+// Compare orders the workloads by priority, higher first, and then by their
+// queue order timestamp, earlier first.
+func (o Ordering) Compare(log logr.Logger, a, b *kueue.Workload) int {
+	// Higher priority comes first (reverse order).
+	if cmpResult := cmp.Compare(priority.EffectivePriority(log, b), priority.EffectivePriority(log, a)); cmpResult != 0 {
+		return cmpResult
+	}
+	tA := o.GetQueueOrderTimestamp(a)
+	tB := o.GetQueueOrderTimestamp(b)
+	if !tA.Equal(tB) {
+		if tA.Before(tB) {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
 // HasQuotaReservation checks if workload is admitted based on conditions
 func HasQuotaReservation(w *kueue.Workload) bool {
 	return apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadQuotaReserved)
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"slices"
 
+	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -37,6 +38,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	"sigs.k8s.io/kueue/pkg/workload"
 	workloadevict "sigs.k8s.io/kueue/pkg/workload/evict"
 	workloadfinish "sigs.k8s.io/kueue/pkg/workload/finish"
@@ -414,3 +416,31 @@ func FindReplacedSliceTarget(preemptor *kueue.Workload, targets []*preemption.Ta
 	}
 	return targets, nil
 }
+
+// DeleteUngatedPods deletes the pods of an elastic job, matching selector in the
+// given namespace, from which the ElasticJobSchedulingGate has been removed.
+// It is used to stop the jobs whose pods are recreated by their controller from
+// the pod template, such as Deployments and StatefulSets, which cannot be
+// suspended: the recreated pods remain gated until the job is admitted again.
+func DeleteUngatedPods(ctx context.Context, clnt client.Client, namespace string, selector *metav1.LabelSelector) error {
+	podSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return err
+	}
+	var pods corev1.PodList
+	if err := clnt.List(ctx, &pods, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: podSelector}); err != nil {
+		return err
+	}
+	log := ctrl.LoggerFrom(ctx)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if utilpod.IsTerminated(pod) || pod.DeletionTimestamp != nil || utilpod.HasGate(pod, kueue.ElasticJobSchedulingGate) {
+			continue
+		}
+		log.V(3).Info("Deleting ungated elastic pod", "pod", klog.KObj(pod))
+		if err := clnt.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
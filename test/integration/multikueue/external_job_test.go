@@ -82,87 +82,9 @@ var _ = ginkgo.Describe(
 				// TODO https://github.com/kubernetes-sigs/kueue/issues/9022 (Eliminate the global state RayJob reconciler)
 				// Originally this test uses RayJob, we hit a global variable issue `var reconciler rayJobReconciler`.
 				// Change to use RayCluster now. When the global variable is solved, change back to use RayJob in this test.
-				managerTestCluster.fwk.StartManager(
-					managerTestCluster.ctx,
-					managerTestCluster.cfg,
-					func(ctx context.Context, mgr manager.Manager) {
-						// Set up core controllers and RayCluster webhook (but not MultiKueue integration)
-						err := indexer.Setup(ctx, mgr.GetFieldIndexer())
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-
-						cCache := schdcache.New(mgr.GetClient())
-						preemptionExpectations := preemptexpectations.New()
-						queueOptions := []qcache.Option{qcache.WithPreemptionExpectations(preemptionExpectations)}
-						queues := util.NewManagerForIntegrationTests(ctx, mgr.GetClient(), cCache, queueOptions...)
-
-						configuration := &config.Configuration{}
-						mgr.GetScheme().Default(configuration)
-
-						failedCtrl, err := core.SetupControllers(
-							mgr,
-							queues,
-							cCache,
-							configuration,
-							core.SetupControllersOpts{PreemptionExpectations: preemptionExpectations},
-						)
-						gomega.Expect(err).ToNot(gomega.HaveOccurred(), "controller", failedCtrl)
-
-						failedWebhook, err := webhooks.Setup(mgr, nil)
-						gomega.Expect(err).ToNot(gomega.HaveOccurred(), "webhook", failedWebhook)
-
-						// Set up RayCluster webhook (but not MultiKueue integration)
-						err = workloadraycluster.SetupIndexes(ctx, mgr.GetFieldIndexer())
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-
-						rayclusterReconciler, _ := workloadraycluster.NewReconciler(
-							ctx,
-							mgr.GetClient(),
-							mgr.GetFieldIndexer(),
-							mgr.GetEventRecorder(constants.JobControllerName))
-						err = rayclusterReconciler.SetupWithManager(mgr)
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-
-						err = workloadraycluster.SetupRayClusterWebhook(
-							mgr,
-							jobframework.WithCache(cCache),
-							jobframework.WithQueues(queues),
-						)
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-
-						// Set up multikueue with external frameworks only
-						err = multikueue.SetupIndexer(ctx, mgr.GetFieldIndexer(), managersConfigNamespace.Name)
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-
-						cfg := &config.Configuration{}
-						mgr.GetScheme().Default(cfg)
-						cfg.MultiKueue.ExternalFrameworks = []config.MultiKueueExternalFramework{
-							{
-								Name: "RayCluster.v1.ray.io",
-							},
-						}
-
-						// Get external adapters for MultiKueue synchronization
-						externalAdapters, err := externalframeworks.NewAdapters(cfg.MultiKueue.ExternalFrameworks)
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-						adapters := make(map[string]jobframework.MultiKueueAdapter)
-						for _, adapter := range externalAdapters {
-							gvk := adapter.GVK()
-							adapters[gvk.String()] = adapter
-						}
-
-						err = multikueue.SetupControllers(mgr, managersConfigNamespace.Name,
-							multikueue.WithGCInterval(2*time.Second),
-							multikueue.WithWorkerLostTimeout(testingWorkerLostTimeout),
-							multikueue.WithEventsBatchPeriod(250*time.Millisecond),
-							multikueue.WithAdapters(adapters),
-							multikueue.WithDispatcherName(config.MultiKueueDispatcherModeAllAtOnce),
-						)
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-
-						_, err = workloaddispatcher.SetupControllers(mgr, configuration, nil)
-						gomega.Expect(err).NotTo(gomega.HaveOccurred())
-					},
-				)
+				managerTestCluster.fwk.StartManager(managerTestCluster.ctx, managerTestCluster.cfg, func(ctx context.Context, mgr manager.Manager) {
+					managerAndExternalRayClusterSetup(ctx, mgr)
+				})
 			})
 
 			ginkgo.AfterAll(func() {
@@ -606,3 +528,83 @@ var _ = ginkgo.Describe(
 		})
 	},
 )
+
+// managerAndExternalRayClusterSetup sets up the core controllers and the RayCluster webhook,
+// and MultiKueue with the external RayCluster adapter only.
+func managerAndExternalRayClusterSetup(ctx context.Context, mgr manager.Manager, opts ...multikueue.SetupOption) {
+	// Set up core controllers and RayCluster webhook (but not MultiKueue integration)
+	err := indexer.Setup(ctx, mgr.GetFieldIndexer())
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	cCache := schdcache.New(mgr.GetClient())
+	preemptionExpectations := preemptexpectations.New()
+	queueOptions := []qcache.Option{qcache.WithPreemptionExpectations(preemptionExpectations)}
+	queues := util.NewManagerForIntegrationTests(ctx, mgr.GetClient(), cCache, queueOptions...)
+
+	configuration := &config.Configuration{}
+	mgr.GetScheme().Default(configuration)
+
+	failedCtrl, err := core.SetupControllers(
+		mgr,
+		queues,
+		cCache,
+		configuration,
+		core.SetupControllersOpts{PreemptionExpectations: preemptionExpectations},
+	)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred(), "controller", failedCtrl)
+
+	failedWebhook, err := webhooks.Setup(mgr, nil)
+	gomega.Expect(err).ToNot(gomega.HaveOccurred(), "webhook", failedWebhook)
+
+	// Set up RayCluster webhook (but not MultiKueue integration)
+	err = workloadraycluster.SetupIndexes(ctx, mgr.GetFieldIndexer())
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	rayclusterReconciler, _ := workloadraycluster.NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		mgr.GetFieldIndexer(),
+		mgr.GetEventRecorder(constants.JobControllerName))
+	err = rayclusterReconciler.SetupWithManager(mgr)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	err = workloadraycluster.SetupRayClusterWebhook(
+		mgr,
+		jobframework.WithCache(cCache),
+		jobframework.WithQueues(queues),
+	)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// Set up multikueue with external frameworks only
+	err = multikueue.SetupIndexer(ctx, mgr.GetFieldIndexer(), managersConfigNamespace.Name)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	cfg := &config.Configuration{}
+	mgr.GetScheme().Default(cfg)
+	cfg.MultiKueue.ExternalFrameworks = []config.MultiKueueExternalFramework{
+		{
+			Name: "RayCluster.v1.ray.io",
+		},
+	}
+
+	// Get external adapters for MultiKueue synchronization
+	externalAdapters, err := externalframeworks.NewAdapters(cfg.MultiKueue.ExternalFrameworks)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	adapters := make(map[string]jobframework.MultiKueueAdapter)
+	for _, adapter := range externalAdapters {
+		gvk := adapter.GVK()
+		adapters[gvk.String()] = adapter
+	}
+
+	err = multikueue.SetupControllers(mgr, managersConfigNamespace.Name, append([]multikueue.SetupOption{
+		multikueue.WithGCInterval(2 * time.Second),
+		multikueue.WithWorkerLostTimeout(testingWorkerLostTimeout),
+		multikueue.WithEventsBatchPeriod(250 * time.Millisecond),
+		multikueue.WithAdapters(adapters),
+		multikueue.WithDispatcherName(config.MultiKueueDispatcherModeAllAtOnce),
+	}, opts...)...)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+	_, err = workloaddispatcher.SetupControllers(mgr, configuration, nil, nil)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+}
@@ -501,7 +501,7 @@ func managerAndMultiKueueSetup(
 		},
 	}
 	mgr.GetScheme().Default(configuration)
-	_, err = workloaddispatcher.SetupControllers(mgr, configuration, nil)
+	_, err = workloaddispatcher.SetupControllers(mgr, configuration, nil, nil)
 	gomega.Expect(err).NotTo(gomega.HaveOccurred())
 }
 